@@ -0,0 +1,5 @@
+//go:build !curve1174_avx2
+
+package curve1174
+
+const avx2Enabled = false
@@ -0,0 +1,33 @@
+package curve1174
+
+//backendNames describes implementations returned by backend
+var backendNames = map[string]string{
+	"adx":       "amd64 assembly with BMI2/ADX",
+	"noadx":     "amd64 assembly without BMI2/ADX",
+	"arm64":     "arm64 assembly",
+	"generic":   "pure Go",
+	"generic32": "pure Go with 32-bit words",
+	"radix51":   "pure Go with radix 2^51 multiplication",
+}
+
+//Backend describes field arithmetic selected by build tags and CPU features (e.g. "amd64 assembly with BMI2/ADX"),
+//it's meant for logs and bug reports
+func Backend() string {
+	name := backendNames[backend()]
+	if useAVX2 {
+		name += ", AVX2 four-way point arithmetic"
+	}
+	return name
+}
+
+//setBackend selects one of implementations returned by backends (it's used by tests to cover all code paths in one
+//binary), it returns false if name isn't available
+func setBackend(name string) bool {
+	for _, b := range backends() {
+		if b == name {
+			useBackend(name)
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,49 @@
+package curve1174
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"testing"
+)
+
+//testBackendEnv selects backend (one of backends()) for the whole test binary
+const testBackendEnv = "CURVE1174_TEST_BACKEND"
+
+func TestMain(m *testing.M) {
+	if name := os.Getenv(testBackendEnv); name != "" && !setBackend(name) {
+		fmt.Fprintf(os.Stderr, "%s=%s: backend not available, available: %v\n", testBackendEnv, name, backends())
+		os.Exit(1)
+	}
+	os.Exit(m.Run())
+}
+
+func TestBackend(t *testing.T) {
+	t.Log(Backend())
+	if backendNames[backend()] == "" {
+		t.Errorf("unknown backend %q", backend())
+	}
+	if !setBackend(backend()) {
+		t.Errorf("backend %q not in %v", backend(), backends())
+	}
+}
+
+//TestBackends runs all tests again with every other backend available in this binary (e.g. code without BMI2/ADX
+//on CPUs that support them)
+func TestBackends(t *testing.T) {
+	if testing.Short() || os.Getenv(testBackendEnv) != "" {
+		t.Skip("other backends are tested only in long mode")
+	}
+	for _, name := range backends() {
+		if name == backend() {
+			continue
+		}
+		t.Run(name, func(t *testing.T) {
+			cmd := exec.Command(os.Args[0])
+			cmd.Env = append(os.Environ(), testBackendEnv+"="+name)
+			if out, err := cmd.CombinedOutput(); err != nil {
+				t.Fatalf("%v\n%s", err, out)
+			}
+		})
+	}
+}
@@ -0,0 +1,163 @@
+package curve1174
+
+import (
+	"bytes"
+	crand "crypto/rand"
+	"errors"
+	"io"
+	"math/big"
+	"testing"
+)
+
+//lowOrderPoints are points of order 2 and 4, they are outside of subgroup generated by Base
+var lowOrderPoints = []*Point{
+	{X: UZero, Y: FieldElement{P0 - 1, P1, P2, P3}, Z: *UOne},
+	{X: *UOne, Y: UZero, Z: *UOne},
+}
+
+func TestGroupOrder(t *testing.T) {
+	var sp Point
+	sp.Add(Base, lowOrderPoints[1])
+	for _, point := range []*Point{Base, &sp, lowOrderPoints[0], lowOrderPoints[1]} {
+		var p Point
+		p.ScalarMult(point, &groupOrder).ToAffine(&p)
+		if !p.Equals(E) {
+			t.Errorf("%x", &p)
+		}
+	}
+}
+
+func TestScalarMultBlinded(t *testing.T) {
+	var sp, sp2 Point
+	sp.ScalarMult(Base, &FieldElement{0xDEADBEEF, 0xCAFEBABE})
+	sp2.Add(&sp, lowOrderPoints[1])
+	randomTestOp(t, func(res *FieldElement, x *FieldElement, y *FieldElement) {
+		var p Point
+		if _, err := p.ScalarMultBlinded(&sp2, x, crand.Reader); err != nil {
+			t.Fatal(err)
+		}
+		p.ToAffine(&p)
+		res.Set(&p.X)
+	}, func(res *big.Int, x *big.Int, y *big.Int) {
+		var p Point
+		p.ScalarMult(&sp2, FromBigInt(x)).ToAffine(&p)
+		res.Set(p.X.ToBigInt())
+	}, 100)
+
+	vectors := []FieldElement{{}, {1}, groupOrder, {^uint64(0), ^uint64(0), ^uint64(0), ^uint64(0)}}
+	for _, b := range vectors {
+		for _, point := range []*Point{Base, &sp, lowOrderPoints[0], lowOrderPoints[1]} {
+			var p1, p2, p3 Point
+			if _, err := p1.ScalarMultBlinded(point, &b, crand.Reader); err != nil {
+				t.Fatal(err)
+			}
+			p1.ToAffine(&p1)
+			p2.ScalarMult(point, &b).ToAffine(&p2)
+			if !p1.Equals(&p2) || !p1.T.Equals(&p2.T) {
+				t.Errorf("%x\n%x\n%x", &b, &p1, &p2)
+			}
+			if point == Base {
+				if _, err := p3.ScalarBaseMultBlinded(&b, crand.Reader); err != nil {
+					t.Fatal(err)
+				}
+				if !p3.ToAffine(&p3).Equals(&p2) {
+					t.Errorf("%x\n%x\n%x", &b, &p3, &p2)
+				}
+			}
+		}
+	}
+}
+
+func TestBlindScalar(t *testing.T) {
+	b := FieldElement{^uint64(0), ^uint64(0), ^uint64(0), ^uint64(0)}
+	var k [5]uint64
+	if err := blindScalar(&k, &b, bytes.NewReader(bytes.Repeat([]byte{0xFF}, 8))); err != nil {
+		t.Fatal(err)
+	}
+	expected := new(big.Int).SetUint64(^uint64(0))
+	expected.Mul(expected, groupOrder.ToBigInt()).Add(expected, b.ToBigInt())
+	res := new(big.Int)
+	for i := len(k) - 1; i >= 0; i-- {
+		res.Lsh(res, 64).Or(res, new(big.Int).SetUint64(k[i]))
+	}
+	if res.Cmp(expected) != 0 {
+		t.Errorf("\n%x\n%x", res, expected)
+	}
+}
+
+type failingReader struct{}
+
+var errRead = errors.New("read failed")
+
+func (failingReader) Read([]byte) (int, error) {
+	return 0, errRead
+}
+
+func TestScalarMultBlindedReaderError(t *testing.T) {
+	var p Point
+	p.Set(Base)
+	for _, r := range []io.Reader{failingReader{}, bytes.NewReader(make([]byte, 10))} {
+		res, err := p.ScalarMultBlinded(Base, &FieldElement{5}, r)
+		if err == nil || res != nil || !p.Equals(Base) {
+			t.Errorf("expected error, got %v %v", res, err)
+		}
+	}
+	//zero Z is rejected and next one is read
+	data := append(make([]byte, 8+32), 1)
+	data = append(data, make([]byte, 31)...)
+	if _, err := p.ScalarMultBlinded(Base, &FieldElement{5}, bytes.NewReader(data)); err != nil {
+		t.Fatal(err)
+	}
+	var expected Point
+	expected.ScalarMult(Base, &FieldElement{5}).ToAffine(&expected)
+	if !p.ToAffine(&p).Equals(&expected) {
+		t.Errorf("\n%x\n%x", &p, &expected)
+	}
+}
+
+func TestScalarBaseMultBlinded(t *testing.T) {
+	defer SetBasePrecomputation(GetBasePrecomputation())
+	vectors := []FieldElement{{}, {1}, {0xDEADBEEF, 0xCAFEBABE}, order, groupOrder,
+		{^uint64(0), ^uint64(0), ^uint64(0), ^uint64(0)}}
+	for _, level := range []BasePrecomputation{PrecomputeNone, PrecomputeSmall, PrecomputeBig} {
+		SetBasePrecomputation(level)
+		for _, b := range vectors {
+			//zeros and 0xFF bytes give the smallest and the largest s, so both branches of splitScalar are checked
+			for _, r := range []io.Reader{crand.Reader, io.MultiReader(bytes.NewReader(make([]byte, 31)), crand.Reader),
+				io.MultiReader(bytes.NewReader(bytes.Repeat([]byte{0xFF}, 31)), crand.Reader)} {
+				var p, expected Point
+				if _, err := p.ScalarBaseMultBlinded(&b, r); err != nil {
+					t.Fatal(err)
+				}
+				expected.ScalarMult(Base, &b).ToAffine(&expected)
+				if !p.ToAffine(&p).Equals(&expected) {
+					t.Errorf("%d %x\n%x\n%x", level, &b, &p, &expected)
+				}
+			}
+		}
+		var p Point
+		p.Set(Base)
+		if res, err := p.ScalarBaseMultBlinded(&FieldElement{5}, failingReader{}); err == nil || res != nil ||
+			!p.Equals(Base) {
+			t.Errorf("expected error, got %v %v", res, err)
+		}
+	}
+}
+
+func TestSplitScalar(t *testing.T) {
+	for _, b := range []FieldElement{{}, {1}, order, {^uint64(0), ^uint64(0), ^uint64(0), ^uint64(0)}} {
+		for i := 0; i < 100; i++ {
+			var s, k FieldElement
+			if err := splitScalar(&s, &k, &b, crand.Reader); err != nil {
+				t.Fatal(err)
+			}
+			if s[3]>>56 != 0 {
+				t.Fatalf("s >= 2^248: %x", &s)
+			}
+			sum := new(big.Int).Add(s.ToBigInt(), k.ToBigInt())
+			if sum.Sub(sum, b.ToBigInt()).Mod(sum, order.ToBigInt()).Sign() != 0 {
+				t.Fatalf("%x + %x != %x", &s, &k, &b)
+			}
+		}
+	}
+}
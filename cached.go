@@ -0,0 +1,159 @@
+package curve1174
+
+//CachedPoint is point on curve in form optimized for being added many times (e.g. as part of precomputed table).
+//It stores (Y+X, Y-X, 2dT, 2Z) computed from extended coordinates so additions don't have to recompute them.
+type CachedPoint struct {
+	YPlusX  FieldElement
+	YMinusX FieldElement
+	T2D     FieldElement
+	Z2      FieldElement
+}
+
+//AffineCachedPoint is CachedPoint for point in affine coordinates (Z == 1), so 2Z doesn't have to be stored.
+//It's the form used by precomputed tables
+type AffineCachedPoint struct {
+	YPlusX  FieldElement
+	YMinusX FieldElement
+	T2D     FieldElement
+}
+
+//cachedE is identity element of curve's group in cached form
+var cachedE = CachedPoint{
+	YPlusX:  FieldElement{1},
+	YMinusX: FieldElement{1},
+	Z2:      FieldElement{2},
+}
+
+//affineCachedE is identity element of curve's group in affine cached form
+var affineCachedE = AffineCachedPoint{
+	YPlusX:  FieldElement{1},
+	YMinusX: FieldElement{1},
+}
+
+//SetPoint sets c to cached form of point p
+func (c *CachedPoint) SetPoint(p *Point) *CachedPoint {
+	c.YPlusX.Add(&p.Y, &p.X)
+	c.YMinusX.Sub(&p.Y, &p.X)
+	c.T2D.MulD(&p.T).Mul2(&c.T2D)
+	c.Z2.Mul2(&p.Z)
+	return c
+}
+
+//SetPoint sets c to affine cached form of point p. p has to be in affine coordinates (p.Z == 1)
+func (c *AffineCachedPoint) SetPoint(p *Point) *AffineCachedPoint {
+	c.YPlusX.Add(&p.Y, &p.X).Mod(&c.YPlusX)
+	c.YMinusX.Sub(&p.Y, &p.X).Mod(&c.YMinusX)
+	c.T2D.MulD(&p.T).Mul2(&c.T2D).Mod(&c.T2D)
+	return c
+}
+
+//setAffineCached sets p to point represented by c. It's much cheaper than adding c to E
+func (p *Point) setAffineCached(c *AffineCachedPoint) *Point {
+	//(Y+X)-(Y-X) == 2X and (Y+X)+(Y-X) == 2Y so with Z == 4 we get X' = 4X, Y' = 4Y and T' = 4XY = 2X * 2Y
+	var x2, y2 FieldElement
+	x2.Sub(&c.YPlusX, &c.YMinusX)
+	y2.Add(&c.YPlusX, &c.YMinusX)
+	p.T.Mul(&x2, &y2)
+	p.X.Mul2(&x2)
+	p.Y.Mul2(&y2)
+	p.Z.Set(&FieldElement{4})
+	return p
+}
+
+//AddCached adds point p1 and cached point p2 and stores result in p
+func (p *Point) AddCached(p1 *Point, p2 *CachedPoint) *Point {
+	if fusedPointOps() {
+		pointAddCached(p, p1, p2)
+		return p
+	}
+	var e, f, g, h, zz FieldElement
+	zz.Mul(&p1.Z, &p2.Z2)
+	cachedSum(&e, &f, &g, &h, p1, &p2.YPlusX, &p2.YMinusX, &p2.T2D, &zz)
+	return p.setEFGH(&e, &f, &g, &h)
+}
+
+//SubCached subtracts cached point p2 from point p1 and stores result in p
+func (p *Point) SubCached(p1 *Point, p2 *CachedPoint) *Point {
+	var e, f, g, h, zz FieldElement
+	zz.Mul(&p1.Z, &p2.Z2)
+	//-(X, Y, T, Z) == (-X, Y, -T, Z), so Y+X and Y-X swap places and F and G swap places
+	cachedSum(&e, &g, &f, &h, p1, &p2.YMinusX, &p2.YPlusX, &p2.T2D, &zz)
+	return p.setEFGH(&e, &f, &g, &h)
+}
+
+//AddAffineCached adds point p1 and affine cached point p2 and stores result in p
+func (p *Point) AddAffineCached(p1 *Point, p2 *AffineCachedPoint) *Point {
+	if fusedPointOps() {
+		pointAddAffineCached(p, p1, p2)
+		return p
+	}
+	var e, f, g, h, zz FieldElement
+	zz.Mul2(&p1.Z)
+	cachedSum(&e, &f, &g, &h, p1, &p2.YPlusX, &p2.YMinusX, &p2.T2D, &zz)
+	return p.setEFGH(&e, &f, &g, &h)
+}
+
+//SubAffineCached subtracts affine cached point p2 from point p1 and stores result in p
+func (p *Point) SubAffineCached(p1 *Point, p2 *AffineCachedPoint) *Point {
+	var e, f, g, h, zz FieldElement
+	zz.Mul2(&p1.Z)
+	cachedSum(&e, &g, &f, &h, p1, &p2.YMinusX, &p2.YPlusX, &p2.T2D, &zz)
+	return p.setEFGH(&e, &f, &g, &h)
+}
+
+//setEFGH sets p to (EF, GH, EH, FG), last step of all addition formulas
+func (p *Point) setEFGH(e, f, g, h *FieldElement) *Point {
+	p.X.Mul(e, f)
+	p.Y.Mul(g, h)
+	p.T.Mul(e, h)
+	p.Z.Mul(f, g)
+	return p
+}
+
+//cachedSum computes E, F, G and H of addition formula from
+//https://www.hyperelliptic.org/EFD/g1p/auto-twisted-extended.html#addition-add-2008-hwcd (all of them multiplied by 2)
+//for p1 and cached point (yPlusX, yMinusX, t2d). zz has to be 2*Z1*Z2.
+//Curve1174 has a == 1 so Y1Y2+X1X2 trick used for a == -1 curves doesn't apply directly, H is computed from
+//(Y1+X1)(Y2+X2) + (Y1-X1)(Y2-X2) == 2(X1X2+Y1Y2) and 2X1X2 == X1((Y2+X2)-(Y2-X2)) instead
+func cachedSum(e, f, g, h *FieldElement, p1 *Point, yPlusX, yMinusX, t2d, zz *FieldElement) {
+	var pp, mm, a, c FieldElement
+	pp.Add(&p1.Y, &p1.X).Mul(&pp, yPlusX)
+	mm.Sub(&p1.Y, &p1.X).Mul(&mm, yMinusX)
+	a.Sub(yPlusX, yMinusX).Mul(&a, &p1.X)
+	c.Mul(&p1.T, t2d)
+	e.Sub(&pp, &mm)
+	h.Add(&pp, &mm).Sub(h, &a).Sub(h, &a)
+	f.Sub(zz, &c)
+	g.Add(zz, &c)
+}
+
+//condSet sets c to c2 if set == 1 and leaves it unchanged if set == 0. Execution time doesn't depend on set.
+func (c *AffineCachedPoint) condSet(c2 *AffineCachedPoint, set uint64) *AffineCachedPoint {
+	c.YPlusX.condSet(&c2.YPlusX, set)
+	c.YMinusX.condSet(&c2.YMinusX, set)
+	c.T2D.condSet(&c2.T2D, set)
+	return c
+}
+
+//condNeg negates c if neg == 1 and leaves it unchanged if neg == 0. Execution time doesn't depend on neg.
+func (c *AffineCachedPoint) condNeg(neg uint64) *AffineCachedPoint {
+	var t FieldElement
+	t.Sub(&zero, &c.T2D)
+	c.YPlusX.condSwap(&c.YMinusX, neg)
+	c.T2D.condSet(&t, neg)
+	return c
+}
+
+//isOnCurve checks if c represents valid point on curve with correctly computed T2D
+func (c *AffineCachedPoint) isOnCurve() bool {
+	var p Point
+	p.setAffineCached(c)
+	if !p.IsOnCurve() {
+		return false
+	}
+	//p.T == 4xy and c.T2D == 2dxy
+	var t, t2d FieldElement
+	t.MulD(&p.T)
+	t2d.Mul2(&c.T2D)
+	return t.Equals(&t2d)
+}
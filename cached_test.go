@@ -0,0 +1,104 @@
+package curve1174
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestAddCached(t *testing.T) {
+	var p1, p2, sum, expected Point
+	var c CachedPoint
+	p1.ScalarBaseMult(&FieldElement{12345})
+	p2.ScalarBaseMult(&FieldElement{0, 0, 777})
+	c.SetPoint(&p2)
+	sum.AddCached(&p1, &c).ToAffine(&sum)
+	expected.Add(&p1, &p2).ToAffine(&expected)
+	if !sum.Equals(&expected) {
+		t.Errorf("not equal %x %x", &sum, &expected)
+	}
+	sum.SubCached(&sum, &c).ToAffine(&sum)
+	expected.ToAffine(&p1)
+	if !sum.Equals(&expected) {
+		t.Errorf("not equal %x %x", &sum, &expected)
+	}
+}
+
+func TestAddAffineCached(t *testing.T) {
+	var p1, p2, sum, expected Point
+	var c AffineCachedPoint
+	p1.ScalarBaseMult(&FieldElement{12345})
+	p2.ScalarBaseMult(&FieldElement{0, 0, 777}).ToAffine(&p2)
+	c.SetPoint(&p2)
+	sum.AddAffineCached(&p1, &c).ToAffine(&sum)
+	expected.AddZ1(&p1, &p2).ToAffine(&expected)
+	if !sum.Equals(&expected) {
+		t.Errorf("not equal %x %x", &sum, &expected)
+	}
+	sum.SubAffineCached(&sum, &c).ToAffine(&sum)
+	expected.ToAffine(&p1)
+	if !sum.Equals(&expected) {
+		t.Errorf("not equal %x %x", &sum, &expected)
+	}
+	sum.setAffineCached(&c).ToAffine(&sum)
+	if !sum.Equals(&p2) || !sum.T.Equals(&p2.T) {
+		t.Errorf("not equal %x %x", &sum, &p2)
+	}
+}
+
+func TestAddCachedNeutralElement(t *testing.T) {
+	var out Point
+	out.AddCached(Base, &cachedE).ToAffine(&out)
+	if !Base.Equals(&out) {
+		t.Errorf("not equal %x %x", Base, &out)
+	}
+	out.AddAffineCached(Base, &affineCachedE).ToAffine(&out)
+	if !Base.Equals(&out) {
+		t.Errorf("not equal %x %x", Base, &out)
+	}
+}
+
+func TestScalarMultCached(t *testing.T) {
+	randomTestOp(t, func(res *FieldElement, x *FieldElement, y *FieldElement) {
+		var p Point
+		p.ScalarMult(Base, x).ToAffine(&p)
+		res.Set(&p.X)
+	}, func(res *big.Int, x *big.Int, y *big.Int) {
+		var p, acc Point
+		acc.Set(E)
+		p.Set(Base)
+		for i := 0; i < x.BitLen(); i++ {
+			if x.Bit(i) == 1 {
+				acc.Add(&acc, &p)
+			}
+			p.Double(&p)
+		}
+		acc.ToAffine(&acc)
+		res.Set(acc.X.ToBigInt())
+	}, 1000)
+}
+
+func TestSelectCached(t *testing.T) {
+	var points [16]CachedPoint
+	var affinePoints [16]AffineCachedPoint
+	var res CachedPoint
+	var affineRes AffineCachedPoint
+	for i := 0; i < 16; i++ {
+		u := uint64(i + 1)
+		u = u + (u << 32)
+		points[i].YPlusX = FieldElement{u, u, u, u}
+		points[i].YMinusX = FieldElement{0xCAFEBABE}
+		points[i].T2D = FieldElement{0xDEADBEEF, u}
+		points[i].Z2 = FieldElement{0xCAFEBABE, 0, u}
+		affinePoints[i] = AffineCachedPoint{points[i].YPlusX, points[i].YMinusX, points[i].T2D}
+	}
+	for i := 0; i < 16; i++ {
+		selectCachedPoint(&res, &points, uint64(i))
+		if res != points[i] {
+			t.Errorf("\n%x\n%x", res, points[i])
+		}
+		selectAffineCachedPoint(&affineRes, &affinePoints, uint64(i))
+		if affineRes != affinePoints[i] {
+			t.Errorf("\n%x\n%x", affineRes, affinePoints[i])
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package curve1174
+
+import "errors"
+
+var (
+	//ErrInvalidPoint is returned by checked operations when input point is not on curve or is not in subgroup
+	//generated by Base
+	ErrInvalidPoint = errors.New("curve1174: point is not on curve or not in prime order subgroup")
+	//ErrFaultDetected is returned by checked operations when intermediate value or result is not valid point, which
+	//means that computation was disturbed (e.g. by induced hardware fault)
+	ErrFaultDetected = errors.New("curve1174: fault detected during computation")
+)
+
+//ScalarMultChecked multiplies point on curve sp by scalar b and stores result in p, like ScalarMult, but with
+//countermeasures against fault attacks. sp has to be on curve and in subgroup generated by Base. During computation
+//precomputed table and intermediate values are verified to be on curve, result is verified to be on curve and in the
+//subgroup. If any check fails p is set to E and error is returned instead of possibly faulty point.
+//It's about 3 times slower than ScalarMult. Execution time doesn't depend on b.
+func (p *Point) ScalarMultChecked(sp *Point, b *FieldElement) (*Point, error) {
+	if !isInSubgroup(sp) {
+		p.Set(&identity)
+		return p, ErrInvalidPoint
+	}
+	var res Point
+	ok := res.scalarMultCheck(sp, b[:], true)
+	return p.setChecked(&res, ok)
+}
+
+//ScalarBaseMultChecked multiplies base point by scalar b and stores result in p, like ScalarBaseMult, but result is
+//verified to be on curve and in subgroup generated by Base. If any check fails p is set to E and error is returned
+//instead of possibly faulty point. Execution time doesn't depend on b.
+//Only the result is verified, there are no checks of intermediate values or precomputed table entries. Corrupted
+//coordinates of the accumulator almost never end up on curve, so such faults are detected, but faults that keep all
+//points valid (e.g. skipped addition or wrong table entry selected) give valid point in the subgroup and aren't.
+func (p *Point) ScalarBaseMultChecked(b *FieldElement) (*Point, error) {
+	var res Point
+	res.ScalarBaseMult(b)
+	return p.setChecked(&res, true)
+}
+
+//setChecked sets p to res if ok is true and res passes final checks, otherwise it sets p to E and returns error
+func (p *Point) setChecked(res *Point, ok bool) (*Point, error) {
+	ok = checkIntermediate(res, false) && ok
+	ok = isInSubgroup(res) && ok
+	if !ok {
+		p.Set(&identity)
+		return p, ErrFaultDetected
+	}
+	return p.Set(res), nil
+}
+
+//checkIntermediate verifies that intermediate point p is on curve, projective means that p.T is not valid and can't be
+//checked
+func checkIntermediate(p *Point, projective bool) bool {
+	injectFault(p)
+	if projective {
+		return p.isOnCurveProjective()
+	}
+	return p.IsOnCurve()
+}
+
+//checkProjective is checkIntermediate for projective point
+func checkProjective(pp *ProjectivePoint) bool {
+	p := Point{X: pp.X, Y: pp.Y, Z: pp.Z}
+	ok := checkIntermediate(&p, true)
+	pp.SetPoint(&p)
+	return ok
+}
+
+//isInSubgroup checks if p is on curve and l*p == E. Execution time doesn't depend on p.
+func isInSubgroup(p *Point) bool {
+	if !p.IsOnCurve() {
+		return false
+	}
+	var lp Point
+	lp.ScalarMult(p, &order)
+	return lp.X.IsZero() && lp.Y.Equals(&lp.Z)
+}
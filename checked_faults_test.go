@@ -0,0 +1,80 @@
+//go:build curve1174_faultinject
+
+package curve1174
+
+import "testing"
+
+//runWithFaults calls f once without faults to count checked intermediate values, then once for every intermediate
+//value with fault injected into it by inject. It verifies that f never returns faulty point: either error and E or
+//correct result is expected. It returns number of detected faults and number of runs.
+func runWithFaults(t *testing.T, f func(p *Point) error, inject func(p *Point)) (int, int) {
+	defer func() { faultHook = nil }()
+	calls := 0
+	faultHook = func(*Point) { calls++ }
+	var expected Point
+	if err := f(&expected); err != nil {
+		t.Fatal(err)
+	}
+	expected.ToAffine(&expected)
+
+	detected := 0
+	for i := 0; i < calls; i++ {
+		call := 0
+		faultHook = func(p *Point) {
+			if call == i {
+				inject(p)
+			}
+			call++
+		}
+		var p Point
+		err := f(&p)
+		if err != nil {
+			detected++
+			if err != ErrFaultDetected || !p.Equals(E) {
+				t.Errorf("fault %d: expected %v and E, got %v %x", i, ErrFaultDetected, err, &p)
+			}
+		} else if !p.ToAffine(&p).Equals(&expected) {
+			t.Errorf("fault %d: faulty point returned %x", i, &p)
+		}
+	}
+	return detected, calls
+}
+
+func TestScalarMultCheckedFaults(t *testing.T) {
+	var sp Point
+	sp.ScalarMult(Base, &FieldElement{0xDEADBEEF, 0xCAFEBABE})
+	b := FieldElement{0x0123456789ABCDEF, 0xFEDCBA9876543210, 0x1111, 0x0222}
+	scalarMult := func(p *Point) error {
+		_, err := p.ScalarMultChecked(&sp, &b)
+		return err
+	}
+	scalarBaseMult := func(p *Point) error {
+		_, err := p.ScalarBaseMultChecked(&b)
+		return err
+	}
+
+	faults := []struct {
+		name   string
+		inject func(p *Point)
+		all    bool
+	}{
+		{"X", func(p *Point) { p.X[0] ^= 1 }, true},
+		{"Y", func(p *Point) { p.Y[2] ^= 1 << 17 }, true},
+		{"Z", func(p *Point) { p.Z[3] ^= 1 << 40 }, true},
+		{"Z=0", func(p *Point) { p.Z = UZero }, true},
+		//T of projective intermediate values isn't used so fault in it may be harmless
+		{"T", func(p *Point) { p.T[1] ^= 1 << 5 }, false},
+		{"low order", func(p *Point) { p.Add(p, lowOrderPoints[1]) }, false},
+	}
+	for _, fault := range faults {
+		for _, f := range []func(p *Point) error{scalarMult, scalarBaseMult} {
+			detected, calls := runWithFaults(t, f, fault.inject)
+			if fault.all && detected != calls {
+				t.Errorf("%s: detected %d of %d faults", fault.name, detected, calls)
+			}
+			if detected == 0 {
+				t.Errorf("%s: no faults detected", fault.name)
+			}
+		}
+	}
+}
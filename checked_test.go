@@ -0,0 +1,49 @@
+package curve1174
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestScalarMultChecked(t *testing.T) {
+	var sp Point
+	sp.ScalarMult(Base, &FieldElement{0xDEADBEEF, 0xCAFEBABE})
+	randomTestOp(t, func(res *FieldElement, x *FieldElement, y *FieldElement) {
+		var p Point
+		if _, err := p.ScalarMultChecked(&sp, x); err != nil {
+			t.Fatal(err)
+		}
+		p.ToAffine(&p)
+		res.Set(&p.X)
+	}, func(res *big.Int, x *big.Int, y *big.Int) {
+		var p Point
+		p.ScalarMult(&sp, FromBigInt(x)).ToAffine(&p)
+		res.Set(p.X.ToBigInt())
+	}, 50)
+	randomTestOp(t, func(res *FieldElement, x *FieldElement, y *FieldElement) {
+		var p Point
+		if _, err := p.ScalarBaseMultChecked(x); err != nil {
+			t.Fatal(err)
+		}
+		p.ToAffine(&p)
+		res.Set(&p.X)
+	}, func(res *big.Int, x *big.Int, y *big.Int) {
+		var p Point
+		p.ScalarMult(Base, FromBigInt(x)).ToAffine(&p)
+		res.Set(p.X.ToBigInt())
+	}, 50)
+}
+
+func TestScalarMultCheckedInvalidPoint(t *testing.T) {
+	var offCurve, outsideSubgroup Point
+	offCurve.Set(Base)
+	offCurve.X.Add(&offCurve.X, UOne)
+	outsideSubgroup.Add(Base, lowOrderPoints[1])
+	for _, sp := range []*Point{&offCurve, &outsideSubgroup, lowOrderPoints[0], lowOrderPoints[1]} {
+		var p Point
+		p.Set(Base)
+		if _, err := p.ScalarMultChecked(sp, &FieldElement{5}); err != ErrInvalidPoint || !p.Equals(E) {
+			t.Errorf("expected %v and E, got %v %x", ErrInvalidPoint, err, &p)
+		}
+	}
+}
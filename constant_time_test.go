@@ -0,0 +1,120 @@
+//go:build curve1174_dudect
+
+package curve1174
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/probakowski/curve1174/internal/dudect"
+)
+
+//Timing tests are opt-in (go test -tags curve1174_dudect -run ConstantTime), they take a while and need quiet machine.
+//Combine the tag with other build tags to check other implementations, TestBackends runs them with every backend
+//available in the binary. Inputs of the fixed class are zeros, inputs of the random class are random. Both are copied
+//to the same variable before measurement, otherwise loads from table of random inputs would make a difference.
+
+func randomFieldElements(n int) []FieldElement {
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	vectors := make([]FieldElement, n)
+	for i := range vectors {
+		vectors[i] = FieldElement{r.Uint64(), r.Uint64(), r.Uint64(), r.Uint64() & P3}
+	}
+	return vectors
+}
+
+//checkConstantTime measures f with dudect and fails if |t| is above dudect.Threshold
+func checkConstantTime(t *testing.T, iterations int, f func(timer *dudect.Timer, random bool, vector int)) {
+	t.Helper()
+	var c dudect.Context
+	tt := c.Measure(f, 1000, iterations)
+	t.Logf("%s: t=%f", Backend(), tt)
+	if tt > dudect.Threshold {
+		t.Errorf("execution time depends on input, t=%f", tt)
+	}
+}
+
+//checkFieldConstantTime measures field operation op with fixed or random x (y is random in both classes)
+func checkFieldConstantTime(t *testing.T, iterations int, op func(res, x, y *FieldElement)) {
+	t.Helper()
+	vectors := randomFieldElements(1000)
+	y := randomFieldElements(1)[0]
+	var x, res FieldElement
+	checkConstantTime(t, iterations, func(timer *dudect.Timer, random bool, vector int) {
+		x = FieldElement{}
+		if random {
+			x = vectors[vector]
+		}
+		timer.Start()
+		op(&res, &x, &y)
+		timer.End()
+	})
+}
+
+func TestMulConstantTime(t *testing.T) {
+	checkFieldConstantTime(t, 200000, func(res, x, y *FieldElement) { res.Mul(x, y) })
+}
+
+func TestSqrConstantTime(t *testing.T) {
+	checkFieldConstantTime(t, 200000, func(res, x, y *FieldElement) { res.Sqr(x) })
+}
+
+func TestInverseConstantTime(t *testing.T) {
+	checkFieldConstantTime(t, 50000, func(res, x, y *FieldElement) { res.Inverse(x) })
+}
+
+func TestSelectPointConstantTime(t *testing.T) {
+	var table [16]Point
+	table[0] = identity
+	for i := 1; i < len(table); i++ {
+		table[i].Add(&table[i-1], &basePoint)
+	}
+	indices := rand.New(rand.NewSource(time.Now().UnixNano())).Perm(1000)
+	var res Point
+	checkConstantTime(t, 200000, func(timer *dudect.Timer, random bool, vector int) {
+		index := uint64(0)
+		if random {
+			index = uint64(indices[vector] % len(table))
+		}
+		timer.Start()
+		selectPoint(&res, &table, index)
+		timer.End()
+	})
+}
+
+//checkScalarMultConstantTime measures scalar multiplication op with fixed or random scalar
+func checkScalarMultConstantTime(t *testing.T, iterations int, op func(res *Point, b *FieldElement)) {
+	t.Helper()
+	vectors := randomFieldElements(1000)
+	var b FieldElement
+	var p Point
+	checkConstantTime(t, iterations, func(timer *dudect.Timer, random bool, vector int) {
+		b = FieldElement{}
+		if random {
+			b = vectors[vector]
+		}
+		timer.Start()
+		op(&p, &b)
+		timer.End()
+	})
+}
+
+func TestScalarMultConstantTime(t *testing.T) {
+	checkScalarMultConstantTime(t, 20000, func(res *Point, b *FieldElement) { res.ScalarMult(&basePoint, b) })
+}
+
+func TestScalarMultLadderConstantTime(t *testing.T) {
+	checkScalarMultConstantTime(t, 20000, func(res *Point, b *FieldElement) { res.ScalarMultLadder(&basePoint, b) })
+}
+
+func TestScalarBaseMultConstantTime(t *testing.T) {
+	defer SetBasePrecomputation(GetBasePrecomputation())
+	for name, level := range map[string]BasePrecomputation{
+		"none": PrecomputeNone, "small": PrecomputeSmall, "big": PrecomputeBig} {
+		t.Run(name, func(t *testing.T) {
+			SetBasePrecomputation(level)
+			checkScalarMultConstantTime(t, 50000, func(res *Point, b *FieldElement) { res.ScalarBaseMult(b) })
+		})
+	}
+}
@@ -8,20 +8,47 @@ import (
 //P is order of F_p, 2^251-9
 var P, _ = new(big.Int).SetString("7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff7", 16)
 
-//Base is base point of curve in affine coordinates (Base.Z == 1)
-var Base = &Point{
+//order is l = 2^249-11332719920821432534773113288178349711, order of subgroup generated by Base
+var order = FieldElement{0x8944d45fd166c971, 0xf77965c4dfd30734, 0xffffffffffffffff, 0x01ffffffffffffff}
+
+//groupOrder is order of curve's group, 4*l (cofactor is 4). Multiplying any point on curve (also one outside of
+//the subgroup generated by Base) by it gives E.
+var groupOrder = FieldElement{0x2513517f459b25c4, 0xdde597137f4c1cd2, 0xffffffffffffffff, 0x07ffffffffffffff}
+
+//basePoint and identity are package's own copies of Base and E, so changing exported variables doesn't affect
+//results of any operation
+var basePoint = Point{
 	X: FieldElement{0x16123f27bce29eda, 0xc021d96a492ecd65, 0x9343aee7c029a190, 0x37fbb0cea308c47},
 	Y: FieldElement{0xa4ccb1bf9b46360e, 0x4fe2dee2af3f976b, 0x6656841169840e0c, 0x6b72f82d47fb7cc},
-	Z: *UOne,
+	Z: one,
 	T: FieldElement{0xfb1ebfece06620ec, 0x9c6c6daf574e84cb, 0x5083299c2d40b958, 0x18b74129cf1e5d9},
 }
 
-//E is identity element of curve's group (x:0, y:1)
-var E = &Point{
-	X: UZero,
-	Y: *UOne,
-	Z: *UOne,
-	T: UZero,
+var identity = Point{
+	X: zero,
+	Y: one,
+	Z: one,
+	T: zero,
+}
+
+//Base is base point of curve in affine coordinates (Base.Z == 1). It's kept for compatibility, the package doesn't
+//read it, use NewBasePoint to get copy that can't be modified by other code.
+var Base = NewBasePoint()
+
+//E is identity element of curve's group (x:0, y:1). It's kept for compatibility, the package doesn't read it, use
+//NewIdentityPoint to get copy that can't be modified by other code.
+var E = NewIdentityPoint()
+
+//NewBasePoint returns new copy of base point of curve in affine coordinates (Z == 1)
+func NewBasePoint() *Point {
+	p := basePoint
+	return &p
+}
+
+//NewIdentityPoint returns new copy of identity element of curve's group (x:0, y:1)
+func NewIdentityPoint() *Point {
+	p := identity
+	return &p
 }
 
 //Point represents point on curve. It supports projective and extended coordinates
@@ -78,7 +105,7 @@ func (p *Point) ToAffine(pp *Point) *Point {
 	p.X.Mul(&pp.X, zInv).Mod(&p.X)
 	p.Y.Mul(&pp.Y, zInv).Mod(&p.Y)
 	p.T.Mul(&pp.T, zInv).Mod(&p.T)
-	p.Z.Set(UOne)
+	p.Z.Set(&one)
 	return p
 }
 
@@ -89,8 +116,21 @@ func (p *Point) Equals(p2 *Point) bool {
 
 //ScalarMult multiplies point on curve sp by scalar b (b<2^251-9) and stores result in p. Execution time doesn't depend on b.
 func (p *Point) ScalarMult(sp *Point, b *FieldElement) *Point {
-	el := [16]Point{*E, *sp}
-	p.Set(E)
+	return p.scalarMult(sp, b[:])
+}
+
+//scalarMult multiplies point sp by scalar b given as little endian 64-bit words (it can be longer than 256 bits) and
+//stores result in p. Execution time depends only on len(b).
+func (p *Point) scalarMult(sp *Point, b []uint64) *Point {
+	p.scalarMultCheck(sp, b, false)
+	return p
+}
+
+//scalarMultCheck is scalarMult that, if check is true, also verifies that all table entries and accumulator (after
+//every 64 bits of b) are on curve. It returns false if any of these checks failed.
+func (p *Point) scalarMultCheck(sp *Point, b []uint64, check bool) bool {
+	ok := true
+	el := [16]Point{identity, *sp}
 	el[2].Double(sp)
 	el[3].Add(&el[2], &el[1])
 	el[4].Double(&el[2])
@@ -106,44 +146,112 @@ func (p *Point) ScalarMult(sp *Point, b *FieldElement) *Point {
 	el[14].Double(&el[7])
 	el[15].Add(&el[14], sp)
 
-	index := b[3] >> 60
-	selectPoint(p, &el, index)
-	var pp Point
+	if check {
+		for i := range el {
+			ok = checkIntermediate(&el[i], false) && ok
+		}
+	}
 
-	for j := 14; j >= 0; j-- {
-		p.doubleProjective(p).doubleProjective(p).doubleProjective(p).Double(p)
-		index = (b[3] >> (j * 4)) & 0xF
-		selectPoint(&pp, &el, index)
-		p.addToProjective(p, &pp)
+	var cached [16]CachedPoint
+	for i := 0; i < 16; i++ {
+		cached[i].SetPoint(&el[i])
 	}
 
-	for i := 2; i > 0; i-- {
+	if useAVX2 && !check {
+		p.scalarMult4(&el, &cached, b)
+		return ok
+	}
+
+	//accumulator is in p after the first window and after the last addition, otherwise it's in acc
+	first := true
+	var acc ProjectivePoint
+	var pp CachedPoint
+	for i := len(b) - 1; i >= 0; i-- {
 		for j := 15; j >= 0; j-- {
-			p.doubleProjective(p).doubleProjective(p).doubleProjective(p).Double(p)
-			index = (b[i] >> (j * 4)) & 0xF
-			selectPoint(&pp, &el, index)
-			p.addToProjective(p, &pp)
+			index := (b[i] >> (j * 4)) & 0xF
+			if first {
+				selectPoint(p, &el, index)
+				acc.SetPoint(p)
+				first = false
+				continue
+			}
+			p.DoubleProjective(acc.Double(&acc).Double(&acc).Double(&acc))
+			selectCachedPoint(&pp, &cached, index)
+			if i == 0 && j == 0 {
+				p.AddCached(p, &pp)
+			} else {
+				acc.AddCached(p, &pp)
+			}
+		}
+		if check && i != 0 {
+			ok = checkProjective(&acc) && ok
+		} else if check {
+			ok = checkIntermediate(p, false) && ok
 		}
 	}
 
-	for j := 15; j > 0; j-- {
-		p.doubleProjective(p).doubleProjective(p).doubleProjective(p).Double(p)
-		index := (b[0] >> (j * 4)) & 0xF
-		selectPoint(&pp, &el, index)
-		p.addToProjective(p, &pp)
+	return ok
+}
+
+//ScalarMultLadder multiplies point on curve sp by scalar b and stores result in p. It's Montgomery ladder using
+//unified addition and doubling with CondSwap on every bit of b, it doesn't use any tables or secret-dependent memory
+//accesses. It's slower than ScalarMult. Execution time doesn't depend on b.
+func (p *Point) ScalarMultLadder(sp *Point, b *FieldElement) *Point {
+	//invariant: r1 - r0 == sp
+	var r0, r1 Point
+	r0.Set(&identity)
+	r1.Set(sp)
+	var swap uint64
+	for i := 255; i >= 0; i-- {
+		bit := (b[i/64] >> (i % 64)) & 1
+		r0.CondSwap(&r1, swap^bit)
+		swap = bit
+		r1.Add(&r0, &r1)
+		r0.Double(&r0)
 	}
+	r0.CondSwap(&r1, swap)
+	return p.Set(&r0)
+}
 
-	p.doubleProjective(p).doubleProjective(p).doubleProjective(p).Double(p)
-	index = b[0] & 0xF
-	selectPoint(&pp, &el, index)
-	p.Add(p, &pp)
+//VarTimeScalarMult multiplies point on curve sp by scalar b and stores result in p. It uses width 5 NAF and table of
+//odd multiples of sp so it's faster than ScalarMult, but execution time (and memory access pattern) depends on both b
+//and sp. Use it only when both of them are public (e.g. for verification), never with secret scalars!
+func (p *Point) VarTimeScalarMult(sp *Point, b *FieldElement) *Point {
+	var naf [257]int32
+	wnaf(&naf, b, 5)
 
-	return p
+	var el [8]Point
+	var p2 Point
+	p2.Double(sp)
+	el[0].Set(sp)
+	for i := 1; i < len(el); i++ {
+		el[i].Add(&el[i-1], &p2)
+	}
+	varTimeBatchToAffine(el[:])
+	var odd [8]AffineCachedPoint
+	for i := range odd {
+		odd[i].SetPoint(&el[i])
+	}
+	return p.varTimeScalarMultNAF(&naf, odd[:])
+}
+
+//CondSwap swaps p and p2 if swap == 1 and leaves them unchanged if swap == 0. Execution time doesn't depend on swap.
+func (p *Point) CondSwap(p2 *Point, swap uint64) {
+	p.X.condSwap(&p2.X, swap)
+	p.Y.condSwap(&p2.Y, swap)
+	p.Z.condSwap(&p2.Z, swap)
+	p.T.condSwap(&p2.T, swap)
 }
 
 //AddZ1 adds two points on curve and store results in p. p2 has to be in affine coordinates (p2.Z == 1)
 //Formula based on https://www.hyperelliptic.org/EFD/g1p/auto-twisted-extended.html#addition-madd-2008-hwcd
+//
+//Deprecated: use AddAffine, Z == 1 is guaranteed by AffinePoint type.
 func (p *Point) AddZ1(p1, p2 *Point) *Point {
+	if fusedPointOps() {
+		pointAddZ1(p, p1, p2)
+		return p
+	}
 	var a, b, c, d, e, e1, f, g, h FieldElement
 	a.Mul(&p1.X, &p2.X)
 	b.Mul(&p1.Y, &p2.Y)
@@ -165,77 +273,36 @@ func (p *Point) AddZ1(p1, p2 *Point) *Point {
 //Formula based on https://www.hyperelliptic.org/EFD/g1p/auto-twisted-extended.html#addition-add-2008-hwcd
 func (p *Point) Add(p1,
 	p2 *Point) *Point {
-	var a, b, c, d, e, e1, f, g, h FieldElement
-	a.Mul(&p1.X, &p2.X)
-	b.Mul(&p1.Y, &p2.Y)
-	c.Mul(&p1.T, &p2.T).MulD(&c)
-	d.Mul(&p1.Z, &p2.Z)
-	e1.Add(&p2.X, &p2.Y)
-	e.Add(&p1.X, &p1.Y).Mul(&e, &e1).Sub(&e, &a).Sub(&e, &b)
-	f.Sub(&d, &c)
-	g.Add(&d, &c)
-	h.Sub(&b, &a)
-	p.X.Mul(&e, &f)
-	p.Y.Mul(&g, &h)
-	p.T.Mul(&e, &h)
-	p.Z.Mul(&f, &g)
-	return p
-}
-
-//addToProjective adds two points on curve store result in p. Result is in projective coordinates (p.T is not correct!)
-func (p *Point) addToProjective(p1,
-	p2 *Point) *Point {
-	var a, b, c, d, e, e1, f, g, h FieldElement
-	a.Mul(&p1.X, &p2.X)
-	b.Mul(&p1.Y, &p2.Y)
-	c.Mul(&p1.T, &p2.T).MulD(&c)
-	d.Mul(&p1.Z, &p2.Z)
-	e1.Add(&p2.X, &p2.Y)
-	e.Add(&p1.X, &p1.Y).Mul(&e, &e1).Sub(&e, &a).Sub(&e, &b)
-	f.Sub(&d, &c)
-	g.Add(&d, &c)
-	h.Sub(&b, &a)
-	p.X.Mul(&e, &f)
-	p.Y.Mul(&g, &h)
-	p.Z.Mul(&f, &g)
-	return p
-}
-
-//doubleProjective doubles point on curve using projective coordinates and store result in p (p.T is not correct!)
-func (p *Point) doubleProjective(dp *Point) *Point {
-	var b, c, d, f, h, j FieldElement
-	b.Add(&dp.X, &dp.Y).Sqr(&b)
-	c.Sqr(&dp.X)
-	d.Sqr(&dp.Y)
-	f.Add(&c, &d)
-	h.Sqr(&dp.Z)
-	j.Mul2(&h).Sub(&f, &j)
-	p.X.Sub(&b, &c).Sub(&p.X, &d).Mul(&p.X, &j)
-	p.Y.Sub(&c, &d).Mul(&p.Y, &f)
-	p.Z.Mul(&f, &j)
-	return p
+	if fusedPointOps() {
+		pointAdd(p, p1, p2)
+		return p
+	}
+	return p.add(p1, p2)
 }
 
 //Double doubles point on curve and store result in p (p = dp+dp)
 //Formula based on https://www.hyperelliptic.org/EFD/g1p/auto-twisted-extended.html#doubling-dbl-2008-hwcd
 func (p *Point) Double(dp *Point) *Point {
-	var a, b, c, e, f, g, h FieldElement
-	a.Sqr(&dp.X)
-	b.Sqr(&dp.Y)
-	c.Sqr(&dp.Z).Mul2(&c)
-	e.Add(&dp.X, &dp.Y).Sqr(&e).Sub(&e, &a).Sub(&e, &b)
-	g.Add(&a, &b)
-	f.Sub(&g, &c)
-	h.Sub(&a, &b)
-	p.X.Mul(&e, &f)
-	p.Y.Mul(&g, &h)
-	p.T.Mul(&e, &h)
-	p.Z.Mul(&f, &g)
-	return p
+	if fusedPointOps() {
+		pointDouble(p, dp)
+		return p
+	}
+	return p.double(&dp.X, &dp.Y, &dp.Z)
+}
+
+//DoubleProjective doubles projective point dp and stores result in p (p = dp+dp) in extended coordinates
+func (p *Point) DoubleProjective(dp *ProjectivePoint) *Point {
+	if fusedPointOps() {
+		pointDoubleProjective(p, dp)
+		return p
+	}
+	return p.double(&dp.X, &dp.Y, &dp.Z)
 }
 
 //DoubleZ1 doubles point on curve and store result in p (p = dp+dp). dp has to be in affine coordinates (dp.Z == 1)
 //Formula based on https://www.hyperelliptic.org/EFD/g1p/auto-twisted-extended.html#doubling-mdbl-2008-hwcd
+//
+//Deprecated: use DoubleAffine, Z == 1 is guaranteed by AffinePoint type.
 func (p *Point) DoubleZ1(dp *Point) *Point {
 	var a, b, c, d, e, f, g, h FieldElement
 	a.Sqr(&dp.X)
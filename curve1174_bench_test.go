@@ -2,6 +2,7 @@ package curve1174
 
 import (
 	"crypto/elliptic"
+	crand "crypto/rand"
 	"math/big"
 	"math/rand"
 	"testing"
@@ -39,11 +40,50 @@ func BenchmarkCurve1174ScalarMult(b *testing.B) {
 	}
 }
 
+func BenchmarkCurve1174ScalarMultBlinded(b *testing.B) {
+	var p Point
+	f := FromBigInt(scalar)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = p.ScalarMultBlinded(Base, f, crand.Reader)
+		p.ToAffine(&p)
+	}
+}
+
+func BenchmarkCurve1174ScalarMultChecked(b *testing.B) {
+	var p Point
+	f := FromBigInt(scalar)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = p.ScalarMultChecked(Base, f)
+		p.ToAffine(&p)
+	}
+}
+
+func BenchmarkCurve1174VarTimeScalarMult(b *testing.B) {
+	var p Point
+	f := FromBigInt(scalar)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		p.VarTimeScalarMult(Base, f).ToAffine(&p)
+	}
+}
+
+func BenchmarkCurve1174ScalarMultLadder(b *testing.B) {
+	var p Point
+	f := FromBigInt(scalar)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		p.ScalarMultLadder(Base, f).ToAffine(&p)
+	}
+}
+
 var pp Point
 
 func BenchmarkCurve1174ScalarBaseMult(b *testing.B) {
 	var p Point
 	f := FromBigInt(scalar)
+	p.ScalarBaseMult(f)
 	b.ResetTimer()
 	b.ReportAllocs()
 	for i := 0; i < b.N; i++ {
@@ -53,6 +93,61 @@ func BenchmarkCurve1174ScalarBaseMult(b *testing.B) {
 	pp = p
 }
 
+func BenchmarkCurve1174ScalarBaseMultBlinded(b *testing.B) {
+	var p Point
+	f := FromBigInt(scalar)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = p.ScalarBaseMultBlinded(f, crand.Reader)
+		p.ToAffine(&p)
+	}
+}
+
+func benchmarkScalarMultPrecomputed(b *testing.B, window int, vartime bool) {
+	var p, sp Point
+	var table PrecomputedPoint
+	sp.ScalarBaseMult(&FieldElement{0xDEADBEEF}).ToAffine(&sp)
+	table.Set(&sp, window)
+	f := FromBigInt(scalar)
+	b.ResetTimer()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if vartime {
+			p.VarTimeScalarMultPrecomputed(&table, f).ToAffine(&p)
+		} else {
+			p.ScalarMultPrecomputed(&table, f).ToAffine(&p)
+		}
+	}
+}
+
+func BenchmarkCurve1174ScalarMultPrecomputed4(b *testing.B) {
+	benchmarkScalarMultPrecomputed(b, 4, false)
+}
+
+func BenchmarkCurve1174ScalarMultPrecomputed6(b *testing.B) {
+	benchmarkScalarMultPrecomputed(b, 6, false)
+}
+
+func BenchmarkCurve1174ScalarMultPrecomputed8(b *testing.B) {
+	benchmarkScalarMultPrecomputed(b, 8, false)
+}
+
+func BenchmarkCurve1174VarTimeScalarMultPrecomputed4(b *testing.B) {
+	benchmarkScalarMultPrecomputed(b, 4, true)
+}
+
+func BenchmarkCurve1174VarTimeScalarMultPrecomputed6(b *testing.B) {
+	benchmarkScalarMultPrecomputed(b, 6, true)
+}
+
+func BenchmarkCurve1174PrecomputedPointSet(b *testing.B) {
+	var table PrecomputedPoint
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		table.Set(Base, 4)
+	}
+}
+
 func BenchmarkCurveP256Add(b *testing.B) {
 	p256 := elliptic.P256()
 	params := p256.Params()
@@ -135,6 +230,20 @@ func BenchmarkInverse(b *testing.B) {
 	}
 }
 
+func BenchmarkVarTimeInverse(b *testing.B) {
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	b2 := new(big.Int).Rand(r, P)
+	e := FromBigInt(b2)
+	var p Point
+	p.ScalarBaseMult(e)
+	b.ReportAllocs()
+	var ee FieldElement
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ee.varTimeInverse(&p.Z)
+	}
+}
+
 func BenchmarkMul(b *testing.B) {
 	r := rand.New(rand.NewSource(time.Now().UnixNano()))
 	var p, p2, p3 FieldElement
@@ -171,6 +280,40 @@ func BenchmarkSqr(b *testing.B) {
 	}
 }
 
+//BenchmarkMulRadix51 and BenchmarkSqrRadix51 compare field_radix51.go with BenchmarkMul and BenchmarkSqr of the
+//selected backend (4x64-bit Go code with tag curve1174_purego)
+func BenchmarkMulRadix51(b *testing.B) {
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	var p, p2, p3 FieldElement
+	p[0] = r.Uint64()
+	p[1] = r.Uint64()
+	p[2] = r.Uint64()
+	p[3] = r.Uint64()
+	p2[0] = r.Uint64()
+	p2[1] = r.Uint64()
+	p2[2] = r.Uint64()
+	p2[3] = r.Uint64()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		mulRadix51(&p3, &p, &p2)
+	}
+}
+
+func BenchmarkSqrRadix51(b *testing.B) {
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	var p, p3 FieldElement
+	p[0] = r.Uint64()
+	p[1] = r.Uint64()
+	p[2] = r.Uint64()
+	p[3] = r.Uint64()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		sqrRadix51(&p3, &p)
+	}
+}
+
 func BenchmarkAdd2(b *testing.B) {
 	r := rand.New(rand.NewSource(time.Now().UnixNano()))
 	var p, p2, p3 FieldElement
@@ -93,6 +93,70 @@ func TestScalarMult(t *testing.T) {
 	}
 }
 
+func TestScalarMultLadder(t *testing.T) {
+	var sp Point
+	sp.ScalarMult(Base, &FieldElement{0xDEADBEEF, 0xCAFEBABE}).ToAffine(&sp)
+	randomTestOp(t, func(res *FieldElement, x *FieldElement, y *FieldElement) {
+		var p Point
+		p.ScalarMultLadder(&sp, x).ToAffine(&p)
+		res.Set(&p.X)
+	}, func(res *big.Int, x *big.Int, y *big.Int) {
+		var p Point
+		p.ScalarMult(&sp, FromBigInt(x)).ToAffine(&p)
+		res.Set(p.X.ToBigInt())
+	}, 100)
+
+	vectors := []FieldElement{{}, {1}, {2}, {3}, {0, 0, 0, 1 << 63}, {^uint64(0), ^uint64(0), ^uint64(0), ^uint64(0)}}
+	for _, b := range vectors {
+		var p1, p2 Point
+		p1.ScalarMultLadder(Base, &b).ToAffine(&p1)
+		p2.ScalarMult(Base, &b).ToAffine(&p2)
+		if !p1.Equals(&p2) || !p1.T.Equals(&p2.T) {
+			t.Errorf("%x\n%x\n%x", &b, &p1, &p2)
+		}
+	}
+}
+
+func TestVarTimeScalarMult(t *testing.T) {
+	var sp Point
+	sp.ScalarMult(Base, &FieldElement{0xDEADBEEF, 0xCAFEBABE})
+	randomTestOp(t, func(res *FieldElement, x *FieldElement, y *FieldElement) {
+		var p Point
+		p.VarTimeScalarMult(&sp, x).ToAffine(&p)
+		res.Set(&p.X)
+	}, func(res *big.Int, x *big.Int, y *big.Int) {
+		var p Point
+		p.ScalarMult(&sp, FromBigInt(x)).ToAffine(&p)
+		res.Set(p.X.ToBigInt())
+	}, 200)
+
+	vectors := []FieldElement{{}, {1}, {2}, {16}, {31}, {32}, {0, 0, 0, 1 << 63}, {^uint64(0), ^uint64(0), ^uint64(0), ^uint64(0)}}
+	for _, b := range vectors {
+		for _, point := range []*Point{Base, E, &sp} {
+			var p1, p2 Point
+			p1.VarTimeScalarMult(point, &b).ToAffine(&p1)
+			p2.ScalarMult(point, &b).ToAffine(&p2)
+			if !p1.Equals(&p2) || !p1.T.Equals(&p2.T) {
+				t.Errorf("%x\n%x\n%x", &b, &p1, &p2)
+			}
+		}
+	}
+}
+
+func TestCondSwap(t *testing.T) {
+	var p1, p2 Point
+	p1.Set(Base)
+	p2.Set(E)
+	p1.CondSwap(&p2, 0)
+	if p1 != *Base || p2 != *E {
+		t.Errorf("points swapped with swap == 0")
+	}
+	p1.CondSwap(&p2, 1)
+	if p1 != *E || p2 != *Base {
+		t.Errorf("points not swapped with swap == 1")
+	}
+}
+
 func TestAdd(t *testing.T) {
 	randomTest(t, func(res *FieldElement, x *FieldElement, y *FieldElement) {
 		add(res, x, y)
@@ -135,6 +199,56 @@ func TestSqr(t *testing.T) {
 	})
 }
 
+//fieldEdgeValues are inputs close to limits of carry handling in all backends
+var fieldEdgeValues = []FieldElement{{}, {1}, {P0 - 1, P1, P2, P3}, *UP, {P0 + 1, P1, P2, P3}, {0, 0, 0, 1 << 63},
+	{^uint64(0), ^uint64(0), ^uint64(0), ^uint64(0)}, {^uint64(0), ^uint64(0), ^uint64(0), P3}, {0, 0, 0, P3 + 1},
+	{1 << 51, 1 << 38, 1 << 25, 1 << 12}, {^uint64(0) - 287, ^uint64(0), ^uint64(0), ^uint64(0)}, {288}}
+
+func TestMulSqrEdgeValues(t *testing.T) {
+	max := ^uint64(0)
+	vectors := []FieldElement{{}, {1}, {P0 - 1, P1, P2, P3}, *UP, {P0 + 1, P1, P2, P3}, {0, 0, 0, 1 << 63},
+		{max, max, max, max}, {max, max, max, P3}, {0, 0, 0, P3 + 1}, {1 << 51, 1 << 38, 1 << 25, 1 << 12}}
+	impls := []struct {
+		name string
+		mul  func(res, x, y *FieldElement)
+		sqr  func(res, x *FieldElement)
+	}{{"backend", mul, sqr}, {"radix51", mulRadix51, sqrRadix51}}
+	for _, impl := range impls {
+		for _, x := range vectors {
+			for _, y := range vectors {
+				var res FieldElement
+				impl.mul(&res, &x, &y)
+				expected := new(big.Int).Mul(x.ToBigInt(), y.ToBigInt())
+				expected.Mod(expected, P)
+				if res.Mod(&res).ToBigInt().Cmp(expected) != 0 {
+					t.Errorf("%s mul\n%x\n%x\n%x\n%x", impl.name, &x, &y, &res, expected)
+				}
+			}
+			var res FieldElement
+			impl.sqr(&res, &x)
+			expected := new(big.Int).Mul(x.ToBigInt(), x.ToBigInt())
+			expected.Mod(expected, P)
+			if res.Mod(&res).ToBigInt().Cmp(expected) != 0 {
+				t.Errorf("%s sqr\n%x\n%x\n%x", impl.name, &x, &res, expected)
+			}
+		}
+	}
+}
+
+func TestMulRadix51(t *testing.T) {
+	randomTest(t, mulRadix51, func(res *big.Int, x *big.Int, y *big.Int) {
+		res.Mul(x, y)
+	})
+}
+
+func TestSqrRadix51(t *testing.T) {
+	randomTest(t, func(res *FieldElement, x *FieldElement, y *FieldElement) {
+		sqrRadix51(res, x)
+	}, func(res *big.Int, x *big.Int, y *big.Int) {
+		res.Mul(x, x)
+	})
+}
+
 func TestMul2(t *testing.T) {
 	randomTest(t, func(res *FieldElement, x *FieldElement, y *FieldElement) {
 		mul2(res, x)
@@ -271,6 +385,34 @@ func TestInverse(t *testing.T) {
 	}, func(res *big.Int, x *big.Int, y *big.Int) {
 		res.ModInverse(x, P)
 	}, 10000)
+
+	//receiver can be the same as argument
+	x := FieldElement{0xDEADBEEF, 0xCAFEBABE, 1, 2}
+	var expected FieldElement
+	expected.Inverse(&x)
+	if x.Inverse(&x); x != expected {
+		t.Errorf("\n%x\n%x", &x, &expected)
+	}
+}
+
+func TestVarTimeInverse(t *testing.T) {
+	randomTestOp(t, func(res *FieldElement, x *FieldElement, y *FieldElement) {
+		res.varTimeInverse(x)
+	}, func(res *big.Int, x *big.Int, y *big.Int) {
+		res.ModInverse(x, P)
+	}, 10000)
+
+	vectors := []FieldElement{{}, *UP, {1}, {2}, {P0 - 1, P1, P2, P3}, {P0 + 1, P1, P2, P3}, {^uint64(0), ^uint64(0), ^uint64(0), ^uint64(0)},
+		//2^250+9 overflowed signed coefficients of fastInverse
+		{9, 0, 0, 1 << 58}}
+	for _, x := range vectors {
+		var res, expected FieldElement
+		res.varTimeInverse(&x)
+		expected.Inverse(&x).Mod(&expected)
+		if res != expected {
+			t.Errorf("\n%x\n%x\n%x", &x, &res, &expected)
+		}
+	}
 }
 
 func TestSqrMul(t *testing.T) {
@@ -285,3 +427,71 @@ func TestSqrMul(t *testing.T) {
 		t.Errorf("\n%x\n%x", &res1, &res2)
 	}
 }
+
+//TestExportedVariablesMutation checks that overwriting Base, E, UOne, UP and UZero doesn't change results of operations
+func TestExportedVariablesMutation(t *testing.T) {
+	ops := map[string]func(p *Point, b *FieldElement){
+		"ScalarBaseMult": func(p *Point, b *FieldElement) { p.ScalarBaseMult(b) },
+		"ScalarBaseMultBig": func(p *Point, b *FieldElement) {
+			defer SetBasePrecomputation(GetBasePrecomputation())
+			SetBasePrecomputation(PrecomputeBig)
+			p.ScalarBaseMult(b)
+		},
+		"ScalarMult":        func(p *Point, b *FieldElement) { p.ScalarMult(NewBasePoint(), b) },
+		"ScalarMultLadder":  func(p *Point, b *FieldElement) { p.ScalarMultLadder(NewBasePoint(), b) },
+		"VarTimeScalarMult": func(p *Point, b *FieldElement) { p.VarTimeScalarMult(NewBasePoint(), b) },
+		"ScalarBaseMultChecked": func(p *Point, b *FieldElement) {
+			if _, err := p.ScalarBaseMultChecked(b); err != nil {
+				t.Error(err)
+			}
+		},
+		"Add": func(p *Point, b *FieldElement) { p.Add(NewIdentityPoint(), new(Point).ScalarMult(NewBasePoint(), b)) },
+	}
+	scalars := []*FieldElement{{0}, {1}, {0xDEADBEEF, 0xCAFEBABE, 3, 0x01ffffffffffffff}}
+
+	var expected Point
+	expected.ScalarMult(NewBasePoint(), scalars[2]).ToAffine(&expected)
+
+	saved := struct {
+		base, e      Point
+		one, p, zero FieldElement
+	}{*Base, *E, *UOne, *UP, UZero}
+	savedP := new(big.Int).Set(P)
+	defer func() {
+		*Base, *E, *UOne, *UP, UZero = saved.base, saved.e, saved.one, saved.p, saved.zero
+		P.Set(savedP)
+	}()
+	expectedParams := Params()
+	Base.Double(Base)
+	E.Set(Base)
+	UOne.Add(UOne, UOne)
+	UP[0]++
+	UZero[1] = 5
+
+	for name, op := range ops {
+		for i, b := range scalars {
+			var p, q Point
+			op(&p, b)
+			p.ToAffine(&p)
+			q.X = FieldElement{}
+			q.Y = FieldElement{1}
+			if i == 1 {
+				q.X, q.Y = basePoint.X, basePoint.Y
+			} else if i == 2 {
+				q = expected
+			}
+			if p.X != q.X || p.Y != q.Y {
+				t.Errorf("%s(%x) changed after mutation of exported variables: %x", name, b, &p)
+			}
+		}
+	}
+	if *NewBasePoint() != basePoint || *NewIdentityPoint() != identity || One() != one || Modulus() != modulus ||
+		Zero() != zero {
+		t.Error("constructors return mutated values")
+	}
+	P.Add(P, big.NewInt(2))
+	if params := Params(); params.P.Cmp(expectedParams.P) != 0 || params.D.Cmp(expectedParams.D) != 0 ||
+		params.MontgomeryA.Cmp(expectedParams.MontgomeryA) != 0 || params.WeierstrassB.Cmp(expectedParams.WeierstrassB) != 0 {
+		t.Errorf("Params changed after mutation of P: p=%x, d=%x", params.P, params.D)
+	}
+}
@@ -0,0 +1,145 @@
+package curve1174
+
+import (
+	"bytes"
+	"math/big"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/probakowski/curve1174/internal/ref"
+)
+
+//toRef converts p to reference point
+func toRef(p *Point) ref.Point {
+	var a Point
+	a.ToAffine(p)
+	return ref.NewPoint(a.X.ToBigInt(), a.Y.ToBigInt())
+}
+
+//fromRef converts reference point to Point with random Z, so formulas don't get only Z == 1 inputs
+func fromRef(rp ref.Point, r *rand.Rand) *Point {
+	var p Point
+	p.X.SetBigInt(rp.X)
+	p.Y.SetBigInt(rp.Y)
+	p.Z.SetBigInt(new(big.Int).Rand(r, P))
+	if p.Z.IsZero() {
+		p.Z = one
+	}
+	p.X.Mul(&p.X, &p.Z)
+	p.Y.Mul(&p.Y, &p.Z)
+	p.T.Mul(&p.X, &p.Y).Mul(&p.T, new(FieldElement).Inverse(&p.Z))
+	return &p
+}
+
+//randomRefPoint returns random point from reference implementation, every other one outside of subgroup generated
+//by Base (with (1, 0) of order 4 added)
+func randomRefPoint(r *rand.Rand) ref.Point {
+	p := ref.ScalarBaseMult(new(big.Int).Rand(r, ref.Order))
+	if r.Intn(2) == 0 {
+		p = ref.Add(p, ref.NewPoint(big.NewInt(1), big.NewInt(0)))
+	}
+	return p
+}
+
+func randomScalar(r *rand.Rand) *big.Int {
+	switch r.Intn(8) {
+	case 0:
+		return big.NewInt(int64(r.Intn(32)))
+	case 1:
+		return new(big.Int).Sub(P, big.NewInt(int64(r.Intn(32)+1)))
+	default:
+		return new(big.Int).Rand(r, P)
+	}
+}
+
+//TestDifferential compares point operations with math/big reference implementation
+func TestDifferential(t *testing.T) {
+	seed := time.Now().UnixNano()
+	r := rand.New(rand.NewSource(seed))
+	n := 50
+	if testing.Short() {
+		n = 10
+	}
+	defer SetBasePrecomputation(GetBasePrecomputation())
+
+	for i := 0; i < n; i++ {
+		rp1, rp2 := randomRefPoint(r), randomRefPoint(r)
+		p1, p2 := fromRef(rp1, r), fromRef(rp2, r)
+		k := randomScalar(r)
+		b := FromBigInt(k)
+
+		var res Point
+		check := func(name string, expected ref.Point) {
+			t.Helper()
+			if !res.IsOnCurve() || !toRef(&res).Equal(expected) {
+				t.Fatalf("%s (seed %d): %v, %v, %v: got %x, expected %v", name, seed, rp1, rp2, k, &res, expected)
+			}
+		}
+		res.Add(p1, p2)
+		check("Add", ref.Add(rp1, rp2))
+		res.Add(p1, p1)
+		check("Add same point", ref.Double(rp1))
+		res.Double(p1)
+		check("Double", ref.Double(rp1))
+		res.SubCached(p1, new(CachedPoint).SetPoint(p2))
+		check("SubCached", ref.Add(rp1, ref.Neg(rp2)))
+		res.AddCached(p1, new(CachedPoint).SetPoint(p2))
+		check("AddCached", ref.Add(rp1, rp2))
+
+		expected := ref.ScalarMult(rp1, k)
+		res.ScalarMult(p1, b)
+		check("ScalarMult", expected)
+		res.ScalarMultLadder(p1, b)
+		check("ScalarMultLadder", expected)
+		res.VarTimeScalarMult(p1, b)
+		check("VarTimeScalarMult", expected)
+
+		expected = ref.ScalarBaseMult(k)
+		for _, level := range []BasePrecomputation{PrecomputeNone, PrecomputeSmall, PrecomputeBig} {
+			SetBasePrecomputation(level)
+			res.ScalarBaseMult(b)
+			check("ScalarBaseMult", expected)
+		}
+	}
+}
+
+//TestEncodingDifferential compares Bytes and SetBytes with reference encoding on valid and random encodings
+func TestEncodingDifferential(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	for i := 0; i < 200; i++ {
+		rp := randomRefPoint(r)
+		enc := fromRef(rp, r).Bytes()
+		if !bytes.Equal(enc, ref.Encode(rp)) {
+			t.Fatalf("Bytes(%v) = %x, expected %x", rp, enc, ref.Encode(rp))
+		}
+		var p Point
+		if _, err := p.SetBytes(enc); err != nil || !toRef(&p).Equal(rp) || !p.IsOnCurve() {
+			t.Fatalf("SetBytes(%x) = %x, %v, expected %v", enc, &p, err, rp)
+		}
+
+		//random bytes, about half of them don't encode any point
+		r.Read(enc)
+		if i%4 == 0 {
+			enc[PointSize-1] |= 0x78
+		}
+		expected, ok := ref.Decode(enc)
+		_, err := p.SetBytes(enc)
+		if ok != (err == nil) || ok && !toRef(&p).Equal(expected) {
+			t.Fatalf("SetBytes(%x) = %x, %v, expected %v, %v", enc, &p, err, expected, ok)
+		}
+	}
+
+	var p Point
+	for _, enc := range [][]byte{nil, make([]byte, 31), make([]byte, 33)} {
+		if _, err := p.SetBytes(enc); err != ErrInvalidEncoding {
+			t.Errorf("SetBytes(%x) = %v", enc, err)
+		}
+	}
+	//x == 0 with sign bit set
+	enc := NewIdentityPoint().Bytes()
+	enc[PointSize-1] |= 0x80
+	if _, err := p.SetBytes(enc); err != ErrInvalidEncoding {
+		t.Errorf("SetBytes(%x) = %v", enc, err)
+	}
+}
@@ -4,6 +4,12 @@ over finite field `Fp, p=2^251-9`. It was introduced by Bernstein, Hamburg, Kras
 
 Each point on curve is represented by `curve1174.Point` object. Base point is provided in `curve1174.Base`,
 identity element of the curve (`x=0, y=1`) is `curve1174.E`.
+`NewBasePoint()` and `NewIdentityPoint()` return new copies of them, `One()`, `Zero()` and `Modulus()` return field
+constants by value. Package uses its own copies of all these values, so modifying exported variables (e.g. by
+`Base.Set(x)`) doesn't change results of any operation.
+`Params()` returns curve parameters (p, d, order l of subgroup generated by base point, cofactor 4 and base point)
+as `math/big` values, together with coefficients and base point of birationally equivalent Montgomery and short
+Weierstrass curves.
 
 API is similar to `math/big` package. The receiver denotes result and the method arguments are operation's operands.
 For instance, given three `*Point` values a,b and c, the invocation
@@ -27,15 +33,107 @@ Operations on curve return point in extended coordinates. To get simple x/y valu
 coordinates with `(*Point).ToAffine` method. This call is expensive so be sure to avoid it for
 intermediate values if possible.
 
+`Point` (also available as `ExtendedPoint`) is the only type accepted by public operations. `AffinePoint` (x, y),
+`ProjectivePoint` (X:Y:Z without T), `CachedPoint` and `AffineCachedPoint` are separate types with explicit conversions
+(`SetPoint`, `SetAffine`, `SetProjective`), so e.g. projective point with invalid T can't be passed where extended
+point is needed. `AddAffine` and `DoubleAffine` replace `AddZ1` and `DoubleZ1` that required Z == 1 without checking it.
+
 All operations (both in the underlying field and on the curve) are designed to be constant time
 (time doesn't depend on points/elements selected).
 
 On amd64 there's specialized assembler code to speed up operations, you can disable it with tag `curve1174_purego`.
-The code is generated in from `gen/asm.go` using `avo`(https://github.com/mmcloughlin/avo).
+The code is generated in from `gen/asm.go` (with generator in `gen/internal/amd64`) using `avo`(https://github.com/mmcloughlin/avo).
+Point addition and doubling formulas used by `ScalarMult` are generated there as single functions too (no calls
+between field operations, results of additions and subtractions are reused from registers), which makes
+`ScalarMult` ~10% faster on CPUs with BMI2/ADX. Addition of affine cached table entries used by `ScalarBaseMult` is
+fused the same way.
+On arm64 field multiplication, squaring, addition, subtraction and point table lookup are written by hand
+in `field_arm64.s` (`avo` doesn't support arm64) and use the same algorithms as pure Go code, tag
+`curve1174_purego` disables them too.
+On 32-bit platforms (386, arm) multiplication and squaring work on 32-bit words with native 32x32->64
+multiplication instead of emulated 64-bit one (on 386 it makes scalar multiplication ~1.5x faster).
+Tag `curve1174_radix51` selects alternative pure Go multiplication and squaring that work on five 51-bit limbs with
+lazy reduction. `FieldElement` keeps its four 64-bit limbs, so every operation has to convert the representation.
+`BenchmarkMulRadix51` and `BenchmarkSqrRadix51` compare it with `BenchmarkMul` and `BenchmarkSqr` in the same binary
+(run them with `curve1174_purego` to compare with four 64-bit limbs in Go). On amd64 (Xeon, Go 1.27) it's slower:
+~60ns vs ~30ns per multiplication, ~37ns vs ~30ns per squaring and ~174µs vs ~113µs per `ScalarMult`, on 386 (same
+CPU) ~265ns vs ~140ns per multiplication, so benchmark it before using it on other platforms.
+On amd64 CPUs with AVX2 but without BMI2/ADX (e.g. Haswell) `ScalarMult` uses four-way vectorized point formulas:
+X, Y, Z and T are kept in 64-bit lanes of 256-bit registers in radix 2^25.1 (ten limbs), so one `VPMULUDQ` multiplies
+a limb of all four coordinates. Doubling takes one four-way squaring and one four-way multiplication, addition of
+cached point three four-way multiplications. On Xeon with AVX-512 and scalar code restricted to `MULQ` it takes
+~75µs instead of ~110µs per `ScalarMult`, but `MULX`/`ADX` code is faster (~60µs), so with BMI2/ADX four-way formulas
+are used only with tag `curve1174_avx2`. `BenchmarkScalarMultAVX2` compares both in one binary, benchmark it on your
+hardware (`CURVE1174_TEST_BACKEND=noadx` selects `MULQ` code).
+`Backend()` describes implementation selected by build tags and CPU features. Tests run with all implementations
+that can be selected at runtime (on amd64 the whole suite is run again without BMI2/ADX and with pure Go field
+arithmetic, unless `-short` is used), environment variable `CURVE1174_TEST_BACKEND` (`adx`, `noadx`, `arm64`, `generic`,
+`generic32`, `radix51`) forces one of them and fails if it's not available in the build.
+
+`ScalarMult` uses 4-bit windows with constant time table lookups. `ScalarMultLadder` is table-free alternative
+(Montgomery ladder with conditional swap on every bit of scalar) that is slower but easier to audit.
+`VarTimeScalarMult` (and `VarTimeScalarMultPrecomputed`) are exceptions to the constant time rule: they use wNAF and
+variable time inversion, so they are faster, but must be used only when both scalar and point are public.
+Timing of `Mul`, `Sqr`, `Inverse`, table lookups, `ScalarMult`, `ScalarMultLadder` and `ScalarBaseMult` (with every
+precomputation level) is checked by dudect-style tests (Welch's t-test on cycle counts of fixed and random inputs,
+harness is in `internal/dudect`). They're opt-in, run them on quiet machine with
+`go test -tags curve1174_dudect -run ConstantTime` and other build tags to check other implementations.
+With `curve1174_opcount` tag the package counts field multiplications, squarings, inversions and table lookups
+(`SnapshotOpCounts`, `ResetOpCounts`, assembly point formulas and AVX2 code are disabled then) and
+`go test -tags curve1174_opcount -run OpCounts` checks counts of `Add`, `Double`, `ScalarMult` and `ScalarBaseMult`.
+Without the tag counters are always zero and cost nothing.
+
+Constant time code doesn't protect against power or electromagnetic analysis. `ScalarMultBlinded` takes randomness
+from `io.Reader`, adds random multiple of group order to the scalar and multiplies input coordinates by random Z, so
+table and intermediate values differ on every call. `ScalarBaseMultBlinded` can't re-randomize static base tables, it
+splits the scalar into two random parts and multiplies Base by each of them with the table (~1.6x slower than
+`ScalarBaseMult`, ~2x faster than `ScalarMultBlinded`).
+`ScalarMultChecked` and `ScalarBaseMultChecked` protect against fault attacks: input, intermediate values and result
+are verified to be on curve (and in prime order subgroup), on failure identity and error are returned instead of
+possibly faulty point. `ScalarBaseMultChecked` verifies only the result, so faults that keep all points valid (e.g.
+skipped addition or wrong table entry) aren't detected there. Tests inject faults into checked values only when built
+with tag `curve1174_faultinject` (`go test -tags curve1174_faultinject -run Faults`), other builds have no hook.
 
 Base point multiplication on the curve uses precomputed table that greatly speeds up computation in common cases (like
-generating public key). It costs ~131kB of heap, you can disable it with tag `curve1174_no_precompute`. If you can spend
-more heap you can use tag `curve1174_precompute_big` which is even faster but eats up 1MB of heap.
+generating public key). Table can be selected at runtime with `SetBasePrecomputation`: `PrecomputeSmall` (default)
+uses ~98kB table, `PrecomputeNone` disables the table and `PrecomputeBig` uses 8-bit signed windows and ~396kB table.
+`PrecomputeBig` needs half of the additions, but every lookup has to scan whole table row to stay constant time, so
+benchmark it on your hardware before switching. Default level can be changed at compile time with tags
+`curve1174_no_precompute` and `curve1174_precompute_big`.
+
+Both tables are generated by `gen/tables` and compiled into the binary as statically initialised variables (Go has
+no read-only variables, they are in the data section, but nothing is computed at runtime), so they cost nothing at
+startup and pages of the table that are never used are never loaded into memory.
+
+Other points that are multiplied many times (e.g. additional generators or long-lived public keys) can be
+precomputed with `(*PrecomputedPoint).Set`. `ScalarMultPrecomputed` then runs in constant time without doublings and
+`VarTimeScalarMultPrecomputed` uses wNAF for public scalars. Window size passed to `Set` trades memory for speed.
+Tables can be saved with `WriteTo`/`MarshalBinary` and loaded with `ReadFrom`/`UnmarshalBinary` instead of being
+recomputed, `UnmarshalBinaryShared` uses the data in place, so read-only memory-mapped file can be shared by many
+processes. The format has versioned header and SHA-256 checksum, every loaded point is checked to be on the curve and
+some of them are checked to be the right multiples. The checksum doesn't protect against tampering and not every entry
+is verified, so tables should be loaded only from trusted sources.
+
+`gen/curve` generates standalone package for other Edwards curves over pseudo-Mersenne fields from the same family
+from JSON parameter file with p = 2^k-c, d and base point. `gen/curve/curves` has files of E-222, E-382, Curve41417
+and E-521 with their standard base points. Generated package has portable Go field arithmetic on any number of
+64-bit limbs, amd64 assembly of field arithmetic (`MULQ` only) from `gen/internal/amd64`, the generator of this
+package's assembly parameterized by curve, Go point formulas copied from `formulas.go`, constant time `ScalarMult`
+and tests against `math/big`. Other optimizations of this package (`MULX`/`ADX`, fused point formulas, AVX2, precomputed
+tables) stay specific to Curve1174.
+
+`(*Point).Bytes` encodes point in 32 bytes (little endian y with the lowest bit of x in the highest bit) and
+`(*Point).SetBytes` decodes it, rejecting non-canonical encodings. Tests compare point operations under every build
+tag with slow and simple `math/big` implementation in `internal/ref`.
+Fuzz targets (`go test -fuzz FuzzFieldMul` etc., Go 1.18 or newer) check field operations on unreduced inputs,
+encoding and scalar multiplications against it too. Seed corpus with edge cases is in `testdata/fuzz`, `TestFuzzSeeds`
+runs the same checks on it with `go test` on any Go version (the module and CI use Go 1.17, fuzz targets aren't
+compiled there).
+Wycheproof-style JSON test vectors for field operations, point encoding and ECDH (with valid, invalid and acceptable
+results, e.g. non-canonical encodings and low order points) are in `testdata/vectors`, they're generated from the
+reference implementation by `gen/vectors` and can be used to test other Curve1174 implementations. There are no
+signature vectors: the package doesn't implement any signature scheme and there's no standard one for Curve1174, so
+signatures are out of its scope.
 
 Finally, `*Point` and `*FieldElement` satisfy fmt package's Formatter interface for formatted printing.
 */
@@ -0,0 +1,85 @@
+package curve1174
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+//PointSize is size of encoded point
+const PointSize = 32
+
+//ErrInvalidEncoding is returned by SetBytes when encoding is not canonical or there's no point with encoded y
+var ErrInvalidEncoding = errors.New("curve1174: invalid point encoding")
+
+//Bytes returns 32 byte encoding of p: affine y as little endian number with the lowest bit of affine x in the highest
+//bit (y < 2^251 so it's never used by y itself). It needs field inversion. Execution time doesn't depend on p.
+func (p *Point) Bytes() []byte {
+	var a Point
+	a.ToAffine(p)
+	b := make([]byte, PointSize)
+	for i := 0; i < 4; i++ {
+		binary.LittleEndian.PutUint64(b[i*8:], a.Y[i])
+	}
+	b[PointSize-1] |= byte(a.X[0]&1) << 7
+	return b
+}
+
+//SetBytes sets p to point encoded by Bytes. It returns ErrInvalidEncoding and leaves p unchanged if b isn't 32 bytes
+//long, y isn't reduced mod p or there's no point with such y and sign of x. Decoded point is on curve, but it doesn't
+//have to be in subgroup generated by Base. Execution time depends only on validity of encoding.
+func (p *Point) SetBytes(b []byte) (*Point, error) {
+	if len(b) != PointSize {
+		return p, ErrInvalidEncoding
+	}
+	var y FieldElement
+	for i := 0; i < 4; i++ {
+		y[i] = binary.LittleEndian.Uint64(b[i*8:])
+	}
+	sign := y[3] >> 63
+	y[3] &^= 1 << 63
+	if y.Cmp(&modulus) >= 0 {
+		return p, ErrInvalidEncoding
+	}
+
+	//x^2 = (1-y^2)/(1-dy^2)
+	var u, v, x FieldElement
+	u.Sqr(&y)
+	v.MulD(&u)
+	u.Sub(&one, &u)
+	v.Sub(&one, &v)
+	if !x.sqrtRatio(&u, &v) || (x.IsZero() && sign == 1) {
+		return p, ErrInvalidEncoding
+	}
+	var negX FieldElement
+	negX.Sub(&zero, &x).Mod(&negX)
+	x.condSet(&negX, (x[0]^sign)&1)
+
+	p.X = x
+	p.Y = y
+	p.Z = one
+	p.T.Mul(&x, &y).Mod(&p.T)
+	return p, nil
+}
+
+//sqrtRatio sets out to square root of u/v (v != 0) and returns true if it exists. p == 3 mod 4 so candidate is
+//(u/v)^((p+1)/4) = (u/v)^(2^249-2), out is reduced mod p.
+func (out *FieldElement) sqrtRatio(u, v *FieldElement) bool {
+	var a, x2, x3, x6, x12, x24, x48, x96, x192, x240, x246, x248 FieldElement
+	a.Inverse(v).Mul(&a, u)
+	//xN = a^(2^N-1)
+	x2.Sqr(&a).Mul(&x2, &a)
+	x3.Sqr(&x2).Mul(&x3, &a)
+	x6.sqrTimes(&x3, 3).Mul(&x6, &x3)
+	x12.sqrTimes(&x6, 6).Mul(&x12, &x6)
+	x24.sqrTimes(&x12, 12).Mul(&x24, &x12)
+	x48.sqrTimes(&x24, 24).Mul(&x48, &x24)
+	x96.sqrTimes(&x48, 48).Mul(&x96, &x48)
+	x192.sqrTimes(&x96, 96).Mul(&x192, &x96)
+	x240.sqrTimes(&x192, 48).Mul(&x240, &x48)
+	x246.sqrTimes(&x240, 6).Mul(&x246, &x6)
+	x248.sqrTimes(&x246, 2).Mul(&x248, &x2)
+	out.Sqr(&x248).Mod(out)
+
+	var check FieldElement
+	return check.Sqr(out).Equals(&a)
+}
@@ -0,0 +1,16 @@
+//go:build curve1174_faultinject
+
+package curve1174
+
+//faultHook is called by checked operations with every verified intermediate point just before the check. Tests
+//built with tag curve1174_faultinject set it to simulate faults.
+var faultHook func(p *Point)
+
+//injectFault calls faultHook with copy of p (so p doesn't escape to heap) and stores modified copy in p
+func injectFault(p *Point) {
+	if faultHook != nil {
+		q := *p
+		faultHook(&q)
+		*p = q
+	}
+}
@@ -19,20 +19,47 @@ const P1 uint64 = 0xffffffffffffffff
 //P0 is 1st (lowest) digit (base 2^64) of P=2^251-9
 const P0 uint64 = 0xfffffffffffffff7
 
-//UOne represents 1
+//one, modulus and zero are package's own copies of UOne, UP and UZero, so changing exported variables doesn't affect
+//results of any operation
+var (
+	one     = FieldElement{1}
+	modulus = FieldElement{P0, P1, P2, P3}
+	zero    FieldElement
+)
+
+//UOne represents 1. It's kept for compatibility, the package doesn't read it, use One to get value that can't be
+//modified by other code.
 var UOne = &FieldElement{1}
 
-//UP represents 2^251-9
+//UP represents 2^251-9. It's kept for compatibility, the package doesn't read it, use Modulus to get value that
+//can't be modified by other code.
 var UP = &FieldElement{P0, P1, P2, P3}
 
-//UZero represents 0
+//UZero represents 0. It's kept for compatibility, the package doesn't read it, use Zero to get value that can't be
+//modified by other code.
 var UZero FieldElement
 
+//One returns 1 as field element
+func One() FieldElement {
+	return one
+}
+
+//Zero returns 0 as field element
+func Zero() FieldElement {
+	return zero
+}
+
+//Modulus returns 2^251-9 (not reduced, it's equal to 0 in the field)
+func Modulus() FieldElement {
+	return modulus
+}
+
 //FieldElement is element of finite field F_p, p=2^251-9
 type FieldElement [4]uint64
 
-//MulD multiplies field element by 1174 mod 2^251-9. Execution time doesn't depend on values
+//MulD multiplies field element by d=-1174 mod 2^251-9. Execution time doesn't depend on values
 func (out *FieldElement) MulD(p *FieldElement) *FieldElement {
+	countOp(&opCounts.MulD)
 	mulD(out, p)
 	return out
 }
@@ -45,12 +72,14 @@ func (out *FieldElement) Mul2(p *FieldElement) *FieldElement {
 
 //Mul multiplies two field elements mod 2^251-9. Execution time doesn't depend on values
 func (out *FieldElement) Mul(p, p2 *FieldElement) *FieldElement {
+	countOp(&opCounts.Mul)
 	mul(out, p, p2)
 	return out
 }
 
 //Sqr squares field element mod 2^251-9. Execution time doesn't depend on values
 func (out *FieldElement) Sqr(p *FieldElement) *FieldElement {
+	countOp(&opCounts.Sqr)
 	sqr(out, p)
 	return out
 }
@@ -86,7 +115,11 @@ func (out *FieldElement) Set(p2 *FieldElement) *FieldElement {
 //inverse by raising p2 to power 2^251-11 (m=2^251-9 is prime, a^-1 == a^(m-2) | m). Execution time doesn't depend on value.
 //Addition chain from https://github.com/mmcloughlin/addchain/blob/master/doc/results.md#curve1174-field-inversion (250sqr+13mul)
 func (out *FieldElement) Inverse(p2 *FieldElement) *FieldElement {
+	countOp(&opCounts.Inverse)
 	var x2, x3, x6, x7, x14, x15, x30, x60, x120, x240, x247 FieldElement
+	//p2 is used after out is written, so copy it in case they are aliased
+	x := *p2
+	p2 = &x
 	x2.Sqr(p2).Mul(&x2, p2)
 	x3.Sqr(&x2).Mul(&x3, p2)
 	x6.sqrTimes(&x3, 3).Mul(&x6, &x3)
@@ -101,6 +134,20 @@ func (out *FieldElement) Inverse(p2 *FieldElement) *FieldElement {
 	return out.sqrTimes(&x247, 2).Mul(out, p2).sqrTimes(out, 2).Mul(out, p2)
 }
 
+//varTimeInverse sets out to be inverse of p2 mod 2^251-9 (out * p2 == 1 | 2^251-9) or to 0 if p2 == 0. It uses binary
+//extended Euclidean algorithm which is much faster than Inverse, but execution time depends on p2, so use it
+//only for public values!
+func (out *FieldElement) varTimeInverse(p2 *FieldElement) *FieldElement {
+	var x FieldElement
+	x.Mod(p2)
+	if x == zero {
+		return out.Set(&zero)
+	}
+	countOp(&opCounts.VarTimeInverse)
+	fastInverse(out, &x)
+	return out.Mod(out)
+}
+
 func (out *FieldElement) IsEven() bool {
 	return out[0]%2 == 0
 }
@@ -151,7 +198,7 @@ func (out *FieldElement) SetBigInt(b1 *big.Int) *FieldElement {
 		}
 	} else {
 		for i := 0; i < len(b) && i < len(out)*2; i++ {
-			out[i/2] |= uint64(b[i]) << ((i / 2) * 32)
+			out[i/2] |= uint64(b[i]) << ((i % 2) * 32)
 		}
 	}
 	return out
@@ -195,3 +242,23 @@ func (out *FieldElement) Cmp(p2 *FieldElement) int {
 	}
 	return 0
 }
+
+//condSet sets out to p2 if set == 1 and leaves it unchanged if set == 0. Execution time doesn't depend on set.
+func (out *FieldElement) condSet(p2 *FieldElement, set uint64) *FieldElement {
+	mask := -set
+	out[0] ^= (out[0] ^ p2[0]) & mask
+	out[1] ^= (out[1] ^ p2[1]) & mask
+	out[2] ^= (out[2] ^ p2[2]) & mask
+	out[3] ^= (out[3] ^ p2[3]) & mask
+	return out
+}
+
+//condSwap swaps out and p2 if swap == 1 and leaves them unchanged if swap == 0. Execution time doesn't depend on swap.
+func (out *FieldElement) condSwap(p2 *FieldElement, swap uint64) {
+	mask := -swap
+	for i := 0; i < 4; i++ {
+		t := (out[i] ^ p2[i]) & mask
+		out[i] ^= t
+		p2[i] ^= t
+	}
+}
@@ -0,0 +1,127 @@
+//go:build !curve1174_purego && !curve1174_radix51
+
+package curve1174
+
+import "github.com/klauspost/cpuid"
+
+//avx2Supported enables code working on four field elements at once
+var avx2Supported = cpuid.CPU.AVX2()
+
+//useAVX2 selects four-way point formulas in ScalarMult. They are faster than scalar code with MULQ, but slower than
+//code with MULX/ADX, so on CPUs with BMI2 and ADX they have to be enabled with tag curve1174_avx2 (and they're
+//disabled with curve1174_opcount, they don't count operations)
+var useAVX2 = avx2Supported && (avx2Enabled || !(cpuid.CPU.BMI2() && cpuid.CPU.ADX())) && !opCountEnabled
+
+//fieldElement4 holds four field elements (lanes) for AVX2 code. Every element is split into ten limbs: 26 bits in the
+//lowest one and 25 bits in the others (limb i > 0 starts at bit 25i+1), limb i of lane k is stored in v[i][k], so one
+//limb of all lanes fits in one 256-bit register and VPMULUDQ multiplies it in all lanes at once.
+type fieldElement4 [10][4]uint64
+
+const (
+	limbMask25 = 1<<25 - 1
+	limbMask26 = 1<<26 - 1
+)
+
+// res=x * y % 2^251-9 in each of four lanes, limbs of x and y have to be smaller than 2^27.6
+//go:noescape
+func mul4(res, x, y *fieldElement4)
+
+// res=x * x % 2^251-9 in each of four lanes, limbs of x have to be smaller than 2^27.6
+//go:noescape
+func sqr4(res, x *fieldElement4)
+
+//setLane sets lane k of v to p
+func (v *fieldElement4) setLane(k int, p *FieldElement) *fieldElement4 {
+	v[0][k] = p[0]&limbMask26 + (p[3]>>59)*9
+	v[1][k] = (p[0] >> 26) & limbMask25
+	v[2][k] = (p[0]>>51 | p[1]<<13) & limbMask25
+	v[3][k] = (p[1] >> 12) & limbMask25
+	v[4][k] = (p[1] >> 37) & limbMask25
+	v[5][k] = (p[1]>>62 | p[2]<<2) & limbMask25
+	v[6][k] = (p[2] >> 23) & limbMask25
+	v[7][k] = (p[2]>>48 | p[3]<<16) & limbMask25
+	v[8][k] = (p[3] >> 9) & limbMask25
+	v[9][k] = (p[3] >> 34) & limbMask25
+	return v
+}
+
+//lane stores value of lane k of v in res. Limbs of v can be bigger than their width (but smaller than 2^63)
+func (v *fieldElement4) lane(k int, res *FieldElement) *FieldElement {
+	var l [10]uint64
+	for i := range l {
+		l[i] = v[i][k]
+	}
+	//bits above 2^251 are folded twice, after last pass only l[9] can exceed its width (result is smaller than 2^252)
+	for pass := 0; pass < 3; pass++ {
+		l[1] += l[0] >> 26
+		l[0] &= limbMask26
+		for i := 1; i < 9; i++ {
+			l[i+1] += l[i] >> 25
+			l[i] &= limbMask25
+		}
+		if pass < 2 {
+			l[0] += (l[9] >> 25) * 9
+			l[9] &= limbMask25
+		}
+	}
+	res[0] = l[0] | l[1]<<26 | l[2]<<51
+	res[1] = l[2]>>13 | l[3]<<12 | l[4]<<37 | l[5]<<62
+	res[2] = l[5]>>2 | l[6]<<23 | l[7]<<48
+	res[3] = l[7]>>16 | l[8]<<9 | l[9]<<34
+	return res
+}
+
+// res=p+p for point p with lanes (X, Y, Z, T), T of p isn't used
+//go:noescape
+func double4(res, p *fieldElement4)
+
+// res=p+q for point p with lanes (X, Y, Z, T) and cached point q with lanes (Y+X, Y-X, 2Z, 2dT)
+//go:noescape
+func addCached4(res, p, q *fieldElement4)
+
+//setPoint sets lanes of v to (X, Y, Z, T) of p
+func (v *fieldElement4) setPoint(p *Point) *fieldElement4 {
+	v.setLane(0, &p.X).setLane(1, &p.Y).setLane(2, &p.Z).setLane(3, &p.T)
+	return v
+}
+
+//setCached sets lanes of v to (Y+X, Y-X, 2Z, 2dT) of c
+func (v *fieldElement4) setCached(c *CachedPoint) *fieldElement4 {
+	v.setLane(0, &c.YPlusX).setLane(1, &c.YMinusX).setLane(2, &c.Z2).setLane(3, &c.T2D)
+	return v
+}
+
+//point stores point with lanes (X, Y, Z, T) of v in p
+func (v *fieldElement4) point(p *Point) *Point {
+	v.lane(0, &p.X)
+	v.lane(1, &p.Y)
+	v.lane(2, &p.Z)
+	v.lane(3, &p.T)
+	return p
+}
+
+//scalarMult4 is main loop of scalarMult using four-way AVX2 point formulas, el and cached are multiples of sp from 0
+//to 15. Execution time depends only on len(b).
+func (p *Point) scalarMult4(el *[16]Point, cached *[16]CachedPoint, b []uint64) *Point {
+	var acc, q fieldElement4
+	var pp CachedPoint
+	first := true
+	for i := len(b) - 1; i >= 0; i-- {
+		for j := 15; j >= 0; j-- {
+			index := (b[i] >> (j * 4)) & 0xF
+			if first {
+				selectPoint(p, el, index)
+				acc.setPoint(p)
+				first = false
+				continue
+			}
+			double4(&acc, &acc)
+			double4(&acc, &acc)
+			double4(&acc, &acc)
+			double4(&acc, &acc)
+			selectCachedPoint(&pp, cached, index)
+			addCached4(&acc, &acc, q.setCached(&pp))
+		}
+	}
+	return acc.point(p)
+}
@@ -0,0 +1,205 @@
+//go:build !curve1174_purego && !curve1174_radix51
+
+package curve1174
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+)
+
+//randomFieldElement4 returns four random field elements and their fieldElement4. If loose is true 2p is added to
+//every lane limb by limb, so limbs are as big as after subtraction.
+func randomFieldElement4(r *rand.Rand, loose bool) ([4]FieldElement, *fieldElement4) {
+	var x [4]FieldElement
+	var v fieldElement4
+	for k := range x {
+		for i := range x[k] {
+			x[k][i] = r.Uint64()
+		}
+		v.setLane(k, &x[k])
+		if loose {
+			v[0][k] += 2 * (limbMask26 - 8)
+			for i := 1; i < 10; i++ {
+				v[i][k] += 2 * limbMask25
+			}
+		}
+	}
+	return x, &v
+}
+
+func TestFieldElement4Lane(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	for n := 0; n < 10000; n++ {
+		x, v := randomFieldElement4(r, n%2 == 1)
+		for k := range x {
+			var res, expected FieldElement
+			v.lane(k, &res).Mod(&res)
+			expected.Mod(&x[k])
+			if res != expected {
+				t.Fatalf("lane %d\n%x\n%x", k, &res, &expected)
+			}
+		}
+	}
+}
+
+func TestMul4(t *testing.T) {
+	if !avx2Supported {
+		t.Skip("AVX2 not supported")
+	}
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	for n := 0; n < 100000; n++ {
+		x, xv := randomFieldElement4(r, n%2 == 1)
+		y, yv := randomFieldElement4(r, n%3 == 1)
+		var mv, sv fieldElement4
+		mul4(&mv, xv, yv)
+		sqr4(&sv, xv)
+		for k := range x {
+			var res, expected FieldElement
+			mv.lane(k, &res).Mod(&res)
+			expected.Mul(&x[k], &y[k]).Mod(&expected)
+			if res != expected {
+				t.Fatalf("mul4 lane %d\n%x\n%x\n%x\n%x", k, &x[k], &y[k], &res, &expected)
+			}
+			sv.lane(k, &res).Mod(&res)
+			expected.Sqr(&x[k]).Mod(&expected)
+			if res != expected {
+				t.Fatalf("sqr4 lane %d\n%x\n%x\n%x", k, &x[k], &res, &expected)
+			}
+		}
+	}
+}
+
+func TestPoint4(t *testing.T) {
+	if !avx2Supported {
+		t.Skip("AVX2 not supported")
+	}
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	var p, q, expected, res Point
+	var b FieldElement
+	for n := 0; n < 1000; n++ {
+		for i := range b {
+			b[i] = r.Uint64()
+		}
+		b[3] &= P3
+		p.ScalarBaseMult(&b)
+		var pv, qv, rv fieldElement4
+		pv.setPoint(&p)
+		//results are fed back to check that limbs stay in range
+		for i := 0; i < 4; i++ {
+			double4(&rv, &pv)
+			expected.Double(&p)
+			rv.point(&res)
+			if !res.IsOnCurve() || !res.ToAffine(&res).Equals(expected.ToAffine(&expected)) {
+				t.Fatalf("double4 %x", &b)
+			}
+			p.Set(&expected)
+			pv = rv
+
+			q.Add(&p, Base)
+			qv.setCached(new(CachedPoint).SetPoint(&q))
+			addCached4(&rv, &pv, &qv)
+			expected.Add(&p, &q)
+			rv.point(&res)
+			if !res.IsOnCurve() || !res.ToAffine(&res).Equals(expected.ToAffine(&expected)) {
+				t.Fatalf("addCached4 %x", &b)
+			}
+			p.Set(&expected)
+			pv = rv
+		}
+	}
+}
+
+func BenchmarkMul4(b *testing.B) {
+	if !avx2Supported {
+		b.Skip("AVX2 not supported")
+	}
+	x := new(fieldElement4).setLane(0, &Base.X).setLane(1, &Base.Y).setLane(2, &Base.Z).setLane(3, &Base.T)
+	for i := 0; i < b.N; i++ {
+		mul4(x, x, x)
+	}
+}
+
+func BenchmarkSqr4(b *testing.B) {
+	if !avx2Supported {
+		b.Skip("AVX2 not supported")
+	}
+	x := new(fieldElement4).setLane(0, &Base.X).setLane(1, &Base.Y).setLane(2, &Base.Z).setLane(3, &Base.T)
+	for i := 0; i < b.N; i++ {
+		sqr4(x, x)
+	}
+}
+
+func BenchmarkDouble4(b *testing.B) {
+	if !avx2Supported {
+		b.Skip("AVX2 not supported")
+	}
+	p := new(fieldElement4).setPoint(Base)
+	for i := 0; i < b.N; i++ {
+		double4(p, p)
+	}
+}
+
+func BenchmarkAddCached4(b *testing.B) {
+	if !avx2Supported {
+		b.Skip("AVX2 not supported")
+	}
+	p := new(fieldElement4).setPoint(Base)
+	q := new(fieldElement4).setCached(new(CachedPoint).SetPoint(Base))
+	for i := 0; i < b.N; i++ {
+		addCached4(p, p, q)
+	}
+}
+
+//BenchmarkScalarMultAVX2 compares ScalarMult with four-way and scalar point formulas in one binary, without tag
+//curve1174_avx2
+func BenchmarkScalarMultAVX2(b *testing.B) {
+	if !avx2Supported {
+		b.Skip("AVX2 not supported")
+	}
+	defer func(use bool) {
+		useAVX2 = use
+	}(useAVX2)
+	f := FromBigInt(scalar)
+	for _, use := range []bool{false, true} {
+		name := "scalar"
+		if use {
+			name = "avx2"
+		}
+		b.Run(name, func(b *testing.B) {
+			useAVX2 = use
+			var p Point
+			for i := 0; i < b.N; i++ {
+				p.ScalarMult(Base, f).ToAffine(&p)
+			}
+		})
+	}
+}
+
+func TestScalarMult4(t *testing.T) {
+	if !avx2Supported {
+		t.Skip("AVX2 not supported")
+	}
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	el := [16]Point{*E, *Base}
+	for i := 2; i < 16; i++ {
+		el[i].Add(&el[i-1], Base)
+	}
+	var cached [16]CachedPoint
+	for i := range cached {
+		cached[i].SetPoint(&el[i])
+	}
+	var b FieldElement
+	var p, expected Point
+	for n := 0; n < 100; n++ {
+		for i := range b {
+			b[i] = r.Uint64()
+		}
+		b[3] &= P3
+		p.scalarMult4(&el, &cached, b[:]).ToAffine(&p)
+		expected.ScalarBaseMult(&b).ToAffine(&expected)
+		if !p.Equals(&expected) {
+			t.Fatalf("%x", &b)
+		}
+	}
+}
@@ -0,0 +1,11 @@
+//go:build !amd64 || curve1174_purego || curve1174_radix51
+
+package curve1174
+
+//AVX2 four-way point arithmetic is available only with amd64 assembly
+
+const useAVX2 = false
+
+func (p *Point) scalarMult4(el *[16]Point, cached *[16]CachedPoint, b []uint64) *Point {
+	panic("curve1174: AVX2 not available")
+}
@@ -1,14 +1,161 @@
-//go:build !curve1174_purego
+//go:build !curve1174_purego && !curve1174_radix51
 
 package curve1174
 
 import "github.com/klauspost/cpuid"
 
+//cpuSupported selects assembly with MULX, ADCX and ADOX (otherwise MULQ is used), useGeneric selects pure Go code
+//from field_generic.go, field_generic64.go and field_portable.go. Only tests change them (see useBackend).
 var cpuSupported = cpuid.CPU.BMI2() && cpuid.CPU.ADX()
+var useGeneric = false
+
+//minusD is -1174 mod 2^251-9
+var minusD = FieldElement{P0 - 1174, P1, P2, P3}
+
+//backends returns implementations available on this CPU
+func backends() []string {
+	if cpuid.CPU.BMI2() && cpuid.CPU.ADX() {
+		return []string{"adx", "noadx", genericBackend}
+	}
+	return []string{"noadx", genericBackend}
+}
+
+func backend() string {
+	switch {
+	case useGeneric:
+		return genericBackend
+	case cpuSupported:
+		return "adx"
+	default:
+		return "noadx"
+	}
+}
+
+func useBackend(name string) {
+	cpuSupported = name == "adx"
+	useGeneric = name == genericBackend
+}
+
+//mulAsm checks cpuSupported itself, sqrAdx and mulDAdx need MULX, ADCX and ADOX so without them mulNoAdx is used
+
+func mul(res, x, y *FieldElement) {
+	if useGeneric {
+		mulGeneric(res, x, y)
+		return
+	}
+	mulAsm(res, x, y)
+}
+
+func sqr(res, x *FieldElement) {
+	switch {
+	case useGeneric:
+		sqrGeneric(res, x)
+	case cpuSupported:
+		sqrAdx(res, x)
+	default:
+		mulNoAdx(res, x, x)
+	}
+}
+
+func mulD(res, x *FieldElement) {
+	switch {
+	case useGeneric:
+		mulDGeneric(res, x)
+	case cpuSupported:
+		mulDAdx(res, x)
+	default:
+		mulNoAdx(res, x, &minusD)
+	}
+}
+
+func mul2(res, x *FieldElement) {
+	if useGeneric {
+		mul2Generic(res, x)
+		return
+	}
+	mul2Asm(res, x)
+}
+
+func add(res, x, y *FieldElement) {
+	if useGeneric {
+		addGeneric(res, x, y)
+		return
+	}
+	addAsm(res, x, y)
+}
+
+func sub(res, x, y *FieldElement) {
+	if useGeneric {
+		subGeneric(res, x, y)
+		return
+	}
+	subAsm(res, x, y)
+}
+
+func mod(res, x *FieldElement) {
+	if useGeneric {
+		modGeneric(res, x)
+		return
+	}
+	modAsm(res, x)
+}
+
+func fastInverse(res, x *FieldElement) {
+	if useGeneric {
+		fastInverseGeneric(res, x)
+		return
+	}
+	fastInverseAsm(res, x)
+}
+
+func selectPoint(res *Point, table *[16]Point, index uint64) {
+	countOp(&opCounts.Select)
+	if useGeneric {
+		selectPointGeneric(res, table, index)
+		return
+	}
+	selectPointAsm(res, table, index)
+}
+
+func selectCachedPoint(res *CachedPoint, table *[16]CachedPoint, index uint64) {
+	countOp(&opCounts.Select)
+	if useGeneric {
+		selectCachedPointGeneric(res, table, index)
+		return
+	}
+	selectCachedPointAsm(res, table, index)
+}
+
+func selectAffineCachedPoint(res *AffineCachedPoint, table *[16]AffineCachedPoint, index uint64) {
+	countOp(&opCounts.Select)
+	if useGeneric {
+		selectAffineCachedPointGeneric(res, table, index)
+		return
+	}
+	selectAffineCachedPointAsm(res, table, index)
+}
+
+func selectAffineCachedPoint129(res *AffineCachedPoint, table *[129]AffineCachedPoint, index uint64) {
+	countOp(&opCounts.Select)
+	if useGeneric {
+		selectAffineCachedPoint129Generic(res, table, index)
+		return
+	}
+	selectAffineCachedPoint129Asm(res, table, index)
+}
+
+func selectAffineCachedPointSlice(res *AffineCachedPoint, table []AffineCachedPoint, index uint64) {
+	countOp(&opCounts.Select)
+	if useGeneric {
+		selectAffineCachedPointSliceGeneric(res, table, index)
+		return
+	}
+	selectAffineCachedPointSliceAsm(res, table, index)
+}
 
 // res=x * y % 2^251-9
 //go:noescape
-func mul(res *FieldElement, x *FieldElement, y *FieldElement)
+func mulAsm(res *FieldElement, x *FieldElement, y *FieldElement)
 
 // res=x * y % 2^251-9
 //go:noescape
@@ -16,14 +163,14 @@ func mulNoAdx(res *FieldElement, x *FieldElement, y *FieldElement)
 
 // res=x % 2^251-9
 //go:noescape
-func mod(res *FieldElement, x *FieldElement)
+func modAsm(res *FieldElement, x *FieldElement)
 
 //go:noescape
-func sqr(res *FieldElement, x *FieldElement)
+func sqrAdx(res *FieldElement, x *FieldElement)
 
 // res=x * 2 % 2^251-9
 //go:noescape
-func mul2(res *FieldElement, x *FieldElement)
+func mul2Asm(res *FieldElement, x *FieldElement)
 
 // res=x >> 1
 //go:noescape
@@ -31,18 +178,30 @@ func div2(res *FieldElement, x *FieldElement)
 
 // res=x - y % 2^251-9
 //go:noescape
-func sub(res *FieldElement, x *FieldElement, y *FieldElement)
+func subAsm(res *FieldElement, x *FieldElement, y *FieldElement)
 
 // res=x + y % 2^251-9
 //go:noescape
-func add(res *FieldElement, x *FieldElement, y *FieldElement)
+func addAsm(res *FieldElement, x *FieldElement, y *FieldElement)
 
 // res=x * -1174 % 2^251-9
 //go:noescape
-func mulD(res *FieldElement, x *FieldElement)
+func mulDAdx(res *FieldElement, x *FieldElement)
+
+//go:noescape
+func selectPointAsm(res *Point, table *[16]Point, index uint64)
+
+//go:noescape
+func fastInverseAsm(res, x *FieldElement)
+
+//go:noescape
+func selectCachedPointAsm(res *CachedPoint, table *[16]CachedPoint, index uint64)
+
+//go:noescape
+func selectAffineCachedPointAsm(res *AffineCachedPoint, table *[16]AffineCachedPoint, index uint64)
 
 //go:noescape
-func selectPoint(res *Point, table *[16]Point, index uint64)
+func selectAffineCachedPoint129Asm(res *AffineCachedPoint, table *[129]AffineCachedPoint, index uint64)
 
 //go:noescape
-func fastInverse(res, x *FieldElement)
+func selectAffineCachedPointSliceAsm(res *AffineCachedPoint, table []AffineCachedPoint, index uint64)
@@ -1,12 +1,12 @@
 // Code generated by command: go run asm.go -out ../field_amd64.s -pkg curve1174. DO NOT EDIT.
 
-// +build !curve1174_purego
+// +build !curve1174_purego,!curve1174_radix51
 
 #include "textflag.h"
 
-// func mul(res *FieldElement, x *FieldElement, y *FieldElement)
+// func mulAsm(res *FieldElement, x *FieldElement, y *FieldElement)
 // Requires: ADX, BMI2
-TEXT ·mul(SB), NOSPLIT, $0-24
+TEXT ·mulAsm(SB), NOSPLIT, $0-24
 	CMPB ·cpuSupported+0(SB), $0x01
 	JNE  mulNoAdx
 	MOVQ x+8(FP), R11
@@ -156,7 +156,6 @@ TEXT ·mul(SB), NOSPLIT, $0-24
 
 mulNoAdx:
 	MOVQ  x+8(FP), R11
-	MOVQ  res+0(FP), AX
 	MOVQ  y+16(FP), R12
 	MOVQ  (R11), AX
 	MULQ  (R12)
@@ -307,7 +306,6 @@ mulNoAdx:
 // func mulNoAdx(res *FieldElement, x *FieldElement, y *FieldElement)
 TEXT ·mulNoAdx(SB), NOSPLIT, $0-24
 	MOVQ  x+8(FP), R11
-	MOVQ  res+0(FP), AX
 	MOVQ  y+16(FP), R12
 	MOVQ  (R11), AX
 	MULQ  (R12)
@@ -455,9 +453,9 @@ TEXT ·mulNoAdx(SB), NOSPLIT, $0-24
 	MOVQ SI, 24(AX)
 	RET
 
-// func mod(res *FieldElement, x *FieldElement)
+// func modAsm(res *FieldElement, x *FieldElement)
 // Requires: CMOV
-TEXT ·mod(SB), NOSPLIT, $0-16
+TEXT ·modAsm(SB), NOSPLIT, $0-16
 	MOVQ x+8(FP), BX
 	MOVQ (BX), AX
 	MOVQ 8(BX), CX
@@ -497,8 +495,8 @@ TEXT ·mod(SB), NOSPLIT, $0-16
 	MOVQ BX, 24(BP)
 	RET
 
-// func mul2(res *FieldElement, x *FieldElement)
-TEXT ·mul2(SB), NOSPLIT, $0-16
+// func mul2Asm(res *FieldElement, x *FieldElement)
+TEXT ·mul2Asm(SB), NOSPLIT, $0-16
 	MOVQ x+8(FP), BX
 	MOVQ (BX), AX
 	MOVQ 8(BX), CX
@@ -547,8 +545,8 @@ TEXT ·div2(SB), NOSPLIT, $0-16
 	MOVQ BX, 24(BP)
 	RET
 
-// func sub(res *FieldElement, x *FieldElement, y *FieldElement)
-TEXT ·sub(SB), NOSPLIT, $0-24
+// func subAsm(res *FieldElement, x *FieldElement, y *FieldElement)
+TEXT ·subAsm(SB), NOSPLIT, $0-24
 	MOVQ x+8(FP), BX
 	MOVQ y+16(FP), BP
 	MOVQ (BX), AX
@@ -577,8 +575,8 @@ TEXT ·sub(SB), NOSPLIT, $0-24
 	MOVQ BX, 24(BP)
 	RET
 
-// func add(res *FieldElement, x *FieldElement, y *FieldElement)
-TEXT ·add(SB), NOSPLIT, $0-24
+// func addAsm(res *FieldElement, x *FieldElement, y *FieldElement)
+TEXT ·addAsm(SB), NOSPLIT, $0-24
 	MOVQ x+8(FP), BX
 	MOVQ y+16(FP), BP
 	MOVQ res+0(FP), AX
@@ -608,11 +606,10 @@ TEXT ·add(SB), NOSPLIT, $0-24
 	MOVQ BX, 24(BP)
 	RET
 
-// func mulD(res *FieldElement, x *FieldElement)
+// func mulDAdx(res *FieldElement, x *FieldElement)
 // Requires: ADX, BMI2
-TEXT ·mulD(SB), NOSPLIT, $0-16
+TEXT ·mulDAdx(SB), NOSPLIT, $0-16
 	MOVQ  x+8(FP), R11
-	MOVQ  res+0(FP), R12
 	XORQ  R10, R10
 	XORQ  BX, BX
 	XORQ  BP, BP
@@ -624,14 +621,14 @@ TEXT ·mulD(SB), NOSPLIT, $0-16
 	MULXQ (R11), AX, CX
 
 	// x[0]*y[1]
-	MULXQ 8(R11), R14, R13
-	ADCXQ R14, CX
-	ADOXQ R13, BX
+	MULXQ 8(R11), R13, R12
+	ADCXQ R13, CX
+	ADOXQ R12, BX
 
 	// x[0]*y[2]
-	MULXQ 16(R11), R14, R13
-	ADCXQ R14, BX
-	ADOXQ R13, BP
+	MULXQ 16(R11), R13, R12
+	ADCXQ R13, BX
+	ADOXQ R12, BP
 
 	// x[0]*y[3]
 	MULXQ 24(R11), R11, DX
@@ -681,15 +678,84 @@ TEXT ·mulD(SB), NOSPLIT, $0-16
 	SBBQ  CX, SI
 	SBBQ  BX, DI
 	SBBQ  BP, R8
-	MOVQ  DX, (R12)
-	MOVQ  SI, 8(R12)
-	MOVQ  DI, 16(R12)
-	MOVQ  R8, 24(R12)
+	MOVQ  DX, AX
+	MOVQ  SI, CX
+	MOVQ  DI, BX
+	MOVQ  R8, BP
+
+	// Store results
+	MOVQ res+0(FP), DX
+	MOVQ AX, (DX)
+	MOVQ CX, 8(DX)
+	MOVQ BX, 16(DX)
+	MOVQ BP, 24(DX)
+	RET
+
+// func selectPointAsm(res *Point, table *[16]Point, index uint64)
+// Requires: SSE2
+TEXT ·selectPointAsm(SB), NOSPLIT, $0-24
+	MOVQ    index+16(FP), X0
+	MOVQ    table+8(FP), AX
+	MOVQ    res+0(FP), CX
+	PSHUFD  $0x00, X0, X0
+	PXOR    X1, X1
+	PXOR    X2, X2
+	PXOR    X3, X3
+	PXOR    X4, X4
+	PXOR    X5, X5
+	PXOR    X6, X6
+	PXOR    X7, X7
+	PXOR    X8, X8
+	MOVQ    $0x0000000000000010, DX
+	PCMPEQL X9, X9
+	PXOR    X11, X11
+	PSUBL   X9, X11
+	PXOR    X9, X9
+
+loop:
+	MOVO    X9, X10
+	PCMPEQL X0, X10
+	MOVOU   (AX), X12
+	PAND    X10, X12
+	POR     X12, X1
+	MOVOU   16(AX), X12
+	PAND    X10, X12
+	POR     X12, X2
+	MOVOU   32(AX), X12
+	PAND    X10, X12
+	POR     X12, X3
+	MOVOU   48(AX), X12
+	PAND    X10, X12
+	POR     X12, X4
+	MOVOU   64(AX), X12
+	PAND    X10, X12
+	POR     X12, X5
+	MOVOU   80(AX), X12
+	PAND    X10, X12
+	POR     X12, X6
+	MOVOU   96(AX), X12
+	PAND    X10, X12
+	POR     X12, X7
+	MOVOU   112(AX), X12
+	PAND    X10, X12
+	POR     X12, X8
+	ADDQ    $0x80, AX
+	PADDL   X11, X9
+	SUBQ    $0x01, DX
+	JNZ     loop
+	MOVOU   X1, (CX)
+	MOVOU   X2, 16(CX)
+	MOVOU   X3, 32(CX)
+	MOVOU   X4, 48(CX)
+	MOVOU   X5, 64(CX)
+	MOVOU   X6, 80(CX)
+	MOVOU   X7, 96(CX)
+	MOVOU   X8, 112(CX)
 	RET
 
-// func selectPoint(res *Point, table *[16]Point, index uint64)
+// func selectCachedPointAsm(res *CachedPoint, table *[16]CachedPoint, index uint64)
 // Requires: SSE2
-TEXT ·selectPoint(SB), NOSPLIT, $0-24
+TEXT ·selectCachedPointAsm(SB), NOSPLIT, $0-24
 	MOVQ    index+16(FP), X0
 	MOVQ    table+8(FP), AX
 	MOVQ    res+0(FP), CX
@@ -749,9 +815,169 @@ loop:
 	MOVOU   X8, 112(CX)
 	RET
 
-// func fastInverse(res *FieldElement, x *FieldElement)
+// func selectAffineCachedPointAsm(res *AffineCachedPoint, table *[16]AffineCachedPoint, index uint64)
+// Requires: SSE2
+TEXT ·selectAffineCachedPointAsm(SB), NOSPLIT, $0-24
+	MOVQ    index+16(FP), X0
+	MOVQ    table+8(FP), AX
+	MOVQ    res+0(FP), CX
+	PSHUFD  $0x00, X0, X0
+	PXOR    X1, X1
+	PXOR    X2, X2
+	PXOR    X3, X3
+	PXOR    X4, X4
+	PXOR    X5, X5
+	PXOR    X6, X6
+	MOVQ    $0x0000000000000010, DX
+	PCMPEQL X7, X7
+	PXOR    X9, X9
+	PSUBL   X7, X9
+	PXOR    X7, X7
+
+loop:
+	MOVO    X7, X8
+	PCMPEQL X0, X8
+	MOVOU   (AX), X10
+	PAND    X8, X10
+	POR     X10, X1
+	MOVOU   16(AX), X10
+	PAND    X8, X10
+	POR     X10, X2
+	MOVOU   32(AX), X10
+	PAND    X8, X10
+	POR     X10, X3
+	MOVOU   48(AX), X10
+	PAND    X8, X10
+	POR     X10, X4
+	MOVOU   64(AX), X10
+	PAND    X8, X10
+	POR     X10, X5
+	MOVOU   80(AX), X10
+	PAND    X8, X10
+	POR     X10, X6
+	ADDQ    $0x60, AX
+	PADDL   X9, X7
+	SUBQ    $0x01, DX
+	JNZ     loop
+	MOVOU   X1, (CX)
+	MOVOU   X2, 16(CX)
+	MOVOU   X3, 32(CX)
+	MOVOU   X4, 48(CX)
+	MOVOU   X5, 64(CX)
+	MOVOU   X6, 80(CX)
+	RET
+
+// func selectAffineCachedPoint129Asm(res *AffineCachedPoint, table *[129]AffineCachedPoint, index uint64)
+// Requires: SSE2
+TEXT ·selectAffineCachedPoint129Asm(SB), NOSPLIT, $0-24
+	MOVQ    index+16(FP), X0
+	MOVQ    table+8(FP), AX
+	MOVQ    res+0(FP), CX
+	PSHUFD  $0x00, X0, X0
+	PXOR    X1, X1
+	PXOR    X2, X2
+	PXOR    X3, X3
+	PXOR    X4, X4
+	PXOR    X5, X5
+	PXOR    X6, X6
+	MOVQ    $0x0000000000000081, DX
+	PCMPEQL X7, X7
+	PXOR    X9, X9
+	PSUBL   X7, X9
+	PXOR    X7, X7
+
+loop:
+	MOVO    X7, X8
+	PCMPEQL X0, X8
+	MOVOU   (AX), X10
+	PAND    X8, X10
+	POR     X10, X1
+	MOVOU   16(AX), X10
+	PAND    X8, X10
+	POR     X10, X2
+	MOVOU   32(AX), X10
+	PAND    X8, X10
+	POR     X10, X3
+	MOVOU   48(AX), X10
+	PAND    X8, X10
+	POR     X10, X4
+	MOVOU   64(AX), X10
+	PAND    X8, X10
+	POR     X10, X5
+	MOVOU   80(AX), X10
+	PAND    X8, X10
+	POR     X10, X6
+	ADDQ    $0x60, AX
+	PADDL   X9, X7
+	SUBQ    $0x01, DX
+	JNZ     loop
+	MOVOU   X1, (CX)
+	MOVOU   X2, 16(CX)
+	MOVOU   X3, 32(CX)
+	MOVOU   X4, 48(CX)
+	MOVOU   X5, 64(CX)
+	MOVOU   X6, 80(CX)
+	RET
+
+// func selectAffineCachedPointSliceAsm(res *AffineCachedPoint, table []AffineCachedPoint, index uint64)
+// Requires: SSE2
+TEXT ·selectAffineCachedPointSliceAsm(SB), NOSPLIT, $0-40
+	MOVQ    index+32(FP), X0
+	MOVQ    table_base+8(FP), AX
+	MOVQ    res+0(FP), CX
+	PSHUFD  $0x00, X0, X0
+	PXOR    X1, X1
+	PXOR    X2, X2
+	PXOR    X3, X3
+	PXOR    X4, X4
+	PXOR    X5, X5
+	PXOR    X6, X6
+	MOVQ    table_len+16(FP), DX
+	TESTQ   DX, DX
+	JZ      store
+	PCMPEQL X7, X7
+	PXOR    X9, X9
+	PSUBL   X7, X9
+	PXOR    X7, X7
+
+loop:
+	MOVO    X7, X8
+	PCMPEQL X0, X8
+	MOVOU   (AX), X10
+	PAND    X8, X10
+	POR     X10, X1
+	MOVOU   16(AX), X10
+	PAND    X8, X10
+	POR     X10, X2
+	MOVOU   32(AX), X10
+	PAND    X8, X10
+	POR     X10, X3
+	MOVOU   48(AX), X10
+	PAND    X8, X10
+	POR     X10, X4
+	MOVOU   64(AX), X10
+	PAND    X8, X10
+	POR     X10, X5
+	MOVOU   80(AX), X10
+	PAND    X8, X10
+	POR     X10, X6
+	ADDQ    $0x60, AX
+	PADDL   X9, X7
+	SUBQ    $0x01, DX
+	JNZ     loop
+
+store:
+	MOVOU X1, (CX)
+	MOVOU X2, 16(CX)
+	MOVOU X3, 32(CX)
+	MOVOU X4, 48(CX)
+	MOVOU X5, 64(CX)
+	MOVOU X6, 80(CX)
+	RET
+
+// func fastInverseAsm(res *FieldElement, x *FieldElement)
 // Requires: SSE2
-TEXT ·fastInverse(SB), NOSPLIT, $8-16
+TEXT ·fastInverseAsm(SB), NOSPLIT, $8-16
 	MOVQ x+8(FP), AX
 	MOVQ (AX), R8
 	MOVQ 8(AX), R9
@@ -789,12 +1015,12 @@ notzero:
 uloop:
 	TESTQ $0x00000001, (SP)
 	JZ    divu
-	SUBQ  $0xf7, (SP)
-	SBBQ  $0xff, SI
-	SBBQ  $0xff, DI
-	MOVQ  (SP), SI
-	MOVQ  $0x07ffffffffffffff, SI
-	SBBQ  SI, BP
+	ADDQ  $0x09, (SP)
+	ADCQ  $0x00, SI
+	ADCQ  $0x00, DI
+	MOVQ  SI, X1
+	MOVQ  $0xf800000000000000, SI
+	ADCQ  SI, BP
 	MOVQ  X1, SI
 
 divu:
@@ -816,12 +1042,12 @@ afteru:
 vloop:
 	TESTQ $0x00000001, R12
 	JZ    divv
-	SUBQ  $0xf7, R12
-	SBBQ  $0xff, R13
-	SBBQ  $0xff, R14
-	MOVQ  R12, SI
-	MOVQ  $0x07ffffffffffffff, SI
-	SBBQ  SI, R15
+	ADDQ  $0x09, R12
+	ADCQ  $0x00, R13
+	ADCQ  $0x00, R14
+	MOVQ  SI, X1
+	MOVQ  $0xf800000000000000, SI
+	ADCQ  SI, R15
 	MOVQ  X1, SI
 
 divv:
@@ -859,7 +1085,40 @@ greateru:
 	SBBQ R13, SI
 	SBBQ R14, DI
 	SBBQ R15, BP
-	JMP  mainloop
+	MOVQ SI, X1
+	MOVQ BP, SI
+	SARQ $0x3c, SI
+	ADDQ $0x02, SI
+	CMPQ SI, $0x02
+	JG   sub4pb
+	CMPQ SI, $0x01
+	JL   add4pb
+	MOVQ X1, SI
+	JMP  reducedb
+
+sub4pb:
+	MOVQ X1, SI
+	ADDQ $0x24, (SP)
+	ADCQ $0x00, SI
+	ADCQ $0x00, DI
+	MOVQ SI, X1
+	MOVQ $0xe000000000000000, SI
+	ADCQ SI, BP
+	MOVQ X1, SI
+	JMP  reducedb
+
+add4pb:
+	MOVQ X1, SI
+	SUBQ $0x24, (SP)
+	SBBQ $0x00, SI
+	SBBQ $0x00, DI
+	MOVQ SI, X1
+	MOVQ $0xe000000000000000, SI
+	SBBQ SI, BP
+	MOVQ X1, SI
+
+reducedb:
+	JMP mainloop
 
 greaterv:
 	SUBQ AX, R8
@@ -870,18 +1129,54 @@ greaterv:
 	SBBQ SI, R13
 	SBBQ DI, R14
 	SBBQ BP, R15
-	JMP  mainloop
+	MOVQ SI, X1
+	MOVQ R15, SI
+	SARQ $0x3c, SI
+	ADDQ $0x02, SI
+	CMPQ SI, $0x02
+	JG   sub4pd
+	CMPQ SI, $0x01
+	JL   add4pd
+	MOVQ X1, SI
+	JMP  reducedd
+
+sub4pd:
+	MOVQ X1, SI
+	ADDQ $0x24, R12
+	ADCQ $0x00, R13
+	ADCQ $0x00, R14
+	MOVQ SI, X1
+	MOVQ $0xe000000000000000, SI
+	ADCQ SI, R15
+	MOVQ X1, SI
+	JMP  reducedd
+
+add4pd:
+	MOVQ X1, SI
+	SUBQ $0x24, R12
+	SBBQ $0x00, R13
+	SBBQ $0x00, R14
+	MOVQ SI, X1
+	MOVQ $0xe000000000000000, SI
+	SBBQ SI, R15
+	MOVQ X1, SI
+
+reducedd:
+	JMP mainloop
 
 aftermain:
 	MOVQ X0, SP
 	MOVQ res+0(FP), CX
+
+fixsign:
 	CMPQ R15, $0x00
 	JNS  store
-	ADDQ $0xf7, R12
-	ADCQ $0xff, R13
-	ADCQ $0xff, R14
-	MOVQ $0x07ffffffffffffff, AX
-	ADCQ AX, R15
+	SUBQ $0x09, R12
+	SBBQ $0x00, R13
+	SBBQ $0x00, R14
+	MOVQ $0xf800000000000000, AX
+	SBBQ AX, R15
+	JMP  fixsign
 
 store:
 	MOVQ R12, (CX)
@@ -890,9 +1185,9 @@ store:
 	MOVQ R15, 24(CX)
 	RET
 
-// func sqr(res *FieldElement, x *FieldElement)
+// func sqrAdx(res *FieldElement, x *FieldElement)
 // Requires: ADX, BMI2
-TEXT ·sqr(SB), NOSPLIT, $0-16
+TEXT ·sqrAdx(SB), NOSPLIT, $0-16
 	MOVQ x+8(FP), AX
 
 	// load x to registers
@@ -1083,3 +1378,13586 @@ TEXT ·shl2(SB), NOSPLIT, $0-16
 	MOVQ DI, 48(R9)
 	MOVQ R8, 56(R9)
 	RET
+
+// func pointAdd(res *Point, p1 *Point, p2 *Point)
+// Requires: ADX, BMI2
+TEXT ·pointAdd(SB), NOSPLIT, $256-24
+	MOVQ p1+8(FP), R11
+	MOVQ p2+16(FP), R12
+
+	// Fill all regs
+	MOVQ  24(R11), DX
+	MULXQ 8(R12), SI, DI
+	MULXQ 24(R12), R8, R9
+	MOVQ  (R11), DX
+	MULXQ (R12), AX, CX
+	MULXQ 16(R12), BX, BP
+	XORQ  R10, R10
+
+	// First 1-5 chain
+	// x[0]*y[1]
+	MULXQ 8(R12), R13, DX
+	ADCXQ R13, CX
+	ADOXQ DX, BX
+
+	// x[2]*y[0]
+	MOVQ  16(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[2]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[2]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, SI
+	ADOXQ R13, DI
+
+	// x[2]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, DI
+	ADOXQ DX, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// Second 1-5 chain
+	// x[1]*y[0]
+	MOVQ  8(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, CX
+	ADOXQ R13, BX
+
+	// x[1]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[1]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[1]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, SI
+	ADOXQ DX, DI
+
+	// x[3]*y[2]
+	MOVQ  24(R11), DX
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, DI
+	ADOXQ R13, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[3]*y[0]
+	MULXQ (R12), R13, DX
+	ADCXQ R13, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[0]*y[3]
+	MOVQ  (R11), DX
+	MULXQ 24(R12), R11, DX
+	ADCXQ R11, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+	MOVQ  R9, DX
+	SHRQ  $0x3b, DX
+	SHLQ  $0x05, R8, R9
+	SHLQ  $0x05, DI, R8
+	SHLQ  $0x05, SI, DI
+	SHLQ  $0x05, BP, SI
+	MOVQ  $0x07ffffffffffffff, R11
+	ANDQ  R11, BP
+	XORQ  R10, R10
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x03, R9, DX
+	SHLQ  $0x03, R8, R9
+	SHLQ  $0x03, DI, R8
+	SHLQ  $0x03, SI, DI
+	SHLQ  $0x03, SI
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x05, BP, R10
+	ANDQ  R11, BP
+	LEAQ  (R10)(R10*8), R10
+	ADDQ  R10, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	LEAQ  (SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	MOVQ  p1+8(FP), R11
+	LEAQ  32(R11), R11
+	MOVQ  p2+16(FP), R12
+	LEAQ  32(R12), R12
+
+	// Fill all regs
+	MOVQ  24(R11), DX
+	MULXQ 8(R12), SI, DI
+	MULXQ 24(R12), R8, R9
+	MOVQ  (R11), DX
+	MULXQ (R12), AX, CX
+	MULXQ 16(R12), BX, BP
+	XORQ  R10, R10
+
+	// First 1-5 chain
+	// x[0]*y[1]
+	MULXQ 8(R12), R13, DX
+	ADCXQ R13, CX
+	ADOXQ DX, BX
+
+	// x[2]*y[0]
+	MOVQ  16(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[2]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[2]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, SI
+	ADOXQ R13, DI
+
+	// x[2]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, DI
+	ADOXQ DX, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// Second 1-5 chain
+	// x[1]*y[0]
+	MOVQ  8(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, CX
+	ADOXQ R13, BX
+
+	// x[1]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[1]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[1]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, SI
+	ADOXQ DX, DI
+
+	// x[3]*y[2]
+	MOVQ  24(R11), DX
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, DI
+	ADOXQ R13, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[3]*y[0]
+	MULXQ (R12), R13, DX
+	ADCXQ R13, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[0]*y[3]
+	MOVQ  (R11), DX
+	MULXQ 24(R12), R11, DX
+	ADCXQ R11, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+	MOVQ  R9, DX
+	SHRQ  $0x3b, DX
+	SHLQ  $0x05, R8, R9
+	SHLQ  $0x05, DI, R8
+	SHLQ  $0x05, SI, DI
+	SHLQ  $0x05, BP, SI
+	MOVQ  $0x07ffffffffffffff, R11
+	ANDQ  R11, BP
+	XORQ  R10, R10
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x03, R9, DX
+	SHLQ  $0x03, R8, R9
+	SHLQ  $0x03, DI, R8
+	SHLQ  $0x03, SI, DI
+	SHLQ  $0x03, SI
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x05, BP, R10
+	ANDQ  R11, BP
+	LEAQ  (R10)(R10*8), R10
+	ADDQ  R10, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	LEAQ  32(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	MOVQ  p1+8(FP), R11
+	LEAQ  64(R11), R11
+	MOVQ  p2+16(FP), R12
+	LEAQ  64(R12), R12
+
+	// Fill all regs
+	MOVQ  24(R11), DX
+	MULXQ 8(R12), SI, DI
+	MULXQ 24(R12), R8, R9
+	MOVQ  (R11), DX
+	MULXQ (R12), AX, CX
+	MULXQ 16(R12), BX, BP
+	XORQ  R10, R10
+
+	// First 1-5 chain
+	// x[0]*y[1]
+	MULXQ 8(R12), R13, DX
+	ADCXQ R13, CX
+	ADOXQ DX, BX
+
+	// x[2]*y[0]
+	MOVQ  16(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[2]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[2]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, SI
+	ADOXQ R13, DI
+
+	// x[2]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, DI
+	ADOXQ DX, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// Second 1-5 chain
+	// x[1]*y[0]
+	MOVQ  8(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, CX
+	ADOXQ R13, BX
+
+	// x[1]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[1]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[1]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, SI
+	ADOXQ DX, DI
+
+	// x[3]*y[2]
+	MOVQ  24(R11), DX
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, DI
+	ADOXQ R13, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[3]*y[0]
+	MULXQ (R12), R13, DX
+	ADCXQ R13, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[0]*y[3]
+	MOVQ  (R11), DX
+	MULXQ 24(R12), R11, DX
+	ADCXQ R11, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+	MOVQ  R9, DX
+	SHRQ  $0x3b, DX
+	SHLQ  $0x05, R8, R9
+	SHLQ  $0x05, DI, R8
+	SHLQ  $0x05, SI, DI
+	SHLQ  $0x05, BP, SI
+	MOVQ  $0x07ffffffffffffff, R11
+	ANDQ  R11, BP
+	XORQ  R10, R10
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x03, R9, DX
+	SHLQ  $0x03, R8, R9
+	SHLQ  $0x03, DI, R8
+	SHLQ  $0x03, SI, DI
+	SHLQ  $0x03, SI
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x05, BP, R10
+	ANDQ  R11, BP
+	LEAQ  (R10)(R10*8), R10
+	ADDQ  R10, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	LEAQ  64(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  64(SP), R11
+	XORQ  R10, R10
+	XORQ  BX, BX
+	XORQ  BP, BP
+	XORQ  SI, SI
+	XORQ  DI, DI
+	XORQ  R8, R8
+	XORQ  R9, R9
+	MOVQ  $0x0000000000000496, DX
+	MULXQ (R11), AX, CX
+
+	// x[0]*y[1]
+	MULXQ 8(R11), R13, R12
+	ADCXQ R13, CX
+	ADOXQ R12, BX
+
+	// x[0]*y[2]
+	MULXQ 16(R11), R13, R12
+	ADCXQ R13, BX
+	ADOXQ R12, BP
+
+	// x[0]*y[3]
+	MULXQ 24(R11), R11, DX
+	ADCXQ R11, BP
+	ADOXQ DX, SI
+
+	// Carry 4-6
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	MOVQ  R9, DX
+	SHRQ  $0x3b, DX
+	SHLQ  $0x05, R8, R9
+	SHLQ  $0x05, DI, R8
+	SHLQ  $0x05, SI, DI
+	SHLQ  $0x05, BP, SI
+	MOVQ  $0x07ffffffffffffff, R11
+	ANDQ  R11, BP
+	XORQ  R10, R10
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x03, R9, DX
+	SHLQ  $0x03, R8, R9
+	SHLQ  $0x03, DI, R8
+	SHLQ  $0x03, SI, DI
+	SHLQ  $0x03, SI
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x05, BP, R10
+	ANDQ  R11, BP
+	LEAQ  (R10)(R10*8), R10
+	ADDQ  R10, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	MOVQ  $0xfffffffffffffff7, DX
+	MOVQ  $0xffffffffffffffff, SI
+	MOVQ  $0xffffffffffffffff, DI
+	MOVQ  $0x07ffffffffffffff, R8
+	SUBQ  AX, DX
+	SBBQ  CX, SI
+	SBBQ  BX, DI
+	SBBQ  BP, R8
+	MOVQ  DX, AX
+	MOVQ  SI, CX
+	MOVQ  DI, BX
+	MOVQ  R8, BP
+	LEAQ  64(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	MOVQ  p1+8(FP), R11
+	LEAQ  96(R11), R11
+	MOVQ  p2+16(FP), R12
+	LEAQ  96(R12), R12
+
+	// Fill all regs
+	MOVQ  24(R11), DX
+	MULXQ 8(R12), SI, DI
+	MULXQ 24(R12), R8, R9
+	MOVQ  (R11), DX
+	MULXQ (R12), AX, CX
+	MULXQ 16(R12), BX, BP
+	XORQ  R10, R10
+
+	// First 1-5 chain
+	// x[0]*y[1]
+	MULXQ 8(R12), R13, DX
+	ADCXQ R13, CX
+	ADOXQ DX, BX
+
+	// x[2]*y[0]
+	MOVQ  16(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[2]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[2]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, SI
+	ADOXQ R13, DI
+
+	// x[2]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, DI
+	ADOXQ DX, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// Second 1-5 chain
+	// x[1]*y[0]
+	MOVQ  8(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, CX
+	ADOXQ R13, BX
+
+	// x[1]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[1]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[1]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, SI
+	ADOXQ DX, DI
+
+	// x[3]*y[2]
+	MOVQ  24(R11), DX
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, DI
+	ADOXQ R13, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[3]*y[0]
+	MULXQ (R12), R13, DX
+	ADCXQ R13, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[0]*y[3]
+	MOVQ  (R11), DX
+	MULXQ 24(R12), R11, DX
+	ADCXQ R11, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+	MOVQ  R9, DX
+	SHRQ  $0x3b, DX
+	SHLQ  $0x05, R8, R9
+	SHLQ  $0x05, DI, R8
+	SHLQ  $0x05, SI, DI
+	SHLQ  $0x05, BP, SI
+	MOVQ  $0x07ffffffffffffff, R11
+	ANDQ  R11, BP
+	XORQ  R10, R10
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x03, R9, DX
+	SHLQ  $0x03, R8, R9
+	SHLQ  $0x03, DI, R8
+	SHLQ  $0x03, SI, DI
+	SHLQ  $0x03, SI
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x05, BP, R10
+	ANDQ  R11, BP
+	LEAQ  (R10)(R10*8), R10
+	ADDQ  R10, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	LEAQ  96(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	MOVQ  p2+16(FP), BP
+	MOVQ  p2+16(FP), DX
+	LEAQ  32(DX), DX
+	MOVQ  (BP), AX
+	MOVQ  8(BP), CX
+	MOVQ  16(BP), BX
+	MOVQ  24(BP), BP
+	ADDQ  (DX), AX
+	ADCQ  8(DX), CX
+	ADCQ  16(DX), BX
+	ADCQ  24(DX), BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	ADDQ  DX, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	ADDQ  DX, AX
+	LEAQ  160(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	MOVQ  p1+8(FP), BP
+	MOVQ  p1+8(FP), DX
+	LEAQ  32(DX), DX
+	MOVQ  (BP), AX
+	MOVQ  8(BP), CX
+	MOVQ  16(BP), BX
+	MOVQ  24(BP), BP
+	ADDQ  (DX), AX
+	ADCQ  8(DX), CX
+	ADCQ  16(DX), BX
+	ADCQ  24(DX), BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	ADDQ  DX, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	ADDQ  DX, AX
+	LEAQ  128(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  128(SP), R11
+	LEAQ  160(SP), R12
+
+	// Fill all regs
+	MOVQ  24(R11), DX
+	MULXQ 8(R12), SI, DI
+	MULXQ 24(R12), R8, R9
+	MOVQ  (R11), DX
+	MULXQ (R12), AX, CX
+	MULXQ 16(R12), BX, BP
+	XORQ  R10, R10
+
+	// First 1-5 chain
+	// x[0]*y[1]
+	MULXQ 8(R12), R13, DX
+	ADCXQ R13, CX
+	ADOXQ DX, BX
+
+	// x[2]*y[0]
+	MOVQ  16(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[2]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[2]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, SI
+	ADOXQ R13, DI
+
+	// x[2]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, DI
+	ADOXQ DX, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// Second 1-5 chain
+	// x[1]*y[0]
+	MOVQ  8(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, CX
+	ADOXQ R13, BX
+
+	// x[1]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[1]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[1]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, SI
+	ADOXQ DX, DI
+
+	// x[3]*y[2]
+	MOVQ  24(R11), DX
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, DI
+	ADOXQ R13, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[3]*y[0]
+	MULXQ (R12), R13, DX
+	ADCXQ R13, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[0]*y[3]
+	MOVQ  (R11), DX
+	MULXQ 24(R12), R11, DX
+	ADCXQ R11, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+	MOVQ  R9, DX
+	SHRQ  $0x3b, DX
+	SHLQ  $0x05, R8, R9
+	SHLQ  $0x05, DI, R8
+	SHLQ  $0x05, SI, DI
+	SHLQ  $0x05, BP, SI
+	MOVQ  $0x07ffffffffffffff, R11
+	ANDQ  R11, BP
+	XORQ  R10, R10
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x03, R9, DX
+	SHLQ  $0x03, R8, R9
+	SHLQ  $0x03, DI, R8
+	SHLQ  $0x03, SI, DI
+	SHLQ  $0x03, SI
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x05, BP, R10
+	ANDQ  R11, BP
+	LEAQ  (R10)(R10*8), R10
+	ADDQ  R10, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	LEAQ  128(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  (SP), DX
+	SUBQ  (DX), AX
+	SBBQ  8(DX), CX
+	SBBQ  16(DX), BX
+	SBBQ  24(DX), BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	SBBQ  $0x00, CX
+	SBBQ  $0x00, BX
+	SBBQ  $0x00, BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	LEAQ  128(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  32(SP), DX
+	SUBQ  (DX), AX
+	SBBQ  8(DX), CX
+	SBBQ  16(DX), BX
+	SBBQ  24(DX), BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	SBBQ  $0x00, CX
+	SBBQ  $0x00, BX
+	SBBQ  $0x00, BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	LEAQ  128(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  96(SP), BP
+	LEAQ  64(SP), DX
+	MOVQ  (BP), AX
+	MOVQ  8(BP), CX
+	MOVQ  16(BP), BX
+	MOVQ  24(BP), BP
+	SUBQ  (DX), AX
+	SBBQ  8(DX), CX
+	SBBQ  16(DX), BX
+	SBBQ  24(DX), BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	SBBQ  $0x00, CX
+	SBBQ  $0x00, BX
+	SBBQ  $0x00, BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	LEAQ  192(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  96(SP), BP
+	LEAQ  64(SP), DX
+	MOVQ  (BP), AX
+	MOVQ  8(BP), CX
+	MOVQ  16(BP), BX
+	MOVQ  24(BP), BP
+	ADDQ  (DX), AX
+	ADCQ  8(DX), CX
+	ADCQ  16(DX), BX
+	ADCQ  24(DX), BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	ADDQ  DX, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	ADDQ  DX, AX
+	LEAQ  224(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  32(SP), BP
+	LEAQ  (SP), DX
+	MOVQ  (BP), AX
+	MOVQ  8(BP), CX
+	MOVQ  16(BP), BX
+	MOVQ  24(BP), BP
+	SUBQ  (DX), AX
+	SBBQ  8(DX), CX
+	SBBQ  16(DX), BX
+	SBBQ  24(DX), BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	SBBQ  $0x00, CX
+	SBBQ  $0x00, BX
+	SBBQ  $0x00, BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	LEAQ  160(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  128(SP), R11
+	LEAQ  192(SP), R12
+
+	// Fill all regs
+	MOVQ  24(R11), DX
+	MULXQ 8(R12), SI, DI
+	MULXQ 24(R12), R8, R9
+	MOVQ  (R11), DX
+	MULXQ (R12), AX, CX
+	MULXQ 16(R12), BX, BP
+	XORQ  R10, R10
+
+	// First 1-5 chain
+	// x[0]*y[1]
+	MULXQ 8(R12), R13, DX
+	ADCXQ R13, CX
+	ADOXQ DX, BX
+
+	// x[2]*y[0]
+	MOVQ  16(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[2]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[2]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, SI
+	ADOXQ R13, DI
+
+	// x[2]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, DI
+	ADOXQ DX, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// Second 1-5 chain
+	// x[1]*y[0]
+	MOVQ  8(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, CX
+	ADOXQ R13, BX
+
+	// x[1]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[1]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[1]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, SI
+	ADOXQ DX, DI
+
+	// x[3]*y[2]
+	MOVQ  24(R11), DX
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, DI
+	ADOXQ R13, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[3]*y[0]
+	MULXQ (R12), R13, DX
+	ADCXQ R13, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[0]*y[3]
+	MOVQ  (R11), DX
+	MULXQ 24(R12), R11, DX
+	ADCXQ R11, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+	MOVQ  R9, DX
+	SHRQ  $0x3b, DX
+	SHLQ  $0x05, R8, R9
+	SHLQ  $0x05, DI, R8
+	SHLQ  $0x05, SI, DI
+	SHLQ  $0x05, BP, SI
+	MOVQ  $0x07ffffffffffffff, R11
+	ANDQ  R11, BP
+	XORQ  R10, R10
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x03, R9, DX
+	SHLQ  $0x03, R8, R9
+	SHLQ  $0x03, DI, R8
+	SHLQ  $0x03, SI, DI
+	SHLQ  $0x03, SI
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x05, BP, R10
+	ANDQ  R11, BP
+	LEAQ  (R10)(R10*8), R10
+	ADDQ  R10, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	MOVQ  res+0(FP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  224(SP), R11
+	LEAQ  160(SP), R12
+
+	// Fill all regs
+	MOVQ  24(R11), DX
+	MULXQ 8(R12), SI, DI
+	MULXQ 24(R12), R8, R9
+	MOVQ  (R11), DX
+	MULXQ (R12), AX, CX
+	MULXQ 16(R12), BX, BP
+	XORQ  R10, R10
+
+	// First 1-5 chain
+	// x[0]*y[1]
+	MULXQ 8(R12), R13, DX
+	ADCXQ R13, CX
+	ADOXQ DX, BX
+
+	// x[2]*y[0]
+	MOVQ  16(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[2]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[2]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, SI
+	ADOXQ R13, DI
+
+	// x[2]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, DI
+	ADOXQ DX, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// Second 1-5 chain
+	// x[1]*y[0]
+	MOVQ  8(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, CX
+	ADOXQ R13, BX
+
+	// x[1]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[1]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[1]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, SI
+	ADOXQ DX, DI
+
+	// x[3]*y[2]
+	MOVQ  24(R11), DX
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, DI
+	ADOXQ R13, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[3]*y[0]
+	MULXQ (R12), R13, DX
+	ADCXQ R13, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[0]*y[3]
+	MOVQ  (R11), DX
+	MULXQ 24(R12), R11, DX
+	ADCXQ R11, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+	MOVQ  R9, DX
+	SHRQ  $0x3b, DX
+	SHLQ  $0x05, R8, R9
+	SHLQ  $0x05, DI, R8
+	SHLQ  $0x05, SI, DI
+	SHLQ  $0x05, BP, SI
+	MOVQ  $0x07ffffffffffffff, R11
+	ANDQ  R11, BP
+	XORQ  R10, R10
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x03, R9, DX
+	SHLQ  $0x03, R8, R9
+	SHLQ  $0x03, DI, R8
+	SHLQ  $0x03, SI, DI
+	SHLQ  $0x03, SI
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x05, BP, R10
+	ANDQ  R11, BP
+	LEAQ  (R10)(R10*8), R10
+	ADDQ  R10, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	MOVQ  res+0(FP), DX
+	LEAQ  32(DX), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  128(SP), R11
+	LEAQ  160(SP), R12
+
+	// Fill all regs
+	MOVQ  24(R11), DX
+	MULXQ 8(R12), SI, DI
+	MULXQ 24(R12), R8, R9
+	MOVQ  (R11), DX
+	MULXQ (R12), AX, CX
+	MULXQ 16(R12), BX, BP
+	XORQ  R10, R10
+
+	// First 1-5 chain
+	// x[0]*y[1]
+	MULXQ 8(R12), R13, DX
+	ADCXQ R13, CX
+	ADOXQ DX, BX
+
+	// x[2]*y[0]
+	MOVQ  16(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[2]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[2]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, SI
+	ADOXQ R13, DI
+
+	// x[2]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, DI
+	ADOXQ DX, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// Second 1-5 chain
+	// x[1]*y[0]
+	MOVQ  8(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, CX
+	ADOXQ R13, BX
+
+	// x[1]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[1]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[1]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, SI
+	ADOXQ DX, DI
+
+	// x[3]*y[2]
+	MOVQ  24(R11), DX
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, DI
+	ADOXQ R13, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[3]*y[0]
+	MULXQ (R12), R13, DX
+	ADCXQ R13, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[0]*y[3]
+	MOVQ  (R11), DX
+	MULXQ 24(R12), R11, DX
+	ADCXQ R11, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+	MOVQ  R9, DX
+	SHRQ  $0x3b, DX
+	SHLQ  $0x05, R8, R9
+	SHLQ  $0x05, DI, R8
+	SHLQ  $0x05, SI, DI
+	SHLQ  $0x05, BP, SI
+	MOVQ  $0x07ffffffffffffff, R11
+	ANDQ  R11, BP
+	XORQ  R10, R10
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x03, R9, DX
+	SHLQ  $0x03, R8, R9
+	SHLQ  $0x03, DI, R8
+	SHLQ  $0x03, SI, DI
+	SHLQ  $0x03, SI
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x05, BP, R10
+	ANDQ  R11, BP
+	LEAQ  (R10)(R10*8), R10
+	ADDQ  R10, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	MOVQ  res+0(FP), DX
+	LEAQ  64(DX), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  192(SP), R11
+	LEAQ  224(SP), R12
+
+	// Fill all regs
+	MOVQ  24(R11), DX
+	MULXQ 8(R12), SI, DI
+	MULXQ 24(R12), R8, R9
+	MOVQ  (R11), DX
+	MULXQ (R12), AX, CX
+	MULXQ 16(R12), BX, BP
+	XORQ  R10, R10
+
+	// First 1-5 chain
+	// x[0]*y[1]
+	MULXQ 8(R12), R13, DX
+	ADCXQ R13, CX
+	ADOXQ DX, BX
+
+	// x[2]*y[0]
+	MOVQ  16(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[2]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[2]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, SI
+	ADOXQ R13, DI
+
+	// x[2]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, DI
+	ADOXQ DX, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// Second 1-5 chain
+	// x[1]*y[0]
+	MOVQ  8(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, CX
+	ADOXQ R13, BX
+
+	// x[1]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[1]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[1]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, SI
+	ADOXQ DX, DI
+
+	// x[3]*y[2]
+	MOVQ  24(R11), DX
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, DI
+	ADOXQ R13, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[3]*y[0]
+	MULXQ (R12), R13, DX
+	ADCXQ R13, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[0]*y[3]
+	MOVQ  (R11), DX
+	MULXQ 24(R12), R11, DX
+	ADCXQ R11, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+	MOVQ  R9, DX
+	SHRQ  $0x3b, DX
+	SHLQ  $0x05, R8, R9
+	SHLQ  $0x05, DI, R8
+	SHLQ  $0x05, SI, DI
+	SHLQ  $0x05, BP, SI
+	MOVQ  $0x07ffffffffffffff, R11
+	ANDQ  R11, BP
+	XORQ  R10, R10
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x03, R9, DX
+	SHLQ  $0x03, R8, R9
+	SHLQ  $0x03, DI, R8
+	SHLQ  $0x03, SI, DI
+	SHLQ  $0x03, SI
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x05, BP, R10
+	ANDQ  R11, BP
+	LEAQ  (R10)(R10*8), R10
+	ADDQ  R10, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	MOVQ  res+0(FP), DX
+	LEAQ  96(DX), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	RET
+
+// func pointAddZ1(res *Point, p1 *Point, p2 *Point)
+// Requires: ADX, BMI2
+TEXT ·pointAddZ1(SB), NOSPLIT, $256-24
+	MOVQ p1+8(FP), R11
+	MOVQ p2+16(FP), R12
+
+	// Fill all regs
+	MOVQ  24(R11), DX
+	MULXQ 8(R12), SI, DI
+	MULXQ 24(R12), R8, R9
+	MOVQ  (R11), DX
+	MULXQ (R12), AX, CX
+	MULXQ 16(R12), BX, BP
+	XORQ  R10, R10
+
+	// First 1-5 chain
+	// x[0]*y[1]
+	MULXQ 8(R12), R13, DX
+	ADCXQ R13, CX
+	ADOXQ DX, BX
+
+	// x[2]*y[0]
+	MOVQ  16(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[2]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[2]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, SI
+	ADOXQ R13, DI
+
+	// x[2]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, DI
+	ADOXQ DX, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// Second 1-5 chain
+	// x[1]*y[0]
+	MOVQ  8(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, CX
+	ADOXQ R13, BX
+
+	// x[1]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[1]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[1]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, SI
+	ADOXQ DX, DI
+
+	// x[3]*y[2]
+	MOVQ  24(R11), DX
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, DI
+	ADOXQ R13, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[3]*y[0]
+	MULXQ (R12), R13, DX
+	ADCXQ R13, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[0]*y[3]
+	MOVQ  (R11), DX
+	MULXQ 24(R12), R11, DX
+	ADCXQ R11, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+	MOVQ  R9, DX
+	SHRQ  $0x3b, DX
+	SHLQ  $0x05, R8, R9
+	SHLQ  $0x05, DI, R8
+	SHLQ  $0x05, SI, DI
+	SHLQ  $0x05, BP, SI
+	MOVQ  $0x07ffffffffffffff, R11
+	ANDQ  R11, BP
+	XORQ  R10, R10
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x03, R9, DX
+	SHLQ  $0x03, R8, R9
+	SHLQ  $0x03, DI, R8
+	SHLQ  $0x03, SI, DI
+	SHLQ  $0x03, SI
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x05, BP, R10
+	ANDQ  R11, BP
+	LEAQ  (R10)(R10*8), R10
+	ADDQ  R10, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	LEAQ  (SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	MOVQ  p1+8(FP), R11
+	LEAQ  32(R11), R11
+	MOVQ  p2+16(FP), R12
+	LEAQ  32(R12), R12
+
+	// Fill all regs
+	MOVQ  24(R11), DX
+	MULXQ 8(R12), SI, DI
+	MULXQ 24(R12), R8, R9
+	MOVQ  (R11), DX
+	MULXQ (R12), AX, CX
+	MULXQ 16(R12), BX, BP
+	XORQ  R10, R10
+
+	// First 1-5 chain
+	// x[0]*y[1]
+	MULXQ 8(R12), R13, DX
+	ADCXQ R13, CX
+	ADOXQ DX, BX
+
+	// x[2]*y[0]
+	MOVQ  16(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[2]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[2]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, SI
+	ADOXQ R13, DI
+
+	// x[2]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, DI
+	ADOXQ DX, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// Second 1-5 chain
+	// x[1]*y[0]
+	MOVQ  8(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, CX
+	ADOXQ R13, BX
+
+	// x[1]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[1]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[1]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, SI
+	ADOXQ DX, DI
+
+	// x[3]*y[2]
+	MOVQ  24(R11), DX
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, DI
+	ADOXQ R13, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[3]*y[0]
+	MULXQ (R12), R13, DX
+	ADCXQ R13, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[0]*y[3]
+	MOVQ  (R11), DX
+	MULXQ 24(R12), R11, DX
+	ADCXQ R11, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+	MOVQ  R9, DX
+	SHRQ  $0x3b, DX
+	SHLQ  $0x05, R8, R9
+	SHLQ  $0x05, DI, R8
+	SHLQ  $0x05, SI, DI
+	SHLQ  $0x05, BP, SI
+	MOVQ  $0x07ffffffffffffff, R11
+	ANDQ  R11, BP
+	XORQ  R10, R10
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x03, R9, DX
+	SHLQ  $0x03, R8, R9
+	SHLQ  $0x03, DI, R8
+	SHLQ  $0x03, SI, DI
+	SHLQ  $0x03, SI
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x05, BP, R10
+	ANDQ  R11, BP
+	LEAQ  (R10)(R10*8), R10
+	ADDQ  R10, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	LEAQ  32(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	MOVQ  p1+8(FP), R11
+	LEAQ  64(R11), R11
+	MOVQ  p2+16(FP), R12
+	LEAQ  64(R12), R12
+
+	// Fill all regs
+	MOVQ  24(R11), DX
+	MULXQ 8(R12), SI, DI
+	MULXQ 24(R12), R8, R9
+	MOVQ  (R11), DX
+	MULXQ (R12), AX, CX
+	MULXQ 16(R12), BX, BP
+	XORQ  R10, R10
+
+	// First 1-5 chain
+	// x[0]*y[1]
+	MULXQ 8(R12), R13, DX
+	ADCXQ R13, CX
+	ADOXQ DX, BX
+
+	// x[2]*y[0]
+	MOVQ  16(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[2]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[2]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, SI
+	ADOXQ R13, DI
+
+	// x[2]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, DI
+	ADOXQ DX, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// Second 1-5 chain
+	// x[1]*y[0]
+	MOVQ  8(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, CX
+	ADOXQ R13, BX
+
+	// x[1]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[1]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[1]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, SI
+	ADOXQ DX, DI
+
+	// x[3]*y[2]
+	MOVQ  24(R11), DX
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, DI
+	ADOXQ R13, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[3]*y[0]
+	MULXQ (R12), R13, DX
+	ADCXQ R13, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[0]*y[3]
+	MOVQ  (R11), DX
+	MULXQ 24(R12), R11, DX
+	ADCXQ R11, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+	MOVQ  R9, DX
+	SHRQ  $0x3b, DX
+	SHLQ  $0x05, R8, R9
+	SHLQ  $0x05, DI, R8
+	SHLQ  $0x05, SI, DI
+	SHLQ  $0x05, BP, SI
+	MOVQ  $0x07ffffffffffffff, R11
+	ANDQ  R11, BP
+	XORQ  R10, R10
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x03, R9, DX
+	SHLQ  $0x03, R8, R9
+	SHLQ  $0x03, DI, R8
+	SHLQ  $0x03, SI, DI
+	SHLQ  $0x03, SI
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x05, BP, R10
+	ANDQ  R11, BP
+	LEAQ  (R10)(R10*8), R10
+	ADDQ  R10, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	LEAQ  64(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  64(SP), R11
+	XORQ  R10, R10
+	XORQ  BX, BX
+	XORQ  BP, BP
+	XORQ  SI, SI
+	XORQ  DI, DI
+	XORQ  R8, R8
+	XORQ  R9, R9
+	MOVQ  $0x0000000000000496, DX
+	MULXQ (R11), AX, CX
+
+	// x[0]*y[1]
+	MULXQ 8(R11), R13, R12
+	ADCXQ R13, CX
+	ADOXQ R12, BX
+
+	// x[0]*y[2]
+	MULXQ 16(R11), R13, R12
+	ADCXQ R13, BX
+	ADOXQ R12, BP
+
+	// x[0]*y[3]
+	MULXQ 24(R11), R11, DX
+	ADCXQ R11, BP
+	ADOXQ DX, SI
+
+	// Carry 4-6
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	MOVQ  R9, DX
+	SHRQ  $0x3b, DX
+	SHLQ  $0x05, R8, R9
+	SHLQ  $0x05, DI, R8
+	SHLQ  $0x05, SI, DI
+	SHLQ  $0x05, BP, SI
+	MOVQ  $0x07ffffffffffffff, R11
+	ANDQ  R11, BP
+	XORQ  R10, R10
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x03, R9, DX
+	SHLQ  $0x03, R8, R9
+	SHLQ  $0x03, DI, R8
+	SHLQ  $0x03, SI, DI
+	SHLQ  $0x03, SI
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x05, BP, R10
+	ANDQ  R11, BP
+	LEAQ  (R10)(R10*8), R10
+	ADDQ  R10, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	MOVQ  $0xfffffffffffffff7, DX
+	MOVQ  $0xffffffffffffffff, SI
+	MOVQ  $0xffffffffffffffff, DI
+	MOVQ  $0x07ffffffffffffff, R8
+	SUBQ  AX, DX
+	SBBQ  CX, SI
+	SBBQ  BX, DI
+	SBBQ  BP, R8
+	MOVQ  DX, AX
+	MOVQ  SI, CX
+	MOVQ  DI, BX
+	MOVQ  R8, BP
+	LEAQ  64(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	MOVQ  p2+16(FP), BP
+	MOVQ  p2+16(FP), DX
+	LEAQ  32(DX), DX
+	MOVQ  (BP), AX
+	MOVQ  8(BP), CX
+	MOVQ  16(BP), BX
+	MOVQ  24(BP), BP
+	ADDQ  (DX), AX
+	ADCQ  8(DX), CX
+	ADCQ  16(DX), BX
+	ADCQ  24(DX), BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	ADDQ  DX, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	ADDQ  DX, AX
+	LEAQ  160(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	MOVQ  p1+8(FP), BP
+	MOVQ  p1+8(FP), DX
+	LEAQ  32(DX), DX
+	MOVQ  (BP), AX
+	MOVQ  8(BP), CX
+	MOVQ  16(BP), BX
+	MOVQ  24(BP), BP
+	ADDQ  (DX), AX
+	ADCQ  8(DX), CX
+	ADCQ  16(DX), BX
+	ADCQ  24(DX), BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	ADDQ  DX, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	ADDQ  DX, AX
+	LEAQ  128(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  128(SP), R11
+	LEAQ  160(SP), R12
+
+	// Fill all regs
+	MOVQ  24(R11), DX
+	MULXQ 8(R12), SI, DI
+	MULXQ 24(R12), R8, R9
+	MOVQ  (R11), DX
+	MULXQ (R12), AX, CX
+	MULXQ 16(R12), BX, BP
+	XORQ  R10, R10
+
+	// First 1-5 chain
+	// x[0]*y[1]
+	MULXQ 8(R12), R13, DX
+	ADCXQ R13, CX
+	ADOXQ DX, BX
+
+	// x[2]*y[0]
+	MOVQ  16(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[2]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[2]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, SI
+	ADOXQ R13, DI
+
+	// x[2]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, DI
+	ADOXQ DX, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// Second 1-5 chain
+	// x[1]*y[0]
+	MOVQ  8(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, CX
+	ADOXQ R13, BX
+
+	// x[1]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[1]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[1]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, SI
+	ADOXQ DX, DI
+
+	// x[3]*y[2]
+	MOVQ  24(R11), DX
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, DI
+	ADOXQ R13, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[3]*y[0]
+	MULXQ (R12), R13, DX
+	ADCXQ R13, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[0]*y[3]
+	MOVQ  (R11), DX
+	MULXQ 24(R12), R11, DX
+	ADCXQ R11, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+	MOVQ  R9, DX
+	SHRQ  $0x3b, DX
+	SHLQ  $0x05, R8, R9
+	SHLQ  $0x05, DI, R8
+	SHLQ  $0x05, SI, DI
+	SHLQ  $0x05, BP, SI
+	MOVQ  $0x07ffffffffffffff, R11
+	ANDQ  R11, BP
+	XORQ  R10, R10
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x03, R9, DX
+	SHLQ  $0x03, R8, R9
+	SHLQ  $0x03, DI, R8
+	SHLQ  $0x03, SI, DI
+	SHLQ  $0x03, SI
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x05, BP, R10
+	ANDQ  R11, BP
+	LEAQ  (R10)(R10*8), R10
+	ADDQ  R10, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	LEAQ  128(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  (SP), DX
+	SUBQ  (DX), AX
+	SBBQ  8(DX), CX
+	SBBQ  16(DX), BX
+	SBBQ  24(DX), BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	SBBQ  $0x00, CX
+	SBBQ  $0x00, BX
+	SBBQ  $0x00, BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	LEAQ  128(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  32(SP), DX
+	SUBQ  (DX), AX
+	SBBQ  8(DX), CX
+	SBBQ  16(DX), BX
+	SBBQ  24(DX), BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	SBBQ  $0x00, CX
+	SBBQ  $0x00, BX
+	SBBQ  $0x00, BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	LEAQ  128(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	MOVQ  p1+8(FP), BP
+	LEAQ  96(BP), BP
+	LEAQ  64(SP), DX
+	MOVQ  (BP), AX
+	MOVQ  8(BP), CX
+	MOVQ  16(BP), BX
+	MOVQ  24(BP), BP
+	SUBQ  (DX), AX
+	SBBQ  8(DX), CX
+	SBBQ  16(DX), BX
+	SBBQ  24(DX), BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	SBBQ  $0x00, CX
+	SBBQ  $0x00, BX
+	SBBQ  $0x00, BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	LEAQ  192(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	MOVQ  p1+8(FP), BP
+	LEAQ  96(BP), BP
+	LEAQ  64(SP), DX
+	MOVQ  (BP), AX
+	MOVQ  8(BP), CX
+	MOVQ  16(BP), BX
+	MOVQ  24(BP), BP
+	ADDQ  (DX), AX
+	ADCQ  8(DX), CX
+	ADCQ  16(DX), BX
+	ADCQ  24(DX), BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	ADDQ  DX, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	ADDQ  DX, AX
+	LEAQ  224(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  32(SP), BP
+	LEAQ  (SP), DX
+	MOVQ  (BP), AX
+	MOVQ  8(BP), CX
+	MOVQ  16(BP), BX
+	MOVQ  24(BP), BP
+	SUBQ  (DX), AX
+	SBBQ  8(DX), CX
+	SBBQ  16(DX), BX
+	SBBQ  24(DX), BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	SBBQ  $0x00, CX
+	SBBQ  $0x00, BX
+	SBBQ  $0x00, BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	LEAQ  160(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  128(SP), R11
+	LEAQ  192(SP), R12
+
+	// Fill all regs
+	MOVQ  24(R11), DX
+	MULXQ 8(R12), SI, DI
+	MULXQ 24(R12), R8, R9
+	MOVQ  (R11), DX
+	MULXQ (R12), AX, CX
+	MULXQ 16(R12), BX, BP
+	XORQ  R10, R10
+
+	// First 1-5 chain
+	// x[0]*y[1]
+	MULXQ 8(R12), R13, DX
+	ADCXQ R13, CX
+	ADOXQ DX, BX
+
+	// x[2]*y[0]
+	MOVQ  16(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[2]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[2]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, SI
+	ADOXQ R13, DI
+
+	// x[2]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, DI
+	ADOXQ DX, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// Second 1-5 chain
+	// x[1]*y[0]
+	MOVQ  8(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, CX
+	ADOXQ R13, BX
+
+	// x[1]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[1]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[1]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, SI
+	ADOXQ DX, DI
+
+	// x[3]*y[2]
+	MOVQ  24(R11), DX
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, DI
+	ADOXQ R13, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[3]*y[0]
+	MULXQ (R12), R13, DX
+	ADCXQ R13, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[0]*y[3]
+	MOVQ  (R11), DX
+	MULXQ 24(R12), R11, DX
+	ADCXQ R11, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+	MOVQ  R9, DX
+	SHRQ  $0x3b, DX
+	SHLQ  $0x05, R8, R9
+	SHLQ  $0x05, DI, R8
+	SHLQ  $0x05, SI, DI
+	SHLQ  $0x05, BP, SI
+	MOVQ  $0x07ffffffffffffff, R11
+	ANDQ  R11, BP
+	XORQ  R10, R10
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x03, R9, DX
+	SHLQ  $0x03, R8, R9
+	SHLQ  $0x03, DI, R8
+	SHLQ  $0x03, SI, DI
+	SHLQ  $0x03, SI
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x05, BP, R10
+	ANDQ  R11, BP
+	LEAQ  (R10)(R10*8), R10
+	ADDQ  R10, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	MOVQ  res+0(FP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  224(SP), R11
+	LEAQ  160(SP), R12
+
+	// Fill all regs
+	MOVQ  24(R11), DX
+	MULXQ 8(R12), SI, DI
+	MULXQ 24(R12), R8, R9
+	MOVQ  (R11), DX
+	MULXQ (R12), AX, CX
+	MULXQ 16(R12), BX, BP
+	XORQ  R10, R10
+
+	// First 1-5 chain
+	// x[0]*y[1]
+	MULXQ 8(R12), R13, DX
+	ADCXQ R13, CX
+	ADOXQ DX, BX
+
+	// x[2]*y[0]
+	MOVQ  16(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[2]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[2]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, SI
+	ADOXQ R13, DI
+
+	// x[2]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, DI
+	ADOXQ DX, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// Second 1-5 chain
+	// x[1]*y[0]
+	MOVQ  8(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, CX
+	ADOXQ R13, BX
+
+	// x[1]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[1]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[1]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, SI
+	ADOXQ DX, DI
+
+	// x[3]*y[2]
+	MOVQ  24(R11), DX
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, DI
+	ADOXQ R13, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[3]*y[0]
+	MULXQ (R12), R13, DX
+	ADCXQ R13, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[0]*y[3]
+	MOVQ  (R11), DX
+	MULXQ 24(R12), R11, DX
+	ADCXQ R11, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+	MOVQ  R9, DX
+	SHRQ  $0x3b, DX
+	SHLQ  $0x05, R8, R9
+	SHLQ  $0x05, DI, R8
+	SHLQ  $0x05, SI, DI
+	SHLQ  $0x05, BP, SI
+	MOVQ  $0x07ffffffffffffff, R11
+	ANDQ  R11, BP
+	XORQ  R10, R10
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x03, R9, DX
+	SHLQ  $0x03, R8, R9
+	SHLQ  $0x03, DI, R8
+	SHLQ  $0x03, SI, DI
+	SHLQ  $0x03, SI
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x05, BP, R10
+	ANDQ  R11, BP
+	LEAQ  (R10)(R10*8), R10
+	ADDQ  R10, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	MOVQ  res+0(FP), DX
+	LEAQ  32(DX), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  128(SP), R11
+	LEAQ  160(SP), R12
+
+	// Fill all regs
+	MOVQ  24(R11), DX
+	MULXQ 8(R12), SI, DI
+	MULXQ 24(R12), R8, R9
+	MOVQ  (R11), DX
+	MULXQ (R12), AX, CX
+	MULXQ 16(R12), BX, BP
+	XORQ  R10, R10
+
+	// First 1-5 chain
+	// x[0]*y[1]
+	MULXQ 8(R12), R13, DX
+	ADCXQ R13, CX
+	ADOXQ DX, BX
+
+	// x[2]*y[0]
+	MOVQ  16(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[2]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[2]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, SI
+	ADOXQ R13, DI
+
+	// x[2]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, DI
+	ADOXQ DX, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// Second 1-5 chain
+	// x[1]*y[0]
+	MOVQ  8(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, CX
+	ADOXQ R13, BX
+
+	// x[1]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[1]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[1]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, SI
+	ADOXQ DX, DI
+
+	// x[3]*y[2]
+	MOVQ  24(R11), DX
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, DI
+	ADOXQ R13, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[3]*y[0]
+	MULXQ (R12), R13, DX
+	ADCXQ R13, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[0]*y[3]
+	MOVQ  (R11), DX
+	MULXQ 24(R12), R11, DX
+	ADCXQ R11, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+	MOVQ  R9, DX
+	SHRQ  $0x3b, DX
+	SHLQ  $0x05, R8, R9
+	SHLQ  $0x05, DI, R8
+	SHLQ  $0x05, SI, DI
+	SHLQ  $0x05, BP, SI
+	MOVQ  $0x07ffffffffffffff, R11
+	ANDQ  R11, BP
+	XORQ  R10, R10
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x03, R9, DX
+	SHLQ  $0x03, R8, R9
+	SHLQ  $0x03, DI, R8
+	SHLQ  $0x03, SI, DI
+	SHLQ  $0x03, SI
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x05, BP, R10
+	ANDQ  R11, BP
+	LEAQ  (R10)(R10*8), R10
+	ADDQ  R10, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	MOVQ  res+0(FP), DX
+	LEAQ  64(DX), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  192(SP), R11
+	LEAQ  224(SP), R12
+
+	// Fill all regs
+	MOVQ  24(R11), DX
+	MULXQ 8(R12), SI, DI
+	MULXQ 24(R12), R8, R9
+	MOVQ  (R11), DX
+	MULXQ (R12), AX, CX
+	MULXQ 16(R12), BX, BP
+	XORQ  R10, R10
+
+	// First 1-5 chain
+	// x[0]*y[1]
+	MULXQ 8(R12), R13, DX
+	ADCXQ R13, CX
+	ADOXQ DX, BX
+
+	// x[2]*y[0]
+	MOVQ  16(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[2]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[2]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, SI
+	ADOXQ R13, DI
+
+	// x[2]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, DI
+	ADOXQ DX, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// Second 1-5 chain
+	// x[1]*y[0]
+	MOVQ  8(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, CX
+	ADOXQ R13, BX
+
+	// x[1]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[1]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[1]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, SI
+	ADOXQ DX, DI
+
+	// x[3]*y[2]
+	MOVQ  24(R11), DX
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, DI
+	ADOXQ R13, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[3]*y[0]
+	MULXQ (R12), R13, DX
+	ADCXQ R13, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[0]*y[3]
+	MOVQ  (R11), DX
+	MULXQ 24(R12), R11, DX
+	ADCXQ R11, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+	MOVQ  R9, DX
+	SHRQ  $0x3b, DX
+	SHLQ  $0x05, R8, R9
+	SHLQ  $0x05, DI, R8
+	SHLQ  $0x05, SI, DI
+	SHLQ  $0x05, BP, SI
+	MOVQ  $0x07ffffffffffffff, R11
+	ANDQ  R11, BP
+	XORQ  R10, R10
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x03, R9, DX
+	SHLQ  $0x03, R8, R9
+	SHLQ  $0x03, DI, R8
+	SHLQ  $0x03, SI, DI
+	SHLQ  $0x03, SI
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x05, BP, R10
+	ANDQ  R11, BP
+	LEAQ  (R10)(R10*8), R10
+	ADDQ  R10, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	MOVQ  res+0(FP), DX
+	LEAQ  96(DX), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	RET
+
+// func pointDouble(res *Point, p *Point)
+// Requires: ADX, BMI2
+TEXT ·pointDouble(SB), NOSPLIT, $224-16
+	MOVQ p+8(FP), CX
+
+	// load x to registers
+	MOVQ (CX), AX
+	MOVQ 8(CX), R11
+	MOVQ 16(CX), R12
+	MOVQ 24(CX), R13
+
+	// clear flags
+	XORQ R10, R10
+
+	// fill registers
+	// x[3]*x[2]
+	MOVQ  R13, DX
+	MULXQ R12, DI, R8
+
+	// x[0]*x[3]
+	MOVQ  AX, DX
+	MULXQ R13, BP, SI
+
+	// x[0]*x[1]
+	MULXQ R11, CX, BX
+
+	// 2-4 pass
+	// x[0]*y[2]
+	MULXQ R12, R14, DX
+	ADCXQ R14, BX
+	ADOXQ DX, BP
+
+	// x[1]*y[2]
+	MOVQ  R11, DX
+	MULXQ R12, R15, R14
+	ADCXQ R15, BP
+	ADOXQ R14, SI
+
+	// x[1]*y[3]
+	MULXQ R13, R14, DX
+	ADCXQ R14, SI
+	ADOXQ DX, DI
+
+	// Carry 5-8
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// clear 7
+	XORQ R9, R9
+
+	// multiply by 2 by shifting
+	SHLQ $0x01, R8, R9
+	SHLQ $0x01, DI, R8
+	SHLQ $0x01, SI, DI
+	SHLQ $0x01, BP, SI
+	SHLQ $0x01, BX, BP
+	SHLQ $0x01, CX, BX
+	SHLQ $0x01, CX
+
+	// add all z*z
+	MOVQ  AX, DX
+	MULXQ AX, AX, DX
+	ADDQ  DX, CX
+	MOVQ  R11, DX
+	MULXQ R11, R10, DX
+	ADCQ  R10, BX
+	ADCQ  DX, BP
+	MOVQ  R12, DX
+	MULXQ R12, R10, DX
+	ADCQ  R10, SI
+	ADCQ  DX, DI
+	MOVQ  R13, DX
+	MULXQ R13, R10, DX
+	ADCQ  R10, R8
+	ADCQ  DX, R9
+	MOVQ  R9, DX
+	SHRQ  $0x3b, DX
+	SHLQ  $0x05, R8, R9
+	SHLQ  $0x05, DI, R8
+	SHLQ  $0x05, SI, DI
+	SHLQ  $0x05, BP, SI
+	MOVQ  $0x07ffffffffffffff, R11
+	ANDQ  R11, BP
+	XORQ  R10, R10
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x03, R9, DX
+	SHLQ  $0x03, R8, R9
+	SHLQ  $0x03, DI, R8
+	SHLQ  $0x03, SI, DI
+	SHLQ  $0x03, SI
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x05, BP, R10
+	ANDQ  R11, BP
+	LEAQ  (R10)(R10*8), R10
+	ADDQ  R10, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	LEAQ  (SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	MOVQ  p+8(FP), CX
+	LEAQ  32(CX), CX
+
+	// load x to registers
+	MOVQ (CX), AX
+	MOVQ 8(CX), R11
+	MOVQ 16(CX), R12
+	MOVQ 24(CX), R13
+
+	// clear flags
+	XORQ R10, R10
+
+	// fill registers
+	// x[3]*x[2]
+	MOVQ  R13, DX
+	MULXQ R12, DI, R8
+
+	// x[0]*x[3]
+	MOVQ  AX, DX
+	MULXQ R13, BP, SI
+
+	// x[0]*x[1]
+	MULXQ R11, CX, BX
+
+	// 2-4 pass
+	// x[0]*y[2]
+	MULXQ R12, R14, DX
+	ADCXQ R14, BX
+	ADOXQ DX, BP
+
+	// x[1]*y[2]
+	MOVQ  R11, DX
+	MULXQ R12, R15, R14
+	ADCXQ R15, BP
+	ADOXQ R14, SI
+
+	// x[1]*y[3]
+	MULXQ R13, R14, DX
+	ADCXQ R14, SI
+	ADOXQ DX, DI
+
+	// Carry 5-8
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// clear 7
+	XORQ R9, R9
+
+	// multiply by 2 by shifting
+	SHLQ $0x01, R8, R9
+	SHLQ $0x01, DI, R8
+	SHLQ $0x01, SI, DI
+	SHLQ $0x01, BP, SI
+	SHLQ $0x01, BX, BP
+	SHLQ $0x01, CX, BX
+	SHLQ $0x01, CX
+
+	// add all z*z
+	MOVQ  AX, DX
+	MULXQ AX, AX, DX
+	ADDQ  DX, CX
+	MOVQ  R11, DX
+	MULXQ R11, R10, DX
+	ADCQ  R10, BX
+	ADCQ  DX, BP
+	MOVQ  R12, DX
+	MULXQ R12, R10, DX
+	ADCQ  R10, SI
+	ADCQ  DX, DI
+	MOVQ  R13, DX
+	MULXQ R13, R10, DX
+	ADCQ  R10, R8
+	ADCQ  DX, R9
+	MOVQ  R9, DX
+	SHRQ  $0x3b, DX
+	SHLQ  $0x05, R8, R9
+	SHLQ  $0x05, DI, R8
+	SHLQ  $0x05, SI, DI
+	SHLQ  $0x05, BP, SI
+	MOVQ  $0x07ffffffffffffff, R11
+	ANDQ  R11, BP
+	XORQ  R10, R10
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x03, R9, DX
+	SHLQ  $0x03, R8, R9
+	SHLQ  $0x03, DI, R8
+	SHLQ  $0x03, SI, DI
+	SHLQ  $0x03, SI
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x05, BP, R10
+	ANDQ  R11, BP
+	LEAQ  (R10)(R10*8), R10
+	ADDQ  R10, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	LEAQ  32(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	MOVQ  p+8(FP), CX
+	LEAQ  96(CX), CX
+
+	// load x to registers
+	MOVQ (CX), AX
+	MOVQ 8(CX), R11
+	MOVQ 16(CX), R12
+	MOVQ 24(CX), R13
+
+	// clear flags
+	XORQ R10, R10
+
+	// fill registers
+	// x[3]*x[2]
+	MOVQ  R13, DX
+	MULXQ R12, DI, R8
+
+	// x[0]*x[3]
+	MOVQ  AX, DX
+	MULXQ R13, BP, SI
+
+	// x[0]*x[1]
+	MULXQ R11, CX, BX
+
+	// 2-4 pass
+	// x[0]*y[2]
+	MULXQ R12, R14, DX
+	ADCXQ R14, BX
+	ADOXQ DX, BP
+
+	// x[1]*y[2]
+	MOVQ  R11, DX
+	MULXQ R12, R15, R14
+	ADCXQ R15, BP
+	ADOXQ R14, SI
+
+	// x[1]*y[3]
+	MULXQ R13, R14, DX
+	ADCXQ R14, SI
+	ADOXQ DX, DI
+
+	// Carry 5-8
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// clear 7
+	XORQ R9, R9
+
+	// multiply by 2 by shifting
+	SHLQ $0x01, R8, R9
+	SHLQ $0x01, DI, R8
+	SHLQ $0x01, SI, DI
+	SHLQ $0x01, BP, SI
+	SHLQ $0x01, BX, BP
+	SHLQ $0x01, CX, BX
+	SHLQ $0x01, CX
+
+	// add all z*z
+	MOVQ  AX, DX
+	MULXQ AX, AX, DX
+	ADDQ  DX, CX
+	MOVQ  R11, DX
+	MULXQ R11, R10, DX
+	ADCQ  R10, BX
+	ADCQ  DX, BP
+	MOVQ  R12, DX
+	MULXQ R12, R10, DX
+	ADCQ  R10, SI
+	ADCQ  DX, DI
+	MOVQ  R13, DX
+	MULXQ R13, R10, DX
+	ADCQ  R10, R8
+	ADCQ  DX, R9
+	MOVQ  R9, DX
+	SHRQ  $0x3b, DX
+	SHLQ  $0x05, R8, R9
+	SHLQ  $0x05, DI, R8
+	SHLQ  $0x05, SI, DI
+	SHLQ  $0x05, BP, SI
+	MOVQ  $0x07ffffffffffffff, R11
+	ANDQ  R11, BP
+	XORQ  R10, R10
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x03, R9, DX
+	SHLQ  $0x03, R8, R9
+	SHLQ  $0x03, DI, R8
+	SHLQ  $0x03, SI, DI
+	SHLQ  $0x03, SI
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x05, BP, R10
+	ANDQ  R11, BP
+	LEAQ  (R10)(R10*8), R10
+	ADDQ  R10, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	LEAQ  64(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	ADDQ  AX, AX
+	ADCQ  CX, CX
+	ADCQ  BX, BX
+	ADCQ  BP, BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	ADDQ  DX, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	ADDQ  DX, AX
+	LEAQ  64(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	MOVQ  p+8(FP), BP
+	MOVQ  p+8(FP), DX
+	LEAQ  32(DX), DX
+	MOVQ  (BP), AX
+	MOVQ  8(BP), CX
+	MOVQ  16(BP), BX
+	MOVQ  24(BP), BP
+	ADDQ  (DX), AX
+	ADCQ  8(DX), CX
+	ADCQ  16(DX), BX
+	ADCQ  24(DX), BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	ADDQ  DX, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	ADDQ  DX, AX
+	LEAQ  96(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+
+	// load x to registers
+	MOVQ CX, R11
+	MOVQ BX, R12
+	MOVQ BP, R13
+
+	// clear flags
+	XORQ R10, R10
+
+	// fill registers
+	// x[3]*x[2]
+	MOVQ  R13, DX
+	MULXQ R12, DI, R8
+
+	// x[0]*x[3]
+	MOVQ  AX, DX
+	MULXQ R13, BP, SI
+
+	// x[0]*x[1]
+	MULXQ R11, CX, BX
+
+	// 2-4 pass
+	// x[0]*y[2]
+	MULXQ R12, R14, DX
+	ADCXQ R14, BX
+	ADOXQ DX, BP
+
+	// x[1]*y[2]
+	MOVQ  R11, DX
+	MULXQ R12, R15, R14
+	ADCXQ R15, BP
+	ADOXQ R14, SI
+
+	// x[1]*y[3]
+	MULXQ R13, R14, DX
+	ADCXQ R14, SI
+	ADOXQ DX, DI
+
+	// Carry 5-8
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// clear 7
+	XORQ R9, R9
+
+	// multiply by 2 by shifting
+	SHLQ $0x01, R8, R9
+	SHLQ $0x01, DI, R8
+	SHLQ $0x01, SI, DI
+	SHLQ $0x01, BP, SI
+	SHLQ $0x01, BX, BP
+	SHLQ $0x01, CX, BX
+	SHLQ $0x01, CX
+
+	// add all z*z
+	MOVQ  AX, DX
+	MULXQ AX, AX, DX
+	ADDQ  DX, CX
+	MOVQ  R11, DX
+	MULXQ R11, R10, DX
+	ADCQ  R10, BX
+	ADCQ  DX, BP
+	MOVQ  R12, DX
+	MULXQ R12, R10, DX
+	ADCQ  R10, SI
+	ADCQ  DX, DI
+	MOVQ  R13, DX
+	MULXQ R13, R10, DX
+	ADCQ  R10, R8
+	ADCQ  DX, R9
+	MOVQ  R9, DX
+	SHRQ  $0x3b, DX
+	SHLQ  $0x05, R8, R9
+	SHLQ  $0x05, DI, R8
+	SHLQ  $0x05, SI, DI
+	SHLQ  $0x05, BP, SI
+	MOVQ  $0x07ffffffffffffff, R11
+	ANDQ  R11, BP
+	XORQ  R10, R10
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x03, R9, DX
+	SHLQ  $0x03, R8, R9
+	SHLQ  $0x03, DI, R8
+	SHLQ  $0x03, SI, DI
+	SHLQ  $0x03, SI
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x05, BP, R10
+	ANDQ  R11, BP
+	LEAQ  (R10)(R10*8), R10
+	ADDQ  R10, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	LEAQ  96(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  (SP), DX
+	SUBQ  (DX), AX
+	SBBQ  8(DX), CX
+	SBBQ  16(DX), BX
+	SBBQ  24(DX), BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	SBBQ  $0x00, CX
+	SBBQ  $0x00, BX
+	SBBQ  $0x00, BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	LEAQ  96(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  32(SP), DX
+	SUBQ  (DX), AX
+	SBBQ  8(DX), CX
+	SBBQ  16(DX), BX
+	SBBQ  24(DX), BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	SBBQ  $0x00, CX
+	SBBQ  $0x00, BX
+	SBBQ  $0x00, BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	LEAQ  96(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  (SP), BP
+	LEAQ  32(SP), DX
+	MOVQ  (BP), AX
+	MOVQ  8(BP), CX
+	MOVQ  16(BP), BX
+	MOVQ  24(BP), BP
+	ADDQ  (DX), AX
+	ADCQ  8(DX), CX
+	ADCQ  16(DX), BX
+	ADCQ  24(DX), BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	ADDQ  DX, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	ADDQ  DX, AX
+	LEAQ  160(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  64(SP), DX
+	SUBQ  (DX), AX
+	SBBQ  8(DX), CX
+	SBBQ  16(DX), BX
+	SBBQ  24(DX), BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	SBBQ  $0x00, CX
+	SBBQ  $0x00, BX
+	SBBQ  $0x00, BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	LEAQ  128(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  (SP), BP
+	LEAQ  32(SP), DX
+	MOVQ  (BP), AX
+	MOVQ  8(BP), CX
+	MOVQ  16(BP), BX
+	MOVQ  24(BP), BP
+	SUBQ  (DX), AX
+	SBBQ  8(DX), CX
+	SBBQ  16(DX), BX
+	SBBQ  24(DX), BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	SBBQ  $0x00, CX
+	SBBQ  $0x00, BX
+	SBBQ  $0x00, BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	LEAQ  192(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  96(SP), R11
+	LEAQ  128(SP), R12
+
+	// Fill all regs
+	MOVQ  24(R11), DX
+	MULXQ 8(R12), SI, DI
+	MULXQ 24(R12), R8, R9
+	MOVQ  (R11), DX
+	MULXQ (R12), AX, CX
+	MULXQ 16(R12), BX, BP
+	XORQ  R10, R10
+
+	// First 1-5 chain
+	// x[0]*y[1]
+	MULXQ 8(R12), R13, DX
+	ADCXQ R13, CX
+	ADOXQ DX, BX
+
+	// x[2]*y[0]
+	MOVQ  16(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[2]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[2]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, SI
+	ADOXQ R13, DI
+
+	// x[2]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, DI
+	ADOXQ DX, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// Second 1-5 chain
+	// x[1]*y[0]
+	MOVQ  8(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, CX
+	ADOXQ R13, BX
+
+	// x[1]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[1]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[1]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, SI
+	ADOXQ DX, DI
+
+	// x[3]*y[2]
+	MOVQ  24(R11), DX
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, DI
+	ADOXQ R13, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[3]*y[0]
+	MULXQ (R12), R13, DX
+	ADCXQ R13, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[0]*y[3]
+	MOVQ  (R11), DX
+	MULXQ 24(R12), R11, DX
+	ADCXQ R11, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+	MOVQ  R9, DX
+	SHRQ  $0x3b, DX
+	SHLQ  $0x05, R8, R9
+	SHLQ  $0x05, DI, R8
+	SHLQ  $0x05, SI, DI
+	SHLQ  $0x05, BP, SI
+	MOVQ  $0x07ffffffffffffff, R11
+	ANDQ  R11, BP
+	XORQ  R10, R10
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x03, R9, DX
+	SHLQ  $0x03, R8, R9
+	SHLQ  $0x03, DI, R8
+	SHLQ  $0x03, SI, DI
+	SHLQ  $0x03, SI
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x05, BP, R10
+	ANDQ  R11, BP
+	LEAQ  (R10)(R10*8), R10
+	ADDQ  R10, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	MOVQ  res+0(FP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  160(SP), R11
+	LEAQ  192(SP), R12
+
+	// Fill all regs
+	MOVQ  24(R11), DX
+	MULXQ 8(R12), SI, DI
+	MULXQ 24(R12), R8, R9
+	MOVQ  (R11), DX
+	MULXQ (R12), AX, CX
+	MULXQ 16(R12), BX, BP
+	XORQ  R10, R10
+
+	// First 1-5 chain
+	// x[0]*y[1]
+	MULXQ 8(R12), R13, DX
+	ADCXQ R13, CX
+	ADOXQ DX, BX
+
+	// x[2]*y[0]
+	MOVQ  16(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[2]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[2]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, SI
+	ADOXQ R13, DI
+
+	// x[2]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, DI
+	ADOXQ DX, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// Second 1-5 chain
+	// x[1]*y[0]
+	MOVQ  8(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, CX
+	ADOXQ R13, BX
+
+	// x[1]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[1]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[1]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, SI
+	ADOXQ DX, DI
+
+	// x[3]*y[2]
+	MOVQ  24(R11), DX
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, DI
+	ADOXQ R13, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[3]*y[0]
+	MULXQ (R12), R13, DX
+	ADCXQ R13, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[0]*y[3]
+	MOVQ  (R11), DX
+	MULXQ 24(R12), R11, DX
+	ADCXQ R11, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+	MOVQ  R9, DX
+	SHRQ  $0x3b, DX
+	SHLQ  $0x05, R8, R9
+	SHLQ  $0x05, DI, R8
+	SHLQ  $0x05, SI, DI
+	SHLQ  $0x05, BP, SI
+	MOVQ  $0x07ffffffffffffff, R11
+	ANDQ  R11, BP
+	XORQ  R10, R10
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x03, R9, DX
+	SHLQ  $0x03, R8, R9
+	SHLQ  $0x03, DI, R8
+	SHLQ  $0x03, SI, DI
+	SHLQ  $0x03, SI
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x05, BP, R10
+	ANDQ  R11, BP
+	LEAQ  (R10)(R10*8), R10
+	ADDQ  R10, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	MOVQ  res+0(FP), DX
+	LEAQ  32(DX), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  96(SP), R11
+	LEAQ  192(SP), R12
+
+	// Fill all regs
+	MOVQ  24(R11), DX
+	MULXQ 8(R12), SI, DI
+	MULXQ 24(R12), R8, R9
+	MOVQ  (R11), DX
+	MULXQ (R12), AX, CX
+	MULXQ 16(R12), BX, BP
+	XORQ  R10, R10
+
+	// First 1-5 chain
+	// x[0]*y[1]
+	MULXQ 8(R12), R13, DX
+	ADCXQ R13, CX
+	ADOXQ DX, BX
+
+	// x[2]*y[0]
+	MOVQ  16(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[2]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[2]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, SI
+	ADOXQ R13, DI
+
+	// x[2]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, DI
+	ADOXQ DX, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// Second 1-5 chain
+	// x[1]*y[0]
+	MOVQ  8(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, CX
+	ADOXQ R13, BX
+
+	// x[1]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[1]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[1]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, SI
+	ADOXQ DX, DI
+
+	// x[3]*y[2]
+	MOVQ  24(R11), DX
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, DI
+	ADOXQ R13, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[3]*y[0]
+	MULXQ (R12), R13, DX
+	ADCXQ R13, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[0]*y[3]
+	MOVQ  (R11), DX
+	MULXQ 24(R12), R11, DX
+	ADCXQ R11, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+	MOVQ  R9, DX
+	SHRQ  $0x3b, DX
+	SHLQ  $0x05, R8, R9
+	SHLQ  $0x05, DI, R8
+	SHLQ  $0x05, SI, DI
+	SHLQ  $0x05, BP, SI
+	MOVQ  $0x07ffffffffffffff, R11
+	ANDQ  R11, BP
+	XORQ  R10, R10
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x03, R9, DX
+	SHLQ  $0x03, R8, R9
+	SHLQ  $0x03, DI, R8
+	SHLQ  $0x03, SI, DI
+	SHLQ  $0x03, SI
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x05, BP, R10
+	ANDQ  R11, BP
+	LEAQ  (R10)(R10*8), R10
+	ADDQ  R10, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	MOVQ  res+0(FP), DX
+	LEAQ  64(DX), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  128(SP), R11
+	LEAQ  160(SP), R12
+
+	// Fill all regs
+	MOVQ  24(R11), DX
+	MULXQ 8(R12), SI, DI
+	MULXQ 24(R12), R8, R9
+	MOVQ  (R11), DX
+	MULXQ (R12), AX, CX
+	MULXQ 16(R12), BX, BP
+	XORQ  R10, R10
+
+	// First 1-5 chain
+	// x[0]*y[1]
+	MULXQ 8(R12), R13, DX
+	ADCXQ R13, CX
+	ADOXQ DX, BX
+
+	// x[2]*y[0]
+	MOVQ  16(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[2]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[2]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, SI
+	ADOXQ R13, DI
+
+	// x[2]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, DI
+	ADOXQ DX, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// Second 1-5 chain
+	// x[1]*y[0]
+	MOVQ  8(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, CX
+	ADOXQ R13, BX
+
+	// x[1]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[1]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[1]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, SI
+	ADOXQ DX, DI
+
+	// x[3]*y[2]
+	MOVQ  24(R11), DX
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, DI
+	ADOXQ R13, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[3]*y[0]
+	MULXQ (R12), R13, DX
+	ADCXQ R13, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[0]*y[3]
+	MOVQ  (R11), DX
+	MULXQ 24(R12), R11, DX
+	ADCXQ R11, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+	MOVQ  R9, DX
+	SHRQ  $0x3b, DX
+	SHLQ  $0x05, R8, R9
+	SHLQ  $0x05, DI, R8
+	SHLQ  $0x05, SI, DI
+	SHLQ  $0x05, BP, SI
+	MOVQ  $0x07ffffffffffffff, R11
+	ANDQ  R11, BP
+	XORQ  R10, R10
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x03, R9, DX
+	SHLQ  $0x03, R8, R9
+	SHLQ  $0x03, DI, R8
+	SHLQ  $0x03, SI, DI
+	SHLQ  $0x03, SI
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x05, BP, R10
+	ANDQ  R11, BP
+	LEAQ  (R10)(R10*8), R10
+	ADDQ  R10, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	MOVQ  res+0(FP), DX
+	LEAQ  96(DX), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	RET
+
+// func pointDoubleProjective(res *Point, p *ProjectivePoint)
+// Requires: ADX, BMI2
+TEXT ·pointDoubleProjective(SB), NOSPLIT, $224-16
+	MOVQ p+8(FP), CX
+
+	// load x to registers
+	MOVQ (CX), AX
+	MOVQ 8(CX), R11
+	MOVQ 16(CX), R12
+	MOVQ 24(CX), R13
+
+	// clear flags
+	XORQ R10, R10
+
+	// fill registers
+	// x[3]*x[2]
+	MOVQ  R13, DX
+	MULXQ R12, DI, R8
+
+	// x[0]*x[3]
+	MOVQ  AX, DX
+	MULXQ R13, BP, SI
+
+	// x[0]*x[1]
+	MULXQ R11, CX, BX
+
+	// 2-4 pass
+	// x[0]*y[2]
+	MULXQ R12, R14, DX
+	ADCXQ R14, BX
+	ADOXQ DX, BP
+
+	// x[1]*y[2]
+	MOVQ  R11, DX
+	MULXQ R12, R15, R14
+	ADCXQ R15, BP
+	ADOXQ R14, SI
+
+	// x[1]*y[3]
+	MULXQ R13, R14, DX
+	ADCXQ R14, SI
+	ADOXQ DX, DI
+
+	// Carry 5-8
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// clear 7
+	XORQ R9, R9
+
+	// multiply by 2 by shifting
+	SHLQ $0x01, R8, R9
+	SHLQ $0x01, DI, R8
+	SHLQ $0x01, SI, DI
+	SHLQ $0x01, BP, SI
+	SHLQ $0x01, BX, BP
+	SHLQ $0x01, CX, BX
+	SHLQ $0x01, CX
+
+	// add all z*z
+	MOVQ  AX, DX
+	MULXQ AX, AX, DX
+	ADDQ  DX, CX
+	MOVQ  R11, DX
+	MULXQ R11, R10, DX
+	ADCQ  R10, BX
+	ADCQ  DX, BP
+	MOVQ  R12, DX
+	MULXQ R12, R10, DX
+	ADCQ  R10, SI
+	ADCQ  DX, DI
+	MOVQ  R13, DX
+	MULXQ R13, R10, DX
+	ADCQ  R10, R8
+	ADCQ  DX, R9
+	MOVQ  R9, DX
+	SHRQ  $0x3b, DX
+	SHLQ  $0x05, R8, R9
+	SHLQ  $0x05, DI, R8
+	SHLQ  $0x05, SI, DI
+	SHLQ  $0x05, BP, SI
+	MOVQ  $0x07ffffffffffffff, R11
+	ANDQ  R11, BP
+	XORQ  R10, R10
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x03, R9, DX
+	SHLQ  $0x03, R8, R9
+	SHLQ  $0x03, DI, R8
+	SHLQ  $0x03, SI, DI
+	SHLQ  $0x03, SI
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x05, BP, R10
+	ANDQ  R11, BP
+	LEAQ  (R10)(R10*8), R10
+	ADDQ  R10, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	LEAQ  (SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	MOVQ  p+8(FP), CX
+	LEAQ  32(CX), CX
+
+	// load x to registers
+	MOVQ (CX), AX
+	MOVQ 8(CX), R11
+	MOVQ 16(CX), R12
+	MOVQ 24(CX), R13
+
+	// clear flags
+	XORQ R10, R10
+
+	// fill registers
+	// x[3]*x[2]
+	MOVQ  R13, DX
+	MULXQ R12, DI, R8
+
+	// x[0]*x[3]
+	MOVQ  AX, DX
+	MULXQ R13, BP, SI
+
+	// x[0]*x[1]
+	MULXQ R11, CX, BX
+
+	// 2-4 pass
+	// x[0]*y[2]
+	MULXQ R12, R14, DX
+	ADCXQ R14, BX
+	ADOXQ DX, BP
+
+	// x[1]*y[2]
+	MOVQ  R11, DX
+	MULXQ R12, R15, R14
+	ADCXQ R15, BP
+	ADOXQ R14, SI
+
+	// x[1]*y[3]
+	MULXQ R13, R14, DX
+	ADCXQ R14, SI
+	ADOXQ DX, DI
+
+	// Carry 5-8
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// clear 7
+	XORQ R9, R9
+
+	// multiply by 2 by shifting
+	SHLQ $0x01, R8, R9
+	SHLQ $0x01, DI, R8
+	SHLQ $0x01, SI, DI
+	SHLQ $0x01, BP, SI
+	SHLQ $0x01, BX, BP
+	SHLQ $0x01, CX, BX
+	SHLQ $0x01, CX
+
+	// add all z*z
+	MOVQ  AX, DX
+	MULXQ AX, AX, DX
+	ADDQ  DX, CX
+	MOVQ  R11, DX
+	MULXQ R11, R10, DX
+	ADCQ  R10, BX
+	ADCQ  DX, BP
+	MOVQ  R12, DX
+	MULXQ R12, R10, DX
+	ADCQ  R10, SI
+	ADCQ  DX, DI
+	MOVQ  R13, DX
+	MULXQ R13, R10, DX
+	ADCQ  R10, R8
+	ADCQ  DX, R9
+	MOVQ  R9, DX
+	SHRQ  $0x3b, DX
+	SHLQ  $0x05, R8, R9
+	SHLQ  $0x05, DI, R8
+	SHLQ  $0x05, SI, DI
+	SHLQ  $0x05, BP, SI
+	MOVQ  $0x07ffffffffffffff, R11
+	ANDQ  R11, BP
+	XORQ  R10, R10
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x03, R9, DX
+	SHLQ  $0x03, R8, R9
+	SHLQ  $0x03, DI, R8
+	SHLQ  $0x03, SI, DI
+	SHLQ  $0x03, SI
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x05, BP, R10
+	ANDQ  R11, BP
+	LEAQ  (R10)(R10*8), R10
+	ADDQ  R10, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	LEAQ  32(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	MOVQ  p+8(FP), CX
+	LEAQ  64(CX), CX
+
+	// load x to registers
+	MOVQ (CX), AX
+	MOVQ 8(CX), R11
+	MOVQ 16(CX), R12
+	MOVQ 24(CX), R13
+
+	// clear flags
+	XORQ R10, R10
+
+	// fill registers
+	// x[3]*x[2]
+	MOVQ  R13, DX
+	MULXQ R12, DI, R8
+
+	// x[0]*x[3]
+	MOVQ  AX, DX
+	MULXQ R13, BP, SI
+
+	// x[0]*x[1]
+	MULXQ R11, CX, BX
+
+	// 2-4 pass
+	// x[0]*y[2]
+	MULXQ R12, R14, DX
+	ADCXQ R14, BX
+	ADOXQ DX, BP
+
+	// x[1]*y[2]
+	MOVQ  R11, DX
+	MULXQ R12, R15, R14
+	ADCXQ R15, BP
+	ADOXQ R14, SI
+
+	// x[1]*y[3]
+	MULXQ R13, R14, DX
+	ADCXQ R14, SI
+	ADOXQ DX, DI
+
+	// Carry 5-8
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// clear 7
+	XORQ R9, R9
+
+	// multiply by 2 by shifting
+	SHLQ $0x01, R8, R9
+	SHLQ $0x01, DI, R8
+	SHLQ $0x01, SI, DI
+	SHLQ $0x01, BP, SI
+	SHLQ $0x01, BX, BP
+	SHLQ $0x01, CX, BX
+	SHLQ $0x01, CX
+
+	// add all z*z
+	MOVQ  AX, DX
+	MULXQ AX, AX, DX
+	ADDQ  DX, CX
+	MOVQ  R11, DX
+	MULXQ R11, R10, DX
+	ADCQ  R10, BX
+	ADCQ  DX, BP
+	MOVQ  R12, DX
+	MULXQ R12, R10, DX
+	ADCQ  R10, SI
+	ADCQ  DX, DI
+	MOVQ  R13, DX
+	MULXQ R13, R10, DX
+	ADCQ  R10, R8
+	ADCQ  DX, R9
+	MOVQ  R9, DX
+	SHRQ  $0x3b, DX
+	SHLQ  $0x05, R8, R9
+	SHLQ  $0x05, DI, R8
+	SHLQ  $0x05, SI, DI
+	SHLQ  $0x05, BP, SI
+	MOVQ  $0x07ffffffffffffff, R11
+	ANDQ  R11, BP
+	XORQ  R10, R10
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x03, R9, DX
+	SHLQ  $0x03, R8, R9
+	SHLQ  $0x03, DI, R8
+	SHLQ  $0x03, SI, DI
+	SHLQ  $0x03, SI
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x05, BP, R10
+	ANDQ  R11, BP
+	LEAQ  (R10)(R10*8), R10
+	ADDQ  R10, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	LEAQ  64(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	ADDQ  AX, AX
+	ADCQ  CX, CX
+	ADCQ  BX, BX
+	ADCQ  BP, BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	ADDQ  DX, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	ADDQ  DX, AX
+	LEAQ  64(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	MOVQ  p+8(FP), BP
+	MOVQ  p+8(FP), DX
+	LEAQ  32(DX), DX
+	MOVQ  (BP), AX
+	MOVQ  8(BP), CX
+	MOVQ  16(BP), BX
+	MOVQ  24(BP), BP
+	ADDQ  (DX), AX
+	ADCQ  8(DX), CX
+	ADCQ  16(DX), BX
+	ADCQ  24(DX), BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	ADDQ  DX, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	ADDQ  DX, AX
+	LEAQ  96(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+
+	// load x to registers
+	MOVQ CX, R11
+	MOVQ BX, R12
+	MOVQ BP, R13
+
+	// clear flags
+	XORQ R10, R10
+
+	// fill registers
+	// x[3]*x[2]
+	MOVQ  R13, DX
+	MULXQ R12, DI, R8
+
+	// x[0]*x[3]
+	MOVQ  AX, DX
+	MULXQ R13, BP, SI
+
+	// x[0]*x[1]
+	MULXQ R11, CX, BX
+
+	// 2-4 pass
+	// x[0]*y[2]
+	MULXQ R12, R14, DX
+	ADCXQ R14, BX
+	ADOXQ DX, BP
+
+	// x[1]*y[2]
+	MOVQ  R11, DX
+	MULXQ R12, R15, R14
+	ADCXQ R15, BP
+	ADOXQ R14, SI
+
+	// x[1]*y[3]
+	MULXQ R13, R14, DX
+	ADCXQ R14, SI
+	ADOXQ DX, DI
+
+	// Carry 5-8
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// clear 7
+	XORQ R9, R9
+
+	// multiply by 2 by shifting
+	SHLQ $0x01, R8, R9
+	SHLQ $0x01, DI, R8
+	SHLQ $0x01, SI, DI
+	SHLQ $0x01, BP, SI
+	SHLQ $0x01, BX, BP
+	SHLQ $0x01, CX, BX
+	SHLQ $0x01, CX
+
+	// add all z*z
+	MOVQ  AX, DX
+	MULXQ AX, AX, DX
+	ADDQ  DX, CX
+	MOVQ  R11, DX
+	MULXQ R11, R10, DX
+	ADCQ  R10, BX
+	ADCQ  DX, BP
+	MOVQ  R12, DX
+	MULXQ R12, R10, DX
+	ADCQ  R10, SI
+	ADCQ  DX, DI
+	MOVQ  R13, DX
+	MULXQ R13, R10, DX
+	ADCQ  R10, R8
+	ADCQ  DX, R9
+	MOVQ  R9, DX
+	SHRQ  $0x3b, DX
+	SHLQ  $0x05, R8, R9
+	SHLQ  $0x05, DI, R8
+	SHLQ  $0x05, SI, DI
+	SHLQ  $0x05, BP, SI
+	MOVQ  $0x07ffffffffffffff, R11
+	ANDQ  R11, BP
+	XORQ  R10, R10
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x03, R9, DX
+	SHLQ  $0x03, R8, R9
+	SHLQ  $0x03, DI, R8
+	SHLQ  $0x03, SI, DI
+	SHLQ  $0x03, SI
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x05, BP, R10
+	ANDQ  R11, BP
+	LEAQ  (R10)(R10*8), R10
+	ADDQ  R10, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	LEAQ  96(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  (SP), DX
+	SUBQ  (DX), AX
+	SBBQ  8(DX), CX
+	SBBQ  16(DX), BX
+	SBBQ  24(DX), BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	SBBQ  $0x00, CX
+	SBBQ  $0x00, BX
+	SBBQ  $0x00, BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	LEAQ  96(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  32(SP), DX
+	SUBQ  (DX), AX
+	SBBQ  8(DX), CX
+	SBBQ  16(DX), BX
+	SBBQ  24(DX), BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	SBBQ  $0x00, CX
+	SBBQ  $0x00, BX
+	SBBQ  $0x00, BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	LEAQ  96(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  (SP), BP
+	LEAQ  32(SP), DX
+	MOVQ  (BP), AX
+	MOVQ  8(BP), CX
+	MOVQ  16(BP), BX
+	MOVQ  24(BP), BP
+	ADDQ  (DX), AX
+	ADCQ  8(DX), CX
+	ADCQ  16(DX), BX
+	ADCQ  24(DX), BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	ADDQ  DX, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	ADDQ  DX, AX
+	LEAQ  160(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  64(SP), DX
+	SUBQ  (DX), AX
+	SBBQ  8(DX), CX
+	SBBQ  16(DX), BX
+	SBBQ  24(DX), BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	SBBQ  $0x00, CX
+	SBBQ  $0x00, BX
+	SBBQ  $0x00, BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	LEAQ  128(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  (SP), BP
+	LEAQ  32(SP), DX
+	MOVQ  (BP), AX
+	MOVQ  8(BP), CX
+	MOVQ  16(BP), BX
+	MOVQ  24(BP), BP
+	SUBQ  (DX), AX
+	SBBQ  8(DX), CX
+	SBBQ  16(DX), BX
+	SBBQ  24(DX), BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	SBBQ  $0x00, CX
+	SBBQ  $0x00, BX
+	SBBQ  $0x00, BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	LEAQ  192(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  96(SP), R11
+	LEAQ  128(SP), R12
+
+	// Fill all regs
+	MOVQ  24(R11), DX
+	MULXQ 8(R12), SI, DI
+	MULXQ 24(R12), R8, R9
+	MOVQ  (R11), DX
+	MULXQ (R12), AX, CX
+	MULXQ 16(R12), BX, BP
+	XORQ  R10, R10
+
+	// First 1-5 chain
+	// x[0]*y[1]
+	MULXQ 8(R12), R13, DX
+	ADCXQ R13, CX
+	ADOXQ DX, BX
+
+	// x[2]*y[0]
+	MOVQ  16(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[2]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[2]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, SI
+	ADOXQ R13, DI
+
+	// x[2]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, DI
+	ADOXQ DX, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// Second 1-5 chain
+	// x[1]*y[0]
+	MOVQ  8(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, CX
+	ADOXQ R13, BX
+
+	// x[1]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[1]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[1]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, SI
+	ADOXQ DX, DI
+
+	// x[3]*y[2]
+	MOVQ  24(R11), DX
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, DI
+	ADOXQ R13, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[3]*y[0]
+	MULXQ (R12), R13, DX
+	ADCXQ R13, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[0]*y[3]
+	MOVQ  (R11), DX
+	MULXQ 24(R12), R11, DX
+	ADCXQ R11, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+	MOVQ  R9, DX
+	SHRQ  $0x3b, DX
+	SHLQ  $0x05, R8, R9
+	SHLQ  $0x05, DI, R8
+	SHLQ  $0x05, SI, DI
+	SHLQ  $0x05, BP, SI
+	MOVQ  $0x07ffffffffffffff, R11
+	ANDQ  R11, BP
+	XORQ  R10, R10
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x03, R9, DX
+	SHLQ  $0x03, R8, R9
+	SHLQ  $0x03, DI, R8
+	SHLQ  $0x03, SI, DI
+	SHLQ  $0x03, SI
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x05, BP, R10
+	ANDQ  R11, BP
+	LEAQ  (R10)(R10*8), R10
+	ADDQ  R10, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	MOVQ  res+0(FP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  160(SP), R11
+	LEAQ  192(SP), R12
+
+	// Fill all regs
+	MOVQ  24(R11), DX
+	MULXQ 8(R12), SI, DI
+	MULXQ 24(R12), R8, R9
+	MOVQ  (R11), DX
+	MULXQ (R12), AX, CX
+	MULXQ 16(R12), BX, BP
+	XORQ  R10, R10
+
+	// First 1-5 chain
+	// x[0]*y[1]
+	MULXQ 8(R12), R13, DX
+	ADCXQ R13, CX
+	ADOXQ DX, BX
+
+	// x[2]*y[0]
+	MOVQ  16(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[2]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[2]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, SI
+	ADOXQ R13, DI
+
+	// x[2]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, DI
+	ADOXQ DX, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// Second 1-5 chain
+	// x[1]*y[0]
+	MOVQ  8(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, CX
+	ADOXQ R13, BX
+
+	// x[1]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[1]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[1]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, SI
+	ADOXQ DX, DI
+
+	// x[3]*y[2]
+	MOVQ  24(R11), DX
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, DI
+	ADOXQ R13, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[3]*y[0]
+	MULXQ (R12), R13, DX
+	ADCXQ R13, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[0]*y[3]
+	MOVQ  (R11), DX
+	MULXQ 24(R12), R11, DX
+	ADCXQ R11, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+	MOVQ  R9, DX
+	SHRQ  $0x3b, DX
+	SHLQ  $0x05, R8, R9
+	SHLQ  $0x05, DI, R8
+	SHLQ  $0x05, SI, DI
+	SHLQ  $0x05, BP, SI
+	MOVQ  $0x07ffffffffffffff, R11
+	ANDQ  R11, BP
+	XORQ  R10, R10
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x03, R9, DX
+	SHLQ  $0x03, R8, R9
+	SHLQ  $0x03, DI, R8
+	SHLQ  $0x03, SI, DI
+	SHLQ  $0x03, SI
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x05, BP, R10
+	ANDQ  R11, BP
+	LEAQ  (R10)(R10*8), R10
+	ADDQ  R10, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	MOVQ  res+0(FP), DX
+	LEAQ  32(DX), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  96(SP), R11
+	LEAQ  192(SP), R12
+
+	// Fill all regs
+	MOVQ  24(R11), DX
+	MULXQ 8(R12), SI, DI
+	MULXQ 24(R12), R8, R9
+	MOVQ  (R11), DX
+	MULXQ (R12), AX, CX
+	MULXQ 16(R12), BX, BP
+	XORQ  R10, R10
+
+	// First 1-5 chain
+	// x[0]*y[1]
+	MULXQ 8(R12), R13, DX
+	ADCXQ R13, CX
+	ADOXQ DX, BX
+
+	// x[2]*y[0]
+	MOVQ  16(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[2]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[2]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, SI
+	ADOXQ R13, DI
+
+	// x[2]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, DI
+	ADOXQ DX, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// Second 1-5 chain
+	// x[1]*y[0]
+	MOVQ  8(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, CX
+	ADOXQ R13, BX
+
+	// x[1]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[1]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[1]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, SI
+	ADOXQ DX, DI
+
+	// x[3]*y[2]
+	MOVQ  24(R11), DX
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, DI
+	ADOXQ R13, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[3]*y[0]
+	MULXQ (R12), R13, DX
+	ADCXQ R13, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[0]*y[3]
+	MOVQ  (R11), DX
+	MULXQ 24(R12), R11, DX
+	ADCXQ R11, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+	MOVQ  R9, DX
+	SHRQ  $0x3b, DX
+	SHLQ  $0x05, R8, R9
+	SHLQ  $0x05, DI, R8
+	SHLQ  $0x05, SI, DI
+	SHLQ  $0x05, BP, SI
+	MOVQ  $0x07ffffffffffffff, R11
+	ANDQ  R11, BP
+	XORQ  R10, R10
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x03, R9, DX
+	SHLQ  $0x03, R8, R9
+	SHLQ  $0x03, DI, R8
+	SHLQ  $0x03, SI, DI
+	SHLQ  $0x03, SI
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x05, BP, R10
+	ANDQ  R11, BP
+	LEAQ  (R10)(R10*8), R10
+	ADDQ  R10, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	MOVQ  res+0(FP), DX
+	LEAQ  64(DX), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  128(SP), R11
+	LEAQ  160(SP), R12
+
+	// Fill all regs
+	MOVQ  24(R11), DX
+	MULXQ 8(R12), SI, DI
+	MULXQ 24(R12), R8, R9
+	MOVQ  (R11), DX
+	MULXQ (R12), AX, CX
+	MULXQ 16(R12), BX, BP
+	XORQ  R10, R10
+
+	// First 1-5 chain
+	// x[0]*y[1]
+	MULXQ 8(R12), R13, DX
+	ADCXQ R13, CX
+	ADOXQ DX, BX
+
+	// x[2]*y[0]
+	MOVQ  16(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[2]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[2]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, SI
+	ADOXQ R13, DI
+
+	// x[2]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, DI
+	ADOXQ DX, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// Second 1-5 chain
+	// x[1]*y[0]
+	MOVQ  8(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, CX
+	ADOXQ R13, BX
+
+	// x[1]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[1]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[1]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, SI
+	ADOXQ DX, DI
+
+	// x[3]*y[2]
+	MOVQ  24(R11), DX
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, DI
+	ADOXQ R13, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[3]*y[0]
+	MULXQ (R12), R13, DX
+	ADCXQ R13, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[0]*y[3]
+	MOVQ  (R11), DX
+	MULXQ 24(R12), R11, DX
+	ADCXQ R11, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+	MOVQ  R9, DX
+	SHRQ  $0x3b, DX
+	SHLQ  $0x05, R8, R9
+	SHLQ  $0x05, DI, R8
+	SHLQ  $0x05, SI, DI
+	SHLQ  $0x05, BP, SI
+	MOVQ  $0x07ffffffffffffff, R11
+	ANDQ  R11, BP
+	XORQ  R10, R10
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x03, R9, DX
+	SHLQ  $0x03, R8, R9
+	SHLQ  $0x03, DI, R8
+	SHLQ  $0x03, SI, DI
+	SHLQ  $0x03, SI
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x05, BP, R10
+	ANDQ  R11, BP
+	LEAQ  (R10)(R10*8), R10
+	ADDQ  R10, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	MOVQ  res+0(FP), DX
+	LEAQ  96(DX), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	RET
+
+// func projectiveDouble(res *ProjectivePoint, p *ProjectivePoint)
+// Requires: ADX, BMI2
+TEXT ·projectiveDouble(SB), NOSPLIT, $192-16
+	MOVQ p+8(FP), BP
+	MOVQ p+8(FP), DX
+	LEAQ 32(DX), DX
+	MOVQ (BP), AX
+	MOVQ 8(BP), CX
+	MOVQ 16(BP), BX
+	MOVQ 24(BP), BP
+	ADDQ (DX), AX
+	ADCQ 8(DX), CX
+	ADCQ 16(DX), BX
+	ADCQ 24(DX), BP
+	SBBQ DX, DX
+	ANDL $0x00000120, DX
+	ADDQ DX, AX
+	ADCQ $0x00, CX
+	ADCQ $0x00, BX
+	ADCQ $0x00, BP
+	SBBQ DX, DX
+	ANDL $0x00000120, DX
+	ADDQ DX, AX
+	LEAQ (SP), DX
+	MOVQ AX, (DX)
+	MOVQ CX, 8(DX)
+	MOVQ BX, 16(DX)
+	MOVQ BP, 24(DX)
+
+	// load x to registers
+	MOVQ CX, R11
+	MOVQ BX, R12
+	MOVQ BP, R13
+
+	// clear flags
+	XORQ R10, R10
+
+	// fill registers
+	// x[3]*x[2]
+	MOVQ  R13, DX
+	MULXQ R12, DI, R8
+
+	// x[0]*x[3]
+	MOVQ  AX, DX
+	MULXQ R13, BP, SI
+
+	// x[0]*x[1]
+	MULXQ R11, CX, BX
+
+	// 2-4 pass
+	// x[0]*y[2]
+	MULXQ R12, R14, DX
+	ADCXQ R14, BX
+	ADOXQ DX, BP
+
+	// x[1]*y[2]
+	MOVQ  R11, DX
+	MULXQ R12, R15, R14
+	ADCXQ R15, BP
+	ADOXQ R14, SI
+
+	// x[1]*y[3]
+	MULXQ R13, R14, DX
+	ADCXQ R14, SI
+	ADOXQ DX, DI
+
+	// Carry 5-8
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// clear 7
+	XORQ R9, R9
+
+	// multiply by 2 by shifting
+	SHLQ $0x01, R8, R9
+	SHLQ $0x01, DI, R8
+	SHLQ $0x01, SI, DI
+	SHLQ $0x01, BP, SI
+	SHLQ $0x01, BX, BP
+	SHLQ $0x01, CX, BX
+	SHLQ $0x01, CX
+
+	// add all z*z
+	MOVQ  AX, DX
+	MULXQ AX, AX, DX
+	ADDQ  DX, CX
+	MOVQ  R11, DX
+	MULXQ R11, R10, DX
+	ADCQ  R10, BX
+	ADCQ  DX, BP
+	MOVQ  R12, DX
+	MULXQ R12, R10, DX
+	ADCQ  R10, SI
+	ADCQ  DX, DI
+	MOVQ  R13, DX
+	MULXQ R13, R10, DX
+	ADCQ  R10, R8
+	ADCQ  DX, R9
+	MOVQ  R9, DX
+	SHRQ  $0x3b, DX
+	SHLQ  $0x05, R8, R9
+	SHLQ  $0x05, DI, R8
+	SHLQ  $0x05, SI, DI
+	SHLQ  $0x05, BP, SI
+	MOVQ  $0x07ffffffffffffff, R11
+	ANDQ  R11, BP
+	XORQ  R10, R10
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x03, R9, DX
+	SHLQ  $0x03, R8, R9
+	SHLQ  $0x03, DI, R8
+	SHLQ  $0x03, SI, DI
+	SHLQ  $0x03, SI
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x05, BP, R10
+	ANDQ  R11, BP
+	LEAQ  (R10)(R10*8), R10
+	ADDQ  R10, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	LEAQ  (SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	MOVQ  p+8(FP), CX
+
+	// load x to registers
+	MOVQ (CX), AX
+	MOVQ 8(CX), R11
+	MOVQ 16(CX), R12
+	MOVQ 24(CX), R13
+
+	// clear flags
+	XORQ R10, R10
+
+	// fill registers
+	// x[3]*x[2]
+	MOVQ  R13, DX
+	MULXQ R12, DI, R8
+
+	// x[0]*x[3]
+	MOVQ  AX, DX
+	MULXQ R13, BP, SI
+
+	// x[0]*x[1]
+	MULXQ R11, CX, BX
+
+	// 2-4 pass
+	// x[0]*y[2]
+	MULXQ R12, R14, DX
+	ADCXQ R14, BX
+	ADOXQ DX, BP
+
+	// x[1]*y[2]
+	MOVQ  R11, DX
+	MULXQ R12, R15, R14
+	ADCXQ R15, BP
+	ADOXQ R14, SI
+
+	// x[1]*y[3]
+	MULXQ R13, R14, DX
+	ADCXQ R14, SI
+	ADOXQ DX, DI
+
+	// Carry 5-8
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// clear 7
+	XORQ R9, R9
+
+	// multiply by 2 by shifting
+	SHLQ $0x01, R8, R9
+	SHLQ $0x01, DI, R8
+	SHLQ $0x01, SI, DI
+	SHLQ $0x01, BP, SI
+	SHLQ $0x01, BX, BP
+	SHLQ $0x01, CX, BX
+	SHLQ $0x01, CX
+
+	// add all z*z
+	MOVQ  AX, DX
+	MULXQ AX, AX, DX
+	ADDQ  DX, CX
+	MOVQ  R11, DX
+	MULXQ R11, R10, DX
+	ADCQ  R10, BX
+	ADCQ  DX, BP
+	MOVQ  R12, DX
+	MULXQ R12, R10, DX
+	ADCQ  R10, SI
+	ADCQ  DX, DI
+	MOVQ  R13, DX
+	MULXQ R13, R10, DX
+	ADCQ  R10, R8
+	ADCQ  DX, R9
+	MOVQ  R9, DX
+	SHRQ  $0x3b, DX
+	SHLQ  $0x05, R8, R9
+	SHLQ  $0x05, DI, R8
+	SHLQ  $0x05, SI, DI
+	SHLQ  $0x05, BP, SI
+	MOVQ  $0x07ffffffffffffff, R11
+	ANDQ  R11, BP
+	XORQ  R10, R10
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x03, R9, DX
+	SHLQ  $0x03, R8, R9
+	SHLQ  $0x03, DI, R8
+	SHLQ  $0x03, SI, DI
+	SHLQ  $0x03, SI
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x05, BP, R10
+	ANDQ  R11, BP
+	LEAQ  (R10)(R10*8), R10
+	ADDQ  R10, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	LEAQ  32(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	MOVQ  p+8(FP), CX
+	LEAQ  32(CX), CX
+
+	// load x to registers
+	MOVQ (CX), AX
+	MOVQ 8(CX), R11
+	MOVQ 16(CX), R12
+	MOVQ 24(CX), R13
+
+	// clear flags
+	XORQ R10, R10
+
+	// fill registers
+	// x[3]*x[2]
+	MOVQ  R13, DX
+	MULXQ R12, DI, R8
+
+	// x[0]*x[3]
+	MOVQ  AX, DX
+	MULXQ R13, BP, SI
+
+	// x[0]*x[1]
+	MULXQ R11, CX, BX
+
+	// 2-4 pass
+	// x[0]*y[2]
+	MULXQ R12, R14, DX
+	ADCXQ R14, BX
+	ADOXQ DX, BP
+
+	// x[1]*y[2]
+	MOVQ  R11, DX
+	MULXQ R12, R15, R14
+	ADCXQ R15, BP
+	ADOXQ R14, SI
+
+	// x[1]*y[3]
+	MULXQ R13, R14, DX
+	ADCXQ R14, SI
+	ADOXQ DX, DI
+
+	// Carry 5-8
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// clear 7
+	XORQ R9, R9
+
+	// multiply by 2 by shifting
+	SHLQ $0x01, R8, R9
+	SHLQ $0x01, DI, R8
+	SHLQ $0x01, SI, DI
+	SHLQ $0x01, BP, SI
+	SHLQ $0x01, BX, BP
+	SHLQ $0x01, CX, BX
+	SHLQ $0x01, CX
+
+	// add all z*z
+	MOVQ  AX, DX
+	MULXQ AX, AX, DX
+	ADDQ  DX, CX
+	MOVQ  R11, DX
+	MULXQ R11, R10, DX
+	ADCQ  R10, BX
+	ADCQ  DX, BP
+	MOVQ  R12, DX
+	MULXQ R12, R10, DX
+	ADCQ  R10, SI
+	ADCQ  DX, DI
+	MOVQ  R13, DX
+	MULXQ R13, R10, DX
+	ADCQ  R10, R8
+	ADCQ  DX, R9
+	MOVQ  R9, DX
+	SHRQ  $0x3b, DX
+	SHLQ  $0x05, R8, R9
+	SHLQ  $0x05, DI, R8
+	SHLQ  $0x05, SI, DI
+	SHLQ  $0x05, BP, SI
+	MOVQ  $0x07ffffffffffffff, R11
+	ANDQ  R11, BP
+	XORQ  R10, R10
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x03, R9, DX
+	SHLQ  $0x03, R8, R9
+	SHLQ  $0x03, DI, R8
+	SHLQ  $0x03, SI, DI
+	SHLQ  $0x03, SI
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x05, BP, R10
+	ANDQ  R11, BP
+	LEAQ  (R10)(R10*8), R10
+	ADDQ  R10, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	LEAQ  64(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  32(SP), DX
+	ADDQ  (DX), AX
+	ADCQ  8(DX), CX
+	ADCQ  16(DX), BX
+	ADCQ  24(DX), BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	ADDQ  DX, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	ADDQ  DX, AX
+	LEAQ  96(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	MOVQ  p+8(FP), CX
+	LEAQ  64(CX), CX
+
+	// load x to registers
+	MOVQ (CX), AX
+	MOVQ 8(CX), R11
+	MOVQ 16(CX), R12
+	MOVQ 24(CX), R13
+
+	// clear flags
+	XORQ R10, R10
+
+	// fill registers
+	// x[3]*x[2]
+	MOVQ  R13, DX
+	MULXQ R12, DI, R8
+
+	// x[0]*x[3]
+	MOVQ  AX, DX
+	MULXQ R13, BP, SI
+
+	// x[0]*x[1]
+	MULXQ R11, CX, BX
+
+	// 2-4 pass
+	// x[0]*y[2]
+	MULXQ R12, R14, DX
+	ADCXQ R14, BX
+	ADOXQ DX, BP
+
+	// x[1]*y[2]
+	MOVQ  R11, DX
+	MULXQ R12, R15, R14
+	ADCXQ R15, BP
+	ADOXQ R14, SI
+
+	// x[1]*y[3]
+	MULXQ R13, R14, DX
+	ADCXQ R14, SI
+	ADOXQ DX, DI
+
+	// Carry 5-8
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// clear 7
+	XORQ R9, R9
+
+	// multiply by 2 by shifting
+	SHLQ $0x01, R8, R9
+	SHLQ $0x01, DI, R8
+	SHLQ $0x01, SI, DI
+	SHLQ $0x01, BP, SI
+	SHLQ $0x01, BX, BP
+	SHLQ $0x01, CX, BX
+	SHLQ $0x01, CX
+
+	// add all z*z
+	MOVQ  AX, DX
+	MULXQ AX, AX, DX
+	ADDQ  DX, CX
+	MOVQ  R11, DX
+	MULXQ R11, R10, DX
+	ADCQ  R10, BX
+	ADCQ  DX, BP
+	MOVQ  R12, DX
+	MULXQ R12, R10, DX
+	ADCQ  R10, SI
+	ADCQ  DX, DI
+	MOVQ  R13, DX
+	MULXQ R13, R10, DX
+	ADCQ  R10, R8
+	ADCQ  DX, R9
+	MOVQ  R9, DX
+	SHRQ  $0x3b, DX
+	SHLQ  $0x05, R8, R9
+	SHLQ  $0x05, DI, R8
+	SHLQ  $0x05, SI, DI
+	SHLQ  $0x05, BP, SI
+	MOVQ  $0x07ffffffffffffff, R11
+	ANDQ  R11, BP
+	XORQ  R10, R10
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x03, R9, DX
+	SHLQ  $0x03, R8, R9
+	SHLQ  $0x03, DI, R8
+	SHLQ  $0x03, SI, DI
+	SHLQ  $0x03, SI
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x05, BP, R10
+	ANDQ  R11, BP
+	LEAQ  (R10)(R10*8), R10
+	ADDQ  R10, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	LEAQ  128(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	ADDQ  AX, AX
+	ADCQ  CX, CX
+	ADCQ  BX, BX
+	ADCQ  BP, BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	ADDQ  DX, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	ADDQ  DX, AX
+	LEAQ  160(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  96(SP), BP
+	LEAQ  160(SP), DX
+	MOVQ  (BP), AX
+	MOVQ  8(BP), CX
+	MOVQ  16(BP), BX
+	MOVQ  24(BP), BP
+	SUBQ  (DX), AX
+	SBBQ  8(DX), CX
+	SBBQ  16(DX), BX
+	SBBQ  24(DX), BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	SBBQ  $0x00, CX
+	SBBQ  $0x00, BX
+	SBBQ  $0x00, BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	LEAQ  160(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  (SP), BP
+	LEAQ  32(SP), DX
+	MOVQ  (BP), AX
+	MOVQ  8(BP), CX
+	MOVQ  16(BP), BX
+	MOVQ  24(BP), BP
+	SUBQ  (DX), AX
+	SBBQ  8(DX), CX
+	SBBQ  16(DX), BX
+	SBBQ  24(DX), BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	SBBQ  $0x00, CX
+	SBBQ  $0x00, BX
+	SBBQ  $0x00, BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	MOVQ  res+0(FP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  64(SP), DX
+	SUBQ  (DX), AX
+	SBBQ  8(DX), CX
+	SBBQ  16(DX), BX
+	SBBQ  24(DX), BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	SBBQ  $0x00, CX
+	SBBQ  $0x00, BX
+	SBBQ  $0x00, BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	MOVQ  res+0(FP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	MOVQ  res+0(FP), R11
+	LEAQ  160(SP), R12
+
+	// Fill all regs
+	MOVQ  24(R11), DX
+	MULXQ 8(R12), SI, DI
+	MULXQ 24(R12), R8, R9
+	MOVQ  (R11), DX
+	MULXQ (R12), AX, CX
+	MULXQ 16(R12), BX, BP
+	XORQ  R10, R10
+
+	// First 1-5 chain
+	// x[0]*y[1]
+	MULXQ 8(R12), R13, DX
+	ADCXQ R13, CX
+	ADOXQ DX, BX
+
+	// x[2]*y[0]
+	MOVQ  16(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[2]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[2]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, SI
+	ADOXQ R13, DI
+
+	// x[2]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, DI
+	ADOXQ DX, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// Second 1-5 chain
+	// x[1]*y[0]
+	MOVQ  8(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, CX
+	ADOXQ R13, BX
+
+	// x[1]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[1]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[1]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, SI
+	ADOXQ DX, DI
+
+	// x[3]*y[2]
+	MOVQ  24(R11), DX
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, DI
+	ADOXQ R13, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[3]*y[0]
+	MULXQ (R12), R13, DX
+	ADCXQ R13, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[0]*y[3]
+	MOVQ  (R11), DX
+	MULXQ 24(R12), R11, DX
+	ADCXQ R11, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+	MOVQ  R9, DX
+	SHRQ  $0x3b, DX
+	SHLQ  $0x05, R8, R9
+	SHLQ  $0x05, DI, R8
+	SHLQ  $0x05, SI, DI
+	SHLQ  $0x05, BP, SI
+	MOVQ  $0x07ffffffffffffff, R11
+	ANDQ  R11, BP
+	XORQ  R10, R10
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x03, R9, DX
+	SHLQ  $0x03, R8, R9
+	SHLQ  $0x03, DI, R8
+	SHLQ  $0x03, SI, DI
+	SHLQ  $0x03, SI
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x05, BP, R10
+	ANDQ  R11, BP
+	LEAQ  (R10)(R10*8), R10
+	ADDQ  R10, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	MOVQ  res+0(FP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  32(SP), BP
+	LEAQ  64(SP), DX
+	MOVQ  (BP), AX
+	MOVQ  8(BP), CX
+	MOVQ  16(BP), BX
+	MOVQ  24(BP), BP
+	SUBQ  (DX), AX
+	SBBQ  8(DX), CX
+	SBBQ  16(DX), BX
+	SBBQ  24(DX), BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	SBBQ  $0x00, CX
+	SBBQ  $0x00, BX
+	SBBQ  $0x00, BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	MOVQ  res+0(FP), DX
+	LEAQ  32(DX), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	MOVQ  res+0(FP), R11
+	LEAQ  32(R11), R11
+	LEAQ  96(SP), R12
+
+	// Fill all regs
+	MOVQ  24(R11), DX
+	MULXQ 8(R12), SI, DI
+	MULXQ 24(R12), R8, R9
+	MOVQ  (R11), DX
+	MULXQ (R12), AX, CX
+	MULXQ 16(R12), BX, BP
+	XORQ  R10, R10
+
+	// First 1-5 chain
+	// x[0]*y[1]
+	MULXQ 8(R12), R13, DX
+	ADCXQ R13, CX
+	ADOXQ DX, BX
+
+	// x[2]*y[0]
+	MOVQ  16(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[2]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[2]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, SI
+	ADOXQ R13, DI
+
+	// x[2]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, DI
+	ADOXQ DX, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// Second 1-5 chain
+	// x[1]*y[0]
+	MOVQ  8(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, CX
+	ADOXQ R13, BX
+
+	// x[1]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[1]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[1]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, SI
+	ADOXQ DX, DI
+
+	// x[3]*y[2]
+	MOVQ  24(R11), DX
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, DI
+	ADOXQ R13, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[3]*y[0]
+	MULXQ (R12), R13, DX
+	ADCXQ R13, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[0]*y[3]
+	MOVQ  (R11), DX
+	MULXQ 24(R12), R11, DX
+	ADCXQ R11, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+	MOVQ  R9, DX
+	SHRQ  $0x3b, DX
+	SHLQ  $0x05, R8, R9
+	SHLQ  $0x05, DI, R8
+	SHLQ  $0x05, SI, DI
+	SHLQ  $0x05, BP, SI
+	MOVQ  $0x07ffffffffffffff, R11
+	ANDQ  R11, BP
+	XORQ  R10, R10
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x03, R9, DX
+	SHLQ  $0x03, R8, R9
+	SHLQ  $0x03, DI, R8
+	SHLQ  $0x03, SI, DI
+	SHLQ  $0x03, SI
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x05, BP, R10
+	ANDQ  R11, BP
+	LEAQ  (R10)(R10*8), R10
+	ADDQ  R10, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	MOVQ  res+0(FP), DX
+	LEAQ  32(DX), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  96(SP), R11
+	LEAQ  160(SP), R12
+
+	// Fill all regs
+	MOVQ  24(R11), DX
+	MULXQ 8(R12), SI, DI
+	MULXQ 24(R12), R8, R9
+	MOVQ  (R11), DX
+	MULXQ (R12), AX, CX
+	MULXQ 16(R12), BX, BP
+	XORQ  R10, R10
+
+	// First 1-5 chain
+	// x[0]*y[1]
+	MULXQ 8(R12), R13, DX
+	ADCXQ R13, CX
+	ADOXQ DX, BX
+
+	// x[2]*y[0]
+	MOVQ  16(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[2]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[2]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, SI
+	ADOXQ R13, DI
+
+	// x[2]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, DI
+	ADOXQ DX, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// Second 1-5 chain
+	// x[1]*y[0]
+	MOVQ  8(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, CX
+	ADOXQ R13, BX
+
+	// x[1]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[1]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[1]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, SI
+	ADOXQ DX, DI
+
+	// x[3]*y[2]
+	MOVQ  24(R11), DX
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, DI
+	ADOXQ R13, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[3]*y[0]
+	MULXQ (R12), R13, DX
+	ADCXQ R13, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[0]*y[3]
+	MOVQ  (R11), DX
+	MULXQ 24(R12), R11, DX
+	ADCXQ R11, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+	MOVQ  R9, DX
+	SHRQ  $0x3b, DX
+	SHLQ  $0x05, R8, R9
+	SHLQ  $0x05, DI, R8
+	SHLQ  $0x05, SI, DI
+	SHLQ  $0x05, BP, SI
+	MOVQ  $0x07ffffffffffffff, R11
+	ANDQ  R11, BP
+	XORQ  R10, R10
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x03, R9, DX
+	SHLQ  $0x03, R8, R9
+	SHLQ  $0x03, DI, R8
+	SHLQ  $0x03, SI, DI
+	SHLQ  $0x03, SI
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x05, BP, R10
+	ANDQ  R11, BP
+	LEAQ  (R10)(R10*8), R10
+	ADDQ  R10, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	MOVQ  res+0(FP), DX
+	LEAQ  64(DX), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	RET
+
+// func pointAddCached(res *Point, p1 *Point, p2 *CachedPoint)
+// Requires: ADX, BMI2
+TEXT ·pointAddCached(SB), NOSPLIT, $256-24
+	MOVQ p1+8(FP), R11
+	LEAQ 96(R11), R11
+	MOVQ p2+16(FP), R12
+	LEAQ 96(R12), R12
+
+	// Fill all regs
+	MOVQ  24(R11), DX
+	MULXQ 8(R12), SI, DI
+	MULXQ 24(R12), R8, R9
+	MOVQ  (R11), DX
+	MULXQ (R12), AX, CX
+	MULXQ 16(R12), BX, BP
+	XORQ  R10, R10
+
+	// First 1-5 chain
+	// x[0]*y[1]
+	MULXQ 8(R12), R13, DX
+	ADCXQ R13, CX
+	ADOXQ DX, BX
+
+	// x[2]*y[0]
+	MOVQ  16(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[2]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[2]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, SI
+	ADOXQ R13, DI
+
+	// x[2]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, DI
+	ADOXQ DX, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// Second 1-5 chain
+	// x[1]*y[0]
+	MOVQ  8(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, CX
+	ADOXQ R13, BX
+
+	// x[1]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[1]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[1]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, SI
+	ADOXQ DX, DI
+
+	// x[3]*y[2]
+	MOVQ  24(R11), DX
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, DI
+	ADOXQ R13, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[3]*y[0]
+	MULXQ (R12), R13, DX
+	ADCXQ R13, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[0]*y[3]
+	MOVQ  (R11), DX
+	MULXQ 24(R12), R11, DX
+	ADCXQ R11, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+	MOVQ  R9, DX
+	SHRQ  $0x3b, DX
+	SHLQ  $0x05, R8, R9
+	SHLQ  $0x05, DI, R8
+	SHLQ  $0x05, SI, DI
+	SHLQ  $0x05, BP, SI
+	MOVQ  $0x07ffffffffffffff, R11
+	ANDQ  R11, BP
+	XORQ  R10, R10
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x03, R9, DX
+	SHLQ  $0x03, R8, R9
+	SHLQ  $0x03, DI, R8
+	SHLQ  $0x03, SI, DI
+	SHLQ  $0x03, SI
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x05, BP, R10
+	ANDQ  R11, BP
+	LEAQ  (R10)(R10*8), R10
+	ADDQ  R10, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	LEAQ  (SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	MOVQ  p1+8(FP), BP
+	LEAQ  32(BP), BP
+	MOVQ  p1+8(FP), DX
+	MOVQ  (BP), AX
+	MOVQ  8(BP), CX
+	MOVQ  16(BP), BX
+	MOVQ  24(BP), BP
+	ADDQ  (DX), AX
+	ADCQ  8(DX), CX
+	ADCQ  16(DX), BX
+	ADCQ  24(DX), BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	ADDQ  DX, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	ADDQ  DX, AX
+	LEAQ  32(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  32(SP), R11
+	MOVQ  p2+16(FP), R12
+
+	// Fill all regs
+	MOVQ  24(R11), DX
+	MULXQ 8(R12), SI, DI
+	MULXQ 24(R12), R8, R9
+	MOVQ  (R11), DX
+	MULXQ (R12), AX, CX
+	MULXQ 16(R12), BX, BP
+	XORQ  R10, R10
+
+	// First 1-5 chain
+	// x[0]*y[1]
+	MULXQ 8(R12), R13, DX
+	ADCXQ R13, CX
+	ADOXQ DX, BX
+
+	// x[2]*y[0]
+	MOVQ  16(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[2]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[2]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, SI
+	ADOXQ R13, DI
+
+	// x[2]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, DI
+	ADOXQ DX, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// Second 1-5 chain
+	// x[1]*y[0]
+	MOVQ  8(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, CX
+	ADOXQ R13, BX
+
+	// x[1]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[1]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[1]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, SI
+	ADOXQ DX, DI
+
+	// x[3]*y[2]
+	MOVQ  24(R11), DX
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, DI
+	ADOXQ R13, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[3]*y[0]
+	MULXQ (R12), R13, DX
+	ADCXQ R13, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[0]*y[3]
+	MOVQ  (R11), DX
+	MULXQ 24(R12), R11, DX
+	ADCXQ R11, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+	MOVQ  R9, DX
+	SHRQ  $0x3b, DX
+	SHLQ  $0x05, R8, R9
+	SHLQ  $0x05, DI, R8
+	SHLQ  $0x05, SI, DI
+	SHLQ  $0x05, BP, SI
+	MOVQ  $0x07ffffffffffffff, R11
+	ANDQ  R11, BP
+	XORQ  R10, R10
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x03, R9, DX
+	SHLQ  $0x03, R8, R9
+	SHLQ  $0x03, DI, R8
+	SHLQ  $0x03, SI, DI
+	SHLQ  $0x03, SI
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x05, BP, R10
+	ANDQ  R11, BP
+	LEAQ  (R10)(R10*8), R10
+	ADDQ  R10, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	LEAQ  32(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	MOVQ  p1+8(FP), BP
+	LEAQ  32(BP), BP
+	MOVQ  p1+8(FP), DX
+	MOVQ  (BP), AX
+	MOVQ  8(BP), CX
+	MOVQ  16(BP), BX
+	MOVQ  24(BP), BP
+	SUBQ  (DX), AX
+	SBBQ  8(DX), CX
+	SBBQ  16(DX), BX
+	SBBQ  24(DX), BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	SBBQ  $0x00, CX
+	SBBQ  $0x00, BX
+	SBBQ  $0x00, BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	LEAQ  64(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  64(SP), R11
+	MOVQ  p2+16(FP), R12
+	LEAQ  32(R12), R12
+
+	// Fill all regs
+	MOVQ  24(R11), DX
+	MULXQ 8(R12), SI, DI
+	MULXQ 24(R12), R8, R9
+	MOVQ  (R11), DX
+	MULXQ (R12), AX, CX
+	MULXQ 16(R12), BX, BP
+	XORQ  R10, R10
+
+	// First 1-5 chain
+	// x[0]*y[1]
+	MULXQ 8(R12), R13, DX
+	ADCXQ R13, CX
+	ADOXQ DX, BX
+
+	// x[2]*y[0]
+	MOVQ  16(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[2]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[2]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, SI
+	ADOXQ R13, DI
+
+	// x[2]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, DI
+	ADOXQ DX, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// Second 1-5 chain
+	// x[1]*y[0]
+	MOVQ  8(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, CX
+	ADOXQ R13, BX
+
+	// x[1]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[1]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[1]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, SI
+	ADOXQ DX, DI
+
+	// x[3]*y[2]
+	MOVQ  24(R11), DX
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, DI
+	ADOXQ R13, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[3]*y[0]
+	MULXQ (R12), R13, DX
+	ADCXQ R13, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[0]*y[3]
+	MOVQ  (R11), DX
+	MULXQ 24(R12), R11, DX
+	ADCXQ R11, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+	MOVQ  R9, DX
+	SHRQ  $0x3b, DX
+	SHLQ  $0x05, R8, R9
+	SHLQ  $0x05, DI, R8
+	SHLQ  $0x05, SI, DI
+	SHLQ  $0x05, BP, SI
+	MOVQ  $0x07ffffffffffffff, R11
+	ANDQ  R11, BP
+	XORQ  R10, R10
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x03, R9, DX
+	SHLQ  $0x03, R8, R9
+	SHLQ  $0x03, DI, R8
+	SHLQ  $0x03, SI, DI
+	SHLQ  $0x03, SI
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x05, BP, R10
+	ANDQ  R11, BP
+	LEAQ  (R10)(R10*8), R10
+	ADDQ  R10, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	LEAQ  64(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	MOVQ  p2+16(FP), BP
+	MOVQ  p2+16(FP), DX
+	LEAQ  32(DX), DX
+	MOVQ  (BP), AX
+	MOVQ  8(BP), CX
+	MOVQ  16(BP), BX
+	MOVQ  24(BP), BP
+	SUBQ  (DX), AX
+	SBBQ  8(DX), CX
+	SBBQ  16(DX), BX
+	SBBQ  24(DX), BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	SBBQ  $0x00, CX
+	SBBQ  $0x00, BX
+	SBBQ  $0x00, BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	LEAQ  96(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  96(SP), R11
+	MOVQ  p1+8(FP), R12
+
+	// Fill all regs
+	MOVQ  24(R11), DX
+	MULXQ 8(R12), SI, DI
+	MULXQ 24(R12), R8, R9
+	MOVQ  (R11), DX
+	MULXQ (R12), AX, CX
+	MULXQ 16(R12), BX, BP
+	XORQ  R10, R10
+
+	// First 1-5 chain
+	// x[0]*y[1]
+	MULXQ 8(R12), R13, DX
+	ADCXQ R13, CX
+	ADOXQ DX, BX
+
+	// x[2]*y[0]
+	MOVQ  16(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[2]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[2]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, SI
+	ADOXQ R13, DI
+
+	// x[2]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, DI
+	ADOXQ DX, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// Second 1-5 chain
+	// x[1]*y[0]
+	MOVQ  8(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, CX
+	ADOXQ R13, BX
+
+	// x[1]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[1]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[1]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, SI
+	ADOXQ DX, DI
+
+	// x[3]*y[2]
+	MOVQ  24(R11), DX
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, DI
+	ADOXQ R13, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[3]*y[0]
+	MULXQ (R12), R13, DX
+	ADCXQ R13, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[0]*y[3]
+	MOVQ  (R11), DX
+	MULXQ 24(R12), R11, DX
+	ADCXQ R11, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+	MOVQ  R9, DX
+	SHRQ  $0x3b, DX
+	SHLQ  $0x05, R8, R9
+	SHLQ  $0x05, DI, R8
+	SHLQ  $0x05, SI, DI
+	SHLQ  $0x05, BP, SI
+	MOVQ  $0x07ffffffffffffff, R11
+	ANDQ  R11, BP
+	XORQ  R10, R10
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x03, R9, DX
+	SHLQ  $0x03, R8, R9
+	SHLQ  $0x03, DI, R8
+	SHLQ  $0x03, SI, DI
+	SHLQ  $0x03, SI
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x05, BP, R10
+	ANDQ  R11, BP
+	LEAQ  (R10)(R10*8), R10
+	ADDQ  R10, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	LEAQ  96(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	MOVQ  p1+8(FP), R11
+	LEAQ  64(R11), R11
+	MOVQ  p2+16(FP), R12
+	LEAQ  64(R12), R12
+
+	// Fill all regs
+	MOVQ  24(R11), DX
+	MULXQ 8(R12), SI, DI
+	MULXQ 24(R12), R8, R9
+	MOVQ  (R11), DX
+	MULXQ (R12), AX, CX
+	MULXQ 16(R12), BX, BP
+	XORQ  R10, R10
+
+	// First 1-5 chain
+	// x[0]*y[1]
+	MULXQ 8(R12), R13, DX
+	ADCXQ R13, CX
+	ADOXQ DX, BX
+
+	// x[2]*y[0]
+	MOVQ  16(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[2]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[2]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, SI
+	ADOXQ R13, DI
+
+	// x[2]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, DI
+	ADOXQ DX, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// Second 1-5 chain
+	// x[1]*y[0]
+	MOVQ  8(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, CX
+	ADOXQ R13, BX
+
+	// x[1]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[1]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[1]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, SI
+	ADOXQ DX, DI
+
+	// x[3]*y[2]
+	MOVQ  24(R11), DX
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, DI
+	ADOXQ R13, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[3]*y[0]
+	MULXQ (R12), R13, DX
+	ADCXQ R13, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[0]*y[3]
+	MOVQ  (R11), DX
+	MULXQ 24(R12), R11, DX
+	ADCXQ R11, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+	MOVQ  R9, DX
+	SHRQ  $0x3b, DX
+	SHLQ  $0x05, R8, R9
+	SHLQ  $0x05, DI, R8
+	SHLQ  $0x05, SI, DI
+	SHLQ  $0x05, BP, SI
+	MOVQ  $0x07ffffffffffffff, R11
+	ANDQ  R11, BP
+	XORQ  R10, R10
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x03, R9, DX
+	SHLQ  $0x03, R8, R9
+	SHLQ  $0x03, DI, R8
+	SHLQ  $0x03, SI, DI
+	SHLQ  $0x03, SI
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x05, BP, R10
+	ANDQ  R11, BP
+	LEAQ  (R10)(R10*8), R10
+	ADDQ  R10, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	LEAQ  128(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  32(SP), BP
+	LEAQ  64(SP), DX
+	MOVQ  (BP), AX
+	MOVQ  8(BP), CX
+	MOVQ  16(BP), BX
+	MOVQ  24(BP), BP
+	SUBQ  (DX), AX
+	SBBQ  8(DX), CX
+	SBBQ  16(DX), BX
+	SBBQ  24(DX), BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	SBBQ  $0x00, CX
+	SBBQ  $0x00, BX
+	SBBQ  $0x00, BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	LEAQ  160(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  (SP), BP
+	LEAQ  128(SP), DX
+	MOVQ  (BP), AX
+	MOVQ  8(BP), CX
+	MOVQ  16(BP), BX
+	MOVQ  24(BP), BP
+	SUBQ  (DX), AX
+	SBBQ  8(DX), CX
+	SBBQ  16(DX), BX
+	SBBQ  24(DX), BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	SBBQ  $0x00, CX
+	SBBQ  $0x00, BX
+	SBBQ  $0x00, BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	LEAQ  192(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  (SP), BP
+	LEAQ  128(SP), DX
+	MOVQ  (BP), AX
+	MOVQ  8(BP), CX
+	MOVQ  16(BP), BX
+	MOVQ  24(BP), BP
+	ADDQ  (DX), AX
+	ADCQ  8(DX), CX
+	ADCQ  16(DX), BX
+	ADCQ  24(DX), BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	ADDQ  DX, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	ADDQ  DX, AX
+	LEAQ  224(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  32(SP), BP
+	LEAQ  64(SP), DX
+	MOVQ  (BP), AX
+	MOVQ  8(BP), CX
+	MOVQ  16(BP), BX
+	MOVQ  24(BP), BP
+	ADDQ  (DX), AX
+	ADCQ  8(DX), CX
+	ADCQ  16(DX), BX
+	ADCQ  24(DX), BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	ADDQ  DX, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	ADDQ  DX, AX
+	LEAQ  (SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  96(SP), DX
+	SUBQ  (DX), AX
+	SBBQ  8(DX), CX
+	SBBQ  16(DX), BX
+	SBBQ  24(DX), BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	SBBQ  $0x00, CX
+	SBBQ  $0x00, BX
+	SBBQ  $0x00, BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	LEAQ  (SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  96(SP), DX
+	SUBQ  (DX), AX
+	SBBQ  8(DX), CX
+	SBBQ  16(DX), BX
+	SBBQ  24(DX), BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	SBBQ  $0x00, CX
+	SBBQ  $0x00, BX
+	SBBQ  $0x00, BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	LEAQ  (SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  160(SP), R11
+	LEAQ  192(SP), R12
+
+	// Fill all regs
+	MOVQ  24(R11), DX
+	MULXQ 8(R12), SI, DI
+	MULXQ 24(R12), R8, R9
+	MOVQ  (R11), DX
+	MULXQ (R12), AX, CX
+	MULXQ 16(R12), BX, BP
+	XORQ  R10, R10
+
+	// First 1-5 chain
+	// x[0]*y[1]
+	MULXQ 8(R12), R13, DX
+	ADCXQ R13, CX
+	ADOXQ DX, BX
+
+	// x[2]*y[0]
+	MOVQ  16(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[2]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[2]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, SI
+	ADOXQ R13, DI
+
+	// x[2]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, DI
+	ADOXQ DX, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// Second 1-5 chain
+	// x[1]*y[0]
+	MOVQ  8(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, CX
+	ADOXQ R13, BX
+
+	// x[1]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[1]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[1]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, SI
+	ADOXQ DX, DI
+
+	// x[3]*y[2]
+	MOVQ  24(R11), DX
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, DI
+	ADOXQ R13, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[3]*y[0]
+	MULXQ (R12), R13, DX
+	ADCXQ R13, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[0]*y[3]
+	MOVQ  (R11), DX
+	MULXQ 24(R12), R11, DX
+	ADCXQ R11, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+	MOVQ  R9, DX
+	SHRQ  $0x3b, DX
+	SHLQ  $0x05, R8, R9
+	SHLQ  $0x05, DI, R8
+	SHLQ  $0x05, SI, DI
+	SHLQ  $0x05, BP, SI
+	MOVQ  $0x07ffffffffffffff, R11
+	ANDQ  R11, BP
+	XORQ  R10, R10
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x03, R9, DX
+	SHLQ  $0x03, R8, R9
+	SHLQ  $0x03, DI, R8
+	SHLQ  $0x03, SI, DI
+	SHLQ  $0x03, SI
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x05, BP, R10
+	ANDQ  R11, BP
+	LEAQ  (R10)(R10*8), R10
+	ADDQ  R10, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	MOVQ  res+0(FP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  224(SP), R11
+	LEAQ  (SP), R12
+
+	// Fill all regs
+	MOVQ  24(R11), DX
+	MULXQ 8(R12), SI, DI
+	MULXQ 24(R12), R8, R9
+	MOVQ  (R11), DX
+	MULXQ (R12), AX, CX
+	MULXQ 16(R12), BX, BP
+	XORQ  R10, R10
+
+	// First 1-5 chain
+	// x[0]*y[1]
+	MULXQ 8(R12), R13, DX
+	ADCXQ R13, CX
+	ADOXQ DX, BX
+
+	// x[2]*y[0]
+	MOVQ  16(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[2]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[2]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, SI
+	ADOXQ R13, DI
+
+	// x[2]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, DI
+	ADOXQ DX, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// Second 1-5 chain
+	// x[1]*y[0]
+	MOVQ  8(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, CX
+	ADOXQ R13, BX
+
+	// x[1]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[1]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[1]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, SI
+	ADOXQ DX, DI
+
+	// x[3]*y[2]
+	MOVQ  24(R11), DX
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, DI
+	ADOXQ R13, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[3]*y[0]
+	MULXQ (R12), R13, DX
+	ADCXQ R13, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[0]*y[3]
+	MOVQ  (R11), DX
+	MULXQ 24(R12), R11, DX
+	ADCXQ R11, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+	MOVQ  R9, DX
+	SHRQ  $0x3b, DX
+	SHLQ  $0x05, R8, R9
+	SHLQ  $0x05, DI, R8
+	SHLQ  $0x05, SI, DI
+	SHLQ  $0x05, BP, SI
+	MOVQ  $0x07ffffffffffffff, R11
+	ANDQ  R11, BP
+	XORQ  R10, R10
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x03, R9, DX
+	SHLQ  $0x03, R8, R9
+	SHLQ  $0x03, DI, R8
+	SHLQ  $0x03, SI, DI
+	SHLQ  $0x03, SI
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x05, BP, R10
+	ANDQ  R11, BP
+	LEAQ  (R10)(R10*8), R10
+	ADDQ  R10, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	MOVQ  res+0(FP), DX
+	LEAQ  32(DX), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  160(SP), R11
+	LEAQ  (SP), R12
+
+	// Fill all regs
+	MOVQ  24(R11), DX
+	MULXQ 8(R12), SI, DI
+	MULXQ 24(R12), R8, R9
+	MOVQ  (R11), DX
+	MULXQ (R12), AX, CX
+	MULXQ 16(R12), BX, BP
+	XORQ  R10, R10
+
+	// First 1-5 chain
+	// x[0]*y[1]
+	MULXQ 8(R12), R13, DX
+	ADCXQ R13, CX
+	ADOXQ DX, BX
+
+	// x[2]*y[0]
+	MOVQ  16(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[2]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[2]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, SI
+	ADOXQ R13, DI
+
+	// x[2]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, DI
+	ADOXQ DX, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// Second 1-5 chain
+	// x[1]*y[0]
+	MOVQ  8(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, CX
+	ADOXQ R13, BX
+
+	// x[1]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[1]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[1]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, SI
+	ADOXQ DX, DI
+
+	// x[3]*y[2]
+	MOVQ  24(R11), DX
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, DI
+	ADOXQ R13, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[3]*y[0]
+	MULXQ (R12), R13, DX
+	ADCXQ R13, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[0]*y[3]
+	MOVQ  (R11), DX
+	MULXQ 24(R12), R11, DX
+	ADCXQ R11, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+	MOVQ  R9, DX
+	SHRQ  $0x3b, DX
+	SHLQ  $0x05, R8, R9
+	SHLQ  $0x05, DI, R8
+	SHLQ  $0x05, SI, DI
+	SHLQ  $0x05, BP, SI
+	MOVQ  $0x07ffffffffffffff, R11
+	ANDQ  R11, BP
+	XORQ  R10, R10
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x03, R9, DX
+	SHLQ  $0x03, R8, R9
+	SHLQ  $0x03, DI, R8
+	SHLQ  $0x03, SI, DI
+	SHLQ  $0x03, SI
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x05, BP, R10
+	ANDQ  R11, BP
+	LEAQ  (R10)(R10*8), R10
+	ADDQ  R10, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	MOVQ  res+0(FP), DX
+	LEAQ  64(DX), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  192(SP), R11
+	LEAQ  224(SP), R12
+
+	// Fill all regs
+	MOVQ  24(R11), DX
+	MULXQ 8(R12), SI, DI
+	MULXQ 24(R12), R8, R9
+	MOVQ  (R11), DX
+	MULXQ (R12), AX, CX
+	MULXQ 16(R12), BX, BP
+	XORQ  R10, R10
+
+	// First 1-5 chain
+	// x[0]*y[1]
+	MULXQ 8(R12), R13, DX
+	ADCXQ R13, CX
+	ADOXQ DX, BX
+
+	// x[2]*y[0]
+	MOVQ  16(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[2]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[2]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, SI
+	ADOXQ R13, DI
+
+	// x[2]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, DI
+	ADOXQ DX, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// Second 1-5 chain
+	// x[1]*y[0]
+	MOVQ  8(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, CX
+	ADOXQ R13, BX
+
+	// x[1]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[1]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[1]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, SI
+	ADOXQ DX, DI
+
+	// x[3]*y[2]
+	MOVQ  24(R11), DX
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, DI
+	ADOXQ R13, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[3]*y[0]
+	MULXQ (R12), R13, DX
+	ADCXQ R13, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[0]*y[3]
+	MOVQ  (R11), DX
+	MULXQ 24(R12), R11, DX
+	ADCXQ R11, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+	MOVQ  R9, DX
+	SHRQ  $0x3b, DX
+	SHLQ  $0x05, R8, R9
+	SHLQ  $0x05, DI, R8
+	SHLQ  $0x05, SI, DI
+	SHLQ  $0x05, BP, SI
+	MOVQ  $0x07ffffffffffffff, R11
+	ANDQ  R11, BP
+	XORQ  R10, R10
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x03, R9, DX
+	SHLQ  $0x03, R8, R9
+	SHLQ  $0x03, DI, R8
+	SHLQ  $0x03, SI, DI
+	SHLQ  $0x03, SI
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x05, BP, R10
+	ANDQ  R11, BP
+	LEAQ  (R10)(R10*8), R10
+	ADDQ  R10, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	MOVQ  res+0(FP), DX
+	LEAQ  96(DX), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	RET
+
+// func projectiveAddCached(res *ProjectivePoint, p1 *Point, p2 *CachedPoint)
+// Requires: ADX, BMI2
+TEXT ·projectiveAddCached(SB), NOSPLIT, $256-24
+	MOVQ p1+8(FP), R11
+	LEAQ 96(R11), R11
+	MOVQ p2+16(FP), R12
+	LEAQ 96(R12), R12
+
+	// Fill all regs
+	MOVQ  24(R11), DX
+	MULXQ 8(R12), SI, DI
+	MULXQ 24(R12), R8, R9
+	MOVQ  (R11), DX
+	MULXQ (R12), AX, CX
+	MULXQ 16(R12), BX, BP
+	XORQ  R10, R10
+
+	// First 1-5 chain
+	// x[0]*y[1]
+	MULXQ 8(R12), R13, DX
+	ADCXQ R13, CX
+	ADOXQ DX, BX
+
+	// x[2]*y[0]
+	MOVQ  16(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[2]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[2]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, SI
+	ADOXQ R13, DI
+
+	// x[2]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, DI
+	ADOXQ DX, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// Second 1-5 chain
+	// x[1]*y[0]
+	MOVQ  8(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, CX
+	ADOXQ R13, BX
+
+	// x[1]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[1]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[1]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, SI
+	ADOXQ DX, DI
+
+	// x[3]*y[2]
+	MOVQ  24(R11), DX
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, DI
+	ADOXQ R13, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[3]*y[0]
+	MULXQ (R12), R13, DX
+	ADCXQ R13, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[0]*y[3]
+	MOVQ  (R11), DX
+	MULXQ 24(R12), R11, DX
+	ADCXQ R11, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+	MOVQ  R9, DX
+	SHRQ  $0x3b, DX
+	SHLQ  $0x05, R8, R9
+	SHLQ  $0x05, DI, R8
+	SHLQ  $0x05, SI, DI
+	SHLQ  $0x05, BP, SI
+	MOVQ  $0x07ffffffffffffff, R11
+	ANDQ  R11, BP
+	XORQ  R10, R10
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x03, R9, DX
+	SHLQ  $0x03, R8, R9
+	SHLQ  $0x03, DI, R8
+	SHLQ  $0x03, SI, DI
+	SHLQ  $0x03, SI
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x05, BP, R10
+	ANDQ  R11, BP
+	LEAQ  (R10)(R10*8), R10
+	ADDQ  R10, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	LEAQ  (SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	MOVQ  p1+8(FP), BP
+	LEAQ  32(BP), BP
+	MOVQ  p1+8(FP), DX
+	MOVQ  (BP), AX
+	MOVQ  8(BP), CX
+	MOVQ  16(BP), BX
+	MOVQ  24(BP), BP
+	ADDQ  (DX), AX
+	ADCQ  8(DX), CX
+	ADCQ  16(DX), BX
+	ADCQ  24(DX), BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	ADDQ  DX, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	ADDQ  DX, AX
+	LEAQ  32(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  32(SP), R11
+	MOVQ  p2+16(FP), R12
+
+	// Fill all regs
+	MOVQ  24(R11), DX
+	MULXQ 8(R12), SI, DI
+	MULXQ 24(R12), R8, R9
+	MOVQ  (R11), DX
+	MULXQ (R12), AX, CX
+	MULXQ 16(R12), BX, BP
+	XORQ  R10, R10
+
+	// First 1-5 chain
+	// x[0]*y[1]
+	MULXQ 8(R12), R13, DX
+	ADCXQ R13, CX
+	ADOXQ DX, BX
+
+	// x[2]*y[0]
+	MOVQ  16(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[2]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[2]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, SI
+	ADOXQ R13, DI
+
+	// x[2]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, DI
+	ADOXQ DX, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// Second 1-5 chain
+	// x[1]*y[0]
+	MOVQ  8(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, CX
+	ADOXQ R13, BX
+
+	// x[1]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[1]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[1]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, SI
+	ADOXQ DX, DI
+
+	// x[3]*y[2]
+	MOVQ  24(R11), DX
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, DI
+	ADOXQ R13, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[3]*y[0]
+	MULXQ (R12), R13, DX
+	ADCXQ R13, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[0]*y[3]
+	MOVQ  (R11), DX
+	MULXQ 24(R12), R11, DX
+	ADCXQ R11, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+	MOVQ  R9, DX
+	SHRQ  $0x3b, DX
+	SHLQ  $0x05, R8, R9
+	SHLQ  $0x05, DI, R8
+	SHLQ  $0x05, SI, DI
+	SHLQ  $0x05, BP, SI
+	MOVQ  $0x07ffffffffffffff, R11
+	ANDQ  R11, BP
+	XORQ  R10, R10
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x03, R9, DX
+	SHLQ  $0x03, R8, R9
+	SHLQ  $0x03, DI, R8
+	SHLQ  $0x03, SI, DI
+	SHLQ  $0x03, SI
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x05, BP, R10
+	ANDQ  R11, BP
+	LEAQ  (R10)(R10*8), R10
+	ADDQ  R10, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	LEAQ  32(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	MOVQ  p1+8(FP), BP
+	LEAQ  32(BP), BP
+	MOVQ  p1+8(FP), DX
+	MOVQ  (BP), AX
+	MOVQ  8(BP), CX
+	MOVQ  16(BP), BX
+	MOVQ  24(BP), BP
+	SUBQ  (DX), AX
+	SBBQ  8(DX), CX
+	SBBQ  16(DX), BX
+	SBBQ  24(DX), BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	SBBQ  $0x00, CX
+	SBBQ  $0x00, BX
+	SBBQ  $0x00, BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	LEAQ  64(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  64(SP), R11
+	MOVQ  p2+16(FP), R12
+	LEAQ  32(R12), R12
+
+	// Fill all regs
+	MOVQ  24(R11), DX
+	MULXQ 8(R12), SI, DI
+	MULXQ 24(R12), R8, R9
+	MOVQ  (R11), DX
+	MULXQ (R12), AX, CX
+	MULXQ 16(R12), BX, BP
+	XORQ  R10, R10
+
+	// First 1-5 chain
+	// x[0]*y[1]
+	MULXQ 8(R12), R13, DX
+	ADCXQ R13, CX
+	ADOXQ DX, BX
+
+	// x[2]*y[0]
+	MOVQ  16(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[2]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[2]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, SI
+	ADOXQ R13, DI
+
+	// x[2]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, DI
+	ADOXQ DX, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// Second 1-5 chain
+	// x[1]*y[0]
+	MOVQ  8(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, CX
+	ADOXQ R13, BX
+
+	// x[1]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[1]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[1]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, SI
+	ADOXQ DX, DI
+
+	// x[3]*y[2]
+	MOVQ  24(R11), DX
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, DI
+	ADOXQ R13, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[3]*y[0]
+	MULXQ (R12), R13, DX
+	ADCXQ R13, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[0]*y[3]
+	MOVQ  (R11), DX
+	MULXQ 24(R12), R11, DX
+	ADCXQ R11, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+	MOVQ  R9, DX
+	SHRQ  $0x3b, DX
+	SHLQ  $0x05, R8, R9
+	SHLQ  $0x05, DI, R8
+	SHLQ  $0x05, SI, DI
+	SHLQ  $0x05, BP, SI
+	MOVQ  $0x07ffffffffffffff, R11
+	ANDQ  R11, BP
+	XORQ  R10, R10
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x03, R9, DX
+	SHLQ  $0x03, R8, R9
+	SHLQ  $0x03, DI, R8
+	SHLQ  $0x03, SI, DI
+	SHLQ  $0x03, SI
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x05, BP, R10
+	ANDQ  R11, BP
+	LEAQ  (R10)(R10*8), R10
+	ADDQ  R10, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	LEAQ  64(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	MOVQ  p2+16(FP), BP
+	MOVQ  p2+16(FP), DX
+	LEAQ  32(DX), DX
+	MOVQ  (BP), AX
+	MOVQ  8(BP), CX
+	MOVQ  16(BP), BX
+	MOVQ  24(BP), BP
+	SUBQ  (DX), AX
+	SBBQ  8(DX), CX
+	SBBQ  16(DX), BX
+	SBBQ  24(DX), BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	SBBQ  $0x00, CX
+	SBBQ  $0x00, BX
+	SBBQ  $0x00, BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	LEAQ  96(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  96(SP), R11
+	MOVQ  p1+8(FP), R12
+
+	// Fill all regs
+	MOVQ  24(R11), DX
+	MULXQ 8(R12), SI, DI
+	MULXQ 24(R12), R8, R9
+	MOVQ  (R11), DX
+	MULXQ (R12), AX, CX
+	MULXQ 16(R12), BX, BP
+	XORQ  R10, R10
+
+	// First 1-5 chain
+	// x[0]*y[1]
+	MULXQ 8(R12), R13, DX
+	ADCXQ R13, CX
+	ADOXQ DX, BX
+
+	// x[2]*y[0]
+	MOVQ  16(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[2]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[2]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, SI
+	ADOXQ R13, DI
+
+	// x[2]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, DI
+	ADOXQ DX, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// Second 1-5 chain
+	// x[1]*y[0]
+	MOVQ  8(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, CX
+	ADOXQ R13, BX
+
+	// x[1]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[1]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[1]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, SI
+	ADOXQ DX, DI
+
+	// x[3]*y[2]
+	MOVQ  24(R11), DX
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, DI
+	ADOXQ R13, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[3]*y[0]
+	MULXQ (R12), R13, DX
+	ADCXQ R13, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[0]*y[3]
+	MOVQ  (R11), DX
+	MULXQ 24(R12), R11, DX
+	ADCXQ R11, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+	MOVQ  R9, DX
+	SHRQ  $0x3b, DX
+	SHLQ  $0x05, R8, R9
+	SHLQ  $0x05, DI, R8
+	SHLQ  $0x05, SI, DI
+	SHLQ  $0x05, BP, SI
+	MOVQ  $0x07ffffffffffffff, R11
+	ANDQ  R11, BP
+	XORQ  R10, R10
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x03, R9, DX
+	SHLQ  $0x03, R8, R9
+	SHLQ  $0x03, DI, R8
+	SHLQ  $0x03, SI, DI
+	SHLQ  $0x03, SI
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x05, BP, R10
+	ANDQ  R11, BP
+	LEAQ  (R10)(R10*8), R10
+	ADDQ  R10, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	LEAQ  96(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	MOVQ  p1+8(FP), R11
+	LEAQ  64(R11), R11
+	MOVQ  p2+16(FP), R12
+	LEAQ  64(R12), R12
+
+	// Fill all regs
+	MOVQ  24(R11), DX
+	MULXQ 8(R12), SI, DI
+	MULXQ 24(R12), R8, R9
+	MOVQ  (R11), DX
+	MULXQ (R12), AX, CX
+	MULXQ 16(R12), BX, BP
+	XORQ  R10, R10
+
+	// First 1-5 chain
+	// x[0]*y[1]
+	MULXQ 8(R12), R13, DX
+	ADCXQ R13, CX
+	ADOXQ DX, BX
+
+	// x[2]*y[0]
+	MOVQ  16(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[2]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[2]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, SI
+	ADOXQ R13, DI
+
+	// x[2]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, DI
+	ADOXQ DX, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// Second 1-5 chain
+	// x[1]*y[0]
+	MOVQ  8(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, CX
+	ADOXQ R13, BX
+
+	// x[1]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[1]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[1]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, SI
+	ADOXQ DX, DI
+
+	// x[3]*y[2]
+	MOVQ  24(R11), DX
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, DI
+	ADOXQ R13, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[3]*y[0]
+	MULXQ (R12), R13, DX
+	ADCXQ R13, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[0]*y[3]
+	MOVQ  (R11), DX
+	MULXQ 24(R12), R11, DX
+	ADCXQ R11, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+	MOVQ  R9, DX
+	SHRQ  $0x3b, DX
+	SHLQ  $0x05, R8, R9
+	SHLQ  $0x05, DI, R8
+	SHLQ  $0x05, SI, DI
+	SHLQ  $0x05, BP, SI
+	MOVQ  $0x07ffffffffffffff, R11
+	ANDQ  R11, BP
+	XORQ  R10, R10
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x03, R9, DX
+	SHLQ  $0x03, R8, R9
+	SHLQ  $0x03, DI, R8
+	SHLQ  $0x03, SI, DI
+	SHLQ  $0x03, SI
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x05, BP, R10
+	ANDQ  R11, BP
+	LEAQ  (R10)(R10*8), R10
+	ADDQ  R10, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	LEAQ  128(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  32(SP), BP
+	LEAQ  64(SP), DX
+	MOVQ  (BP), AX
+	MOVQ  8(BP), CX
+	MOVQ  16(BP), BX
+	MOVQ  24(BP), BP
+	SUBQ  (DX), AX
+	SBBQ  8(DX), CX
+	SBBQ  16(DX), BX
+	SBBQ  24(DX), BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	SBBQ  $0x00, CX
+	SBBQ  $0x00, BX
+	SBBQ  $0x00, BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	LEAQ  160(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  (SP), BP
+	LEAQ  128(SP), DX
+	MOVQ  (BP), AX
+	MOVQ  8(BP), CX
+	MOVQ  16(BP), BX
+	MOVQ  24(BP), BP
+	SUBQ  (DX), AX
+	SBBQ  8(DX), CX
+	SBBQ  16(DX), BX
+	SBBQ  24(DX), BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	SBBQ  $0x00, CX
+	SBBQ  $0x00, BX
+	SBBQ  $0x00, BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	LEAQ  192(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  (SP), BP
+	LEAQ  128(SP), DX
+	MOVQ  (BP), AX
+	MOVQ  8(BP), CX
+	MOVQ  16(BP), BX
+	MOVQ  24(BP), BP
+	ADDQ  (DX), AX
+	ADCQ  8(DX), CX
+	ADCQ  16(DX), BX
+	ADCQ  24(DX), BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	ADDQ  DX, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	ADDQ  DX, AX
+	LEAQ  224(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  32(SP), BP
+	LEAQ  64(SP), DX
+	MOVQ  (BP), AX
+	MOVQ  8(BP), CX
+	MOVQ  16(BP), BX
+	MOVQ  24(BP), BP
+	ADDQ  (DX), AX
+	ADCQ  8(DX), CX
+	ADCQ  16(DX), BX
+	ADCQ  24(DX), BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	ADDQ  DX, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	ADDQ  DX, AX
+	LEAQ  (SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  96(SP), DX
+	SUBQ  (DX), AX
+	SBBQ  8(DX), CX
+	SBBQ  16(DX), BX
+	SBBQ  24(DX), BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	SBBQ  $0x00, CX
+	SBBQ  $0x00, BX
+	SBBQ  $0x00, BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	LEAQ  (SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  96(SP), DX
+	SUBQ  (DX), AX
+	SBBQ  8(DX), CX
+	SBBQ  16(DX), BX
+	SBBQ  24(DX), BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	SBBQ  $0x00, CX
+	SBBQ  $0x00, BX
+	SBBQ  $0x00, BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	LEAQ  (SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  160(SP), R11
+	LEAQ  192(SP), R12
+
+	// Fill all regs
+	MOVQ  24(R11), DX
+	MULXQ 8(R12), SI, DI
+	MULXQ 24(R12), R8, R9
+	MOVQ  (R11), DX
+	MULXQ (R12), AX, CX
+	MULXQ 16(R12), BX, BP
+	XORQ  R10, R10
+
+	// First 1-5 chain
+	// x[0]*y[1]
+	MULXQ 8(R12), R13, DX
+	ADCXQ R13, CX
+	ADOXQ DX, BX
+
+	// x[2]*y[0]
+	MOVQ  16(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[2]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[2]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, SI
+	ADOXQ R13, DI
+
+	// x[2]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, DI
+	ADOXQ DX, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// Second 1-5 chain
+	// x[1]*y[0]
+	MOVQ  8(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, CX
+	ADOXQ R13, BX
+
+	// x[1]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[1]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[1]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, SI
+	ADOXQ DX, DI
+
+	// x[3]*y[2]
+	MOVQ  24(R11), DX
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, DI
+	ADOXQ R13, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[3]*y[0]
+	MULXQ (R12), R13, DX
+	ADCXQ R13, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[0]*y[3]
+	MOVQ  (R11), DX
+	MULXQ 24(R12), R11, DX
+	ADCXQ R11, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+	MOVQ  R9, DX
+	SHRQ  $0x3b, DX
+	SHLQ  $0x05, R8, R9
+	SHLQ  $0x05, DI, R8
+	SHLQ  $0x05, SI, DI
+	SHLQ  $0x05, BP, SI
+	MOVQ  $0x07ffffffffffffff, R11
+	ANDQ  R11, BP
+	XORQ  R10, R10
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x03, R9, DX
+	SHLQ  $0x03, R8, R9
+	SHLQ  $0x03, DI, R8
+	SHLQ  $0x03, SI, DI
+	SHLQ  $0x03, SI
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x05, BP, R10
+	ANDQ  R11, BP
+	LEAQ  (R10)(R10*8), R10
+	ADDQ  R10, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	MOVQ  res+0(FP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  224(SP), R11
+	LEAQ  (SP), R12
+
+	// Fill all regs
+	MOVQ  24(R11), DX
+	MULXQ 8(R12), SI, DI
+	MULXQ 24(R12), R8, R9
+	MOVQ  (R11), DX
+	MULXQ (R12), AX, CX
+	MULXQ 16(R12), BX, BP
+	XORQ  R10, R10
+
+	// First 1-5 chain
+	// x[0]*y[1]
+	MULXQ 8(R12), R13, DX
+	ADCXQ R13, CX
+	ADOXQ DX, BX
+
+	// x[2]*y[0]
+	MOVQ  16(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[2]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[2]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, SI
+	ADOXQ R13, DI
+
+	// x[2]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, DI
+	ADOXQ DX, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// Second 1-5 chain
+	// x[1]*y[0]
+	MOVQ  8(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, CX
+	ADOXQ R13, BX
+
+	// x[1]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[1]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[1]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, SI
+	ADOXQ DX, DI
+
+	// x[3]*y[2]
+	MOVQ  24(R11), DX
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, DI
+	ADOXQ R13, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[3]*y[0]
+	MULXQ (R12), R13, DX
+	ADCXQ R13, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[0]*y[3]
+	MOVQ  (R11), DX
+	MULXQ 24(R12), R11, DX
+	ADCXQ R11, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+	MOVQ  R9, DX
+	SHRQ  $0x3b, DX
+	SHLQ  $0x05, R8, R9
+	SHLQ  $0x05, DI, R8
+	SHLQ  $0x05, SI, DI
+	SHLQ  $0x05, BP, SI
+	MOVQ  $0x07ffffffffffffff, R11
+	ANDQ  R11, BP
+	XORQ  R10, R10
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x03, R9, DX
+	SHLQ  $0x03, R8, R9
+	SHLQ  $0x03, DI, R8
+	SHLQ  $0x03, SI, DI
+	SHLQ  $0x03, SI
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x05, BP, R10
+	ANDQ  R11, BP
+	LEAQ  (R10)(R10*8), R10
+	ADDQ  R10, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	MOVQ  res+0(FP), DX
+	LEAQ  32(DX), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  192(SP), R11
+	LEAQ  224(SP), R12
+
+	// Fill all regs
+	MOVQ  24(R11), DX
+	MULXQ 8(R12), SI, DI
+	MULXQ 24(R12), R8, R9
+	MOVQ  (R11), DX
+	MULXQ (R12), AX, CX
+	MULXQ 16(R12), BX, BP
+	XORQ  R10, R10
+
+	// First 1-5 chain
+	// x[0]*y[1]
+	MULXQ 8(R12), R13, DX
+	ADCXQ R13, CX
+	ADOXQ DX, BX
+
+	// x[2]*y[0]
+	MOVQ  16(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[2]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[2]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, SI
+	ADOXQ R13, DI
+
+	// x[2]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, DI
+	ADOXQ DX, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// Second 1-5 chain
+	// x[1]*y[0]
+	MOVQ  8(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, CX
+	ADOXQ R13, BX
+
+	// x[1]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[1]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[1]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, SI
+	ADOXQ DX, DI
+
+	// x[3]*y[2]
+	MOVQ  24(R11), DX
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, DI
+	ADOXQ R13, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[3]*y[0]
+	MULXQ (R12), R13, DX
+	ADCXQ R13, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[0]*y[3]
+	MOVQ  (R11), DX
+	MULXQ 24(R12), R11, DX
+	ADCXQ R11, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+	MOVQ  R9, DX
+	SHRQ  $0x3b, DX
+	SHLQ  $0x05, R8, R9
+	SHLQ  $0x05, DI, R8
+	SHLQ  $0x05, SI, DI
+	SHLQ  $0x05, BP, SI
+	MOVQ  $0x07ffffffffffffff, R11
+	ANDQ  R11, BP
+	XORQ  R10, R10
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x03, R9, DX
+	SHLQ  $0x03, R8, R9
+	SHLQ  $0x03, DI, R8
+	SHLQ  $0x03, SI, DI
+	SHLQ  $0x03, SI
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x05, BP, R10
+	ANDQ  R11, BP
+	LEAQ  (R10)(R10*8), R10
+	ADDQ  R10, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	MOVQ  res+0(FP), DX
+	LEAQ  64(DX), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	RET
+
+// func pointAddAffineCached(res *Point, p1 *Point, p2 *AffineCachedPoint)
+// Requires: ADX, BMI2
+TEXT ·pointAddAffineCached(SB), NOSPLIT, $256-24
+	MOVQ p1+8(FP), DX
+	LEAQ 96(DX), DX
+	MOVQ (DX), AX
+	MOVQ 8(DX), CX
+	MOVQ 16(DX), BX
+	MOVQ 24(DX), BP
+	ADDQ AX, AX
+	ADCQ CX, CX
+	ADCQ BX, BX
+	ADCQ BP, BP
+	SBBQ DX, DX
+	ANDL $0x00000120, DX
+	ADDQ DX, AX
+	ADCQ $0x00, CX
+	ADCQ $0x00, BX
+	ADCQ $0x00, BP
+	SBBQ DX, DX
+	ANDL $0x00000120, DX
+	ADDQ DX, AX
+	LEAQ (SP), DX
+	MOVQ AX, (DX)
+	MOVQ CX, 8(DX)
+	MOVQ BX, 16(DX)
+	MOVQ BP, 24(DX)
+	MOVQ p1+8(FP), BP
+	LEAQ 32(BP), BP
+	MOVQ p1+8(FP), DX
+	MOVQ (BP), AX
+	MOVQ 8(BP), CX
+	MOVQ 16(BP), BX
+	MOVQ 24(BP), BP
+	ADDQ (DX), AX
+	ADCQ 8(DX), CX
+	ADCQ 16(DX), BX
+	ADCQ 24(DX), BP
+	SBBQ DX, DX
+	ANDL $0x00000120, DX
+	ADDQ DX, AX
+	ADCQ $0x00, CX
+	ADCQ $0x00, BX
+	ADCQ $0x00, BP
+	SBBQ DX, DX
+	ANDL $0x00000120, DX
+	ADDQ DX, AX
+	LEAQ 32(SP), DX
+	MOVQ AX, (DX)
+	MOVQ CX, 8(DX)
+	MOVQ BX, 16(DX)
+	MOVQ BP, 24(DX)
+	LEAQ 32(SP), R11
+	MOVQ p2+16(FP), R12
+
+	// Fill all regs
+	MOVQ  24(R11), DX
+	MULXQ 8(R12), SI, DI
+	MULXQ 24(R12), R8, R9
+	MOVQ  (R11), DX
+	MULXQ (R12), AX, CX
+	MULXQ 16(R12), BX, BP
+	XORQ  R10, R10
+
+	// First 1-5 chain
+	// x[0]*y[1]
+	MULXQ 8(R12), R13, DX
+	ADCXQ R13, CX
+	ADOXQ DX, BX
+
+	// x[2]*y[0]
+	MOVQ  16(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[2]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[2]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, SI
+	ADOXQ R13, DI
+
+	// x[2]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, DI
+	ADOXQ DX, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// Second 1-5 chain
+	// x[1]*y[0]
+	MOVQ  8(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, CX
+	ADOXQ R13, BX
+
+	// x[1]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[1]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[1]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, SI
+	ADOXQ DX, DI
+
+	// x[3]*y[2]
+	MOVQ  24(R11), DX
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, DI
+	ADOXQ R13, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[3]*y[0]
+	MULXQ (R12), R13, DX
+	ADCXQ R13, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[0]*y[3]
+	MOVQ  (R11), DX
+	MULXQ 24(R12), R11, DX
+	ADCXQ R11, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+	MOVQ  R9, DX
+	SHRQ  $0x3b, DX
+	SHLQ  $0x05, R8, R9
+	SHLQ  $0x05, DI, R8
+	SHLQ  $0x05, SI, DI
+	SHLQ  $0x05, BP, SI
+	MOVQ  $0x07ffffffffffffff, R11
+	ANDQ  R11, BP
+	XORQ  R10, R10
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x03, R9, DX
+	SHLQ  $0x03, R8, R9
+	SHLQ  $0x03, DI, R8
+	SHLQ  $0x03, SI, DI
+	SHLQ  $0x03, SI
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x05, BP, R10
+	ANDQ  R11, BP
+	LEAQ  (R10)(R10*8), R10
+	ADDQ  R10, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	LEAQ  32(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	MOVQ  p1+8(FP), BP
+	LEAQ  32(BP), BP
+	MOVQ  p1+8(FP), DX
+	MOVQ  (BP), AX
+	MOVQ  8(BP), CX
+	MOVQ  16(BP), BX
+	MOVQ  24(BP), BP
+	SUBQ  (DX), AX
+	SBBQ  8(DX), CX
+	SBBQ  16(DX), BX
+	SBBQ  24(DX), BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	SBBQ  $0x00, CX
+	SBBQ  $0x00, BX
+	SBBQ  $0x00, BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	LEAQ  64(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  64(SP), R11
+	MOVQ  p2+16(FP), R12
+	LEAQ  32(R12), R12
+
+	// Fill all regs
+	MOVQ  24(R11), DX
+	MULXQ 8(R12), SI, DI
+	MULXQ 24(R12), R8, R9
+	MOVQ  (R11), DX
+	MULXQ (R12), AX, CX
+	MULXQ 16(R12), BX, BP
+	XORQ  R10, R10
+
+	// First 1-5 chain
+	// x[0]*y[1]
+	MULXQ 8(R12), R13, DX
+	ADCXQ R13, CX
+	ADOXQ DX, BX
+
+	// x[2]*y[0]
+	MOVQ  16(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[2]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[2]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, SI
+	ADOXQ R13, DI
+
+	// x[2]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, DI
+	ADOXQ DX, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// Second 1-5 chain
+	// x[1]*y[0]
+	MOVQ  8(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, CX
+	ADOXQ R13, BX
+
+	// x[1]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[1]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[1]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, SI
+	ADOXQ DX, DI
+
+	// x[3]*y[2]
+	MOVQ  24(R11), DX
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, DI
+	ADOXQ R13, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[3]*y[0]
+	MULXQ (R12), R13, DX
+	ADCXQ R13, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[0]*y[3]
+	MOVQ  (R11), DX
+	MULXQ 24(R12), R11, DX
+	ADCXQ R11, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+	MOVQ  R9, DX
+	SHRQ  $0x3b, DX
+	SHLQ  $0x05, R8, R9
+	SHLQ  $0x05, DI, R8
+	SHLQ  $0x05, SI, DI
+	SHLQ  $0x05, BP, SI
+	MOVQ  $0x07ffffffffffffff, R11
+	ANDQ  R11, BP
+	XORQ  R10, R10
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x03, R9, DX
+	SHLQ  $0x03, R8, R9
+	SHLQ  $0x03, DI, R8
+	SHLQ  $0x03, SI, DI
+	SHLQ  $0x03, SI
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x05, BP, R10
+	ANDQ  R11, BP
+	LEAQ  (R10)(R10*8), R10
+	ADDQ  R10, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	LEAQ  64(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	MOVQ  p2+16(FP), BP
+	MOVQ  p2+16(FP), DX
+	LEAQ  32(DX), DX
+	MOVQ  (BP), AX
+	MOVQ  8(BP), CX
+	MOVQ  16(BP), BX
+	MOVQ  24(BP), BP
+	SUBQ  (DX), AX
+	SBBQ  8(DX), CX
+	SBBQ  16(DX), BX
+	SBBQ  24(DX), BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	SBBQ  $0x00, CX
+	SBBQ  $0x00, BX
+	SBBQ  $0x00, BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	LEAQ  96(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  96(SP), R11
+	MOVQ  p1+8(FP), R12
+
+	// Fill all regs
+	MOVQ  24(R11), DX
+	MULXQ 8(R12), SI, DI
+	MULXQ 24(R12), R8, R9
+	MOVQ  (R11), DX
+	MULXQ (R12), AX, CX
+	MULXQ 16(R12), BX, BP
+	XORQ  R10, R10
+
+	// First 1-5 chain
+	// x[0]*y[1]
+	MULXQ 8(R12), R13, DX
+	ADCXQ R13, CX
+	ADOXQ DX, BX
+
+	// x[2]*y[0]
+	MOVQ  16(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[2]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[2]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, SI
+	ADOXQ R13, DI
+
+	// x[2]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, DI
+	ADOXQ DX, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// Second 1-5 chain
+	// x[1]*y[0]
+	MOVQ  8(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, CX
+	ADOXQ R13, BX
+
+	// x[1]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[1]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[1]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, SI
+	ADOXQ DX, DI
+
+	// x[3]*y[2]
+	MOVQ  24(R11), DX
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, DI
+	ADOXQ R13, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[3]*y[0]
+	MULXQ (R12), R13, DX
+	ADCXQ R13, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[0]*y[3]
+	MOVQ  (R11), DX
+	MULXQ 24(R12), R11, DX
+	ADCXQ R11, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+	MOVQ  R9, DX
+	SHRQ  $0x3b, DX
+	SHLQ  $0x05, R8, R9
+	SHLQ  $0x05, DI, R8
+	SHLQ  $0x05, SI, DI
+	SHLQ  $0x05, BP, SI
+	MOVQ  $0x07ffffffffffffff, R11
+	ANDQ  R11, BP
+	XORQ  R10, R10
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x03, R9, DX
+	SHLQ  $0x03, R8, R9
+	SHLQ  $0x03, DI, R8
+	SHLQ  $0x03, SI, DI
+	SHLQ  $0x03, SI
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x05, BP, R10
+	ANDQ  R11, BP
+	LEAQ  (R10)(R10*8), R10
+	ADDQ  R10, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	LEAQ  96(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	MOVQ  p1+8(FP), R11
+	LEAQ  64(R11), R11
+	MOVQ  p2+16(FP), R12
+	LEAQ  64(R12), R12
+
+	// Fill all regs
+	MOVQ  24(R11), DX
+	MULXQ 8(R12), SI, DI
+	MULXQ 24(R12), R8, R9
+	MOVQ  (R11), DX
+	MULXQ (R12), AX, CX
+	MULXQ 16(R12), BX, BP
+	XORQ  R10, R10
+
+	// First 1-5 chain
+	// x[0]*y[1]
+	MULXQ 8(R12), R13, DX
+	ADCXQ R13, CX
+	ADOXQ DX, BX
+
+	// x[2]*y[0]
+	MOVQ  16(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[2]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[2]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, SI
+	ADOXQ R13, DI
+
+	// x[2]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, DI
+	ADOXQ DX, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// Second 1-5 chain
+	// x[1]*y[0]
+	MOVQ  8(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, CX
+	ADOXQ R13, BX
+
+	// x[1]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[1]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[1]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, SI
+	ADOXQ DX, DI
+
+	// x[3]*y[2]
+	MOVQ  24(R11), DX
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, DI
+	ADOXQ R13, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[3]*y[0]
+	MULXQ (R12), R13, DX
+	ADCXQ R13, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[0]*y[3]
+	MOVQ  (R11), DX
+	MULXQ 24(R12), R11, DX
+	ADCXQ R11, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+	MOVQ  R9, DX
+	SHRQ  $0x3b, DX
+	SHLQ  $0x05, R8, R9
+	SHLQ  $0x05, DI, R8
+	SHLQ  $0x05, SI, DI
+	SHLQ  $0x05, BP, SI
+	MOVQ  $0x07ffffffffffffff, R11
+	ANDQ  R11, BP
+	XORQ  R10, R10
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x03, R9, DX
+	SHLQ  $0x03, R8, R9
+	SHLQ  $0x03, DI, R8
+	SHLQ  $0x03, SI, DI
+	SHLQ  $0x03, SI
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x05, BP, R10
+	ANDQ  R11, BP
+	LEAQ  (R10)(R10*8), R10
+	ADDQ  R10, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	LEAQ  128(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  32(SP), BP
+	LEAQ  64(SP), DX
+	MOVQ  (BP), AX
+	MOVQ  8(BP), CX
+	MOVQ  16(BP), BX
+	MOVQ  24(BP), BP
+	SUBQ  (DX), AX
+	SBBQ  8(DX), CX
+	SBBQ  16(DX), BX
+	SBBQ  24(DX), BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	SBBQ  $0x00, CX
+	SBBQ  $0x00, BX
+	SBBQ  $0x00, BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	LEAQ  160(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  (SP), BP
+	LEAQ  128(SP), DX
+	MOVQ  (BP), AX
+	MOVQ  8(BP), CX
+	MOVQ  16(BP), BX
+	MOVQ  24(BP), BP
+	SUBQ  (DX), AX
+	SBBQ  8(DX), CX
+	SBBQ  16(DX), BX
+	SBBQ  24(DX), BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	SBBQ  $0x00, CX
+	SBBQ  $0x00, BX
+	SBBQ  $0x00, BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	LEAQ  192(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  (SP), BP
+	LEAQ  128(SP), DX
+	MOVQ  (BP), AX
+	MOVQ  8(BP), CX
+	MOVQ  16(BP), BX
+	MOVQ  24(BP), BP
+	ADDQ  (DX), AX
+	ADCQ  8(DX), CX
+	ADCQ  16(DX), BX
+	ADCQ  24(DX), BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	ADDQ  DX, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	ADDQ  DX, AX
+	LEAQ  224(SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  32(SP), BP
+	LEAQ  64(SP), DX
+	MOVQ  (BP), AX
+	MOVQ  8(BP), CX
+	MOVQ  16(BP), BX
+	MOVQ  24(BP), BP
+	ADDQ  (DX), AX
+	ADCQ  8(DX), CX
+	ADCQ  16(DX), BX
+	ADCQ  24(DX), BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	ADDQ  DX, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	ADDQ  DX, AX
+	LEAQ  (SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  96(SP), DX
+	SUBQ  (DX), AX
+	SBBQ  8(DX), CX
+	SBBQ  16(DX), BX
+	SBBQ  24(DX), BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	SBBQ  $0x00, CX
+	SBBQ  $0x00, BX
+	SBBQ  $0x00, BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	LEAQ  (SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  96(SP), DX
+	SUBQ  (DX), AX
+	SBBQ  8(DX), CX
+	SBBQ  16(DX), BX
+	SBBQ  24(DX), BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	SBBQ  $0x00, CX
+	SBBQ  $0x00, BX
+	SBBQ  $0x00, BP
+	SBBQ  DX, DX
+	ANDL  $0x00000120, DX
+	SUBQ  DX, AX
+	LEAQ  (SP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  160(SP), R11
+	LEAQ  192(SP), R12
+
+	// Fill all regs
+	MOVQ  24(R11), DX
+	MULXQ 8(R12), SI, DI
+	MULXQ 24(R12), R8, R9
+	MOVQ  (R11), DX
+	MULXQ (R12), AX, CX
+	MULXQ 16(R12), BX, BP
+	XORQ  R10, R10
+
+	// First 1-5 chain
+	// x[0]*y[1]
+	MULXQ 8(R12), R13, DX
+	ADCXQ R13, CX
+	ADOXQ DX, BX
+
+	// x[2]*y[0]
+	MOVQ  16(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[2]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[2]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, SI
+	ADOXQ R13, DI
+
+	// x[2]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, DI
+	ADOXQ DX, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// Second 1-5 chain
+	// x[1]*y[0]
+	MOVQ  8(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, CX
+	ADOXQ R13, BX
+
+	// x[1]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[1]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[1]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, SI
+	ADOXQ DX, DI
+
+	// x[3]*y[2]
+	MOVQ  24(R11), DX
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, DI
+	ADOXQ R13, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[3]*y[0]
+	MULXQ (R12), R13, DX
+	ADCXQ R13, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[0]*y[3]
+	MOVQ  (R11), DX
+	MULXQ 24(R12), R11, DX
+	ADCXQ R11, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+	MOVQ  R9, DX
+	SHRQ  $0x3b, DX
+	SHLQ  $0x05, R8, R9
+	SHLQ  $0x05, DI, R8
+	SHLQ  $0x05, SI, DI
+	SHLQ  $0x05, BP, SI
+	MOVQ  $0x07ffffffffffffff, R11
+	ANDQ  R11, BP
+	XORQ  R10, R10
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x03, R9, DX
+	SHLQ  $0x03, R8, R9
+	SHLQ  $0x03, DI, R8
+	SHLQ  $0x03, SI, DI
+	SHLQ  $0x03, SI
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x05, BP, R10
+	ANDQ  R11, BP
+	LEAQ  (R10)(R10*8), R10
+	ADDQ  R10, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	MOVQ  res+0(FP), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  224(SP), R11
+	LEAQ  (SP), R12
+
+	// Fill all regs
+	MOVQ  24(R11), DX
+	MULXQ 8(R12), SI, DI
+	MULXQ 24(R12), R8, R9
+	MOVQ  (R11), DX
+	MULXQ (R12), AX, CX
+	MULXQ 16(R12), BX, BP
+	XORQ  R10, R10
+
+	// First 1-5 chain
+	// x[0]*y[1]
+	MULXQ 8(R12), R13, DX
+	ADCXQ R13, CX
+	ADOXQ DX, BX
+
+	// x[2]*y[0]
+	MOVQ  16(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[2]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[2]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, SI
+	ADOXQ R13, DI
+
+	// x[2]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, DI
+	ADOXQ DX, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// Second 1-5 chain
+	// x[1]*y[0]
+	MOVQ  8(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, CX
+	ADOXQ R13, BX
+
+	// x[1]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[1]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[1]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, SI
+	ADOXQ DX, DI
+
+	// x[3]*y[2]
+	MOVQ  24(R11), DX
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, DI
+	ADOXQ R13, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[3]*y[0]
+	MULXQ (R12), R13, DX
+	ADCXQ R13, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[0]*y[3]
+	MOVQ  (R11), DX
+	MULXQ 24(R12), R11, DX
+	ADCXQ R11, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+	MOVQ  R9, DX
+	SHRQ  $0x3b, DX
+	SHLQ  $0x05, R8, R9
+	SHLQ  $0x05, DI, R8
+	SHLQ  $0x05, SI, DI
+	SHLQ  $0x05, BP, SI
+	MOVQ  $0x07ffffffffffffff, R11
+	ANDQ  R11, BP
+	XORQ  R10, R10
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x03, R9, DX
+	SHLQ  $0x03, R8, R9
+	SHLQ  $0x03, DI, R8
+	SHLQ  $0x03, SI, DI
+	SHLQ  $0x03, SI
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x05, BP, R10
+	ANDQ  R11, BP
+	LEAQ  (R10)(R10*8), R10
+	ADDQ  R10, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	MOVQ  res+0(FP), DX
+	LEAQ  32(DX), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  160(SP), R11
+	LEAQ  (SP), R12
+
+	// Fill all regs
+	MOVQ  24(R11), DX
+	MULXQ 8(R12), SI, DI
+	MULXQ 24(R12), R8, R9
+	MOVQ  (R11), DX
+	MULXQ (R12), AX, CX
+	MULXQ 16(R12), BX, BP
+	XORQ  R10, R10
+
+	// First 1-5 chain
+	// x[0]*y[1]
+	MULXQ 8(R12), R13, DX
+	ADCXQ R13, CX
+	ADOXQ DX, BX
+
+	// x[2]*y[0]
+	MOVQ  16(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[2]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[2]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, SI
+	ADOXQ R13, DI
+
+	// x[2]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, DI
+	ADOXQ DX, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// Second 1-5 chain
+	// x[1]*y[0]
+	MOVQ  8(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, CX
+	ADOXQ R13, BX
+
+	// x[1]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[1]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[1]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, SI
+	ADOXQ DX, DI
+
+	// x[3]*y[2]
+	MOVQ  24(R11), DX
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, DI
+	ADOXQ R13, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[3]*y[0]
+	MULXQ (R12), R13, DX
+	ADCXQ R13, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[0]*y[3]
+	MOVQ  (R11), DX
+	MULXQ 24(R12), R11, DX
+	ADCXQ R11, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+	MOVQ  R9, DX
+	SHRQ  $0x3b, DX
+	SHLQ  $0x05, R8, R9
+	SHLQ  $0x05, DI, R8
+	SHLQ  $0x05, SI, DI
+	SHLQ  $0x05, BP, SI
+	MOVQ  $0x07ffffffffffffff, R11
+	ANDQ  R11, BP
+	XORQ  R10, R10
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x03, R9, DX
+	SHLQ  $0x03, R8, R9
+	SHLQ  $0x03, DI, R8
+	SHLQ  $0x03, SI, DI
+	SHLQ  $0x03, SI
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x05, BP, R10
+	ANDQ  R11, BP
+	LEAQ  (R10)(R10*8), R10
+	ADDQ  R10, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	MOVQ  res+0(FP), DX
+	LEAQ  64(DX), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	LEAQ  192(SP), R11
+	LEAQ  224(SP), R12
+
+	// Fill all regs
+	MOVQ  24(R11), DX
+	MULXQ 8(R12), SI, DI
+	MULXQ 24(R12), R8, R9
+	MOVQ  (R11), DX
+	MULXQ (R12), AX, CX
+	MULXQ 16(R12), BX, BP
+	XORQ  R10, R10
+
+	// First 1-5 chain
+	// x[0]*y[1]
+	MULXQ 8(R12), R13, DX
+	ADCXQ R13, CX
+	ADOXQ DX, BX
+
+	// x[2]*y[0]
+	MOVQ  16(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[2]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[2]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, SI
+	ADOXQ R13, DI
+
+	// x[2]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, DI
+	ADOXQ DX, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// Second 1-5 chain
+	// x[1]*y[0]
+	MOVQ  8(R11), DX
+	MULXQ (R12), R14, R13
+	ADCXQ R14, CX
+	ADOXQ R13, BX
+
+	// x[1]*y[1]
+	MULXQ 8(R12), R14, R13
+	ADCXQ R14, BX
+	ADOXQ R13, BP
+
+	// x[1]*y[2]
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, BP
+	ADOXQ R13, SI
+
+	// x[1]*y[3]
+	MULXQ 24(R12), R13, DX
+	ADCXQ R13, SI
+	ADOXQ DX, DI
+
+	// x[3]*y[2]
+	MOVQ  24(R11), DX
+	MULXQ 16(R12), R14, R13
+	ADCXQ R14, DI
+	ADOXQ R13, R8
+
+	// Carry 6-8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[3]*y[0]
+	MULXQ (R12), R13, DX
+	ADCXQ R13, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+
+	// x[0]*y[3]
+	MOVQ  (R11), DX
+	MULXQ 24(R12), R11, DX
+	ADCXQ R11, BP
+	ADOXQ DX, SI
+
+	// Carry 4-8
+	ADCXQ R10, SI
+	ADOXQ R10, DI
+	ADCXQ R10, DI
+	ADOXQ R10, R8
+	ADCXQ R10, R8
+	ADOXQ R10, R9
+	ADCXQ R10, R9
+	MOVQ  R9, DX
+	SHRQ  $0x3b, DX
+	SHLQ  $0x05, R8, R9
+	SHLQ  $0x05, DI, R8
+	SHLQ  $0x05, SI, DI
+	SHLQ  $0x05, BP, SI
+	MOVQ  $0x07ffffffffffffff, R11
+	ANDQ  R11, BP
+	XORQ  R10, R10
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x03, R9, DX
+	SHLQ  $0x03, R8, R9
+	SHLQ  $0x03, DI, R8
+	SHLQ  $0x03, SI, DI
+	SHLQ  $0x03, SI
+	ADDQ  SI, AX
+	ADCQ  DI, CX
+	ADCQ  R8, BX
+	ADCQ  R9, BP
+	ADCQ  DX, R10
+	SHLQ  $0x05, BP, R10
+	ANDQ  R11, BP
+	LEAQ  (R10)(R10*8), R10
+	ADDQ  R10, AX
+	ADCQ  $0x00, CX
+	ADCQ  $0x00, BX
+	ADCQ  $0x00, BP
+	MOVQ  res+0(FP), DX
+	LEAQ  96(DX), DX
+	MOVQ  AX, (DX)
+	MOVQ  CX, 8(DX)
+	MOVQ  BX, 16(DX)
+	MOVQ  BP, 24(DX)
+	RET
+
+DATA mask25<>+0(SB)/8, $0x0000000001ffffff
+GLOBL mask25<>(SB), RODATA|NOPTR, $8
+
+DATA mask26<>+0(SB)/8, $0x0000000003ffffff
+GLOBL mask26<>(SB), RODATA|NOPTR, $8
+
+// func mul4(res *fieldElement4, x *fieldElement4, y *fieldElement4)
+// Requires: AVX, AVX2
+TEXT ·mul4(SB), NOSPLIT, $288-24
+	MOVQ x+8(FP), AX
+	MOVQ y+16(FP), CX
+
+	// Scaled limbs
+	VMOVDQU 32(CX), Y0
+	VPSLLQ  $0x03, Y0, Y1
+	VPADDQ  Y0, Y1, Y1
+	VMOVDQU Y1, (SP)
+	VMOVDQU 64(CX), Y0
+	VPSLLQ  $0x03, Y0, Y1
+	VPADDQ  Y0, Y1, Y1
+	VMOVDQU Y1, 32(SP)
+	VMOVDQU 96(CX), Y0
+	VPSLLQ  $0x03, Y0, Y1
+	VPADDQ  Y0, Y1, Y1
+	VMOVDQU Y1, 64(SP)
+	VMOVDQU 128(CX), Y0
+	VPSLLQ  $0x03, Y0, Y1
+	VPADDQ  Y0, Y1, Y1
+	VMOVDQU Y1, 96(SP)
+	VMOVDQU 160(CX), Y0
+	VPSLLQ  $0x03, Y0, Y1
+	VPADDQ  Y0, Y1, Y1
+	VMOVDQU Y1, 128(SP)
+	VMOVDQU 192(CX), Y0
+	VPSLLQ  $0x03, Y0, Y1
+	VPADDQ  Y0, Y1, Y1
+	VMOVDQU Y1, 160(SP)
+	VMOVDQU 224(CX), Y0
+	VPSLLQ  $0x03, Y0, Y1
+	VPADDQ  Y0, Y1, Y1
+	VMOVDQU Y1, 192(SP)
+	VMOVDQU 256(CX), Y0
+	VPSLLQ  $0x03, Y0, Y1
+	VPADDQ  Y0, Y1, Y1
+	VMOVDQU Y1, 224(SP)
+	VMOVDQU 288(CX), Y0
+	VPSLLQ  $0x03, Y0, Y1
+	VPADDQ  Y0, Y1, Y1
+	VMOVDQU Y1, 256(SP)
+
+	// x[0]*1
+	VMOVDQU  (AX), Y0
+	VPMULUDQ (CX), Y0, Y1
+	VPMULUDQ 32(CX), Y0, Y2
+	VPMULUDQ 64(CX), Y0, Y3
+	VPMULUDQ 96(CX), Y0, Y4
+	VPMULUDQ 128(CX), Y0, Y5
+	VPMULUDQ 160(CX), Y0, Y6
+	VPMULUDQ 192(CX), Y0, Y7
+	VPMULUDQ 224(CX), Y0, Y8
+	VPMULUDQ 256(CX), Y0, Y9
+	VPMULUDQ 288(CX), Y0, Y0
+
+	// x[1]*1
+	VMOVDQU  32(AX), Y10
+	VPMULUDQ (CX), Y10, Y10
+	VPADDQ   Y10, Y2, Y2
+
+	// x[1]*2
+	VMOVDQU  32(AX), Y10
+	VPADDQ   Y10, Y10, Y10
+	VPMULUDQ 32(CX), Y10, Y11
+	VPADDQ   Y11, Y3, Y3
+	VPMULUDQ 64(CX), Y10, Y11
+	VPADDQ   Y11, Y4, Y4
+	VPMULUDQ 96(CX), Y10, Y11
+	VPADDQ   Y11, Y5, Y5
+	VPMULUDQ 128(CX), Y10, Y11
+	VPADDQ   Y11, Y6, Y6
+	VPMULUDQ 160(CX), Y10, Y11
+	VPADDQ   Y11, Y7, Y7
+	VPMULUDQ 192(CX), Y10, Y11
+	VPADDQ   Y11, Y8, Y8
+	VPMULUDQ 224(CX), Y10, Y11
+	VPADDQ   Y11, Y9, Y9
+	VPMULUDQ 256(CX), Y10, Y11
+	VPADDQ   Y11, Y0, Y0
+	VPMULUDQ 256(SP), Y10, Y10
+	VPADDQ   Y10, Y1, Y1
+
+	// x[2]*1
+	VMOVDQU  64(AX), Y10
+	VPMULUDQ (CX), Y10, Y11
+	VPADDQ   Y11, Y3, Y3
+	VPMULUDQ 256(SP), Y10, Y10
+	VPADDQ   Y10, Y2, Y2
+
+	// x[2]*2
+	VMOVDQU  64(AX), Y10
+	VPADDQ   Y10, Y10, Y10
+	VPMULUDQ 32(CX), Y10, Y11
+	VPADDQ   Y11, Y4, Y4
+	VPMULUDQ 64(CX), Y10, Y11
+	VPADDQ   Y11, Y5, Y5
+	VPMULUDQ 96(CX), Y10, Y11
+	VPADDQ   Y11, Y6, Y6
+	VPMULUDQ 128(CX), Y10, Y11
+	VPADDQ   Y11, Y7, Y7
+	VPMULUDQ 160(CX), Y10, Y11
+	VPADDQ   Y11, Y8, Y8
+	VPMULUDQ 192(CX), Y10, Y11
+	VPADDQ   Y11, Y9, Y9
+	VPMULUDQ 224(CX), Y10, Y11
+	VPADDQ   Y11, Y0, Y0
+	VPMULUDQ 224(SP), Y10, Y10
+	VPADDQ   Y10, Y1, Y1
+
+	// x[3]*1
+	VMOVDQU  96(AX), Y10
+	VPMULUDQ (CX), Y10, Y11
+	VPADDQ   Y11, Y4, Y4
+	VPMULUDQ 224(SP), Y10, Y11
+	VPADDQ   Y11, Y2, Y2
+	VPMULUDQ 256(SP), Y10, Y10
+	VPADDQ   Y10, Y3, Y3
+
+	// x[3]*2
+	VMOVDQU  96(AX), Y10
+	VPADDQ   Y10, Y10, Y10
+	VPMULUDQ 32(CX), Y10, Y11
+	VPADDQ   Y11, Y5, Y5
+	VPMULUDQ 64(CX), Y10, Y11
+	VPADDQ   Y11, Y6, Y6
+	VPMULUDQ 96(CX), Y10, Y11
+	VPADDQ   Y11, Y7, Y7
+	VPMULUDQ 128(CX), Y10, Y11
+	VPADDQ   Y11, Y8, Y8
+	VPMULUDQ 160(CX), Y10, Y11
+	VPADDQ   Y11, Y9, Y9
+	VPMULUDQ 192(CX), Y10, Y11
+	VPADDQ   Y11, Y0, Y0
+	VPMULUDQ 192(SP), Y10, Y10
+	VPADDQ   Y10, Y1, Y1
+
+	// x[4]*1
+	VMOVDQU  128(AX), Y10
+	VPMULUDQ (CX), Y10, Y11
+	VPADDQ   Y11, Y5, Y5
+	VPMULUDQ 192(SP), Y10, Y11
+	VPADDQ   Y11, Y2, Y2
+	VPMULUDQ 224(SP), Y10, Y11
+	VPADDQ   Y11, Y3, Y3
+	VPMULUDQ 256(SP), Y10, Y10
+	VPADDQ   Y10, Y4, Y4
+
+	// x[4]*2
+	VMOVDQU  128(AX), Y10
+	VPADDQ   Y10, Y10, Y10
+	VPMULUDQ 32(CX), Y10, Y11
+	VPADDQ   Y11, Y6, Y6
+	VPMULUDQ 64(CX), Y10, Y11
+	VPADDQ   Y11, Y7, Y7
+	VPMULUDQ 96(CX), Y10, Y11
+	VPADDQ   Y11, Y8, Y8
+	VPMULUDQ 128(CX), Y10, Y11
+	VPADDQ   Y11, Y9, Y9
+	VPMULUDQ 160(CX), Y10, Y11
+	VPADDQ   Y11, Y0, Y0
+	VPMULUDQ 160(SP), Y10, Y10
+	VPADDQ   Y10, Y1, Y1
+
+	// x[5]*1
+	VMOVDQU  160(AX), Y10
+	VPMULUDQ (CX), Y10, Y11
+	VPADDQ   Y11, Y6, Y6
+	VPMULUDQ 160(SP), Y10, Y11
+	VPADDQ   Y11, Y2, Y2
+	VPMULUDQ 192(SP), Y10, Y11
+	VPADDQ   Y11, Y3, Y3
+	VPMULUDQ 224(SP), Y10, Y11
+	VPADDQ   Y11, Y4, Y4
+	VPMULUDQ 256(SP), Y10, Y10
+	VPADDQ   Y10, Y5, Y5
+
+	// x[5]*2
+	VMOVDQU  160(AX), Y10
+	VPADDQ   Y10, Y10, Y10
+	VPMULUDQ 32(CX), Y10, Y11
+	VPADDQ   Y11, Y7, Y7
+	VPMULUDQ 64(CX), Y10, Y11
+	VPADDQ   Y11, Y8, Y8
+	VPMULUDQ 96(CX), Y10, Y11
+	VPADDQ   Y11, Y9, Y9
+	VPMULUDQ 128(CX), Y10, Y11
+	VPADDQ   Y11, Y0, Y0
+	VPMULUDQ 128(SP), Y10, Y10
+	VPADDQ   Y10, Y1, Y1
+
+	// x[6]*1
+	VMOVDQU  192(AX), Y10
+	VPMULUDQ (CX), Y10, Y11
+	VPADDQ   Y11, Y7, Y7
+	VPMULUDQ 128(SP), Y10, Y11
+	VPADDQ   Y11, Y2, Y2
+	VPMULUDQ 160(SP), Y10, Y11
+	VPADDQ   Y11, Y3, Y3
+	VPMULUDQ 192(SP), Y10, Y11
+	VPADDQ   Y11, Y4, Y4
+	VPMULUDQ 224(SP), Y10, Y11
+	VPADDQ   Y11, Y5, Y5
+	VPMULUDQ 256(SP), Y10, Y10
+	VPADDQ   Y10, Y6, Y6
+
+	// x[6]*2
+	VMOVDQU  192(AX), Y10
+	VPADDQ   Y10, Y10, Y10
+	VPMULUDQ 32(CX), Y10, Y11
+	VPADDQ   Y11, Y8, Y8
+	VPMULUDQ 64(CX), Y10, Y11
+	VPADDQ   Y11, Y9, Y9
+	VPMULUDQ 96(CX), Y10, Y11
+	VPADDQ   Y11, Y0, Y0
+	VPMULUDQ 96(SP), Y10, Y10
+	VPADDQ   Y10, Y1, Y1
+
+	// x[7]*1
+	VMOVDQU  224(AX), Y10
+	VPMULUDQ (CX), Y10, Y11
+	VPADDQ   Y11, Y8, Y8
+	VPMULUDQ 96(SP), Y10, Y11
+	VPADDQ   Y11, Y2, Y2
+	VPMULUDQ 128(SP), Y10, Y11
+	VPADDQ   Y11, Y3, Y3
+	VPMULUDQ 160(SP), Y10, Y11
+	VPADDQ   Y11, Y4, Y4
+	VPMULUDQ 192(SP), Y10, Y11
+	VPADDQ   Y11, Y5, Y5
+	VPMULUDQ 224(SP), Y10, Y11
+	VPADDQ   Y11, Y6, Y6
+	VPMULUDQ 256(SP), Y10, Y10
+	VPADDQ   Y10, Y7, Y7
+
+	// x[7]*2
+	VMOVDQU  224(AX), Y10
+	VPADDQ   Y10, Y10, Y10
+	VPMULUDQ 32(CX), Y10, Y11
+	VPADDQ   Y11, Y9, Y9
+	VPMULUDQ 64(CX), Y10, Y11
+	VPADDQ   Y11, Y0, Y0
+	VPMULUDQ 64(SP), Y10, Y10
+	VPADDQ   Y10, Y1, Y1
+
+	// x[8]*1
+	VMOVDQU  256(AX), Y10
+	VPMULUDQ (CX), Y10, Y11
+	VPADDQ   Y11, Y9, Y9
+	VPMULUDQ 64(SP), Y10, Y11
+	VPADDQ   Y11, Y2, Y2
+	VPMULUDQ 96(SP), Y10, Y11
+	VPADDQ   Y11, Y3, Y3
+	VPMULUDQ 128(SP), Y10, Y11
+	VPADDQ   Y11, Y4, Y4
+	VPMULUDQ 160(SP), Y10, Y11
+	VPADDQ   Y11, Y5, Y5
+	VPMULUDQ 192(SP), Y10, Y11
+	VPADDQ   Y11, Y6, Y6
+	VPMULUDQ 224(SP), Y10, Y11
+	VPADDQ   Y11, Y7, Y7
+	VPMULUDQ 256(SP), Y10, Y10
+	VPADDQ   Y10, Y8, Y8
+
+	// x[8]*2
+	VMOVDQU  256(AX), Y10
+	VPADDQ   Y10, Y10, Y10
+	VPMULUDQ 32(CX), Y10, Y11
+	VPADDQ   Y11, Y0, Y0
+	VPMULUDQ 32(SP), Y10, Y10
+	VPADDQ   Y10, Y1, Y1
+
+	// x[9]*1
+	VMOVDQU  288(AX), Y10
+	VPMULUDQ (CX), Y10, Y11
+	VPADDQ   Y11, Y0, Y0
+	VPMULUDQ 32(SP), Y10, Y11
+	VPADDQ   Y11, Y2, Y2
+	VPMULUDQ 64(SP), Y10, Y11
+	VPADDQ   Y11, Y3, Y3
+	VPMULUDQ 96(SP), Y10, Y11
+	VPADDQ   Y11, Y4, Y4
+	VPMULUDQ 128(SP), Y10, Y11
+	VPADDQ   Y11, Y5, Y5
+	VPMULUDQ 160(SP), Y10, Y11
+	VPADDQ   Y11, Y6, Y6
+	VPMULUDQ 192(SP), Y10, Y11
+	VPADDQ   Y11, Y7, Y7
+	VPMULUDQ 224(SP), Y10, Y11
+	VPADDQ   Y11, Y8, Y8
+	VPMULUDQ 256(SP), Y10, Y10
+	VPADDQ   Y10, Y9, Y9
+
+	// x[9]*2
+	VMOVDQU      288(AX), Y10
+	VPADDQ       Y10, Y10, Y10
+	VPMULUDQ     (SP), Y10, Y10
+	VPADDQ       Y10, Y1, Y1
+	VPBROADCASTQ mask25<>+0(SB), Y10
+	VPBROADCASTQ mask26<>+0(SB), Y11
+
+	// Carry
+	VPSRLQ $0x1a, Y1, Y12
+	VPAND  Y11, Y1, Y1
+	VPADDQ Y12, Y2, Y2
+	VPSRLQ $0x19, Y2, Y12
+	VPAND  Y10, Y2, Y2
+	VPADDQ Y12, Y3, Y3
+	VPSRLQ $0x19, Y3, Y12
+	VPAND  Y10, Y3, Y3
+	VPADDQ Y12, Y4, Y4
+	VPSRLQ $0x19, Y4, Y12
+	VPAND  Y10, Y4, Y4
+	VPADDQ Y12, Y5, Y5
+	VPSRLQ $0x19, Y5, Y12
+	VPAND  Y10, Y5, Y5
+	VPADDQ Y12, Y6, Y6
+	VPSRLQ $0x19, Y6, Y12
+	VPAND  Y10, Y6, Y6
+	VPADDQ Y12, Y7, Y7
+	VPSRLQ $0x19, Y7, Y12
+	VPAND  Y10, Y7, Y7
+	VPADDQ Y12, Y8, Y8
+	VPSRLQ $0x19, Y8, Y12
+	VPAND  Y10, Y8, Y8
+	VPADDQ Y12, Y9, Y9
+	VPSRLQ $0x19, Y9, Y12
+	VPAND  Y10, Y9, Y9
+	VPADDQ Y12, Y0, Y0
+
+	// Fold bits above 2^251 with 2^251 == 9
+	VPSRLQ  $0x19, Y0, Y12
+	VPAND   Y10, Y0, Y0
+	VPSLLQ  $0x03, Y12, Y13
+	VPADDQ  Y12, Y13, Y13
+	VPADDQ  Y13, Y1, Y1
+	VPSRLQ  $0x1a, Y1, Y12
+	VPAND   Y11, Y1, Y1
+	VPADDQ  Y12, Y2, Y2
+	VPSRLQ  $0x19, Y2, Y11
+	VPAND   Y10, Y2, Y2
+	VPADDQ  Y11, Y3, Y3
+	VPSRLQ  $0x19, Y3, Y11
+	VPAND   Y10, Y3, Y3
+	VPADDQ  Y11, Y4, Y4
+	VPSRLQ  $0x19, Y4, Y11
+	VPAND   Y10, Y4, Y4
+	VPADDQ  Y11, Y5, Y5
+	VPSRLQ  $0x19, Y5, Y11
+	VPAND   Y10, Y5, Y5
+	VPADDQ  Y11, Y6, Y6
+	VPSRLQ  $0x19, Y6, Y11
+	VPAND   Y10, Y6, Y6
+	VPADDQ  Y11, Y7, Y7
+	VPSRLQ  $0x19, Y7, Y11
+	VPAND   Y10, Y7, Y7
+	VPADDQ  Y11, Y8, Y8
+	VPSRLQ  $0x19, Y8, Y11
+	VPAND   Y10, Y8, Y8
+	VPADDQ  Y11, Y9, Y9
+	VPSRLQ  $0x19, Y9, Y11
+	VPAND   Y10, Y9, Y9
+	VPADDQ  Y11, Y0, Y0
+	MOVQ    res+0(FP), AX
+	VMOVDQU Y1, (AX)
+	VMOVDQU Y2, 32(AX)
+	VMOVDQU Y3, 64(AX)
+	VMOVDQU Y4, 96(AX)
+	VMOVDQU Y5, 128(AX)
+	VMOVDQU Y6, 160(AX)
+	VMOVDQU Y7, 192(AX)
+	VMOVDQU Y8, 224(AX)
+	VMOVDQU Y9, 256(AX)
+	VMOVDQU Y0, 288(AX)
+	VZEROUPPER
+	RET
+
+// func sqr4(res *fieldElement4, x *fieldElement4)
+// Requires: AVX, AVX2
+TEXT ·sqr4(SB), NOSPLIT, $512-16
+	MOVQ x+8(FP), AX
+
+	// Scaled limbs
+	VMOVDQU 64(AX), Y0
+	VPADDQ  Y0, Y0, Y0
+	VMOVDQU Y0, (SP)
+	VMOVDQU 96(AX), Y0
+	VPADDQ  Y0, Y0, Y0
+	VMOVDQU Y0, 32(SP)
+	VMOVDQU 128(AX), Y0
+	VPADDQ  Y0, Y0, Y0
+	VMOVDQU Y0, 64(SP)
+	VMOVDQU 160(AX), Y0
+	VPADDQ  Y0, Y0, Y1
+	VMOVDQU Y1, 96(SP)
+	VPSLLQ  $0x03, Y0, Y1
+	VPADDQ  Y0, Y1, Y1
+	VMOVDQU Y1, 128(SP)
+	VMOVDQU 192(AX), Y0
+	VPADDQ  Y0, Y0, Y1
+	VMOVDQU Y1, 160(SP)
+	VPSLLQ  $0x03, Y0, Y1
+	VPADDQ  Y0, Y1, Y1
+	VMOVDQU Y1, 192(SP)
+	VPSLLQ  $0x03, Y0, Y1
+	VPADDQ  Y0, Y1, Y1
+	VPADDQ  Y1, Y1, Y1
+	VMOVDQU Y1, 224(SP)
+	VMOVDQU 224(AX), Y0
+	VPADDQ  Y0, Y0, Y1
+	VMOVDQU Y1, 256(SP)
+	VPSLLQ  $0x03, Y0, Y1
+	VPADDQ  Y0, Y1, Y1
+	VMOVDQU Y1, 288(SP)
+	VPSLLQ  $0x03, Y0, Y1
+	VPADDQ  Y0, Y1, Y1
+	VPADDQ  Y1, Y1, Y1
+	VMOVDQU Y1, 320(SP)
+	VMOVDQU 256(AX), Y0
+	VPADDQ  Y0, Y0, Y1
+	VMOVDQU Y1, 352(SP)
+	VPSLLQ  $0x03, Y0, Y1
+	VPADDQ  Y0, Y1, Y1
+	VMOVDQU Y1, 384(SP)
+	VPSLLQ  $0x03, Y0, Y1
+	VPADDQ  Y0, Y1, Y1
+	VPADDQ  Y1, Y1, Y1
+	VMOVDQU Y1, 416(SP)
+	VMOVDQU 288(AX), Y0
+	VPSLLQ  $0x03, Y0, Y1
+	VPADDQ  Y0, Y1, Y1
+	VMOVDQU Y1, 448(SP)
+	VPSLLQ  $0x03, Y0, Y1
+	VPADDQ  Y0, Y1, Y1
+	VPADDQ  Y1, Y1, Y1
+	VMOVDQU Y1, 480(SP)
+
+	// x[0]*1
+	VMOVDQU  (AX), Y0
+	VPMULUDQ (AX), Y0, Y0
+
+	// x[0]*2
+	VMOVDQU  (AX), Y1
+	VPADDQ   Y1, Y1, Y1
+	VPMULUDQ 32(AX), Y1, Y2
+	VPMULUDQ 64(AX), Y1, Y3
+	VPMULUDQ 96(AX), Y1, Y4
+	VPMULUDQ 128(AX), Y1, Y5
+	VPMULUDQ 160(AX), Y1, Y6
+	VPMULUDQ 192(AX), Y1, Y7
+	VPMULUDQ 224(AX), Y1, Y8
+	VPMULUDQ 256(AX), Y1, Y9
+	VPMULUDQ 288(AX), Y1, Y1
+
+	// x[1]*2
+	VMOVDQU  32(AX), Y10
+	VPADDQ   Y10, Y10, Y10
+	VPMULUDQ 32(AX), Y10, Y11
+	VPADDQ   Y11, Y3, Y3
+	VPMULUDQ (SP), Y10, Y11
+	VPADDQ   Y11, Y4, Y4
+	VPMULUDQ 32(SP), Y10, Y11
+	VPADDQ   Y11, Y5, Y5
+	VPMULUDQ 64(SP), Y10, Y11
+	VPADDQ   Y11, Y6, Y6
+	VPMULUDQ 96(SP), Y10, Y11
+	VPADDQ   Y11, Y7, Y7
+	VPMULUDQ 160(SP), Y10, Y11
+	VPADDQ   Y11, Y8, Y8
+	VPMULUDQ 256(SP), Y10, Y11
+	VPADDQ   Y11, Y9, Y9
+	VPMULUDQ 352(SP), Y10, Y11
+	VPADDQ   Y11, Y1, Y1
+	VPMULUDQ 480(SP), Y10, Y10
+	VPADDQ   Y10, Y0, Y0
+
+	// x[2]*2
+	VMOVDQU  64(AX), Y10
+	VPADDQ   Y10, Y10, Y10
+	VPMULUDQ 64(AX), Y10, Y11
+	VPADDQ   Y11, Y5, Y5
+	VPMULUDQ 32(SP), Y10, Y11
+	VPADDQ   Y11, Y6, Y6
+	VPMULUDQ 64(SP), Y10, Y11
+	VPADDQ   Y11, Y7, Y7
+	VPMULUDQ 96(SP), Y10, Y11
+	VPADDQ   Y11, Y8, Y8
+	VPMULUDQ 160(SP), Y10, Y11
+	VPADDQ   Y11, Y9, Y9
+	VPMULUDQ 256(SP), Y10, Y11
+	VPADDQ   Y11, Y1, Y1
+	VPMULUDQ 416(SP), Y10, Y11
+	VPADDQ   Y11, Y0, Y0
+	VPMULUDQ 448(SP), Y10, Y10
+	VPADDQ   Y10, Y2, Y2
+
+	// x[3]*2
+	VMOVDQU  96(AX), Y10
+	VPADDQ   Y10, Y10, Y10
+	VPMULUDQ 96(AX), Y10, Y11
+	VPADDQ   Y11, Y7, Y7
+	VPMULUDQ 64(SP), Y10, Y11
+	VPADDQ   Y11, Y8, Y8
+	VPMULUDQ 96(SP), Y10, Y11
+	VPADDQ   Y11, Y9, Y9
+	VPMULUDQ 160(SP), Y10, Y11
+	VPADDQ   Y11, Y1, Y1
+	VPMULUDQ 320(SP), Y10, Y11
+	VPADDQ   Y11, Y0, Y0
+	VPMULUDQ 384(SP), Y10, Y11
+	VPADDQ   Y11, Y2, Y2
+	VPMULUDQ 448(SP), Y10, Y10
+	VPADDQ   Y10, Y3, Y3
+
+	// x[4]*2
+	VMOVDQU  128(AX), Y10
+	VPADDQ   Y10, Y10, Y10
+	VPMULUDQ 128(AX), Y10, Y11
+	VPADDQ   Y11, Y9, Y9
+	VPMULUDQ 96(SP), Y10, Y11
+	VPADDQ   Y11, Y1, Y1
+	VPMULUDQ 224(SP), Y10, Y11
+	VPADDQ   Y11, Y0, Y0
+	VPMULUDQ 288(SP), Y10, Y11
+	VPADDQ   Y11, Y2, Y2
+	VPMULUDQ 384(SP), Y10, Y11
+	VPADDQ   Y11, Y3, Y3
+	VPMULUDQ 448(SP), Y10, Y10
+	VPADDQ   Y10, Y4, Y4
+
+	// x[5]*2
+	VMOVDQU  160(AX), Y10
+	VPADDQ   Y10, Y10, Y10
+	VPMULUDQ 128(SP), Y10, Y11
+	VPADDQ   Y11, Y0, Y0
+	VPMULUDQ 192(SP), Y10, Y11
+	VPADDQ   Y11, Y2, Y2
+	VPMULUDQ 288(SP), Y10, Y11
+	VPADDQ   Y11, Y3, Y3
+	VPMULUDQ 384(SP), Y10, Y11
+	VPADDQ   Y11, Y4, Y4
+	VPMULUDQ 448(SP), Y10, Y10
+	VPADDQ   Y10, Y5, Y5
+
+	// x[6]*1
+	VMOVDQU  192(AX), Y10
+	VPMULUDQ 192(SP), Y10, Y10
+	VPADDQ   Y10, Y3, Y3
+
+	// x[6]*2
+	VMOVDQU  192(AX), Y10
+	VPADDQ   Y10, Y10, Y10
+	VPMULUDQ 288(SP), Y10, Y11
+	VPADDQ   Y11, Y4, Y4
+	VPMULUDQ 384(SP), Y10, Y11
+	VPADDQ   Y11, Y5, Y5
+	VPMULUDQ 448(SP), Y10, Y10
+	VPADDQ   Y10, Y6, Y6
+
+	// x[7]*1
+	VMOVDQU  224(AX), Y10
+	VPMULUDQ 288(SP), Y10, Y10
+	VPADDQ   Y10, Y5, Y5
+
+	// x[7]*2
+	VMOVDQU  224(AX), Y10
+	VPADDQ   Y10, Y10, Y10
+	VPMULUDQ 384(SP), Y10, Y11
+	VPADDQ   Y11, Y6, Y6
+	VPMULUDQ 448(SP), Y10, Y10
+	VPADDQ   Y10, Y7, Y7
+
+	// x[8]*1
+	VMOVDQU  256(AX), Y10
+	VPMULUDQ 384(SP), Y10, Y10
+	VPADDQ   Y10, Y7, Y7
+
+	// x[8]*2
+	VMOVDQU  256(AX), Y10
+	VPADDQ   Y10, Y10, Y10
+	VPMULUDQ 448(SP), Y10, Y10
+	VPADDQ   Y10, Y8, Y8
+
+	// x[9]*1
+	VMOVDQU      288(AX), Y10
+	VPMULUDQ     448(SP), Y10, Y10
+	VPADDQ       Y10, Y9, Y9
+	VPBROADCASTQ mask25<>+0(SB), Y10
+	VPBROADCASTQ mask26<>+0(SB), Y11
+
+	// Carry
+	VPSRLQ $0x1a, Y0, Y12
+	VPAND  Y11, Y0, Y0
+	VPADDQ Y12, Y2, Y2
+	VPSRLQ $0x19, Y2, Y12
+	VPAND  Y10, Y2, Y2
+	VPADDQ Y12, Y3, Y3
+	VPSRLQ $0x19, Y3, Y12
+	VPAND  Y10, Y3, Y3
+	VPADDQ Y12, Y4, Y4
+	VPSRLQ $0x19, Y4, Y12
+	VPAND  Y10, Y4, Y4
+	VPADDQ Y12, Y5, Y5
+	VPSRLQ $0x19, Y5, Y12
+	VPAND  Y10, Y5, Y5
+	VPADDQ Y12, Y6, Y6
+	VPSRLQ $0x19, Y6, Y12
+	VPAND  Y10, Y6, Y6
+	VPADDQ Y12, Y7, Y7
+	VPSRLQ $0x19, Y7, Y12
+	VPAND  Y10, Y7, Y7
+	VPADDQ Y12, Y8, Y8
+	VPSRLQ $0x19, Y8, Y12
+	VPAND  Y10, Y8, Y8
+	VPADDQ Y12, Y9, Y9
+	VPSRLQ $0x19, Y9, Y12
+	VPAND  Y10, Y9, Y9
+	VPADDQ Y12, Y1, Y1
+
+	// Fold bits above 2^251 with 2^251 == 9
+	VPSRLQ  $0x19, Y1, Y12
+	VPAND   Y10, Y1, Y1
+	VPSLLQ  $0x03, Y12, Y13
+	VPADDQ  Y12, Y13, Y13
+	VPADDQ  Y13, Y0, Y0
+	VPSRLQ  $0x1a, Y0, Y12
+	VPAND   Y11, Y0, Y0
+	VPADDQ  Y12, Y2, Y2
+	VPSRLQ  $0x19, Y2, Y11
+	VPAND   Y10, Y2, Y2
+	VPADDQ  Y11, Y3, Y3
+	VPSRLQ  $0x19, Y3, Y11
+	VPAND   Y10, Y3, Y3
+	VPADDQ  Y11, Y4, Y4
+	VPSRLQ  $0x19, Y4, Y11
+	VPAND   Y10, Y4, Y4
+	VPADDQ  Y11, Y5, Y5
+	VPSRLQ  $0x19, Y5, Y11
+	VPAND   Y10, Y5, Y5
+	VPADDQ  Y11, Y6, Y6
+	VPSRLQ  $0x19, Y6, Y11
+	VPAND   Y10, Y6, Y6
+	VPADDQ  Y11, Y7, Y7
+	VPSRLQ  $0x19, Y7, Y11
+	VPAND   Y10, Y7, Y7
+	VPADDQ  Y11, Y8, Y8
+	VPSRLQ  $0x19, Y8, Y11
+	VPAND   Y10, Y8, Y8
+	VPADDQ  Y11, Y9, Y9
+	VPSRLQ  $0x19, Y9, Y11
+	VPAND   Y10, Y9, Y9
+	VPADDQ  Y11, Y1, Y1
+	MOVQ    res+0(FP), AX
+	VMOVDQU Y0, (AX)
+	VMOVDQU Y2, 32(AX)
+	VMOVDQU Y3, 64(AX)
+	VMOVDQU Y4, 96(AX)
+	VMOVDQU Y5, 128(AX)
+	VMOVDQU Y6, 160(AX)
+	VMOVDQU Y7, 192(AX)
+	VMOVDQU Y8, 224(AX)
+	VMOVDQU Y9, 256(AX)
+	VMOVDQU Y1, 288(AX)
+	VZEROUPPER
+	RET
+
+DATA bias4p0<>+0(SB)/8, $0x000000000fffffdc
+GLOBL bias4p0<>(SB), RODATA|NOPTR, $8
+
+DATA bias4p<>+0(SB)/8, $0x0000000007fffffc
+GLOBL bias4p<>(SB), RODATA|NOPTR, $8
+
+DATA doubleShift<>+0(SB)/8, $0x0000000000000040
+DATA doubleShift<>+8(SB)/8, $0x0000000000000040
+DATA doubleShift<>+16(SB)/8, $0x0000000000000001
+DATA doubleShift<>+24(SB)/8, $0x0000000000000000
+GLOBL doubleShift<>(SB), RODATA|NOPTR, $32
+
+DATA addShift<>+0(SB)/8, $0x0000000000000040
+DATA addShift<>+8(SB)/8, $0x0000000000000001
+DATA addShift<>+16(SB)/8, $0x0000000000000040
+DATA addShift<>+24(SB)/8, $0x0000000000000040
+GLOBL addShift<>(SB), RODATA|NOPTR, $32
+
+// func double4(res *fieldElement4, p *fieldElement4)
+// Requires: AVX, AVX2
+TEXT ·double4(SB), $2400-16
+	MOVQ p+8(FP), AX
+
+	// (X, Y, Z, X+Y)
+	LEAQ     (SP), CX
+	VMOVDQU  (AX), Y0
+	VPERMQ   $0x24, Y0, Y1
+	VPERMQ   $0x40, Y0, Y2
+	VPADDQ   Y2, Y1, Y1
+	VPBLENDD $0xc0, Y1, Y0, Y0
+	VMOVDQU  Y0, (CX)
+	VMOVDQU  32(AX), Y0
+	VPERMQ   $0x24, Y0, Y1
+	VPERMQ   $0x40, Y0, Y2
+	VPADDQ   Y2, Y1, Y1
+	VPBLENDD $0xc0, Y1, Y0, Y0
+	VMOVDQU  Y0, 32(CX)
+	VMOVDQU  64(AX), Y0
+	VPERMQ   $0x24, Y0, Y1
+	VPERMQ   $0x40, Y0, Y2
+	VPADDQ   Y2, Y1, Y1
+	VPBLENDD $0xc0, Y1, Y0, Y0
+	VMOVDQU  Y0, 64(CX)
+	VMOVDQU  96(AX), Y0
+	VPERMQ   $0x24, Y0, Y1
+	VPERMQ   $0x40, Y0, Y2
+	VPADDQ   Y2, Y1, Y1
+	VPBLENDD $0xc0, Y1, Y0, Y0
+	VMOVDQU  Y0, 96(CX)
+	VMOVDQU  128(AX), Y0
+	VPERMQ   $0x24, Y0, Y1
+	VPERMQ   $0x40, Y0, Y2
+	VPADDQ   Y2, Y1, Y1
+	VPBLENDD $0xc0, Y1, Y0, Y0
+	VMOVDQU  Y0, 128(CX)
+	VMOVDQU  160(AX), Y0
+	VPERMQ   $0x24, Y0, Y1
+	VPERMQ   $0x40, Y0, Y2
+	VPADDQ   Y2, Y1, Y1
+	VPBLENDD $0xc0, Y1, Y0, Y0
+	VMOVDQU  Y0, 160(CX)
+	VMOVDQU  192(AX), Y0
+	VPERMQ   $0x24, Y0, Y1
+	VPERMQ   $0x40, Y0, Y2
+	VPADDQ   Y2, Y1, Y1
+	VPBLENDD $0xc0, Y1, Y0, Y0
+	VMOVDQU  Y0, 192(CX)
+	VMOVDQU  224(AX), Y0
+	VPERMQ   $0x24, Y0, Y1
+	VPERMQ   $0x40, Y0, Y2
+	VPADDQ   Y2, Y1, Y1
+	VPBLENDD $0xc0, Y1, Y0, Y0
+	VMOVDQU  Y0, 224(CX)
+	VMOVDQU  256(AX), Y0
+	VPERMQ   $0x24, Y0, Y1
+	VPERMQ   $0x40, Y0, Y2
+	VPADDQ   Y2, Y1, Y1
+	VPBLENDD $0xc0, Y1, Y0, Y0
+	VMOVDQU  Y0, 256(CX)
+	VMOVDQU  288(AX), Y0
+	VPERMQ   $0x24, Y0, Y1
+	VPERMQ   $0x40, Y0, Y2
+	VPADDQ   Y2, Y1, Y1
+	VPBLENDD $0xc0, Y1, Y0, Y0
+	VMOVDQU  Y0, 288(CX)
+
+	// (A, B, Z^2, (X+Y)^2)
+	// Scaled limbs
+	VMOVDQU 64(CX), Y0
+	VPADDQ  Y0, Y0, Y0
+	VMOVDQU Y0, 320(SP)
+	VMOVDQU 96(CX), Y0
+	VPADDQ  Y0, Y0, Y0
+	VMOVDQU Y0, 352(SP)
+	VMOVDQU 128(CX), Y0
+	VPADDQ  Y0, Y0, Y0
+	VMOVDQU Y0, 384(SP)
+	VMOVDQU 160(CX), Y0
+	VPADDQ  Y0, Y0, Y1
+	VMOVDQU Y1, 416(SP)
+	VPSLLQ  $0x03, Y0, Y1
+	VPADDQ  Y0, Y1, Y1
+	VMOVDQU Y1, 448(SP)
+	VMOVDQU 192(CX), Y0
+	VPADDQ  Y0, Y0, Y1
+	VMOVDQU Y1, 480(SP)
+	VPSLLQ  $0x03, Y0, Y1
+	VPADDQ  Y0, Y1, Y1
+	VMOVDQU Y1, 512(SP)
+	VPSLLQ  $0x03, Y0, Y1
+	VPADDQ  Y0, Y1, Y1
+	VPADDQ  Y1, Y1, Y1
+	VMOVDQU Y1, 544(SP)
+	VMOVDQU 224(CX), Y0
+	VPADDQ  Y0, Y0, Y1
+	VMOVDQU Y1, 576(SP)
+	VPSLLQ  $0x03, Y0, Y1
+	VPADDQ  Y0, Y1, Y1
+	VMOVDQU Y1, 608(SP)
+	VPSLLQ  $0x03, Y0, Y1
+	VPADDQ  Y0, Y1, Y1
+	VPADDQ  Y1, Y1, Y1
+	VMOVDQU Y1, 640(SP)
+	VMOVDQU 256(CX), Y0
+	VPADDQ  Y0, Y0, Y1
+	VMOVDQU Y1, 672(SP)
+	VPSLLQ  $0x03, Y0, Y1
+	VPADDQ  Y0, Y1, Y1
+	VMOVDQU Y1, 704(SP)
+	VPSLLQ  $0x03, Y0, Y1
+	VPADDQ  Y0, Y1, Y1
+	VPADDQ  Y1, Y1, Y1
+	VMOVDQU Y1, 736(SP)
+	VMOVDQU 288(CX), Y0
+	VPSLLQ  $0x03, Y0, Y1
+	VPADDQ  Y0, Y1, Y1
+	VMOVDQU Y1, 768(SP)
+	VPSLLQ  $0x03, Y0, Y1
+	VPADDQ  Y0, Y1, Y1
+	VPADDQ  Y1, Y1, Y1
+	VMOVDQU Y1, 800(SP)
+
+	// x[0]*1
+	VMOVDQU  (CX), Y0
+	VPMULUDQ (CX), Y0, Y0
+
+	// x[0]*2
+	VMOVDQU  (CX), Y1
+	VPADDQ   Y1, Y1, Y1
+	VPMULUDQ 32(CX), Y1, Y2
+	VPMULUDQ 64(CX), Y1, Y3
+	VPMULUDQ 96(CX), Y1, Y4
+	VPMULUDQ 128(CX), Y1, Y5
+	VPMULUDQ 160(CX), Y1, Y6
+	VPMULUDQ 192(CX), Y1, Y7
+	VPMULUDQ 224(CX), Y1, Y8
+	VPMULUDQ 256(CX), Y1, Y9
+	VPMULUDQ 288(CX), Y1, Y1
+
+	// x[1]*2
+	VMOVDQU  32(CX), Y10
+	VPADDQ   Y10, Y10, Y10
+	VPMULUDQ 32(CX), Y10, Y11
+	VPADDQ   Y11, Y3, Y3
+	VPMULUDQ 320(SP), Y10, Y11
+	VPADDQ   Y11, Y4, Y4
+	VPMULUDQ 352(SP), Y10, Y11
+	VPADDQ   Y11, Y5, Y5
+	VPMULUDQ 384(SP), Y10, Y11
+	VPADDQ   Y11, Y6, Y6
+	VPMULUDQ 416(SP), Y10, Y11
+	VPADDQ   Y11, Y7, Y7
+	VPMULUDQ 480(SP), Y10, Y11
+	VPADDQ   Y11, Y8, Y8
+	VPMULUDQ 576(SP), Y10, Y11
+	VPADDQ   Y11, Y9, Y9
+	VPMULUDQ 672(SP), Y10, Y11
+	VPADDQ   Y11, Y1, Y1
+	VPMULUDQ 800(SP), Y10, Y10
+	VPADDQ   Y10, Y0, Y0
+
+	// x[2]*2
+	VMOVDQU  64(CX), Y10
+	VPADDQ   Y10, Y10, Y10
+	VPMULUDQ 64(CX), Y10, Y11
+	VPADDQ   Y11, Y5, Y5
+	VPMULUDQ 352(SP), Y10, Y11
+	VPADDQ   Y11, Y6, Y6
+	VPMULUDQ 384(SP), Y10, Y11
+	VPADDQ   Y11, Y7, Y7
+	VPMULUDQ 416(SP), Y10, Y11
+	VPADDQ   Y11, Y8, Y8
+	VPMULUDQ 480(SP), Y10, Y11
+	VPADDQ   Y11, Y9, Y9
+	VPMULUDQ 576(SP), Y10, Y11
+	VPADDQ   Y11, Y1, Y1
+	VPMULUDQ 736(SP), Y10, Y11
+	VPADDQ   Y11, Y0, Y0
+	VPMULUDQ 768(SP), Y10, Y10
+	VPADDQ   Y10, Y2, Y2
+
+	// x[3]*2
+	VMOVDQU  96(CX), Y10
+	VPADDQ   Y10, Y10, Y10
+	VPMULUDQ 96(CX), Y10, Y11
+	VPADDQ   Y11, Y7, Y7
+	VPMULUDQ 384(SP), Y10, Y11
+	VPADDQ   Y11, Y8, Y8
+	VPMULUDQ 416(SP), Y10, Y11
+	VPADDQ   Y11, Y9, Y9
+	VPMULUDQ 480(SP), Y10, Y11
+	VPADDQ   Y11, Y1, Y1
+	VPMULUDQ 640(SP), Y10, Y11
+	VPADDQ   Y11, Y0, Y0
+	VPMULUDQ 704(SP), Y10, Y11
+	VPADDQ   Y11, Y2, Y2
+	VPMULUDQ 768(SP), Y10, Y10
+	VPADDQ   Y10, Y3, Y3
+
+	// x[4]*2
+	VMOVDQU  128(CX), Y10
+	VPADDQ   Y10, Y10, Y10
+	VPMULUDQ 128(CX), Y10, Y11
+	VPADDQ   Y11, Y9, Y9
+	VPMULUDQ 416(SP), Y10, Y11
+	VPADDQ   Y11, Y1, Y1
+	VPMULUDQ 544(SP), Y10, Y11
+	VPADDQ   Y11, Y0, Y0
+	VPMULUDQ 608(SP), Y10, Y11
+	VPADDQ   Y11, Y2, Y2
+	VPMULUDQ 704(SP), Y10, Y11
+	VPADDQ   Y11, Y3, Y3
+	VPMULUDQ 768(SP), Y10, Y10
+	VPADDQ   Y10, Y4, Y4
+
+	// x[5]*2
+	VMOVDQU  160(CX), Y10
+	VPADDQ   Y10, Y10, Y10
+	VPMULUDQ 448(SP), Y10, Y11
+	VPADDQ   Y11, Y0, Y0
+	VPMULUDQ 512(SP), Y10, Y11
+	VPADDQ   Y11, Y2, Y2
+	VPMULUDQ 608(SP), Y10, Y11
+	VPADDQ   Y11, Y3, Y3
+	VPMULUDQ 704(SP), Y10, Y11
+	VPADDQ   Y11, Y4, Y4
+	VPMULUDQ 768(SP), Y10, Y10
+	VPADDQ   Y10, Y5, Y5
+
+	// x[6]*1
+	VMOVDQU  192(CX), Y10
+	VPMULUDQ 512(SP), Y10, Y10
+	VPADDQ   Y10, Y3, Y3
+
+	// x[6]*2
+	VMOVDQU  192(CX), Y10
+	VPADDQ   Y10, Y10, Y10
+	VPMULUDQ 608(SP), Y10, Y11
+	VPADDQ   Y11, Y4, Y4
+	VPMULUDQ 704(SP), Y10, Y11
+	VPADDQ   Y11, Y5, Y5
+	VPMULUDQ 768(SP), Y10, Y10
+	VPADDQ   Y10, Y6, Y6
+
+	// x[7]*1
+	VMOVDQU  224(CX), Y10
+	VPMULUDQ 608(SP), Y10, Y10
+	VPADDQ   Y10, Y5, Y5
+
+	// x[7]*2
+	VMOVDQU  224(CX), Y10
+	VPADDQ   Y10, Y10, Y10
+	VPMULUDQ 704(SP), Y10, Y11
+	VPADDQ   Y11, Y6, Y6
+	VPMULUDQ 768(SP), Y10, Y10
+	VPADDQ   Y10, Y7, Y7
+
+	// x[8]*1
+	VMOVDQU  256(CX), Y10
+	VPMULUDQ 704(SP), Y10, Y10
+	VPADDQ   Y10, Y7, Y7
+
+	// x[8]*2
+	VMOVDQU  256(CX), Y10
+	VPADDQ   Y10, Y10, Y10
+	VPMULUDQ 768(SP), Y10, Y10
+	VPADDQ   Y10, Y8, Y8
+
+	// x[9]*1
+	VMOVDQU      288(CX), Y10
+	VPMULUDQ     768(SP), Y10, Y10
+	VPADDQ       Y10, Y9, Y9
+	VPBROADCASTQ mask25<>+0(SB), Y10
+	VPBROADCASTQ mask26<>+0(SB), Y11
+
+	// Carry
+	VPSRLQ $0x1a, Y0, Y12
+	VPAND  Y11, Y0, Y0
+	VPADDQ Y12, Y2, Y2
+	VPSRLQ $0x19, Y2, Y12
+	VPAND  Y10, Y2, Y2
+	VPADDQ Y12, Y3, Y3
+	VPSRLQ $0x19, Y3, Y12
+	VPAND  Y10, Y3, Y3
+	VPADDQ Y12, Y4, Y4
+	VPSRLQ $0x19, Y4, Y12
+	VPAND  Y10, Y4, Y4
+	VPADDQ Y12, Y5, Y5
+	VPSRLQ $0x19, Y5, Y12
+	VPAND  Y10, Y5, Y5
+	VPADDQ Y12, Y6, Y6
+	VPSRLQ $0x19, Y6, Y12
+	VPAND  Y10, Y6, Y6
+	VPADDQ Y12, Y7, Y7
+	VPSRLQ $0x19, Y7, Y12
+	VPAND  Y10, Y7, Y7
+	VPADDQ Y12, Y8, Y8
+	VPSRLQ $0x19, Y8, Y12
+	VPAND  Y10, Y8, Y8
+	VPADDQ Y12, Y9, Y9
+	VPSRLQ $0x19, Y9, Y12
+	VPAND  Y10, Y9, Y9
+	VPADDQ Y12, Y1, Y1
+
+	// Fold bits above 2^251 with 2^251 == 9
+	VPSRLQ  $0x19, Y1, Y12
+	VPAND   Y10, Y1, Y1
+	VPSLLQ  $0x03, Y12, Y13
+	VPADDQ  Y12, Y13, Y13
+	VPADDQ  Y13, Y0, Y0
+	VPSRLQ  $0x1a, Y0, Y12
+	VPAND   Y11, Y0, Y0
+	VPADDQ  Y12, Y2, Y2
+	VPSRLQ  $0x19, Y2, Y11
+	VPAND   Y10, Y2, Y2
+	VPADDQ  Y11, Y3, Y3
+	VPSRLQ  $0x19, Y3, Y11
+	VPAND   Y10, Y3, Y3
+	VPADDQ  Y11, Y4, Y4
+	VPSRLQ  $0x19, Y4, Y11
+	VPAND   Y10, Y4, Y4
+	VPADDQ  Y11, Y5, Y5
+	VPSRLQ  $0x19, Y5, Y11
+	VPAND   Y10, Y5, Y5
+	VPADDQ  Y11, Y6, Y6
+	VPSRLQ  $0x19, Y6, Y11
+	VPAND   Y10, Y6, Y6
+	VPADDQ  Y11, Y7, Y7
+	VPSRLQ  $0x19, Y7, Y11
+	VPAND   Y10, Y7, Y7
+	VPADDQ  Y11, Y8, Y8
+	VPSRLQ  $0x19, Y8, Y11
+	VPAND   Y10, Y8, Y8
+	VPADDQ  Y11, Y9, Y9
+	VPSRLQ  $0x19, Y9, Y11
+	VPAND   Y10, Y9, Y9
+	VPADDQ  Y11, Y1, Y1
+	LEAQ    832(SP), AX
+	VMOVDQU Y0, (AX)
+	VMOVDQU Y2, 32(AX)
+	VMOVDQU Y3, 64(AX)
+	VMOVDQU Y4, 96(AX)
+	VMOVDQU Y5, 128(AX)
+	VMOVDQU Y6, 160(AX)
+	VMOVDQU Y7, 192(AX)
+	VMOVDQU Y8, 224(AX)
+	VMOVDQU Y9, 256(AX)
+	VMOVDQU Y1, 288(AX)
+	MOVQ    res+0(FP), CX
+
+	// Combine E, F, G and H
+	LEAQ         1152(SP), DX
+	VMOVDQU      (AX), Y0
+	VPERMQ       $0xc0, Y0, Y1
+	VPERMQ       $0x15, Y0, Y2
+	VPERMQ       $0x6a, Y0, Y3
+	VPSLLVQ      doubleShift<>+0(SB), Y3, Y3
+	VPBROADCASTQ bias4p0<>+0(SB), Y4
+	VPSUBQ       Y2, Y4, Y0
+	VPBLENDD     $0xcc, Y0, Y2, Y2
+	VPADDQ       Y2, Y1, Y1
+	VPADDQ       Y4, Y1, Y1
+	VPSUBQ       Y3, Y1, Y1
+	VMOVDQU      Y1, (DX)
+	VMOVDQU      32(AX), Y0
+	VPERMQ       $0xc0, Y0, Y1
+	VPERMQ       $0x15, Y0, Y2
+	VPERMQ       $0x6a, Y0, Y3
+	VPSLLVQ      doubleShift<>+0(SB), Y3, Y3
+	VPBROADCASTQ bias4p<>+0(SB), Y4
+	VPSUBQ       Y2, Y4, Y0
+	VPBLENDD     $0xcc, Y0, Y2, Y2
+	VPADDQ       Y2, Y1, Y1
+	VPADDQ       Y4, Y1, Y1
+	VPSUBQ       Y3, Y1, Y1
+	VMOVDQU      Y1, 32(DX)
+	VMOVDQU      64(AX), Y0
+	VPERMQ       $0xc0, Y0, Y1
+	VPERMQ       $0x15, Y0, Y2
+	VPERMQ       $0x6a, Y0, Y3
+	VPSLLVQ      doubleShift<>+0(SB), Y3, Y3
+	VPBROADCASTQ bias4p<>+0(SB), Y4
+	VPSUBQ       Y2, Y4, Y0
+	VPBLENDD     $0xcc, Y0, Y2, Y2
+	VPADDQ       Y2, Y1, Y1
+	VPADDQ       Y4, Y1, Y1
+	VPSUBQ       Y3, Y1, Y1
+	VMOVDQU      Y1, 64(DX)
+	VMOVDQU      96(AX), Y0
+	VPERMQ       $0xc0, Y0, Y1
+	VPERMQ       $0x15, Y0, Y2
+	VPERMQ       $0x6a, Y0, Y3
+	VPSLLVQ      doubleShift<>+0(SB), Y3, Y3
+	VPBROADCASTQ bias4p<>+0(SB), Y4
+	VPSUBQ       Y2, Y4, Y0
+	VPBLENDD     $0xcc, Y0, Y2, Y2
+	VPADDQ       Y2, Y1, Y1
+	VPADDQ       Y4, Y1, Y1
+	VPSUBQ       Y3, Y1, Y1
+	VMOVDQU      Y1, 96(DX)
+	VMOVDQU      128(AX), Y0
+	VPERMQ       $0xc0, Y0, Y1
+	VPERMQ       $0x15, Y0, Y2
+	VPERMQ       $0x6a, Y0, Y3
+	VPSLLVQ      doubleShift<>+0(SB), Y3, Y3
+	VPBROADCASTQ bias4p<>+0(SB), Y4
+	VPSUBQ       Y2, Y4, Y0
+	VPBLENDD     $0xcc, Y0, Y2, Y2
+	VPADDQ       Y2, Y1, Y1
+	VPADDQ       Y4, Y1, Y1
+	VPSUBQ       Y3, Y1, Y1
+	VMOVDQU      Y1, 128(DX)
+	VMOVDQU      160(AX), Y0
+	VPERMQ       $0xc0, Y0, Y1
+	VPERMQ       $0x15, Y0, Y2
+	VPERMQ       $0x6a, Y0, Y3
+	VPSLLVQ      doubleShift<>+0(SB), Y3, Y3
+	VPBROADCASTQ bias4p<>+0(SB), Y4
+	VPSUBQ       Y2, Y4, Y0
+	VPBLENDD     $0xcc, Y0, Y2, Y2
+	VPADDQ       Y2, Y1, Y1
+	VPADDQ       Y4, Y1, Y1
+	VPSUBQ       Y3, Y1, Y1
+	VMOVDQU      Y1, 160(DX)
+	VMOVDQU      192(AX), Y0
+	VPERMQ       $0xc0, Y0, Y1
+	VPERMQ       $0x15, Y0, Y2
+	VPERMQ       $0x6a, Y0, Y3
+	VPSLLVQ      doubleShift<>+0(SB), Y3, Y3
+	VPBROADCASTQ bias4p<>+0(SB), Y4
+	VPSUBQ       Y2, Y4, Y0
+	VPBLENDD     $0xcc, Y0, Y2, Y2
+	VPADDQ       Y2, Y1, Y1
+	VPADDQ       Y4, Y1, Y1
+	VPSUBQ       Y3, Y1, Y1
+	VMOVDQU      Y1, 192(DX)
+	VMOVDQU      224(AX), Y0
+	VPERMQ       $0xc0, Y0, Y1
+	VPERMQ       $0x15, Y0, Y2
+	VPERMQ       $0x6a, Y0, Y3
+	VPSLLVQ      doubleShift<>+0(SB), Y3, Y3
+	VPBROADCASTQ bias4p<>+0(SB), Y4
+	VPSUBQ       Y2, Y4, Y0
+	VPBLENDD     $0xcc, Y0, Y2, Y2
+	VPADDQ       Y2, Y1, Y1
+	VPADDQ       Y4, Y1, Y1
+	VPSUBQ       Y3, Y1, Y1
+	VMOVDQU      Y1, 224(DX)
+	VMOVDQU      256(AX), Y0
+	VPERMQ       $0xc0, Y0, Y1
+	VPERMQ       $0x15, Y0, Y2
+	VPERMQ       $0x6a, Y0, Y3
+	VPSLLVQ      doubleShift<>+0(SB), Y3, Y3
+	VPBROADCASTQ bias4p<>+0(SB), Y4
+	VPSUBQ       Y2, Y4, Y0
+	VPBLENDD     $0xcc, Y0, Y2, Y2
+	VPADDQ       Y2, Y1, Y1
+	VPADDQ       Y4, Y1, Y1
+	VPSUBQ       Y3, Y1, Y1
+	VMOVDQU      Y1, 256(DX)
+	VMOVDQU      288(AX), Y0
+	VPERMQ       $0xc0, Y0, Y1
+	VPERMQ       $0x15, Y0, Y2
+	VPERMQ       $0x6a, Y0, Y3
+	VPSLLVQ      doubleShift<>+0(SB), Y3, Y3
+	VPBROADCASTQ bias4p<>+0(SB), Y4
+	VPSUBQ       Y2, Y4, Y0
+	VPBLENDD     $0xcc, Y0, Y2, Y2
+	VPADDQ       Y2, Y1, Y1
+	VPADDQ       Y4, Y1, Y1
+	VPSUBQ       Y3, Y1, Y1
+	VMOVDQU      Y1, 288(DX)
+
+	// Normalize limbs (one parallel carry pass)
+	VPBROADCASTQ mask25<>+0(SB), Y0
+	VPBROADCASTQ mask26<>+0(SB), Y1
+	VMOVDQU      (DX), Y2
+	VMOVDQU      32(DX), Y3
+	VMOVDQU      64(DX), Y4
+	VMOVDQU      96(DX), Y5
+	VMOVDQU      128(DX), Y6
+	VMOVDQU      160(DX), Y7
+	VMOVDQU      192(DX), Y8
+	VMOVDQU      224(DX), Y9
+	VMOVDQU      256(DX), Y10
+	VMOVDQU      288(DX), Y11
+	VPSRLQ       $0x19, Y11, Y12
+	VPAND        Y0, Y11, Y11
+	VPSLLQ       $0x03, Y12, Y13
+	VPADDQ       Y12, Y13, Y13
+	VPADDQ       Y13, Y2, Y2
+	VPSRLQ       $0x19, Y10, Y12
+	VPAND        Y0, Y10, Y10
+	VPADDQ       Y12, Y11, Y11
+	VPSRLQ       $0x19, Y9, Y12
+	VPAND        Y0, Y9, Y9
+	VPADDQ       Y12, Y10, Y10
+	VPSRLQ       $0x19, Y8, Y12
+	VPAND        Y0, Y8, Y8
+	VPADDQ       Y12, Y9, Y9
+	VPSRLQ       $0x19, Y7, Y12
+	VPAND        Y0, Y7, Y7
+	VPADDQ       Y12, Y8, Y8
+	VPSRLQ       $0x19, Y6, Y12
+	VPAND        Y0, Y6, Y6
+	VPADDQ       Y12, Y7, Y7
+	VPSRLQ       $0x19, Y5, Y12
+	VPAND        Y0, Y5, Y5
+	VPADDQ       Y12, Y6, Y6
+	VPSRLQ       $0x19, Y4, Y12
+	VPAND        Y0, Y4, Y4
+	VPADDQ       Y12, Y5, Y5
+	VPSRLQ       $0x19, Y3, Y12
+	VPAND        Y0, Y3, Y3
+	VPADDQ       Y12, Y4, Y4
+	VPSRLQ       $0x1a, Y2, Y0
+	VPAND        Y1, Y2, Y2
+	VPADDQ       Y0, Y3, Y3
+	LEAQ         1472(SP), AX
+	LEAQ         1792(SP), DX
+	VPERMQ       $0xe3, Y2, Y0
+	VPERMQ       $0x46, Y2, Y1
+	VMOVDQU      Y0, (AX)
+	VMOVDQU      Y1, (DX)
+	VPERMQ       $0xe3, Y3, Y0
+	VPERMQ       $0x46, Y3, Y1
+	VMOVDQU      Y0, 32(AX)
+	VMOVDQU      Y1, 32(DX)
+	VPERMQ       $0xe3, Y4, Y0
+	VPERMQ       $0x46, Y4, Y1
+	VMOVDQU      Y0, 64(AX)
+	VMOVDQU      Y1, 64(DX)
+	VPERMQ       $0xe3, Y5, Y0
+	VPERMQ       $0x46, Y5, Y1
+	VMOVDQU      Y0, 96(AX)
+	VMOVDQU      Y1, 96(DX)
+	VPERMQ       $0xe3, Y6, Y0
+	VPERMQ       $0x46, Y6, Y1
+	VMOVDQU      Y0, 128(AX)
+	VMOVDQU      Y1, 128(DX)
+	VPERMQ       $0xe3, Y7, Y0
+	VPERMQ       $0x46, Y7, Y1
+	VMOVDQU      Y0, 160(AX)
+	VMOVDQU      Y1, 160(DX)
+	VPERMQ       $0xe3, Y8, Y0
+	VPERMQ       $0x46, Y8, Y1
+	VMOVDQU      Y0, 192(AX)
+	VMOVDQU      Y1, 192(DX)
+	VPERMQ       $0xe3, Y9, Y0
+	VPERMQ       $0x46, Y9, Y1
+	VMOVDQU      Y0, 224(AX)
+	VMOVDQU      Y1, 224(DX)
+	VPERMQ       $0xe3, Y10, Y0
+	VPERMQ       $0x46, Y10, Y1
+	VMOVDQU      Y0, 256(AX)
+	VMOVDQU      Y1, 256(DX)
+	VPERMQ       $0xe3, Y11, Y0
+	VPERMQ       $0x46, Y11, Y1
+	VMOVDQU      Y0, 288(AX)
+	VMOVDQU      Y1, 288(DX)
+
+	// (X, Y, Z, T) = (EF, GH, FG, EH)
+	// Scaled limbs
+	VMOVDQU 32(DX), Y0
+	VPSLLQ  $0x03, Y0, Y1
+	VPADDQ  Y0, Y1, Y1
+	VMOVDQU Y1, 2112(SP)
+	VMOVDQU 64(DX), Y0
+	VPSLLQ  $0x03, Y0, Y1
+	VPADDQ  Y0, Y1, Y1
+	VMOVDQU Y1, 2144(SP)
+	VMOVDQU 96(DX), Y0
+	VPSLLQ  $0x03, Y0, Y1
+	VPADDQ  Y0, Y1, Y1
+	VMOVDQU Y1, 2176(SP)
+	VMOVDQU 128(DX), Y0
+	VPSLLQ  $0x03, Y0, Y1
+	VPADDQ  Y0, Y1, Y1
+	VMOVDQU Y1, 2208(SP)
+	VMOVDQU 160(DX), Y0
+	VPSLLQ  $0x03, Y0, Y1
+	VPADDQ  Y0, Y1, Y1
+	VMOVDQU Y1, 2240(SP)
+	VMOVDQU 192(DX), Y0
+	VPSLLQ  $0x03, Y0, Y1
+	VPADDQ  Y0, Y1, Y1
+	VMOVDQU Y1, 2272(SP)
+	VMOVDQU 224(DX), Y0
+	VPSLLQ  $0x03, Y0, Y1
+	VPADDQ  Y0, Y1, Y1
+	VMOVDQU Y1, 2304(SP)
+	VMOVDQU 256(DX), Y0
+	VPSLLQ  $0x03, Y0, Y1
+	VPADDQ  Y0, Y1, Y1
+	VMOVDQU Y1, 2336(SP)
+	VMOVDQU 288(DX), Y0
+	VPSLLQ  $0x03, Y0, Y1
+	VPADDQ  Y0, Y1, Y1
+	VMOVDQU Y1, 2368(SP)
+
+	// x[0]*1
+	VMOVDQU  (AX), Y0
+	VPMULUDQ (DX), Y0, Y1
+	VPMULUDQ 32(DX), Y0, Y2
+	VPMULUDQ 64(DX), Y0, Y3
+	VPMULUDQ 96(DX), Y0, Y4
+	VPMULUDQ 128(DX), Y0, Y5
+	VPMULUDQ 160(DX), Y0, Y6
+	VPMULUDQ 192(DX), Y0, Y7
+	VPMULUDQ 224(DX), Y0, Y8
+	VPMULUDQ 256(DX), Y0, Y9
+	VPMULUDQ 288(DX), Y0, Y0
+
+	// x[1]*1
+	VMOVDQU  32(AX), Y10
+	VPMULUDQ (DX), Y10, Y10
+	VPADDQ   Y10, Y2, Y2
+
+	// x[1]*2
+	VMOVDQU  32(AX), Y10
+	VPADDQ   Y10, Y10, Y10
+	VPMULUDQ 32(DX), Y10, Y11
+	VPADDQ   Y11, Y3, Y3
+	VPMULUDQ 64(DX), Y10, Y11
+	VPADDQ   Y11, Y4, Y4
+	VPMULUDQ 96(DX), Y10, Y11
+	VPADDQ   Y11, Y5, Y5
+	VPMULUDQ 128(DX), Y10, Y11
+	VPADDQ   Y11, Y6, Y6
+	VPMULUDQ 160(DX), Y10, Y11
+	VPADDQ   Y11, Y7, Y7
+	VPMULUDQ 192(DX), Y10, Y11
+	VPADDQ   Y11, Y8, Y8
+	VPMULUDQ 224(DX), Y10, Y11
+	VPADDQ   Y11, Y9, Y9
+	VPMULUDQ 256(DX), Y10, Y11
+	VPADDQ   Y11, Y0, Y0
+	VPMULUDQ 2368(SP), Y10, Y10
+	VPADDQ   Y10, Y1, Y1
+
+	// x[2]*1
+	VMOVDQU  64(AX), Y10
+	VPMULUDQ (DX), Y10, Y11
+	VPADDQ   Y11, Y3, Y3
+	VPMULUDQ 2368(SP), Y10, Y10
+	VPADDQ   Y10, Y2, Y2
+
+	// x[2]*2
+	VMOVDQU  64(AX), Y10
+	VPADDQ   Y10, Y10, Y10
+	VPMULUDQ 32(DX), Y10, Y11
+	VPADDQ   Y11, Y4, Y4
+	VPMULUDQ 64(DX), Y10, Y11
+	VPADDQ   Y11, Y5, Y5
+	VPMULUDQ 96(DX), Y10, Y11
+	VPADDQ   Y11, Y6, Y6
+	VPMULUDQ 128(DX), Y10, Y11
+	VPADDQ   Y11, Y7, Y7
+	VPMULUDQ 160(DX), Y10, Y11
+	VPADDQ   Y11, Y8, Y8
+	VPMULUDQ 192(DX), Y10, Y11
+	VPADDQ   Y11, Y9, Y9
+	VPMULUDQ 224(DX), Y10, Y11
+	VPADDQ   Y11, Y0, Y0
+	VPMULUDQ 2336(SP), Y10, Y10
+	VPADDQ   Y10, Y1, Y1
+
+	// x[3]*1
+	VMOVDQU  96(AX), Y10
+	VPMULUDQ (DX), Y10, Y11
+	VPADDQ   Y11, Y4, Y4
+	VPMULUDQ 2336(SP), Y10, Y11
+	VPADDQ   Y11, Y2, Y2
+	VPMULUDQ 2368(SP), Y10, Y10
+	VPADDQ   Y10, Y3, Y3
+
+	// x[3]*2
+	VMOVDQU  96(AX), Y10
+	VPADDQ   Y10, Y10, Y10
+	VPMULUDQ 32(DX), Y10, Y11
+	VPADDQ   Y11, Y5, Y5
+	VPMULUDQ 64(DX), Y10, Y11
+	VPADDQ   Y11, Y6, Y6
+	VPMULUDQ 96(DX), Y10, Y11
+	VPADDQ   Y11, Y7, Y7
+	VPMULUDQ 128(DX), Y10, Y11
+	VPADDQ   Y11, Y8, Y8
+	VPMULUDQ 160(DX), Y10, Y11
+	VPADDQ   Y11, Y9, Y9
+	VPMULUDQ 192(DX), Y10, Y11
+	VPADDQ   Y11, Y0, Y0
+	VPMULUDQ 2304(SP), Y10, Y10
+	VPADDQ   Y10, Y1, Y1
+
+	// x[4]*1
+	VMOVDQU  128(AX), Y10
+	VPMULUDQ (DX), Y10, Y11
+	VPADDQ   Y11, Y5, Y5
+	VPMULUDQ 2304(SP), Y10, Y11
+	VPADDQ   Y11, Y2, Y2
+	VPMULUDQ 2336(SP), Y10, Y11
+	VPADDQ   Y11, Y3, Y3
+	VPMULUDQ 2368(SP), Y10, Y10
+	VPADDQ   Y10, Y4, Y4
+
+	// x[4]*2
+	VMOVDQU  128(AX), Y10
+	VPADDQ   Y10, Y10, Y10
+	VPMULUDQ 32(DX), Y10, Y11
+	VPADDQ   Y11, Y6, Y6
+	VPMULUDQ 64(DX), Y10, Y11
+	VPADDQ   Y11, Y7, Y7
+	VPMULUDQ 96(DX), Y10, Y11
+	VPADDQ   Y11, Y8, Y8
+	VPMULUDQ 128(DX), Y10, Y11
+	VPADDQ   Y11, Y9, Y9
+	VPMULUDQ 160(DX), Y10, Y11
+	VPADDQ   Y11, Y0, Y0
+	VPMULUDQ 2272(SP), Y10, Y10
+	VPADDQ   Y10, Y1, Y1
+
+	// x[5]*1
+	VMOVDQU  160(AX), Y10
+	VPMULUDQ (DX), Y10, Y11
+	VPADDQ   Y11, Y6, Y6
+	VPMULUDQ 2272(SP), Y10, Y11
+	VPADDQ   Y11, Y2, Y2
+	VPMULUDQ 2304(SP), Y10, Y11
+	VPADDQ   Y11, Y3, Y3
+	VPMULUDQ 2336(SP), Y10, Y11
+	VPADDQ   Y11, Y4, Y4
+	VPMULUDQ 2368(SP), Y10, Y10
+	VPADDQ   Y10, Y5, Y5
+
+	// x[5]*2
+	VMOVDQU  160(AX), Y10
+	VPADDQ   Y10, Y10, Y10
+	VPMULUDQ 32(DX), Y10, Y11
+	VPADDQ   Y11, Y7, Y7
+	VPMULUDQ 64(DX), Y10, Y11
+	VPADDQ   Y11, Y8, Y8
+	VPMULUDQ 96(DX), Y10, Y11
+	VPADDQ   Y11, Y9, Y9
+	VPMULUDQ 128(DX), Y10, Y11
+	VPADDQ   Y11, Y0, Y0
+	VPMULUDQ 2240(SP), Y10, Y10
+	VPADDQ   Y10, Y1, Y1
+
+	// x[6]*1
+	VMOVDQU  192(AX), Y10
+	VPMULUDQ (DX), Y10, Y11
+	VPADDQ   Y11, Y7, Y7
+	VPMULUDQ 2240(SP), Y10, Y11
+	VPADDQ   Y11, Y2, Y2
+	VPMULUDQ 2272(SP), Y10, Y11
+	VPADDQ   Y11, Y3, Y3
+	VPMULUDQ 2304(SP), Y10, Y11
+	VPADDQ   Y11, Y4, Y4
+	VPMULUDQ 2336(SP), Y10, Y11
+	VPADDQ   Y11, Y5, Y5
+	VPMULUDQ 2368(SP), Y10, Y10
+	VPADDQ   Y10, Y6, Y6
+
+	// x[6]*2
+	VMOVDQU  192(AX), Y10
+	VPADDQ   Y10, Y10, Y10
+	VPMULUDQ 32(DX), Y10, Y11
+	VPADDQ   Y11, Y8, Y8
+	VPMULUDQ 64(DX), Y10, Y11
+	VPADDQ   Y11, Y9, Y9
+	VPMULUDQ 96(DX), Y10, Y11
+	VPADDQ   Y11, Y0, Y0
+	VPMULUDQ 2208(SP), Y10, Y10
+	VPADDQ   Y10, Y1, Y1
+
+	// x[7]*1
+	VMOVDQU  224(AX), Y10
+	VPMULUDQ (DX), Y10, Y11
+	VPADDQ   Y11, Y8, Y8
+	VPMULUDQ 2208(SP), Y10, Y11
+	VPADDQ   Y11, Y2, Y2
+	VPMULUDQ 2240(SP), Y10, Y11
+	VPADDQ   Y11, Y3, Y3
+	VPMULUDQ 2272(SP), Y10, Y11
+	VPADDQ   Y11, Y4, Y4
+	VPMULUDQ 2304(SP), Y10, Y11
+	VPADDQ   Y11, Y5, Y5
+	VPMULUDQ 2336(SP), Y10, Y11
+	VPADDQ   Y11, Y6, Y6
+	VPMULUDQ 2368(SP), Y10, Y10
+	VPADDQ   Y10, Y7, Y7
+
+	// x[7]*2
+	VMOVDQU  224(AX), Y10
+	VPADDQ   Y10, Y10, Y10
+	VPMULUDQ 32(DX), Y10, Y11
+	VPADDQ   Y11, Y9, Y9
+	VPMULUDQ 64(DX), Y10, Y11
+	VPADDQ   Y11, Y0, Y0
+	VPMULUDQ 2176(SP), Y10, Y10
+	VPADDQ   Y10, Y1, Y1
+
+	// x[8]*1
+	VMOVDQU  256(AX), Y10
+	VPMULUDQ (DX), Y10, Y11
+	VPADDQ   Y11, Y9, Y9
+	VPMULUDQ 2176(SP), Y10, Y11
+	VPADDQ   Y11, Y2, Y2
+	VPMULUDQ 2208(SP), Y10, Y11
+	VPADDQ   Y11, Y3, Y3
+	VPMULUDQ 2240(SP), Y10, Y11
+	VPADDQ   Y11, Y4, Y4
+	VPMULUDQ 2272(SP), Y10, Y11
+	VPADDQ   Y11, Y5, Y5
+	VPMULUDQ 2304(SP), Y10, Y11
+	VPADDQ   Y11, Y6, Y6
+	VPMULUDQ 2336(SP), Y10, Y11
+	VPADDQ   Y11, Y7, Y7
+	VPMULUDQ 2368(SP), Y10, Y10
+	VPADDQ   Y10, Y8, Y8
+
+	// x[8]*2
+	VMOVDQU  256(AX), Y10
+	VPADDQ   Y10, Y10, Y10
+	VPMULUDQ 32(DX), Y10, Y11
+	VPADDQ   Y11, Y0, Y0
+	VPMULUDQ 2144(SP), Y10, Y10
+	VPADDQ   Y10, Y1, Y1
+
+	// x[9]*1
+	VMOVDQU  288(AX), Y10
+	VPMULUDQ (DX), Y10, Y11
+	VPADDQ   Y11, Y0, Y0
+	VPMULUDQ 2144(SP), Y10, Y11
+	VPADDQ   Y11, Y2, Y2
+	VPMULUDQ 2176(SP), Y10, Y11
+	VPADDQ   Y11, Y3, Y3
+	VPMULUDQ 2208(SP), Y10, Y11
+	VPADDQ   Y11, Y4, Y4
+	VPMULUDQ 2240(SP), Y10, Y11
+	VPADDQ   Y11, Y5, Y5
+	VPMULUDQ 2272(SP), Y10, Y11
+	VPADDQ   Y11, Y6, Y6
+	VPMULUDQ 2304(SP), Y10, Y11
+	VPADDQ   Y11, Y7, Y7
+	VPMULUDQ 2336(SP), Y10, Y11
+	VPADDQ   Y11, Y8, Y8
+	VPMULUDQ 2368(SP), Y10, Y10
+	VPADDQ   Y10, Y9, Y9
+
+	// x[9]*2
+	VMOVDQU      288(AX), Y10
+	VPADDQ       Y10, Y10, Y10
+	VPMULUDQ     2112(SP), Y10, Y10
+	VPADDQ       Y10, Y1, Y1
+	VPBROADCASTQ mask25<>+0(SB), Y10
+	VPBROADCASTQ mask26<>+0(SB), Y11
+
+	// Carry
+	VPSRLQ $0x1a, Y1, Y12
+	VPAND  Y11, Y1, Y1
+	VPADDQ Y12, Y2, Y2
+	VPSRLQ $0x19, Y2, Y12
+	VPAND  Y10, Y2, Y2
+	VPADDQ Y12, Y3, Y3
+	VPSRLQ $0x19, Y3, Y12
+	VPAND  Y10, Y3, Y3
+	VPADDQ Y12, Y4, Y4
+	VPSRLQ $0x19, Y4, Y12
+	VPAND  Y10, Y4, Y4
+	VPADDQ Y12, Y5, Y5
+	VPSRLQ $0x19, Y5, Y12
+	VPAND  Y10, Y5, Y5
+	VPADDQ Y12, Y6, Y6
+	VPSRLQ $0x19, Y6, Y12
+	VPAND  Y10, Y6, Y6
+	VPADDQ Y12, Y7, Y7
+	VPSRLQ $0x19, Y7, Y12
+	VPAND  Y10, Y7, Y7
+	VPADDQ Y12, Y8, Y8
+	VPSRLQ $0x19, Y8, Y12
+	VPAND  Y10, Y8, Y8
+	VPADDQ Y12, Y9, Y9
+	VPSRLQ $0x19, Y9, Y12
+	VPAND  Y10, Y9, Y9
+	VPADDQ Y12, Y0, Y0
+
+	// Fold bits above 2^251 with 2^251 == 9
+	VPSRLQ  $0x19, Y0, Y12
+	VPAND   Y10, Y0, Y0
+	VPSLLQ  $0x03, Y12, Y13
+	VPADDQ  Y12, Y13, Y13
+	VPADDQ  Y13, Y1, Y1
+	VPSRLQ  $0x1a, Y1, Y12
+	VPAND   Y11, Y1, Y1
+	VPADDQ  Y12, Y2, Y2
+	VPSRLQ  $0x19, Y2, Y11
+	VPAND   Y10, Y2, Y2
+	VPADDQ  Y11, Y3, Y3
+	VPSRLQ  $0x19, Y3, Y11
+	VPAND   Y10, Y3, Y3
+	VPADDQ  Y11, Y4, Y4
+	VPSRLQ  $0x19, Y4, Y11
+	VPAND   Y10, Y4, Y4
+	VPADDQ  Y11, Y5, Y5
+	VPSRLQ  $0x19, Y5, Y11
+	VPAND   Y10, Y5, Y5
+	VPADDQ  Y11, Y6, Y6
+	VPSRLQ  $0x19, Y6, Y11
+	VPAND   Y10, Y6, Y6
+	VPADDQ  Y11, Y7, Y7
+	VPSRLQ  $0x19, Y7, Y11
+	VPAND   Y10, Y7, Y7
+	VPADDQ  Y11, Y8, Y8
+	VPSRLQ  $0x19, Y8, Y11
+	VPAND   Y10, Y8, Y8
+	VPADDQ  Y11, Y9, Y9
+	VPSRLQ  $0x19, Y9, Y11
+	VPAND   Y10, Y9, Y9
+	VPADDQ  Y11, Y0, Y0
+	VMOVDQU Y1, (CX)
+	VMOVDQU Y2, 32(CX)
+	VMOVDQU Y3, 64(CX)
+	VMOVDQU Y4, 96(CX)
+	VMOVDQU Y5, 128(CX)
+	VMOVDQU Y6, 160(CX)
+	VMOVDQU Y7, 192(CX)
+	VMOVDQU Y8, 224(CX)
+	VMOVDQU Y9, 256(CX)
+	VMOVDQU Y0, 288(CX)
+	VZEROUPPER
+	RET
+
+// func addCached4(res *fieldElement4, p *fieldElement4, q *fieldElement4)
+// Requires: AVX, AVX2
+TEXT ·addCached4(SB), $3104-24
+	MOVQ p+8(FP), AX
+	MOVQ q+16(FP), CX
+
+	// (Y+X, Y-X, Z, T) and 2X of q
+	LEAQ         (SP), DX
+	LEAQ         320(SP), BX
+	VPXOR        Y0, Y0, Y0
+	VMOVDQU      (AX), Y1
+	VPERMQ       $0xe5, Y1, Y2
+	VPERMQ       $0x00, Y1, Y1
+	VPBROADCASTQ bias4p0<>+0(SB), Y4
+	VPSRLQ       $0x01, Y4, Y4
+	VPSUBQ       Y1, Y4, Y3
+	VPBLENDD     $0x0c, Y3, Y1, Y1
+	VPBLENDD     $0xf0, Y0, Y1, Y1
+	VPADDQ       Y1, Y2, Y2
+	VMOVDQU      Y2, (DX)
+	VPERMQ       $0x00, (CX), Y1
+	VPERMQ       $0x55, (CX), Y2
+	VPADDQ       Y4, Y1, Y1
+	VPSUBQ       Y2, Y1, Y1
+	VMOVDQU      Y1, (BX)
+	VMOVDQU      32(AX), Y1
+	VPERMQ       $0xe5, Y1, Y2
+	VPERMQ       $0x00, Y1, Y1
+	VPBROADCASTQ bias4p<>+0(SB), Y4
+	VPSRLQ       $0x01, Y4, Y4
+	VPSUBQ       Y1, Y4, Y3
+	VPBLENDD     $0x0c, Y3, Y1, Y1
+	VPBLENDD     $0xf0, Y0, Y1, Y1
+	VPADDQ       Y1, Y2, Y2
+	VMOVDQU      Y2, 32(DX)
+	VPERMQ       $0x00, 32(CX), Y1
+	VPERMQ       $0x55, 32(CX), Y2
+	VPADDQ       Y4, Y1, Y1
+	VPSUBQ       Y2, Y1, Y1
+	VMOVDQU      Y1, 32(BX)
+	VMOVDQU      64(AX), Y1
+	VPERMQ       $0xe5, Y1, Y2
+	VPERMQ       $0x00, Y1, Y1
+	VPBROADCASTQ bias4p<>+0(SB), Y4
+	VPSRLQ       $0x01, Y4, Y4
+	VPSUBQ       Y1, Y4, Y3
+	VPBLENDD     $0x0c, Y3, Y1, Y1
+	VPBLENDD     $0xf0, Y0, Y1, Y1
+	VPADDQ       Y1, Y2, Y2
+	VMOVDQU      Y2, 64(DX)
+	VPERMQ       $0x00, 64(CX), Y1
+	VPERMQ       $0x55, 64(CX), Y2
+	VPADDQ       Y4, Y1, Y1
+	VPSUBQ       Y2, Y1, Y1
+	VMOVDQU      Y1, 64(BX)
+	VMOVDQU      96(AX), Y1
+	VPERMQ       $0xe5, Y1, Y2
+	VPERMQ       $0x00, Y1, Y1
+	VPBROADCASTQ bias4p<>+0(SB), Y4
+	VPSRLQ       $0x01, Y4, Y4
+	VPSUBQ       Y1, Y4, Y3
+	VPBLENDD     $0x0c, Y3, Y1, Y1
+	VPBLENDD     $0xf0, Y0, Y1, Y1
+	VPADDQ       Y1, Y2, Y2
+	VMOVDQU      Y2, 96(DX)
+	VPERMQ       $0x00, 96(CX), Y1
+	VPERMQ       $0x55, 96(CX), Y2
+	VPADDQ       Y4, Y1, Y1
+	VPSUBQ       Y2, Y1, Y1
+	VMOVDQU      Y1, 96(BX)
+	VMOVDQU      128(AX), Y1
+	VPERMQ       $0xe5, Y1, Y2
+	VPERMQ       $0x00, Y1, Y1
+	VPBROADCASTQ bias4p<>+0(SB), Y4
+	VPSRLQ       $0x01, Y4, Y4
+	VPSUBQ       Y1, Y4, Y3
+	VPBLENDD     $0x0c, Y3, Y1, Y1
+	VPBLENDD     $0xf0, Y0, Y1, Y1
+	VPADDQ       Y1, Y2, Y2
+	VMOVDQU      Y2, 128(DX)
+	VPERMQ       $0x00, 128(CX), Y1
+	VPERMQ       $0x55, 128(CX), Y2
+	VPADDQ       Y4, Y1, Y1
+	VPSUBQ       Y2, Y1, Y1
+	VMOVDQU      Y1, 128(BX)
+	VMOVDQU      160(AX), Y1
+	VPERMQ       $0xe5, Y1, Y2
+	VPERMQ       $0x00, Y1, Y1
+	VPBROADCASTQ bias4p<>+0(SB), Y4
+	VPSRLQ       $0x01, Y4, Y4
+	VPSUBQ       Y1, Y4, Y3
+	VPBLENDD     $0x0c, Y3, Y1, Y1
+	VPBLENDD     $0xf0, Y0, Y1, Y1
+	VPADDQ       Y1, Y2, Y2
+	VMOVDQU      Y2, 160(DX)
+	VPERMQ       $0x00, 160(CX), Y1
+	VPERMQ       $0x55, 160(CX), Y2
+	VPADDQ       Y4, Y1, Y1
+	VPSUBQ       Y2, Y1, Y1
+	VMOVDQU      Y1, 160(BX)
+	VMOVDQU      192(AX), Y1
+	VPERMQ       $0xe5, Y1, Y2
+	VPERMQ       $0x00, Y1, Y1
+	VPBROADCASTQ bias4p<>+0(SB), Y4
+	VPSRLQ       $0x01, Y4, Y4
+	VPSUBQ       Y1, Y4, Y3
+	VPBLENDD     $0x0c, Y3, Y1, Y1
+	VPBLENDD     $0xf0, Y0, Y1, Y1
+	VPADDQ       Y1, Y2, Y2
+	VMOVDQU      Y2, 192(DX)
+	VPERMQ       $0x00, 192(CX), Y1
+	VPERMQ       $0x55, 192(CX), Y2
+	VPADDQ       Y4, Y1, Y1
+	VPSUBQ       Y2, Y1, Y1
+	VMOVDQU      Y1, 192(BX)
+	VMOVDQU      224(AX), Y1
+	VPERMQ       $0xe5, Y1, Y2
+	VPERMQ       $0x00, Y1, Y1
+	VPBROADCASTQ bias4p<>+0(SB), Y4
+	VPSRLQ       $0x01, Y4, Y4
+	VPSUBQ       Y1, Y4, Y3
+	VPBLENDD     $0x0c, Y3, Y1, Y1
+	VPBLENDD     $0xf0, Y0, Y1, Y1
+	VPADDQ       Y1, Y2, Y2
+	VMOVDQU      Y2, 224(DX)
+	VPERMQ       $0x00, 224(CX), Y1
+	VPERMQ       $0x55, 224(CX), Y2
+	VPADDQ       Y4, Y1, Y1
+	VPSUBQ       Y2, Y1, Y1
+	VMOVDQU      Y1, 224(BX)
+	VMOVDQU      256(AX), Y1
+	VPERMQ       $0xe5, Y1, Y2
+	VPERMQ       $0x00, Y1, Y1
+	VPBROADCASTQ bias4p<>+0(SB), Y4
+	VPSRLQ       $0x01, Y4, Y4
+	VPSUBQ       Y1, Y4, Y3
+	VPBLENDD     $0x0c, Y3, Y1, Y1
+	VPBLENDD     $0xf0, Y0, Y1, Y1
+	VPADDQ       Y1, Y2, Y2
+	VMOVDQU      Y2, 256(DX)
+	VPERMQ       $0x00, 256(CX), Y1
+	VPERMQ       $0x55, 256(CX), Y2
+	VPADDQ       Y4, Y1, Y1
+	VPSUBQ       Y2, Y1, Y1
+	VMOVDQU      Y1, 256(BX)
+	VMOVDQU      288(AX), Y1
+	VPERMQ       $0xe5, Y1, Y2
+	VPERMQ       $0x00, Y1, Y1
+	VPBROADCASTQ bias4p<>+0(SB), Y4
+	VPSRLQ       $0x01, Y4, Y4
+	VPSUBQ       Y1, Y4, Y3
+	VPBLENDD     $0x0c, Y3, Y1, Y1
+	VPBLENDD     $0xf0, Y0, Y1, Y1
+	VPADDQ       Y1, Y2, Y2
+	VMOVDQU      Y2, 288(DX)
+	VPERMQ       $0x00, 288(CX), Y0
+	VPERMQ       $0x55, 288(CX), Y1
+	VPADDQ       Y4, Y0, Y0
+	VPSUBQ       Y1, Y0, Y0
+	VMOVDQU      Y0, 288(BX)
+
+	// ((Y1+X1)(Y2+X2), (Y1-X1)(Y2-X2), 2Z1Z2, 2dT1T2)
+	// Scaled limbs
+	VMOVDQU 32(CX), Y0
+	VPSLLQ  $0x03, Y0, Y1
+	VPADDQ  Y0, Y1, Y1
+	VMOVDQU Y1, 640(SP)
+	VMOVDQU 64(CX), Y0
+	VPSLLQ  $0x03, Y0, Y1
+	VPADDQ  Y0, Y1, Y1
+	VMOVDQU Y1, 672(SP)
+	VMOVDQU 96(CX), Y0
+	VPSLLQ  $0x03, Y0, Y1
+	VPADDQ  Y0, Y1, Y1
+	VMOVDQU Y1, 704(SP)
+	VMOVDQU 128(CX), Y0
+	VPSLLQ  $0x03, Y0, Y1
+	VPADDQ  Y0, Y1, Y1
+	VMOVDQU Y1, 736(SP)
+	VMOVDQU 160(CX), Y0
+	VPSLLQ  $0x03, Y0, Y1
+	VPADDQ  Y0, Y1, Y1
+	VMOVDQU Y1, 768(SP)
+	VMOVDQU 192(CX), Y0
+	VPSLLQ  $0x03, Y0, Y1
+	VPADDQ  Y0, Y1, Y1
+	VMOVDQU Y1, 800(SP)
+	VMOVDQU 224(CX), Y0
+	VPSLLQ  $0x03, Y0, Y1
+	VPADDQ  Y0, Y1, Y1
+	VMOVDQU Y1, 832(SP)
+	VMOVDQU 256(CX), Y0
+	VPSLLQ  $0x03, Y0, Y1
+	VPADDQ  Y0, Y1, Y1
+	VMOVDQU Y1, 864(SP)
+	VMOVDQU 288(CX), Y0
+	VPSLLQ  $0x03, Y0, Y1
+	VPADDQ  Y0, Y1, Y1
+	VMOVDQU Y1, 896(SP)
+
+	// x[0]*1
+	VMOVDQU  (DX), Y0
+	VPMULUDQ (CX), Y0, Y1
+	VPMULUDQ 32(CX), Y0, Y2
+	VPMULUDQ 64(CX), Y0, Y3
+	VPMULUDQ 96(CX), Y0, Y4
+	VPMULUDQ 128(CX), Y0, Y5
+	VPMULUDQ 160(CX), Y0, Y6
+	VPMULUDQ 192(CX), Y0, Y7
+	VPMULUDQ 224(CX), Y0, Y8
+	VPMULUDQ 256(CX), Y0, Y9
+	VPMULUDQ 288(CX), Y0, Y0
+
+	// x[1]*1
+	VMOVDQU  32(DX), Y10
+	VPMULUDQ (CX), Y10, Y10
+	VPADDQ   Y10, Y2, Y2
+
+	// x[1]*2
+	VMOVDQU  32(DX), Y10
+	VPADDQ   Y10, Y10, Y10
+	VPMULUDQ 32(CX), Y10, Y11
+	VPADDQ   Y11, Y3, Y3
+	VPMULUDQ 64(CX), Y10, Y11
+	VPADDQ   Y11, Y4, Y4
+	VPMULUDQ 96(CX), Y10, Y11
+	VPADDQ   Y11, Y5, Y5
+	VPMULUDQ 128(CX), Y10, Y11
+	VPADDQ   Y11, Y6, Y6
+	VPMULUDQ 160(CX), Y10, Y11
+	VPADDQ   Y11, Y7, Y7
+	VPMULUDQ 192(CX), Y10, Y11
+	VPADDQ   Y11, Y8, Y8
+	VPMULUDQ 224(CX), Y10, Y11
+	VPADDQ   Y11, Y9, Y9
+	VPMULUDQ 256(CX), Y10, Y11
+	VPADDQ   Y11, Y0, Y0
+	VPMULUDQ 896(SP), Y10, Y10
+	VPADDQ   Y10, Y1, Y1
+
+	// x[2]*1
+	VMOVDQU  64(DX), Y10
+	VPMULUDQ (CX), Y10, Y11
+	VPADDQ   Y11, Y3, Y3
+	VPMULUDQ 896(SP), Y10, Y10
+	VPADDQ   Y10, Y2, Y2
+
+	// x[2]*2
+	VMOVDQU  64(DX), Y10
+	VPADDQ   Y10, Y10, Y10
+	VPMULUDQ 32(CX), Y10, Y11
+	VPADDQ   Y11, Y4, Y4
+	VPMULUDQ 64(CX), Y10, Y11
+	VPADDQ   Y11, Y5, Y5
+	VPMULUDQ 96(CX), Y10, Y11
+	VPADDQ   Y11, Y6, Y6
+	VPMULUDQ 128(CX), Y10, Y11
+	VPADDQ   Y11, Y7, Y7
+	VPMULUDQ 160(CX), Y10, Y11
+	VPADDQ   Y11, Y8, Y8
+	VPMULUDQ 192(CX), Y10, Y11
+	VPADDQ   Y11, Y9, Y9
+	VPMULUDQ 224(CX), Y10, Y11
+	VPADDQ   Y11, Y0, Y0
+	VPMULUDQ 864(SP), Y10, Y10
+	VPADDQ   Y10, Y1, Y1
+
+	// x[3]*1
+	VMOVDQU  96(DX), Y10
+	VPMULUDQ (CX), Y10, Y11
+	VPADDQ   Y11, Y4, Y4
+	VPMULUDQ 864(SP), Y10, Y11
+	VPADDQ   Y11, Y2, Y2
+	VPMULUDQ 896(SP), Y10, Y10
+	VPADDQ   Y10, Y3, Y3
+
+	// x[3]*2
+	VMOVDQU  96(DX), Y10
+	VPADDQ   Y10, Y10, Y10
+	VPMULUDQ 32(CX), Y10, Y11
+	VPADDQ   Y11, Y5, Y5
+	VPMULUDQ 64(CX), Y10, Y11
+	VPADDQ   Y11, Y6, Y6
+	VPMULUDQ 96(CX), Y10, Y11
+	VPADDQ   Y11, Y7, Y7
+	VPMULUDQ 128(CX), Y10, Y11
+	VPADDQ   Y11, Y8, Y8
+	VPMULUDQ 160(CX), Y10, Y11
+	VPADDQ   Y11, Y9, Y9
+	VPMULUDQ 192(CX), Y10, Y11
+	VPADDQ   Y11, Y0, Y0
+	VPMULUDQ 832(SP), Y10, Y10
+	VPADDQ   Y10, Y1, Y1
+
+	// x[4]*1
+	VMOVDQU  128(DX), Y10
+	VPMULUDQ (CX), Y10, Y11
+	VPADDQ   Y11, Y5, Y5
+	VPMULUDQ 832(SP), Y10, Y11
+	VPADDQ   Y11, Y2, Y2
+	VPMULUDQ 864(SP), Y10, Y11
+	VPADDQ   Y11, Y3, Y3
+	VPMULUDQ 896(SP), Y10, Y10
+	VPADDQ   Y10, Y4, Y4
+
+	// x[4]*2
+	VMOVDQU  128(DX), Y10
+	VPADDQ   Y10, Y10, Y10
+	VPMULUDQ 32(CX), Y10, Y11
+	VPADDQ   Y11, Y6, Y6
+	VPMULUDQ 64(CX), Y10, Y11
+	VPADDQ   Y11, Y7, Y7
+	VPMULUDQ 96(CX), Y10, Y11
+	VPADDQ   Y11, Y8, Y8
+	VPMULUDQ 128(CX), Y10, Y11
+	VPADDQ   Y11, Y9, Y9
+	VPMULUDQ 160(CX), Y10, Y11
+	VPADDQ   Y11, Y0, Y0
+	VPMULUDQ 800(SP), Y10, Y10
+	VPADDQ   Y10, Y1, Y1
+
+	// x[5]*1
+	VMOVDQU  160(DX), Y10
+	VPMULUDQ (CX), Y10, Y11
+	VPADDQ   Y11, Y6, Y6
+	VPMULUDQ 800(SP), Y10, Y11
+	VPADDQ   Y11, Y2, Y2
+	VPMULUDQ 832(SP), Y10, Y11
+	VPADDQ   Y11, Y3, Y3
+	VPMULUDQ 864(SP), Y10, Y11
+	VPADDQ   Y11, Y4, Y4
+	VPMULUDQ 896(SP), Y10, Y10
+	VPADDQ   Y10, Y5, Y5
+
+	// x[5]*2
+	VMOVDQU  160(DX), Y10
+	VPADDQ   Y10, Y10, Y10
+	VPMULUDQ 32(CX), Y10, Y11
+	VPADDQ   Y11, Y7, Y7
+	VPMULUDQ 64(CX), Y10, Y11
+	VPADDQ   Y11, Y8, Y8
+	VPMULUDQ 96(CX), Y10, Y11
+	VPADDQ   Y11, Y9, Y9
+	VPMULUDQ 128(CX), Y10, Y11
+	VPADDQ   Y11, Y0, Y0
+	VPMULUDQ 768(SP), Y10, Y10
+	VPADDQ   Y10, Y1, Y1
+
+	// x[6]*1
+	VMOVDQU  192(DX), Y10
+	VPMULUDQ (CX), Y10, Y11
+	VPADDQ   Y11, Y7, Y7
+	VPMULUDQ 768(SP), Y10, Y11
+	VPADDQ   Y11, Y2, Y2
+	VPMULUDQ 800(SP), Y10, Y11
+	VPADDQ   Y11, Y3, Y3
+	VPMULUDQ 832(SP), Y10, Y11
+	VPADDQ   Y11, Y4, Y4
+	VPMULUDQ 864(SP), Y10, Y11
+	VPADDQ   Y11, Y5, Y5
+	VPMULUDQ 896(SP), Y10, Y10
+	VPADDQ   Y10, Y6, Y6
+
+	// x[6]*2
+	VMOVDQU  192(DX), Y10
+	VPADDQ   Y10, Y10, Y10
+	VPMULUDQ 32(CX), Y10, Y11
+	VPADDQ   Y11, Y8, Y8
+	VPMULUDQ 64(CX), Y10, Y11
+	VPADDQ   Y11, Y9, Y9
+	VPMULUDQ 96(CX), Y10, Y11
+	VPADDQ   Y11, Y0, Y0
+	VPMULUDQ 736(SP), Y10, Y10
+	VPADDQ   Y10, Y1, Y1
+
+	// x[7]*1
+	VMOVDQU  224(DX), Y10
+	VPMULUDQ (CX), Y10, Y11
+	VPADDQ   Y11, Y8, Y8
+	VPMULUDQ 736(SP), Y10, Y11
+	VPADDQ   Y11, Y2, Y2
+	VPMULUDQ 768(SP), Y10, Y11
+	VPADDQ   Y11, Y3, Y3
+	VPMULUDQ 800(SP), Y10, Y11
+	VPADDQ   Y11, Y4, Y4
+	VPMULUDQ 832(SP), Y10, Y11
+	VPADDQ   Y11, Y5, Y5
+	VPMULUDQ 864(SP), Y10, Y11
+	VPADDQ   Y11, Y6, Y6
+	VPMULUDQ 896(SP), Y10, Y10
+	VPADDQ   Y10, Y7, Y7
+
+	// x[7]*2
+	VMOVDQU  224(DX), Y10
+	VPADDQ   Y10, Y10, Y10
+	VPMULUDQ 32(CX), Y10, Y11
+	VPADDQ   Y11, Y9, Y9
+	VPMULUDQ 64(CX), Y10, Y11
+	VPADDQ   Y11, Y0, Y0
+	VPMULUDQ 704(SP), Y10, Y10
+	VPADDQ   Y10, Y1, Y1
+
+	// x[8]*1
+	VMOVDQU  256(DX), Y10
+	VPMULUDQ (CX), Y10, Y11
+	VPADDQ   Y11, Y9, Y9
+	VPMULUDQ 704(SP), Y10, Y11
+	VPADDQ   Y11, Y2, Y2
+	VPMULUDQ 736(SP), Y10, Y11
+	VPADDQ   Y11, Y3, Y3
+	VPMULUDQ 768(SP), Y10, Y11
+	VPADDQ   Y11, Y4, Y4
+	VPMULUDQ 800(SP), Y10, Y11
+	VPADDQ   Y11, Y5, Y5
+	VPMULUDQ 832(SP), Y10, Y11
+	VPADDQ   Y11, Y6, Y6
+	VPMULUDQ 864(SP), Y10, Y11
+	VPADDQ   Y11, Y7, Y7
+	VPMULUDQ 896(SP), Y10, Y10
+	VPADDQ   Y10, Y8, Y8
+
+	// x[8]*2
+	VMOVDQU  256(DX), Y10
+	VPADDQ   Y10, Y10, Y10
+	VPMULUDQ 32(CX), Y10, Y11
+	VPADDQ   Y11, Y0, Y0
+	VPMULUDQ 672(SP), Y10, Y10
+	VPADDQ   Y10, Y1, Y1
+
+	// x[9]*1
+	VMOVDQU  288(DX), Y10
+	VPMULUDQ (CX), Y10, Y11
+	VPADDQ   Y11, Y0, Y0
+	VPMULUDQ 672(SP), Y10, Y11
+	VPADDQ   Y11, Y2, Y2
+	VPMULUDQ 704(SP), Y10, Y11
+	VPADDQ   Y11, Y3, Y3
+	VPMULUDQ 736(SP), Y10, Y11
+	VPADDQ   Y11, Y4, Y4
+	VPMULUDQ 768(SP), Y10, Y11
+	VPADDQ   Y11, Y5, Y5
+	VPMULUDQ 800(SP), Y10, Y11
+	VPADDQ   Y11, Y6, Y6
+	VPMULUDQ 832(SP), Y10, Y11
+	VPADDQ   Y11, Y7, Y7
+	VPMULUDQ 864(SP), Y10, Y11
+	VPADDQ   Y11, Y8, Y8
+	VPMULUDQ 896(SP), Y10, Y10
+	VPADDQ   Y10, Y9, Y9
+
+	// x[9]*2
+	VMOVDQU      288(DX), Y10
+	VPADDQ       Y10, Y10, Y10
+	VPMULUDQ     640(SP), Y10, Y10
+	VPADDQ       Y10, Y1, Y1
+	VPBROADCASTQ mask25<>+0(SB), Y10
+	VPBROADCASTQ mask26<>+0(SB), Y11
+
+	// Carry
+	VPSRLQ $0x1a, Y1, Y12
+	VPAND  Y11, Y1, Y1
+	VPADDQ Y12, Y2, Y2
+	VPSRLQ $0x19, Y2, Y12
+	VPAND  Y10, Y2, Y2
+	VPADDQ Y12, Y3, Y3
+	VPSRLQ $0x19, Y3, Y12
+	VPAND  Y10, Y3, Y3
+	VPADDQ Y12, Y4, Y4
+	VPSRLQ $0x19, Y4, Y12
+	VPAND  Y10, Y4, Y4
+	VPADDQ Y12, Y5, Y5
+	VPSRLQ $0x19, Y5, Y12
+	VPAND  Y10, Y5, Y5
+	VPADDQ Y12, Y6, Y6
+	VPSRLQ $0x19, Y6, Y12
+	VPAND  Y10, Y6, Y6
+	VPADDQ Y12, Y7, Y7
+	VPSRLQ $0x19, Y7, Y12
+	VPAND  Y10, Y7, Y7
+	VPADDQ Y12, Y8, Y8
+	VPSRLQ $0x19, Y8, Y12
+	VPAND  Y10, Y8, Y8
+	VPADDQ Y12, Y9, Y9
+	VPSRLQ $0x19, Y9, Y12
+	VPAND  Y10, Y9, Y9
+	VPADDQ Y12, Y0, Y0
+
+	// Fold bits above 2^251 with 2^251 == 9
+	VPSRLQ  $0x19, Y0, Y12
+	VPAND   Y10, Y0, Y0
+	VPSLLQ  $0x03, Y12, Y13
+	VPADDQ  Y12, Y13, Y13
+	VPADDQ  Y13, Y1, Y1
+	VPSRLQ  $0x1a, Y1, Y12
+	VPAND   Y11, Y1, Y1
+	VPADDQ  Y12, Y2, Y2
+	VPSRLQ  $0x19, Y2, Y11
+	VPAND   Y10, Y2, Y2
+	VPADDQ  Y11, Y3, Y3
+	VPSRLQ  $0x19, Y3, Y11
+	VPAND   Y10, Y3, Y3
+	VPADDQ  Y11, Y4, Y4
+	VPSRLQ  $0x19, Y4, Y11
+	VPAND   Y10, Y4, Y4
+	VPADDQ  Y11, Y5, Y5
+	VPSRLQ  $0x19, Y5, Y11
+	VPAND   Y10, Y5, Y5
+	VPADDQ  Y11, Y6, Y6
+	VPSRLQ  $0x19, Y6, Y11
+	VPAND   Y10, Y6, Y6
+	VPADDQ  Y11, Y7, Y7
+	VPSRLQ  $0x19, Y7, Y11
+	VPAND   Y10, Y7, Y7
+	VPADDQ  Y11, Y8, Y8
+	VPSRLQ  $0x19, Y8, Y11
+	VPAND   Y10, Y8, Y8
+	VPADDQ  Y11, Y9, Y9
+	VPSRLQ  $0x19, Y9, Y11
+	VPAND   Y10, Y9, Y9
+	VPADDQ  Y11, Y0, Y0
+	LEAQ    928(SP), CX
+	VMOVDQU Y1, (CX)
+	VMOVDQU Y2, 32(CX)
+	VMOVDQU Y3, 64(CX)
+	VMOVDQU Y4, 96(CX)
+	VMOVDQU Y5, 128(CX)
+	VMOVDQU Y6, 160(CX)
+	VMOVDQU Y7, 192(CX)
+	VMOVDQU Y8, 224(CX)
+	VMOVDQU Y9, 256(CX)
+	VMOVDQU Y0, 288(CX)
+
+	// 2X1X2 in lane 0
+	// Scaled limbs
+	VMOVDQU 32(BX), Y0
+	VPSLLQ  $0x03, Y0, Y1
+	VPADDQ  Y0, Y1, Y1
+	VMOVDQU Y1, 1248(SP)
+	VMOVDQU 64(BX), Y0
+	VPSLLQ  $0x03, Y0, Y1
+	VPADDQ  Y0, Y1, Y1
+	VMOVDQU Y1, 1280(SP)
+	VMOVDQU 96(BX), Y0
+	VPSLLQ  $0x03, Y0, Y1
+	VPADDQ  Y0, Y1, Y1
+	VMOVDQU Y1, 1312(SP)
+	VMOVDQU 128(BX), Y0
+	VPSLLQ  $0x03, Y0, Y1
+	VPADDQ  Y0, Y1, Y1
+	VMOVDQU Y1, 1344(SP)
+	VMOVDQU 160(BX), Y0
+	VPSLLQ  $0x03, Y0, Y1
+	VPADDQ  Y0, Y1, Y1
+	VMOVDQU Y1, 1376(SP)
+	VMOVDQU 192(BX), Y0
+	VPSLLQ  $0x03, Y0, Y1
+	VPADDQ  Y0, Y1, Y1
+	VMOVDQU Y1, 1408(SP)
+	VMOVDQU 224(BX), Y0
+	VPSLLQ  $0x03, Y0, Y1
+	VPADDQ  Y0, Y1, Y1
+	VMOVDQU Y1, 1440(SP)
+	VMOVDQU 256(BX), Y0
+	VPSLLQ  $0x03, Y0, Y1
+	VPADDQ  Y0, Y1, Y1
+	VMOVDQU Y1, 1472(SP)
+	VMOVDQU 288(BX), Y0
+	VPSLLQ  $0x03, Y0, Y1
+	VPADDQ  Y0, Y1, Y1
+	VMOVDQU Y1, 1504(SP)
+
+	// x[0]*1
+	VMOVDQU  (AX), Y0
+	VPMULUDQ (BX), Y0, Y1
+	VPMULUDQ 32(BX), Y0, Y2
+	VPMULUDQ 64(BX), Y0, Y3
+	VPMULUDQ 96(BX), Y0, Y4
+	VPMULUDQ 128(BX), Y0, Y5
+	VPMULUDQ 160(BX), Y0, Y6
+	VPMULUDQ 192(BX), Y0, Y7
+	VPMULUDQ 224(BX), Y0, Y8
+	VPMULUDQ 256(BX), Y0, Y9
+	VPMULUDQ 288(BX), Y0, Y0
+
+	// x[1]*1
+	VMOVDQU  32(AX), Y10
+	VPMULUDQ (BX), Y10, Y10
+	VPADDQ   Y10, Y2, Y2
+
+	// x[1]*2
+	VMOVDQU  32(AX), Y10
+	VPADDQ   Y10, Y10, Y10
+	VPMULUDQ 32(BX), Y10, Y11
+	VPADDQ   Y11, Y3, Y3
+	VPMULUDQ 64(BX), Y10, Y11
+	VPADDQ   Y11, Y4, Y4
+	VPMULUDQ 96(BX), Y10, Y11
+	VPADDQ   Y11, Y5, Y5
+	VPMULUDQ 128(BX), Y10, Y11
+	VPADDQ   Y11, Y6, Y6
+	VPMULUDQ 160(BX), Y10, Y11
+	VPADDQ   Y11, Y7, Y7
+	VPMULUDQ 192(BX), Y10, Y11
+	VPADDQ   Y11, Y8, Y8
+	VPMULUDQ 224(BX), Y10, Y11
+	VPADDQ   Y11, Y9, Y9
+	VPMULUDQ 256(BX), Y10, Y11
+	VPADDQ   Y11, Y0, Y0
+	VPMULUDQ 1504(SP), Y10, Y10
+	VPADDQ   Y10, Y1, Y1
+
+	// x[2]*1
+	VMOVDQU  64(AX), Y10
+	VPMULUDQ (BX), Y10, Y11
+	VPADDQ   Y11, Y3, Y3
+	VPMULUDQ 1504(SP), Y10, Y10
+	VPADDQ   Y10, Y2, Y2
+
+	// x[2]*2
+	VMOVDQU  64(AX), Y10
+	VPADDQ   Y10, Y10, Y10
+	VPMULUDQ 32(BX), Y10, Y11
+	VPADDQ   Y11, Y4, Y4
+	VPMULUDQ 64(BX), Y10, Y11
+	VPADDQ   Y11, Y5, Y5
+	VPMULUDQ 96(BX), Y10, Y11
+	VPADDQ   Y11, Y6, Y6
+	VPMULUDQ 128(BX), Y10, Y11
+	VPADDQ   Y11, Y7, Y7
+	VPMULUDQ 160(BX), Y10, Y11
+	VPADDQ   Y11, Y8, Y8
+	VPMULUDQ 192(BX), Y10, Y11
+	VPADDQ   Y11, Y9, Y9
+	VPMULUDQ 224(BX), Y10, Y11
+	VPADDQ   Y11, Y0, Y0
+	VPMULUDQ 1472(SP), Y10, Y10
+	VPADDQ   Y10, Y1, Y1
+
+	// x[3]*1
+	VMOVDQU  96(AX), Y10
+	VPMULUDQ (BX), Y10, Y11
+	VPADDQ   Y11, Y4, Y4
+	VPMULUDQ 1472(SP), Y10, Y11
+	VPADDQ   Y11, Y2, Y2
+	VPMULUDQ 1504(SP), Y10, Y10
+	VPADDQ   Y10, Y3, Y3
+
+	// x[3]*2
+	VMOVDQU  96(AX), Y10
+	VPADDQ   Y10, Y10, Y10
+	VPMULUDQ 32(BX), Y10, Y11
+	VPADDQ   Y11, Y5, Y5
+	VPMULUDQ 64(BX), Y10, Y11
+	VPADDQ   Y11, Y6, Y6
+	VPMULUDQ 96(BX), Y10, Y11
+	VPADDQ   Y11, Y7, Y7
+	VPMULUDQ 128(BX), Y10, Y11
+	VPADDQ   Y11, Y8, Y8
+	VPMULUDQ 160(BX), Y10, Y11
+	VPADDQ   Y11, Y9, Y9
+	VPMULUDQ 192(BX), Y10, Y11
+	VPADDQ   Y11, Y0, Y0
+	VPMULUDQ 1440(SP), Y10, Y10
+	VPADDQ   Y10, Y1, Y1
+
+	// x[4]*1
+	VMOVDQU  128(AX), Y10
+	VPMULUDQ (BX), Y10, Y11
+	VPADDQ   Y11, Y5, Y5
+	VPMULUDQ 1440(SP), Y10, Y11
+	VPADDQ   Y11, Y2, Y2
+	VPMULUDQ 1472(SP), Y10, Y11
+	VPADDQ   Y11, Y3, Y3
+	VPMULUDQ 1504(SP), Y10, Y10
+	VPADDQ   Y10, Y4, Y4
+
+	// x[4]*2
+	VMOVDQU  128(AX), Y10
+	VPADDQ   Y10, Y10, Y10
+	VPMULUDQ 32(BX), Y10, Y11
+	VPADDQ   Y11, Y6, Y6
+	VPMULUDQ 64(BX), Y10, Y11
+	VPADDQ   Y11, Y7, Y7
+	VPMULUDQ 96(BX), Y10, Y11
+	VPADDQ   Y11, Y8, Y8
+	VPMULUDQ 128(BX), Y10, Y11
+	VPADDQ   Y11, Y9, Y9
+	VPMULUDQ 160(BX), Y10, Y11
+	VPADDQ   Y11, Y0, Y0
+	VPMULUDQ 1408(SP), Y10, Y10
+	VPADDQ   Y10, Y1, Y1
+
+	// x[5]*1
+	VMOVDQU  160(AX), Y10
+	VPMULUDQ (BX), Y10, Y11
+	VPADDQ   Y11, Y6, Y6
+	VPMULUDQ 1408(SP), Y10, Y11
+	VPADDQ   Y11, Y2, Y2
+	VPMULUDQ 1440(SP), Y10, Y11
+	VPADDQ   Y11, Y3, Y3
+	VPMULUDQ 1472(SP), Y10, Y11
+	VPADDQ   Y11, Y4, Y4
+	VPMULUDQ 1504(SP), Y10, Y10
+	VPADDQ   Y10, Y5, Y5
+
+	// x[5]*2
+	VMOVDQU  160(AX), Y10
+	VPADDQ   Y10, Y10, Y10
+	VPMULUDQ 32(BX), Y10, Y11
+	VPADDQ   Y11, Y7, Y7
+	VPMULUDQ 64(BX), Y10, Y11
+	VPADDQ   Y11, Y8, Y8
+	VPMULUDQ 96(BX), Y10, Y11
+	VPADDQ   Y11, Y9, Y9
+	VPMULUDQ 128(BX), Y10, Y11
+	VPADDQ   Y11, Y0, Y0
+	VPMULUDQ 1376(SP), Y10, Y10
+	VPADDQ   Y10, Y1, Y1
+
+	// x[6]*1
+	VMOVDQU  192(AX), Y10
+	VPMULUDQ (BX), Y10, Y11
+	VPADDQ   Y11, Y7, Y7
+	VPMULUDQ 1376(SP), Y10, Y11
+	VPADDQ   Y11, Y2, Y2
+	VPMULUDQ 1408(SP), Y10, Y11
+	VPADDQ   Y11, Y3, Y3
+	VPMULUDQ 1440(SP), Y10, Y11
+	VPADDQ   Y11, Y4, Y4
+	VPMULUDQ 1472(SP), Y10, Y11
+	VPADDQ   Y11, Y5, Y5
+	VPMULUDQ 1504(SP), Y10, Y10
+	VPADDQ   Y10, Y6, Y6
+
+	// x[6]*2
+	VMOVDQU  192(AX), Y10
+	VPADDQ   Y10, Y10, Y10
+	VPMULUDQ 32(BX), Y10, Y11
+	VPADDQ   Y11, Y8, Y8
+	VPMULUDQ 64(BX), Y10, Y11
+	VPADDQ   Y11, Y9, Y9
+	VPMULUDQ 96(BX), Y10, Y11
+	VPADDQ   Y11, Y0, Y0
+	VPMULUDQ 1344(SP), Y10, Y10
+	VPADDQ   Y10, Y1, Y1
+
+	// x[7]*1
+	VMOVDQU  224(AX), Y10
+	VPMULUDQ (BX), Y10, Y11
+	VPADDQ   Y11, Y8, Y8
+	VPMULUDQ 1344(SP), Y10, Y11
+	VPADDQ   Y11, Y2, Y2
+	VPMULUDQ 1376(SP), Y10, Y11
+	VPADDQ   Y11, Y3, Y3
+	VPMULUDQ 1408(SP), Y10, Y11
+	VPADDQ   Y11, Y4, Y4
+	VPMULUDQ 1440(SP), Y10, Y11
+	VPADDQ   Y11, Y5, Y5
+	VPMULUDQ 1472(SP), Y10, Y11
+	VPADDQ   Y11, Y6, Y6
+	VPMULUDQ 1504(SP), Y10, Y10
+	VPADDQ   Y10, Y7, Y7
+
+	// x[7]*2
+	VMOVDQU  224(AX), Y10
+	VPADDQ   Y10, Y10, Y10
+	VPMULUDQ 32(BX), Y10, Y11
+	VPADDQ   Y11, Y9, Y9
+	VPMULUDQ 64(BX), Y10, Y11
+	VPADDQ   Y11, Y0, Y0
+	VPMULUDQ 1312(SP), Y10, Y10
+	VPADDQ   Y10, Y1, Y1
+
+	// x[8]*1
+	VMOVDQU  256(AX), Y10
+	VPMULUDQ (BX), Y10, Y11
+	VPADDQ   Y11, Y9, Y9
+	VPMULUDQ 1312(SP), Y10, Y11
+	VPADDQ   Y11, Y2, Y2
+	VPMULUDQ 1344(SP), Y10, Y11
+	VPADDQ   Y11, Y3, Y3
+	VPMULUDQ 1376(SP), Y10, Y11
+	VPADDQ   Y11, Y4, Y4
+	VPMULUDQ 1408(SP), Y10, Y11
+	VPADDQ   Y11, Y5, Y5
+	VPMULUDQ 1440(SP), Y10, Y11
+	VPADDQ   Y11, Y6, Y6
+	VPMULUDQ 1472(SP), Y10, Y11
+	VPADDQ   Y11, Y7, Y7
+	VPMULUDQ 1504(SP), Y10, Y10
+	VPADDQ   Y10, Y8, Y8
+
+	// x[8]*2
+	VMOVDQU  256(AX), Y10
+	VPADDQ   Y10, Y10, Y10
+	VPMULUDQ 32(BX), Y10, Y11
+	VPADDQ   Y11, Y0, Y0
+	VPMULUDQ 1280(SP), Y10, Y10
+	VPADDQ   Y10, Y1, Y1
+
+	// x[9]*1
+	VMOVDQU  288(AX), Y10
+	VPMULUDQ (BX), Y10, Y11
+	VPADDQ   Y11, Y0, Y0
+	VPMULUDQ 1280(SP), Y10, Y11
+	VPADDQ   Y11, Y2, Y2
+	VPMULUDQ 1312(SP), Y10, Y11
+	VPADDQ   Y11, Y3, Y3
+	VPMULUDQ 1344(SP), Y10, Y11
+	VPADDQ   Y11, Y4, Y4
+	VPMULUDQ 1376(SP), Y10, Y11
+	VPADDQ   Y11, Y5, Y5
+	VPMULUDQ 1408(SP), Y10, Y11
+	VPADDQ   Y11, Y6, Y6
+	VPMULUDQ 1440(SP), Y10, Y11
+	VPADDQ   Y11, Y7, Y7
+	VPMULUDQ 1472(SP), Y10, Y11
+	VPADDQ   Y11, Y8, Y8
+	VPMULUDQ 1504(SP), Y10, Y10
+	VPADDQ   Y10, Y9, Y9
+
+	// x[9]*2
+	VMOVDQU      288(AX), Y10
+	VPADDQ       Y10, Y10, Y10
+	VPMULUDQ     1248(SP), Y10, Y10
+	VPADDQ       Y10, Y1, Y1
+	VPBROADCASTQ mask25<>+0(SB), Y10
+	VPBROADCASTQ mask26<>+0(SB), Y11
+
+	// Carry
+	VPSRLQ $0x1a, Y1, Y12
+	VPAND  Y11, Y1, Y1
+	VPADDQ Y12, Y2, Y2
+	VPSRLQ $0x19, Y2, Y12
+	VPAND  Y10, Y2, Y2
+	VPADDQ Y12, Y3, Y3
+	VPSRLQ $0x19, Y3, Y12
+	VPAND  Y10, Y3, Y3
+	VPADDQ Y12, Y4, Y4
+	VPSRLQ $0x19, Y4, Y12
+	VPAND  Y10, Y4, Y4
+	VPADDQ Y12, Y5, Y5
+	VPSRLQ $0x19, Y5, Y12
+	VPAND  Y10, Y5, Y5
+	VPADDQ Y12, Y6, Y6
+	VPSRLQ $0x19, Y6, Y12
+	VPAND  Y10, Y6, Y6
+	VPADDQ Y12, Y7, Y7
+	VPSRLQ $0x19, Y7, Y12
+	VPAND  Y10, Y7, Y7
+	VPADDQ Y12, Y8, Y8
+	VPSRLQ $0x19, Y8, Y12
+	VPAND  Y10, Y8, Y8
+	VPADDQ Y12, Y9, Y9
+	VPSRLQ $0x19, Y9, Y12
+	VPAND  Y10, Y9, Y9
+	VPADDQ Y12, Y0, Y0
+
+	// Fold bits above 2^251 with 2^251 == 9
+	VPSRLQ  $0x19, Y0, Y12
+	VPAND   Y10, Y0, Y0
+	VPSLLQ  $0x03, Y12, Y13
+	VPADDQ  Y12, Y13, Y13
+	VPADDQ  Y13, Y1, Y1
+	VPSRLQ  $0x1a, Y1, Y12
+	VPAND   Y11, Y1, Y1
+	VPADDQ  Y12, Y2, Y2
+	VPSRLQ  $0x19, Y2, Y11
+	VPAND   Y10, Y2, Y2
+	VPADDQ  Y11, Y3, Y3
+	VPSRLQ  $0x19, Y3, Y11
+	VPAND   Y10, Y3, Y3
+	VPADDQ  Y11, Y4, Y4
+	VPSRLQ  $0x19, Y4, Y11
+	VPAND   Y10, Y4, Y4
+	VPADDQ  Y11, Y5, Y5
+	VPSRLQ  $0x19, Y5, Y11
+	VPAND   Y10, Y5, Y5
+	VPADDQ  Y11, Y6, Y6
+	VPSRLQ  $0x19, Y6, Y11
+	VPAND   Y10, Y6, Y6
+	VPADDQ  Y11, Y7, Y7
+	VPSRLQ  $0x19, Y7, Y11
+	VPAND   Y10, Y7, Y7
+	VPADDQ  Y11, Y8, Y8
+	VPSRLQ  $0x19, Y8, Y11
+	VPAND   Y10, Y8, Y8
+	VPADDQ  Y11, Y9, Y9
+	VPSRLQ  $0x19, Y9, Y11
+	VPAND   Y10, Y9, Y9
+	VPADDQ  Y11, Y0, Y0
+	LEAQ    1536(SP), AX
+	VMOVDQU Y1, (AX)
+	VMOVDQU Y2, 32(AX)
+	VMOVDQU Y3, 64(AX)
+	VMOVDQU Y4, 96(AX)
+	VMOVDQU Y5, 128(AX)
+	VMOVDQU Y6, 160(AX)
+	VMOVDQU Y7, 192(AX)
+	VMOVDQU Y8, 224(AX)
+	VMOVDQU Y9, 256(AX)
+	VMOVDQU Y0, 288(AX)
+	MOVQ    res+0(FP), DX
+
+	// Combine E, F, G and H
+	LEAQ         1856(SP), BX
+	VMOVDQU      (CX), Y0
+	VPERMQ       $0x22, Y0, Y1
+	VPERMQ       $0x77, Y0, Y0
+	VPERMQ       $0x00, (AX), Y3
+	VPSLLVQ      addShift<>+0(SB), Y3, Y3
+	VPBROADCASTQ bias4p0<>+0(SB), Y4
+	VPSUBQ       Y0, Y4, Y2
+	VPBLENDD     $0xf0, Y2, Y0, Y0
+	VPADDQ       Y0, Y1, Y1
+	VPADDQ       Y4, Y1, Y1
+	VPSUBQ       Y3, Y1, Y1
+	VMOVDQU      Y1, (BX)
+	VMOVDQU      32(CX), Y0
+	VPERMQ       $0x22, Y0, Y1
+	VPERMQ       $0x77, Y0, Y0
+	VPERMQ       $0x00, 32(AX), Y3
+	VPSLLVQ      addShift<>+0(SB), Y3, Y3
+	VPBROADCASTQ bias4p<>+0(SB), Y4
+	VPSUBQ       Y0, Y4, Y2
+	VPBLENDD     $0xf0, Y2, Y0, Y0
+	VPADDQ       Y0, Y1, Y1
+	VPADDQ       Y4, Y1, Y1
+	VPSUBQ       Y3, Y1, Y1
+	VMOVDQU      Y1, 32(BX)
+	VMOVDQU      64(CX), Y0
+	VPERMQ       $0x22, Y0, Y1
+	VPERMQ       $0x77, Y0, Y0
+	VPERMQ       $0x00, 64(AX), Y3
+	VPSLLVQ      addShift<>+0(SB), Y3, Y3
+	VPBROADCASTQ bias4p<>+0(SB), Y4
+	VPSUBQ       Y0, Y4, Y2
+	VPBLENDD     $0xf0, Y2, Y0, Y0
+	VPADDQ       Y0, Y1, Y1
+	VPADDQ       Y4, Y1, Y1
+	VPSUBQ       Y3, Y1, Y1
+	VMOVDQU      Y1, 64(BX)
+	VMOVDQU      96(CX), Y0
+	VPERMQ       $0x22, Y0, Y1
+	VPERMQ       $0x77, Y0, Y0
+	VPERMQ       $0x00, 96(AX), Y3
+	VPSLLVQ      addShift<>+0(SB), Y3, Y3
+	VPBROADCASTQ bias4p<>+0(SB), Y4
+	VPSUBQ       Y0, Y4, Y2
+	VPBLENDD     $0xf0, Y2, Y0, Y0
+	VPADDQ       Y0, Y1, Y1
+	VPADDQ       Y4, Y1, Y1
+	VPSUBQ       Y3, Y1, Y1
+	VMOVDQU      Y1, 96(BX)
+	VMOVDQU      128(CX), Y0
+	VPERMQ       $0x22, Y0, Y1
+	VPERMQ       $0x77, Y0, Y0
+	VPERMQ       $0x00, 128(AX), Y3
+	VPSLLVQ      addShift<>+0(SB), Y3, Y3
+	VPBROADCASTQ bias4p<>+0(SB), Y4
+	VPSUBQ       Y0, Y4, Y2
+	VPBLENDD     $0xf0, Y2, Y0, Y0
+	VPADDQ       Y0, Y1, Y1
+	VPADDQ       Y4, Y1, Y1
+	VPSUBQ       Y3, Y1, Y1
+	VMOVDQU      Y1, 128(BX)
+	VMOVDQU      160(CX), Y0
+	VPERMQ       $0x22, Y0, Y1
+	VPERMQ       $0x77, Y0, Y0
+	VPERMQ       $0x00, 160(AX), Y3
+	VPSLLVQ      addShift<>+0(SB), Y3, Y3
+	VPBROADCASTQ bias4p<>+0(SB), Y4
+	VPSUBQ       Y0, Y4, Y2
+	VPBLENDD     $0xf0, Y2, Y0, Y0
+	VPADDQ       Y0, Y1, Y1
+	VPADDQ       Y4, Y1, Y1
+	VPSUBQ       Y3, Y1, Y1
+	VMOVDQU      Y1, 160(BX)
+	VMOVDQU      192(CX), Y0
+	VPERMQ       $0x22, Y0, Y1
+	VPERMQ       $0x77, Y0, Y0
+	VPERMQ       $0x00, 192(AX), Y3
+	VPSLLVQ      addShift<>+0(SB), Y3, Y3
+	VPBROADCASTQ bias4p<>+0(SB), Y4
+	VPSUBQ       Y0, Y4, Y2
+	VPBLENDD     $0xf0, Y2, Y0, Y0
+	VPADDQ       Y0, Y1, Y1
+	VPADDQ       Y4, Y1, Y1
+	VPSUBQ       Y3, Y1, Y1
+	VMOVDQU      Y1, 192(BX)
+	VMOVDQU      224(CX), Y0
+	VPERMQ       $0x22, Y0, Y1
+	VPERMQ       $0x77, Y0, Y0
+	VPERMQ       $0x00, 224(AX), Y3
+	VPSLLVQ      addShift<>+0(SB), Y3, Y3
+	VPBROADCASTQ bias4p<>+0(SB), Y4
+	VPSUBQ       Y0, Y4, Y2
+	VPBLENDD     $0xf0, Y2, Y0, Y0
+	VPADDQ       Y0, Y1, Y1
+	VPADDQ       Y4, Y1, Y1
+	VPSUBQ       Y3, Y1, Y1
+	VMOVDQU      Y1, 224(BX)
+	VMOVDQU      256(CX), Y0
+	VPERMQ       $0x22, Y0, Y1
+	VPERMQ       $0x77, Y0, Y0
+	VPERMQ       $0x00, 256(AX), Y3
+	VPSLLVQ      addShift<>+0(SB), Y3, Y3
+	VPBROADCASTQ bias4p<>+0(SB), Y4
+	VPSUBQ       Y0, Y4, Y2
+	VPBLENDD     $0xf0, Y2, Y0, Y0
+	VPADDQ       Y0, Y1, Y1
+	VPADDQ       Y4, Y1, Y1
+	VPSUBQ       Y3, Y1, Y1
+	VMOVDQU      Y1, 256(BX)
+	VMOVDQU      288(CX), Y0
+	VPERMQ       $0x22, Y0, Y1
+	VPERMQ       $0x77, Y0, Y0
+	VPERMQ       $0x00, 288(AX), Y3
+	VPSLLVQ      addShift<>+0(SB), Y3, Y3
+	VPBROADCASTQ bias4p<>+0(SB), Y4
+	VPSUBQ       Y0, Y4, Y2
+	VPBLENDD     $0xf0, Y2, Y0, Y0
+	VPADDQ       Y0, Y1, Y1
+	VPADDQ       Y4, Y1, Y1
+	VPSUBQ       Y3, Y1, Y1
+	VMOVDQU      Y1, 288(BX)
+
+	// Normalize limbs (one parallel carry pass)
+	VPBROADCASTQ mask25<>+0(SB), Y0
+	VPBROADCASTQ mask26<>+0(SB), Y1
+	VMOVDQU      (BX), Y2
+	VMOVDQU      32(BX), Y3
+	VMOVDQU      64(BX), Y4
+	VMOVDQU      96(BX), Y5
+	VMOVDQU      128(BX), Y6
+	VMOVDQU      160(BX), Y7
+	VMOVDQU      192(BX), Y8
+	VMOVDQU      224(BX), Y9
+	VMOVDQU      256(BX), Y10
+	VMOVDQU      288(BX), Y11
+	VPSRLQ       $0x19, Y11, Y12
+	VPAND        Y0, Y11, Y11
+	VPSLLQ       $0x03, Y12, Y13
+	VPADDQ       Y12, Y13, Y13
+	VPADDQ       Y13, Y2, Y2
+	VPSRLQ       $0x19, Y10, Y12
+	VPAND        Y0, Y10, Y10
+	VPADDQ       Y12, Y11, Y11
+	VPSRLQ       $0x19, Y9, Y12
+	VPAND        Y0, Y9, Y9
+	VPADDQ       Y12, Y10, Y10
+	VPSRLQ       $0x19, Y8, Y12
+	VPAND        Y0, Y8, Y8
+	VPADDQ       Y12, Y9, Y9
+	VPSRLQ       $0x19, Y7, Y12
+	VPAND        Y0, Y7, Y7
+	VPADDQ       Y12, Y8, Y8
+	VPSRLQ       $0x19, Y6, Y12
+	VPAND        Y0, Y6, Y6
+	VPADDQ       Y12, Y7, Y7
+	VPSRLQ       $0x19, Y5, Y12
+	VPAND        Y0, Y5, Y5
+	VPADDQ       Y12, Y6, Y6
+	VPSRLQ       $0x19, Y4, Y12
+	VPAND        Y0, Y4, Y4
+	VPADDQ       Y12, Y5, Y5
+	VPSRLQ       $0x19, Y3, Y12
+	VPAND        Y0, Y3, Y3
+	VPADDQ       Y12, Y4, Y4
+	VPSRLQ       $0x1a, Y2, Y0
+	VPAND        Y1, Y2, Y2
+	VPADDQ       Y0, Y3, Y3
+	LEAQ         2176(SP), AX
+	LEAQ         2496(SP), CX
+	VPERMQ       $0xe3, Y2, Y0
+	VPERMQ       $0x46, Y2, Y1
+	VMOVDQU      Y0, (AX)
+	VMOVDQU      Y1, (CX)
+	VPERMQ       $0xe3, Y3, Y0
+	VPERMQ       $0x46, Y3, Y1
+	VMOVDQU      Y0, 32(AX)
+	VMOVDQU      Y1, 32(CX)
+	VPERMQ       $0xe3, Y4, Y0
+	VPERMQ       $0x46, Y4, Y1
+	VMOVDQU      Y0, 64(AX)
+	VMOVDQU      Y1, 64(CX)
+	VPERMQ       $0xe3, Y5, Y0
+	VPERMQ       $0x46, Y5, Y1
+	VMOVDQU      Y0, 96(AX)
+	VMOVDQU      Y1, 96(CX)
+	VPERMQ       $0xe3, Y6, Y0
+	VPERMQ       $0x46, Y6, Y1
+	VMOVDQU      Y0, 128(AX)
+	VMOVDQU      Y1, 128(CX)
+	VPERMQ       $0xe3, Y7, Y0
+	VPERMQ       $0x46, Y7, Y1
+	VMOVDQU      Y0, 160(AX)
+	VMOVDQU      Y1, 160(CX)
+	VPERMQ       $0xe3, Y8, Y0
+	VPERMQ       $0x46, Y8, Y1
+	VMOVDQU      Y0, 192(AX)
+	VMOVDQU      Y1, 192(CX)
+	VPERMQ       $0xe3, Y9, Y0
+	VPERMQ       $0x46, Y9, Y1
+	VMOVDQU      Y0, 224(AX)
+	VMOVDQU      Y1, 224(CX)
+	VPERMQ       $0xe3, Y10, Y0
+	VPERMQ       $0x46, Y10, Y1
+	VMOVDQU      Y0, 256(AX)
+	VMOVDQU      Y1, 256(CX)
+	VPERMQ       $0xe3, Y11, Y0
+	VPERMQ       $0x46, Y11, Y1
+	VMOVDQU      Y0, 288(AX)
+	VMOVDQU      Y1, 288(CX)
+
+	// (X, Y, Z, T) = (EF, GH, FG, EH)
+	// Scaled limbs
+	VMOVDQU 32(CX), Y0
+	VPSLLQ  $0x03, Y0, Y1
+	VPADDQ  Y0, Y1, Y1
+	VMOVDQU Y1, 2816(SP)
+	VMOVDQU 64(CX), Y0
+	VPSLLQ  $0x03, Y0, Y1
+	VPADDQ  Y0, Y1, Y1
+	VMOVDQU Y1, 2848(SP)
+	VMOVDQU 96(CX), Y0
+	VPSLLQ  $0x03, Y0, Y1
+	VPADDQ  Y0, Y1, Y1
+	VMOVDQU Y1, 2880(SP)
+	VMOVDQU 128(CX), Y0
+	VPSLLQ  $0x03, Y0, Y1
+	VPADDQ  Y0, Y1, Y1
+	VMOVDQU Y1, 2912(SP)
+	VMOVDQU 160(CX), Y0
+	VPSLLQ  $0x03, Y0, Y1
+	VPADDQ  Y0, Y1, Y1
+	VMOVDQU Y1, 2944(SP)
+	VMOVDQU 192(CX), Y0
+	VPSLLQ  $0x03, Y0, Y1
+	VPADDQ  Y0, Y1, Y1
+	VMOVDQU Y1, 2976(SP)
+	VMOVDQU 224(CX), Y0
+	VPSLLQ  $0x03, Y0, Y1
+	VPADDQ  Y0, Y1, Y1
+	VMOVDQU Y1, 3008(SP)
+	VMOVDQU 256(CX), Y0
+	VPSLLQ  $0x03, Y0, Y1
+	VPADDQ  Y0, Y1, Y1
+	VMOVDQU Y1, 3040(SP)
+	VMOVDQU 288(CX), Y0
+	VPSLLQ  $0x03, Y0, Y1
+	VPADDQ  Y0, Y1, Y1
+	VMOVDQU Y1, 3072(SP)
+
+	// x[0]*1
+	VMOVDQU  (AX), Y0
+	VPMULUDQ (CX), Y0, Y1
+	VPMULUDQ 32(CX), Y0, Y2
+	VPMULUDQ 64(CX), Y0, Y3
+	VPMULUDQ 96(CX), Y0, Y4
+	VPMULUDQ 128(CX), Y0, Y5
+	VPMULUDQ 160(CX), Y0, Y6
+	VPMULUDQ 192(CX), Y0, Y7
+	VPMULUDQ 224(CX), Y0, Y8
+	VPMULUDQ 256(CX), Y0, Y9
+	VPMULUDQ 288(CX), Y0, Y0
+
+	// x[1]*1
+	VMOVDQU  32(AX), Y10
+	VPMULUDQ (CX), Y10, Y10
+	VPADDQ   Y10, Y2, Y2
+
+	// x[1]*2
+	VMOVDQU  32(AX), Y10
+	VPADDQ   Y10, Y10, Y10
+	VPMULUDQ 32(CX), Y10, Y11
+	VPADDQ   Y11, Y3, Y3
+	VPMULUDQ 64(CX), Y10, Y11
+	VPADDQ   Y11, Y4, Y4
+	VPMULUDQ 96(CX), Y10, Y11
+	VPADDQ   Y11, Y5, Y5
+	VPMULUDQ 128(CX), Y10, Y11
+	VPADDQ   Y11, Y6, Y6
+	VPMULUDQ 160(CX), Y10, Y11
+	VPADDQ   Y11, Y7, Y7
+	VPMULUDQ 192(CX), Y10, Y11
+	VPADDQ   Y11, Y8, Y8
+	VPMULUDQ 224(CX), Y10, Y11
+	VPADDQ   Y11, Y9, Y9
+	VPMULUDQ 256(CX), Y10, Y11
+	VPADDQ   Y11, Y0, Y0
+	VPMULUDQ 3072(SP), Y10, Y10
+	VPADDQ   Y10, Y1, Y1
+
+	// x[2]*1
+	VMOVDQU  64(AX), Y10
+	VPMULUDQ (CX), Y10, Y11
+	VPADDQ   Y11, Y3, Y3
+	VPMULUDQ 3072(SP), Y10, Y10
+	VPADDQ   Y10, Y2, Y2
+
+	// x[2]*2
+	VMOVDQU  64(AX), Y10
+	VPADDQ   Y10, Y10, Y10
+	VPMULUDQ 32(CX), Y10, Y11
+	VPADDQ   Y11, Y4, Y4
+	VPMULUDQ 64(CX), Y10, Y11
+	VPADDQ   Y11, Y5, Y5
+	VPMULUDQ 96(CX), Y10, Y11
+	VPADDQ   Y11, Y6, Y6
+	VPMULUDQ 128(CX), Y10, Y11
+	VPADDQ   Y11, Y7, Y7
+	VPMULUDQ 160(CX), Y10, Y11
+	VPADDQ   Y11, Y8, Y8
+	VPMULUDQ 192(CX), Y10, Y11
+	VPADDQ   Y11, Y9, Y9
+	VPMULUDQ 224(CX), Y10, Y11
+	VPADDQ   Y11, Y0, Y0
+	VPMULUDQ 3040(SP), Y10, Y10
+	VPADDQ   Y10, Y1, Y1
+
+	// x[3]*1
+	VMOVDQU  96(AX), Y10
+	VPMULUDQ (CX), Y10, Y11
+	VPADDQ   Y11, Y4, Y4
+	VPMULUDQ 3040(SP), Y10, Y11
+	VPADDQ   Y11, Y2, Y2
+	VPMULUDQ 3072(SP), Y10, Y10
+	VPADDQ   Y10, Y3, Y3
+
+	// x[3]*2
+	VMOVDQU  96(AX), Y10
+	VPADDQ   Y10, Y10, Y10
+	VPMULUDQ 32(CX), Y10, Y11
+	VPADDQ   Y11, Y5, Y5
+	VPMULUDQ 64(CX), Y10, Y11
+	VPADDQ   Y11, Y6, Y6
+	VPMULUDQ 96(CX), Y10, Y11
+	VPADDQ   Y11, Y7, Y7
+	VPMULUDQ 128(CX), Y10, Y11
+	VPADDQ   Y11, Y8, Y8
+	VPMULUDQ 160(CX), Y10, Y11
+	VPADDQ   Y11, Y9, Y9
+	VPMULUDQ 192(CX), Y10, Y11
+	VPADDQ   Y11, Y0, Y0
+	VPMULUDQ 3008(SP), Y10, Y10
+	VPADDQ   Y10, Y1, Y1
+
+	// x[4]*1
+	VMOVDQU  128(AX), Y10
+	VPMULUDQ (CX), Y10, Y11
+	VPADDQ   Y11, Y5, Y5
+	VPMULUDQ 3008(SP), Y10, Y11
+	VPADDQ   Y11, Y2, Y2
+	VPMULUDQ 3040(SP), Y10, Y11
+	VPADDQ   Y11, Y3, Y3
+	VPMULUDQ 3072(SP), Y10, Y10
+	VPADDQ   Y10, Y4, Y4
+
+	// x[4]*2
+	VMOVDQU  128(AX), Y10
+	VPADDQ   Y10, Y10, Y10
+	VPMULUDQ 32(CX), Y10, Y11
+	VPADDQ   Y11, Y6, Y6
+	VPMULUDQ 64(CX), Y10, Y11
+	VPADDQ   Y11, Y7, Y7
+	VPMULUDQ 96(CX), Y10, Y11
+	VPADDQ   Y11, Y8, Y8
+	VPMULUDQ 128(CX), Y10, Y11
+	VPADDQ   Y11, Y9, Y9
+	VPMULUDQ 160(CX), Y10, Y11
+	VPADDQ   Y11, Y0, Y0
+	VPMULUDQ 2976(SP), Y10, Y10
+	VPADDQ   Y10, Y1, Y1
+
+	// x[5]*1
+	VMOVDQU  160(AX), Y10
+	VPMULUDQ (CX), Y10, Y11
+	VPADDQ   Y11, Y6, Y6
+	VPMULUDQ 2976(SP), Y10, Y11
+	VPADDQ   Y11, Y2, Y2
+	VPMULUDQ 3008(SP), Y10, Y11
+	VPADDQ   Y11, Y3, Y3
+	VPMULUDQ 3040(SP), Y10, Y11
+	VPADDQ   Y11, Y4, Y4
+	VPMULUDQ 3072(SP), Y10, Y10
+	VPADDQ   Y10, Y5, Y5
+
+	// x[5]*2
+	VMOVDQU  160(AX), Y10
+	VPADDQ   Y10, Y10, Y10
+	VPMULUDQ 32(CX), Y10, Y11
+	VPADDQ   Y11, Y7, Y7
+	VPMULUDQ 64(CX), Y10, Y11
+	VPADDQ   Y11, Y8, Y8
+	VPMULUDQ 96(CX), Y10, Y11
+	VPADDQ   Y11, Y9, Y9
+	VPMULUDQ 128(CX), Y10, Y11
+	VPADDQ   Y11, Y0, Y0
+	VPMULUDQ 2944(SP), Y10, Y10
+	VPADDQ   Y10, Y1, Y1
+
+	// x[6]*1
+	VMOVDQU  192(AX), Y10
+	VPMULUDQ (CX), Y10, Y11
+	VPADDQ   Y11, Y7, Y7
+	VPMULUDQ 2944(SP), Y10, Y11
+	VPADDQ   Y11, Y2, Y2
+	VPMULUDQ 2976(SP), Y10, Y11
+	VPADDQ   Y11, Y3, Y3
+	VPMULUDQ 3008(SP), Y10, Y11
+	VPADDQ   Y11, Y4, Y4
+	VPMULUDQ 3040(SP), Y10, Y11
+	VPADDQ   Y11, Y5, Y5
+	VPMULUDQ 3072(SP), Y10, Y10
+	VPADDQ   Y10, Y6, Y6
+
+	// x[6]*2
+	VMOVDQU  192(AX), Y10
+	VPADDQ   Y10, Y10, Y10
+	VPMULUDQ 32(CX), Y10, Y11
+	VPADDQ   Y11, Y8, Y8
+	VPMULUDQ 64(CX), Y10, Y11
+	VPADDQ   Y11, Y9, Y9
+	VPMULUDQ 96(CX), Y10, Y11
+	VPADDQ   Y11, Y0, Y0
+	VPMULUDQ 2912(SP), Y10, Y10
+	VPADDQ   Y10, Y1, Y1
+
+	// x[7]*1
+	VMOVDQU  224(AX), Y10
+	VPMULUDQ (CX), Y10, Y11
+	VPADDQ   Y11, Y8, Y8
+	VPMULUDQ 2912(SP), Y10, Y11
+	VPADDQ   Y11, Y2, Y2
+	VPMULUDQ 2944(SP), Y10, Y11
+	VPADDQ   Y11, Y3, Y3
+	VPMULUDQ 2976(SP), Y10, Y11
+	VPADDQ   Y11, Y4, Y4
+	VPMULUDQ 3008(SP), Y10, Y11
+	VPADDQ   Y11, Y5, Y5
+	VPMULUDQ 3040(SP), Y10, Y11
+	VPADDQ   Y11, Y6, Y6
+	VPMULUDQ 3072(SP), Y10, Y10
+	VPADDQ   Y10, Y7, Y7
+
+	// x[7]*2
+	VMOVDQU  224(AX), Y10
+	VPADDQ   Y10, Y10, Y10
+	VPMULUDQ 32(CX), Y10, Y11
+	VPADDQ   Y11, Y9, Y9
+	VPMULUDQ 64(CX), Y10, Y11
+	VPADDQ   Y11, Y0, Y0
+	VPMULUDQ 2880(SP), Y10, Y10
+	VPADDQ   Y10, Y1, Y1
+
+	// x[8]*1
+	VMOVDQU  256(AX), Y10
+	VPMULUDQ (CX), Y10, Y11
+	VPADDQ   Y11, Y9, Y9
+	VPMULUDQ 2880(SP), Y10, Y11
+	VPADDQ   Y11, Y2, Y2
+	VPMULUDQ 2912(SP), Y10, Y11
+	VPADDQ   Y11, Y3, Y3
+	VPMULUDQ 2944(SP), Y10, Y11
+	VPADDQ   Y11, Y4, Y4
+	VPMULUDQ 2976(SP), Y10, Y11
+	VPADDQ   Y11, Y5, Y5
+	VPMULUDQ 3008(SP), Y10, Y11
+	VPADDQ   Y11, Y6, Y6
+	VPMULUDQ 3040(SP), Y10, Y11
+	VPADDQ   Y11, Y7, Y7
+	VPMULUDQ 3072(SP), Y10, Y10
+	VPADDQ   Y10, Y8, Y8
+
+	// x[8]*2
+	VMOVDQU  256(AX), Y10
+	VPADDQ   Y10, Y10, Y10
+	VPMULUDQ 32(CX), Y10, Y11
+	VPADDQ   Y11, Y0, Y0
+	VPMULUDQ 2848(SP), Y10, Y10
+	VPADDQ   Y10, Y1, Y1
+
+	// x[9]*1
+	VMOVDQU  288(AX), Y10
+	VPMULUDQ (CX), Y10, Y11
+	VPADDQ   Y11, Y0, Y0
+	VPMULUDQ 2848(SP), Y10, Y11
+	VPADDQ   Y11, Y2, Y2
+	VPMULUDQ 2880(SP), Y10, Y11
+	VPADDQ   Y11, Y3, Y3
+	VPMULUDQ 2912(SP), Y10, Y11
+	VPADDQ   Y11, Y4, Y4
+	VPMULUDQ 2944(SP), Y10, Y11
+	VPADDQ   Y11, Y5, Y5
+	VPMULUDQ 2976(SP), Y10, Y11
+	VPADDQ   Y11, Y6, Y6
+	VPMULUDQ 3008(SP), Y10, Y11
+	VPADDQ   Y11, Y7, Y7
+	VPMULUDQ 3040(SP), Y10, Y11
+	VPADDQ   Y11, Y8, Y8
+	VPMULUDQ 3072(SP), Y10, Y10
+	VPADDQ   Y10, Y9, Y9
+
+	// x[9]*2
+	VMOVDQU      288(AX), Y10
+	VPADDQ       Y10, Y10, Y10
+	VPMULUDQ     2816(SP), Y10, Y10
+	VPADDQ       Y10, Y1, Y1
+	VPBROADCASTQ mask25<>+0(SB), Y10
+	VPBROADCASTQ mask26<>+0(SB), Y11
+
+	// Carry
+	VPSRLQ $0x1a, Y1, Y12
+	VPAND  Y11, Y1, Y1
+	VPADDQ Y12, Y2, Y2
+	VPSRLQ $0x19, Y2, Y12
+	VPAND  Y10, Y2, Y2
+	VPADDQ Y12, Y3, Y3
+	VPSRLQ $0x19, Y3, Y12
+	VPAND  Y10, Y3, Y3
+	VPADDQ Y12, Y4, Y4
+	VPSRLQ $0x19, Y4, Y12
+	VPAND  Y10, Y4, Y4
+	VPADDQ Y12, Y5, Y5
+	VPSRLQ $0x19, Y5, Y12
+	VPAND  Y10, Y5, Y5
+	VPADDQ Y12, Y6, Y6
+	VPSRLQ $0x19, Y6, Y12
+	VPAND  Y10, Y6, Y6
+	VPADDQ Y12, Y7, Y7
+	VPSRLQ $0x19, Y7, Y12
+	VPAND  Y10, Y7, Y7
+	VPADDQ Y12, Y8, Y8
+	VPSRLQ $0x19, Y8, Y12
+	VPAND  Y10, Y8, Y8
+	VPADDQ Y12, Y9, Y9
+	VPSRLQ $0x19, Y9, Y12
+	VPAND  Y10, Y9, Y9
+	VPADDQ Y12, Y0, Y0
+
+	// Fold bits above 2^251 with 2^251 == 9
+	VPSRLQ  $0x19, Y0, Y12
+	VPAND   Y10, Y0, Y0
+	VPSLLQ  $0x03, Y12, Y13
+	VPADDQ  Y12, Y13, Y13
+	VPADDQ  Y13, Y1, Y1
+	VPSRLQ  $0x1a, Y1, Y12
+	VPAND   Y11, Y1, Y1
+	VPADDQ  Y12, Y2, Y2
+	VPSRLQ  $0x19, Y2, Y11
+	VPAND   Y10, Y2, Y2
+	VPADDQ  Y11, Y3, Y3
+	VPSRLQ  $0x19, Y3, Y11
+	VPAND   Y10, Y3, Y3
+	VPADDQ  Y11, Y4, Y4
+	VPSRLQ  $0x19, Y4, Y11
+	VPAND   Y10, Y4, Y4
+	VPADDQ  Y11, Y5, Y5
+	VPSRLQ  $0x19, Y5, Y11
+	VPAND   Y10, Y5, Y5
+	VPADDQ  Y11, Y6, Y6
+	VPSRLQ  $0x19, Y6, Y11
+	VPAND   Y10, Y6, Y6
+	VPADDQ  Y11, Y7, Y7
+	VPSRLQ  $0x19, Y7, Y11
+	VPAND   Y10, Y7, Y7
+	VPADDQ  Y11, Y8, Y8
+	VPSRLQ  $0x19, Y8, Y11
+	VPAND   Y10, Y8, Y8
+	VPADDQ  Y11, Y9, Y9
+	VPSRLQ  $0x19, Y9, Y11
+	VPAND   Y10, Y9, Y9
+	VPADDQ  Y11, Y0, Y0
+	VMOVDQU Y1, (DX)
+	VMOVDQU Y2, 32(DX)
+	VMOVDQU Y3, 64(DX)
+	VMOVDQU Y4, 96(DX)
+	VMOVDQU Y5, 128(DX)
+	VMOVDQU Y6, 160(DX)
+	VMOVDQU Y7, 192(DX)
+	VMOVDQU Y8, 224(DX)
+	VMOVDQU Y9, 256(DX)
+	VMOVDQU Y0, 288(DX)
+	VZEROUPPER
+	RET
@@ -0,0 +1,75 @@
+//go:build !curve1174_purego && !curve1174_radix51
+
+package curve1174
+
+//Field arithmetic implemented in field_arm64.s, the rest (inversion, cached point selection) comes from
+//field_portable.go
+
+func backends() []string {
+	return []string{"arm64"}
+}
+
+func backend() string {
+	return "arm64"
+}
+
+func useBackend(string) {}
+
+// res=x * y % 2^251-9
+//go:noescape
+func mul(res *FieldElement, x *FieldElement, y *FieldElement)
+
+// res=x % 2^251-9
+//go:noescape
+func mod(res *FieldElement, x *FieldElement)
+
+//go:noescape
+func sqr(res *FieldElement, x *FieldElement)
+
+// res=x * 2 % 2^251-9
+//go:noescape
+func mul2(res *FieldElement, x *FieldElement)
+
+// res=x - y % 2^251-9
+//go:noescape
+func sub(res *FieldElement, x *FieldElement, y *FieldElement)
+
+// res=x + y % 2^251-9
+//go:noescape
+func add(res *FieldElement, x *FieldElement, y *FieldElement)
+
+// res=x * -1174 % 2^251-9
+//go:noescape
+func mulD(res *FieldElement, x *FieldElement)
+
+func selectPoint(res *Point, table *[16]Point, index uint64) {
+	countOp(&opCounts.Select)
+	selectPointAsm(res, table, index)
+}
+
+//go:noescape
+func selectPointAsm(res *Point, table *[16]Point, index uint64)
+
+func fastInverse(res, x *FieldElement) {
+	fastInverseGeneric(res, x)
+}
+
+func selectCachedPoint(res *CachedPoint, table *[16]CachedPoint, index uint64) {
+	countOp(&opCounts.Select)
+	selectCachedPointGeneric(res, table, index)
+}
+
+func selectAffineCachedPoint(res *AffineCachedPoint, table *[16]AffineCachedPoint, index uint64) {
+	countOp(&opCounts.Select)
+	selectAffineCachedPointGeneric(res, table, index)
+}
+
+func selectAffineCachedPoint129(res *AffineCachedPoint, table *[129]AffineCachedPoint, index uint64) {
+	countOp(&opCounts.Select)
+	selectAffineCachedPoint129Generic(res, table, index)
+}
+
+func selectAffineCachedPointSlice(res *AffineCachedPoint, table []AffineCachedPoint, index uint64) {
+	countOp(&opCounts.Select)
+	selectAffineCachedPointSliceGeneric(res, table, index)
+}
@@ -0,0 +1,429 @@
+//go:build !curve1174_purego && !curve1174_radix51
+
+#include "textflag.h"
+
+// Field arithmetic mod 2^251-9 for arm64. Algorithms are the same as in field_generic.go and field_generic64.go
+// (except sqr that computes products x_i*x_j for i != j only once), field elements are four 64-bit little endian limbs.
+//
+// Register usage in mul/sqr:
+//   x0..x3 R3..R6, y0..y3 R7..R10 (temporaries in sqr), product r0..r7 R11..R17, R19, temporaries R20..R23.
+//   R18 (platform register) and R26..R30 are not used.
+
+// REDUCE reduces 512-bit product r0..r7 (R11..R17, R19) mod 2^251-9 and stores result to res (R0), it's shared by mul
+// and sqr. h = r >> 251 (5 limbs: r4..r7, R20) multiplied by 9 (2^251 == 9) is added to r mod 2^251, bits above 2^251
+// are folded once more. R3..R10 and R20..R23 are clobbered.
+#define REDUCE \
+	LSR	$59, R19, R20; \
+	LSR	$59, R17, R21; \
+	LSL	$5, R19, R19; \
+	ORR	R21, R19, R19; \
+	LSR	$59, R16, R21; \
+	LSL	$5, R17, R17; \
+	ORR	R21, R17, R17; \
+	LSR	$59, R15, R21; \
+	LSL	$5, R16, R16; \
+	ORR	R21, R16, R16; \
+	LSR	$59, R14, R21; \
+	LSL	$5, R15, R15; \
+	ORR	R21, R15, R15; \
+	AND	$0x07ffffffffffffff, R14, R14; \
+	MOVD	$9, R21; \
+	MUL	R21, R15, R3; \
+	UMULH	R21, R15, R4; \
+	MUL	R21, R16, R5; \
+	UMULH	R21, R16, R6; \
+	MUL	R21, R17, R7; \
+	UMULH	R21, R17, R8; \
+	MUL	R21, R19, R9; \
+	UMULH	R21, R19, R10; \
+	MUL	R21, R20, R22; \
+	ADDS	R4, R5, R5; \
+	ADCS	R6, R7, R7; \
+	ADCS	R8, R9, R9; \
+	ADC	R10, R22, R22; \
+	ADDS	R3, R11, R11; \
+	ADCS	R5, R12, R12; \
+	ADCS	R7, R13, R13; \
+	ADCS	R9, R14, R14; \
+	ADC	ZR, R22, R22; \
+	LSL	$5, R22, R22; \
+	LSR	$59, R14, R23; \
+	ORR	R23, R22, R22; \
+	MUL	R21, R22, R22; \
+	AND	$0x07ffffffffffffff, R14, R14; \
+	ADDS	R22, R11, R11; \
+	ADCS	ZR, R12, R12; \
+	ADCS	ZR, R13, R13; \
+	ADC	ZR, R14, R14; \
+	STP	(R11, R12), 0(R0); \
+	STP	(R13, R14), 16(R0);
+
+// func mul(res, x, y *FieldElement)
+TEXT ·mul(SB), NOSPLIT, $0-24
+	MOVD	res+0(FP), R0
+	MOVD	x+8(FP), R1
+	MOVD	y+16(FP), R2
+	LDP	0(R1), (R3, R4)
+	LDP	16(R1), (R5, R6)
+	LDP	0(R2), (R7, R8)
+	LDP	16(R2), (R9, R10)
+	// r = x * y, row 0
+	MUL	R7, R3, R11
+	UMULH	R7, R3, R12
+	MUL	R8, R3, R20
+	UMULH	R8, R3, R13
+	MUL	R9, R3, R21
+	UMULH	R9, R3, R14
+	MUL	R10, R3, R22
+	UMULH	R10, R3, R15
+	ADDS	R20, R12, R12
+	ADCS	R21, R13, R13
+	ADCS	R22, R14, R14
+	ADC	ZR, R15, R15
+	// row 1
+	MUL	R7, R4, R20
+	MUL	R8, R4, R21
+	MUL	R9, R4, R22
+	MUL	R10, R4, R23
+	ADDS	R20, R12, R12
+	ADCS	R21, R13, R13
+	ADCS	R22, R14, R14
+	ADCS	R23, R15, R15
+	ADC	ZR, ZR, R16
+	UMULH	R7, R4, R20
+	UMULH	R8, R4, R21
+	UMULH	R9, R4, R22
+	UMULH	R10, R4, R23
+	ADDS	R20, R13, R13
+	ADCS	R21, R14, R14
+	ADCS	R22, R15, R15
+	ADC	R23, R16, R16
+	// row 2
+	MUL	R7, R5, R20
+	MUL	R8, R5, R21
+	MUL	R9, R5, R22
+	MUL	R10, R5, R23
+	ADDS	R20, R13, R13
+	ADCS	R21, R14, R14
+	ADCS	R22, R15, R15
+	ADCS	R23, R16, R16
+	ADC	ZR, ZR, R17
+	UMULH	R7, R5, R20
+	UMULH	R8, R5, R21
+	UMULH	R9, R5, R22
+	UMULH	R10, R5, R23
+	ADDS	R20, R14, R14
+	ADCS	R21, R15, R15
+	ADCS	R22, R16, R16
+	ADC	R23, R17, R17
+	// row 3
+	MUL	R7, R6, R20
+	MUL	R8, R6, R21
+	MUL	R9, R6, R22
+	MUL	R10, R6, R23
+	ADDS	R20, R14, R14
+	ADCS	R21, R15, R15
+	ADCS	R22, R16, R16
+	ADCS	R23, R17, R17
+	ADC	ZR, ZR, R19
+	UMULH	R7, R6, R20
+	UMULH	R8, R6, R21
+	UMULH	R9, R6, R22
+	UMULH	R10, R6, R23
+	ADDS	R20, R15, R15
+	ADCS	R21, R16, R16
+	ADCS	R22, R17, R17
+	ADC	R23, R19, R19
+	REDUCE
+	RET
+
+// func sqr(res, x *FieldElement)
+TEXT ·sqr(SB), NOSPLIT, $0-16
+	MOVD	res+0(FP), R0
+	MOVD	x+8(FP), R1
+	LDP	0(R1), (R3, R4)
+	LDP	16(R1), (R5, R6)
+	// r1..r6 = x0*x1, x0*x2, x0*x3, x1*x3, x2*x3
+	MUL	R4, R3, R12
+	UMULH	R4, R3, R13
+	MUL	R5, R3, R7
+	UMULH	R5, R3, R14
+	MUL	R6, R3, R8
+	UMULH	R6, R3, R15
+	MUL	R6, R4, R9
+	UMULH	R6, R4, R16
+	MUL	R6, R5, R10
+	UMULH	R6, R5, R17
+	ADDS	R7, R13, R13
+	ADCS	R8, R14, R14
+	ADCS	R9, R15, R15
+	ADCS	R10, R16, R16
+	ADC	ZR, R17, R17
+	// r3..r7 += x1*x2
+	MUL	R5, R4, R7
+	UMULH	R5, R4, R8
+	ADDS	R7, R14, R14
+	ADCS	R8, R15, R15
+	ADCS	ZR, R16, R16
+	ADCS	ZR, R17, R17
+	ADC	ZR, ZR, R19
+	// r = 2*r + x0^2 + x1^2*2^128 + x2^2*2^256 + x3^2*2^384
+	ADDS	R12, R12, R12
+	ADCS	R13, R13, R13
+	ADCS	R14, R14, R14
+	ADCS	R15, R15, R15
+	ADCS	R16, R16, R16
+	ADCS	R17, R17, R17
+	ADC	R19, R19, R19
+	MUL	R3, R3, R11
+	UMULH	R3, R3, R7
+	MUL	R4, R4, R8
+	UMULH	R4, R4, R9
+	MUL	R5, R5, R10
+	UMULH	R5, R5, R20
+	MUL	R6, R6, R21
+	UMULH	R6, R6, R22
+	ADDS	R7, R12, R12
+	ADCS	R8, R13, R13
+	ADCS	R9, R14, R14
+	ADCS	R10, R15, R15
+	ADCS	R20, R16, R16
+	ADCS	R21, R17, R17
+	ADC	R22, R19, R19
+	REDUCE
+	RET
+
+// func add(res, x, y *FieldElement)
+TEXT ·add(SB), NOSPLIT, $0-24
+	MOVD	res+0(FP), R0
+	MOVD	x+8(FP), R1
+	MOVD	y+16(FP), R2
+	LDP	0(R1), (R3, R4)
+	LDP	16(R1), (R5, R6)
+	LDP	0(R2), (R7, R8)
+	LDP	16(R2), (R9, R10)
+	ADDS	R7, R3, R3
+	ADCS	R8, R4, R4
+	ADCS	R9, R5, R5
+	ADCS	R10, R6, R6
+	// 2^256 == 288
+	MOVD	$288, R11
+	CSEL	HS, R11, ZR, R12
+	ADDS	R12, R3, R3
+	ADCS	ZR, R4, R4
+	ADCS	ZR, R5, R5
+	ADCS	ZR, R6, R6
+	CSEL	HS, R11, ZR, R12
+	ADD	R12, R3, R3
+	STP	(R3, R4), 0(R0)
+	STP	(R5, R6), 16(R0)
+	RET
+
+// func mul2(res, x *FieldElement)
+TEXT ·mul2(SB), NOSPLIT, $0-16
+	MOVD	res+0(FP), R0
+	MOVD	x+8(FP), R1
+	LDP	0(R1), (R3, R4)
+	LDP	16(R1), (R5, R6)
+	ADDS	R3, R3, R3
+	ADCS	R4, R4, R4
+	ADCS	R5, R5, R5
+	ADCS	R6, R6, R6
+	MOVD	$288, R11
+	CSEL	HS, R11, ZR, R12
+	ADDS	R12, R3, R3
+	ADCS	ZR, R4, R4
+	ADCS	ZR, R5, R5
+	ADCS	ZR, R6, R6
+	CSEL	HS, R11, ZR, R12
+	ADD	R12, R3, R3
+	STP	(R3, R4), 0(R0)
+	STP	(R5, R6), 16(R0)
+	RET
+
+// func sub(res, x, y *FieldElement)
+TEXT ·sub(SB), NOSPLIT, $0-24
+	MOVD	res+0(FP), R0
+	MOVD	x+8(FP), R1
+	MOVD	y+16(FP), R2
+	LDP	0(R1), (R3, R4)
+	LDP	16(R1), (R5, R6)
+	LDP	0(R2), (R7, R8)
+	LDP	16(R2), (R9, R10)
+	SUBS	R7, R3, R3
+	SBCS	R8, R4, R4
+	SBCS	R9, R5, R5
+	SBCS	R10, R6, R6
+	// borrow (carry clear) means result is 2^256 too big, 2^256 == 288
+	MOVD	$288, R11
+	CSEL	LO, R11, ZR, R12
+	SUBS	R12, R3, R3
+	SBCS	ZR, R4, R4
+	SBCS	ZR, R5, R5
+	SBCS	ZR, R6, R6
+	CSEL	LO, R11, ZR, R12
+	SUB	R12, R3, R3
+	STP	(R3, R4), 0(R0)
+	STP	(R5, R6), 16(R0)
+	RET
+
+// func mulD(res, x *FieldElement)
+// res = x * -1174
+TEXT ·mulD(SB), NOSPLIT, $0-16
+	MOVD	res+0(FP), R0
+	MOVD	x+8(FP), R1
+	LDP	0(R1), (R3, R4)
+	LDP	16(R1), (R5, R6)
+	MOVD	$1174, R2
+	MUL	R2, R3, R10
+	UMULH	R2, R3, R11
+	MUL	R2, R4, R12
+	UMULH	R2, R4, R13
+	MUL	R2, R5, R14
+	UMULH	R2, R5, R15
+	MUL	R2, R6, R16
+	UMULH	R2, R6, R17
+	// r0..r4 = R10, R11, R13, R15, R17
+	ADDS	R12, R11, R11
+	ADCS	R14, R13, R13
+	ADCS	R16, R15, R15
+	ADC	ZR, R17, R17
+	// fold bits above 2^251, 2^251 == 9
+	LSL	$5, R17, R17
+	LSR	$59, R15, R7
+	ORR	R7, R17, R17
+	MOVD	$9, R8
+	MUL	R8, R17, R17
+	AND	$0x07ffffffffffffff, R15, R15
+	ADDS	R17, R10, R10
+	ADCS	ZR, R11, R11
+	ADCS	ZR, R13, R13
+	ADC	ZR, R15, R15
+	// res = p - r
+	MOVD	$-9, R7
+	MOVD	$-1, R8
+	MOVD	$0x07ffffffffffffff, R9
+	SUBS	R10, R7, R10
+	SBCS	R11, R8, R11
+	SBCS	R13, R8, R13
+	SBCS	R15, R9, R15
+	MOVD	$288, R7
+	CSEL	LO, R7, ZR, R8
+	SUBS	R8, R10, R10
+	SBCS	ZR, R11, R11
+	SBCS	ZR, R13, R13
+	SBCS	ZR, R15, R15
+	CSEL	LO, R7, ZR, R8
+	SUB	R8, R10, R10
+	STP	(R10, R11), 0(R0)
+	STP	(R13, R15), 16(R0)
+	RET
+
+// func mod(res, x *FieldElement)
+TEXT ·mod(SB), NOSPLIT, $0-16
+	MOVD	res+0(FP), R0
+	MOVD	x+8(FP), R1
+	LDP	0(R1), (R3, R4)
+	LDP	16(R1), (R5, R6)
+	LSR	$59, R6, R7
+	MOVD	$9, R8
+	MUL	R8, R7, R7
+	AND	$0x07ffffffffffffff, R6, R6
+	ADDS	R7, R3, R3
+	ADCS	ZR, R4, R4
+	ADCS	ZR, R5, R5
+	ADC	ZR, R6, R6
+	// subtract p, keep original value if it borrows
+	MOVD	$-9, R7
+	MOVD	$-1, R8
+	MOVD	$0x07ffffffffffffff, R9
+	SUBS	R7, R3, R10
+	SBCS	R8, R4, R11
+	SBCS	R8, R5, R12
+	SBCS	R9, R6, R13
+	CSEL	HS, R10, R3, R3
+	CSEL	HS, R11, R4, R4
+	CSEL	HS, R12, R5, R5
+	CSEL	HS, R13, R6, R6
+	STP	(R3, R4), 0(R0)
+	STP	(R5, R6), 16(R0)
+	RET
+
+// func selectPointAsm(res *Point, table *[16]Point, index uint64)
+// res = table[index], all entries are read so execution time doesn't depend on index
+TEXT ·selectPointAsm(SB), NOSPLIT, $0-24
+	MOVD	res+0(FP), R0
+	MOVD	table+8(FP), R1
+	MOVD	index+16(FP), R2
+	MOVD	ZR, R7
+	MOVD	ZR, R8
+	MOVD	ZR, R9
+	MOVD	ZR, R10
+	MOVD	ZR, R11
+	MOVD	ZR, R12
+	MOVD	ZR, R13
+	MOVD	ZR, R14
+	MOVD	ZR, R15
+	MOVD	ZR, R16
+	MOVD	ZR, R17
+	MOVD	ZR, R19
+	MOVD	ZR, R20
+	MOVD	ZR, R21
+	MOVD	ZR, R22
+	MOVD	ZR, R23
+	MOVD	ZR, R3
+loop:
+	CMP	R3, R2
+	CSETM	EQ, R4
+	LDP.P	16(R1), (R5, R6)
+	AND	R4, R5, R5
+	AND	R4, R6, R6
+	ORR	R5, R7, R7
+	ORR	R6, R8, R8
+	LDP.P	16(R1), (R5, R6)
+	AND	R4, R5, R5
+	AND	R4, R6, R6
+	ORR	R5, R9, R9
+	ORR	R6, R10, R10
+	LDP.P	16(R1), (R5, R6)
+	AND	R4, R5, R5
+	AND	R4, R6, R6
+	ORR	R5, R11, R11
+	ORR	R6, R12, R12
+	LDP.P	16(R1), (R5, R6)
+	AND	R4, R5, R5
+	AND	R4, R6, R6
+	ORR	R5, R13, R13
+	ORR	R6, R14, R14
+	LDP.P	16(R1), (R5, R6)
+	AND	R4, R5, R5
+	AND	R4, R6, R6
+	ORR	R5, R15, R15
+	ORR	R6, R16, R16
+	LDP.P	16(R1), (R5, R6)
+	AND	R4, R5, R5
+	AND	R4, R6, R6
+	ORR	R5, R17, R17
+	ORR	R6, R19, R19
+	LDP.P	16(R1), (R5, R6)
+	AND	R4, R5, R5
+	AND	R4, R6, R6
+	ORR	R5, R20, R20
+	ORR	R6, R21, R21
+	LDP.P	16(R1), (R5, R6)
+	AND	R4, R5, R5
+	AND	R4, R6, R6
+	ORR	R5, R22, R22
+	ORR	R6, R23, R23
+	ADD	$1, R3, R3
+	CMP	$16, R3
+	BNE	loop
+	STP	(R7, R8), 0(R0)
+	STP	(R9, R10), 16(R0)
+	STP	(R11, R12), 32(R0)
+	STP	(R13, R14), 48(R0)
+	STP	(R15, R16), 64(R0)
+	STP	(R17, R19), 80(R0)
+	STP	(R20, R21), 96(R0)
+	STP	(R22, R23), 112(R0)
+	RET
@@ -0,0 +1,376 @@
+//go:build (386 || arm) && !curve1174_radix51
+
+package curve1174
+
+//Multiplication and squaring for 32-bit platforms. bits.Mul64 and bits.Add64 used in field_generic64.go are emulated
+//there with several 32-bit instructions each, so here field elements are split into eight 32-bit words and partial
+//products are accumulated in uint64 with native 32x32->64 multiplication. Squaring computes every cross product only
+//once. Unsaturated representation with ten 25/26-bit limbs was slower on 386: it needs 100 multiplications instead
+//of 64 and doesn't fit in registers anyway.
+
+//genericBackend is name of pure Go backend
+const genericBackend = "generic32"
+
+//words32 splits p into eight 32-bit words, least significant first
+func words32(p *FieldElement) [8]uint32 {
+	return [8]uint32{uint32(p[0]), uint32(p[0] >> 32), uint32(p[1]), uint32(p[1] >> 32),
+		uint32(p[2]), uint32(p[2] >> 32), uint32(p[3]), uint32(p[3] >> 32)}
+}
+
+func mulGeneric(res, p1, p2 *FieldElement) {
+	x := words32(p1)
+	y := words32(p2)
+	var r [16]uint32
+	var c, xi uint64
+
+	//r += x[0] * y << 0
+	xi = uint64(x[0])
+	c = xi * uint64(y[0])
+	r[0] = uint32(c)
+	c = xi*uint64(y[1]) + c>>32
+	r[1] = uint32(c)
+	c = xi*uint64(y[2]) + c>>32
+	r[2] = uint32(c)
+	c = xi*uint64(y[3]) + c>>32
+	r[3] = uint32(c)
+	c = xi*uint64(y[4]) + c>>32
+	r[4] = uint32(c)
+	c = xi*uint64(y[5]) + c>>32
+	r[5] = uint32(c)
+	c = xi*uint64(y[6]) + c>>32
+	r[6] = uint32(c)
+	c = xi*uint64(y[7]) + c>>32
+	r[7] = uint32(c)
+	r[8] = uint32(c >> 32)
+
+	//r += x[1] * y << 32
+	xi = uint64(x[1])
+	c = xi*uint64(y[0]) + uint64(r[1])
+	r[1] = uint32(c)
+	c = xi*uint64(y[1]) + uint64(r[2]) + c>>32
+	r[2] = uint32(c)
+	c = xi*uint64(y[2]) + uint64(r[3]) + c>>32
+	r[3] = uint32(c)
+	c = xi*uint64(y[3]) + uint64(r[4]) + c>>32
+	r[4] = uint32(c)
+	c = xi*uint64(y[4]) + uint64(r[5]) + c>>32
+	r[5] = uint32(c)
+	c = xi*uint64(y[5]) + uint64(r[6]) + c>>32
+	r[6] = uint32(c)
+	c = xi*uint64(y[6]) + uint64(r[7]) + c>>32
+	r[7] = uint32(c)
+	c = xi*uint64(y[7]) + uint64(r[8]) + c>>32
+	r[8] = uint32(c)
+	r[9] = uint32(c >> 32)
+
+	//r += x[2] * y << 64
+	xi = uint64(x[2])
+	c = xi*uint64(y[0]) + uint64(r[2])
+	r[2] = uint32(c)
+	c = xi*uint64(y[1]) + uint64(r[3]) + c>>32
+	r[3] = uint32(c)
+	c = xi*uint64(y[2]) + uint64(r[4]) + c>>32
+	r[4] = uint32(c)
+	c = xi*uint64(y[3]) + uint64(r[5]) + c>>32
+	r[5] = uint32(c)
+	c = xi*uint64(y[4]) + uint64(r[6]) + c>>32
+	r[6] = uint32(c)
+	c = xi*uint64(y[5]) + uint64(r[7]) + c>>32
+	r[7] = uint32(c)
+	c = xi*uint64(y[6]) + uint64(r[8]) + c>>32
+	r[8] = uint32(c)
+	c = xi*uint64(y[7]) + uint64(r[9]) + c>>32
+	r[9] = uint32(c)
+	r[10] = uint32(c >> 32)
+
+	//r += x[3] * y << 96
+	xi = uint64(x[3])
+	c = xi*uint64(y[0]) + uint64(r[3])
+	r[3] = uint32(c)
+	c = xi*uint64(y[1]) + uint64(r[4]) + c>>32
+	r[4] = uint32(c)
+	c = xi*uint64(y[2]) + uint64(r[5]) + c>>32
+	r[5] = uint32(c)
+	c = xi*uint64(y[3]) + uint64(r[6]) + c>>32
+	r[6] = uint32(c)
+	c = xi*uint64(y[4]) + uint64(r[7]) + c>>32
+	r[7] = uint32(c)
+	c = xi*uint64(y[5]) + uint64(r[8]) + c>>32
+	r[8] = uint32(c)
+	c = xi*uint64(y[6]) + uint64(r[9]) + c>>32
+	r[9] = uint32(c)
+	c = xi*uint64(y[7]) + uint64(r[10]) + c>>32
+	r[10] = uint32(c)
+	r[11] = uint32(c >> 32)
+
+	//r += x[4] * y << 128
+	xi = uint64(x[4])
+	c = xi*uint64(y[0]) + uint64(r[4])
+	r[4] = uint32(c)
+	c = xi*uint64(y[1]) + uint64(r[5]) + c>>32
+	r[5] = uint32(c)
+	c = xi*uint64(y[2]) + uint64(r[6]) + c>>32
+	r[6] = uint32(c)
+	c = xi*uint64(y[3]) + uint64(r[7]) + c>>32
+	r[7] = uint32(c)
+	c = xi*uint64(y[4]) + uint64(r[8]) + c>>32
+	r[8] = uint32(c)
+	c = xi*uint64(y[5]) + uint64(r[9]) + c>>32
+	r[9] = uint32(c)
+	c = xi*uint64(y[6]) + uint64(r[10]) + c>>32
+	r[10] = uint32(c)
+	c = xi*uint64(y[7]) + uint64(r[11]) + c>>32
+	r[11] = uint32(c)
+	r[12] = uint32(c >> 32)
+
+	//r += x[5] * y << 160
+	xi = uint64(x[5])
+	c = xi*uint64(y[0]) + uint64(r[5])
+	r[5] = uint32(c)
+	c = xi*uint64(y[1]) + uint64(r[6]) + c>>32
+	r[6] = uint32(c)
+	c = xi*uint64(y[2]) + uint64(r[7]) + c>>32
+	r[7] = uint32(c)
+	c = xi*uint64(y[3]) + uint64(r[8]) + c>>32
+	r[8] = uint32(c)
+	c = xi*uint64(y[4]) + uint64(r[9]) + c>>32
+	r[9] = uint32(c)
+	c = xi*uint64(y[5]) + uint64(r[10]) + c>>32
+	r[10] = uint32(c)
+	c = xi*uint64(y[6]) + uint64(r[11]) + c>>32
+	r[11] = uint32(c)
+	c = xi*uint64(y[7]) + uint64(r[12]) + c>>32
+	r[12] = uint32(c)
+	r[13] = uint32(c >> 32)
+
+	//r += x[6] * y << 192
+	xi = uint64(x[6])
+	c = xi*uint64(y[0]) + uint64(r[6])
+	r[6] = uint32(c)
+	c = xi*uint64(y[1]) + uint64(r[7]) + c>>32
+	r[7] = uint32(c)
+	c = xi*uint64(y[2]) + uint64(r[8]) + c>>32
+	r[8] = uint32(c)
+	c = xi*uint64(y[3]) + uint64(r[9]) + c>>32
+	r[9] = uint32(c)
+	c = xi*uint64(y[4]) + uint64(r[10]) + c>>32
+	r[10] = uint32(c)
+	c = xi*uint64(y[5]) + uint64(r[11]) + c>>32
+	r[11] = uint32(c)
+	c = xi*uint64(y[6]) + uint64(r[12]) + c>>32
+	r[12] = uint32(c)
+	c = xi*uint64(y[7]) + uint64(r[13]) + c>>32
+	r[13] = uint32(c)
+	r[14] = uint32(c >> 32)
+
+	//r += x[7] * y << 224
+	xi = uint64(x[7])
+	c = xi*uint64(y[0]) + uint64(r[7])
+	r[7] = uint32(c)
+	c = xi*uint64(y[1]) + uint64(r[8]) + c>>32
+	r[8] = uint32(c)
+	c = xi*uint64(y[2]) + uint64(r[9]) + c>>32
+	r[9] = uint32(c)
+	c = xi*uint64(y[3]) + uint64(r[10]) + c>>32
+	r[10] = uint32(c)
+	c = xi*uint64(y[4]) + uint64(r[11]) + c>>32
+	r[11] = uint32(c)
+	c = xi*uint64(y[5]) + uint64(r[12]) + c>>32
+	r[12] = uint32(c)
+	c = xi*uint64(y[6]) + uint64(r[13]) + c>>32
+	r[13] = uint32(c)
+	c = xi*uint64(y[7]) + uint64(r[14]) + c>>32
+	r[14] = uint32(c)
+	r[15] = uint32(c >> 32)
+
+	reduce32(res, &r)
+}
+
+func sqrGeneric(res, p1 *FieldElement) {
+	x := words32(p1)
+	var r [16]uint32
+	var c, xi uint64
+
+	//r += x[0] * x[1..7] << 32
+	xi = uint64(x[0])
+	c = xi * uint64(x[1])
+	r[1] = uint32(c)
+	c = xi*uint64(x[2]) + c>>32
+	r[2] = uint32(c)
+	c = xi*uint64(x[3]) + c>>32
+	r[3] = uint32(c)
+	c = xi*uint64(x[4]) + c>>32
+	r[4] = uint32(c)
+	c = xi*uint64(x[5]) + c>>32
+	r[5] = uint32(c)
+	c = xi*uint64(x[6]) + c>>32
+	r[6] = uint32(c)
+	c = xi*uint64(x[7]) + c>>32
+	r[7] = uint32(c)
+	r[8] = uint32(c >> 32)
+
+	//r += x[1] * x[2..7] << 96
+	xi = uint64(x[1])
+	c = xi*uint64(x[2]) + uint64(r[3])
+	r[3] = uint32(c)
+	c = xi*uint64(x[3]) + uint64(r[4]) + c>>32
+	r[4] = uint32(c)
+	c = xi*uint64(x[4]) + uint64(r[5]) + c>>32
+	r[5] = uint32(c)
+	c = xi*uint64(x[5]) + uint64(r[6]) + c>>32
+	r[6] = uint32(c)
+	c = xi*uint64(x[6]) + uint64(r[7]) + c>>32
+	r[7] = uint32(c)
+	c = xi*uint64(x[7]) + uint64(r[8]) + c>>32
+	r[8] = uint32(c)
+	r[9] = uint32(c >> 32)
+
+	//r += x[2] * x[3..7] << 160
+	xi = uint64(x[2])
+	c = xi*uint64(x[3]) + uint64(r[5])
+	r[5] = uint32(c)
+	c = xi*uint64(x[4]) + uint64(r[6]) + c>>32
+	r[6] = uint32(c)
+	c = xi*uint64(x[5]) + uint64(r[7]) + c>>32
+	r[7] = uint32(c)
+	c = xi*uint64(x[6]) + uint64(r[8]) + c>>32
+	r[8] = uint32(c)
+	c = xi*uint64(x[7]) + uint64(r[9]) + c>>32
+	r[9] = uint32(c)
+	r[10] = uint32(c >> 32)
+
+	//r += x[3] * x[4..7] << 224
+	xi = uint64(x[3])
+	c = xi*uint64(x[4]) + uint64(r[7])
+	r[7] = uint32(c)
+	c = xi*uint64(x[5]) + uint64(r[8]) + c>>32
+	r[8] = uint32(c)
+	c = xi*uint64(x[6]) + uint64(r[9]) + c>>32
+	r[9] = uint32(c)
+	c = xi*uint64(x[7]) + uint64(r[10]) + c>>32
+	r[10] = uint32(c)
+	r[11] = uint32(c >> 32)
+
+	//r += x[4] * x[5..7] << 288
+	xi = uint64(x[4])
+	c = xi*uint64(x[5]) + uint64(r[9])
+	r[9] = uint32(c)
+	c = xi*uint64(x[6]) + uint64(r[10]) + c>>32
+	r[10] = uint32(c)
+	c = xi*uint64(x[7]) + uint64(r[11]) + c>>32
+	r[11] = uint32(c)
+	r[12] = uint32(c >> 32)
+
+	//r += x[5] * x[6..7] << 352
+	xi = uint64(x[5])
+	c = xi*uint64(x[6]) + uint64(r[11])
+	r[11] = uint32(c)
+	c = xi*uint64(x[7]) + uint64(r[12]) + c>>32
+	r[12] = uint32(c)
+	r[13] = uint32(c >> 32)
+
+	//r += x[6] * x[7..7] << 416
+	xi = uint64(x[6])
+	c = xi*uint64(x[7]) + uint64(r[13])
+	r[13] = uint32(c)
+	r[14] = uint32(c >> 32)
+
+	//cross products are counted twice
+	r[15] = r[14] >> 31
+	r[14] = r[14]<<1 | r[13]>>31
+	r[13] = r[13]<<1 | r[12]>>31
+	r[12] = r[12]<<1 | r[11]>>31
+	r[11] = r[11]<<1 | r[10]>>31
+	r[10] = r[10]<<1 | r[9]>>31
+	r[9] = r[9]<<1 | r[8]>>31
+	r[8] = r[8]<<1 | r[7]>>31
+	r[7] = r[7]<<1 | r[6]>>31
+	r[6] = r[6]<<1 | r[5]>>31
+	r[5] = r[5]<<1 | r[4]>>31
+	r[4] = r[4]<<1 | r[3]>>31
+	r[3] = r[3]<<1 | r[2]>>31
+	r[2] = r[2]<<1 | r[1]>>31
+	r[1] <<= 1
+
+	//r += x[i]^2 << 64i
+	c = uint64(x[0]) * uint64(x[0])
+	r[0] = uint32(c)
+	c = uint64(r[1]) + c>>32
+	r[1] = uint32(c)
+	c = uint64(x[1])*uint64(x[1]) + uint64(r[2]) + c>>32
+	r[2] = uint32(c)
+	c = uint64(r[3]) + c>>32
+	r[3] = uint32(c)
+	c = uint64(x[2])*uint64(x[2]) + uint64(r[4]) + c>>32
+	r[4] = uint32(c)
+	c = uint64(r[5]) + c>>32
+	r[5] = uint32(c)
+	c = uint64(x[3])*uint64(x[3]) + uint64(r[6]) + c>>32
+	r[6] = uint32(c)
+	c = uint64(r[7]) + c>>32
+	r[7] = uint32(c)
+	c = uint64(x[4])*uint64(x[4]) + uint64(r[8]) + c>>32
+	r[8] = uint32(c)
+	c = uint64(r[9]) + c>>32
+	r[9] = uint32(c)
+	c = uint64(x[5])*uint64(x[5]) + uint64(r[10]) + c>>32
+	r[10] = uint32(c)
+	c = uint64(r[11]) + c>>32
+	r[11] = uint32(c)
+	c = uint64(x[6])*uint64(x[6]) + uint64(r[12]) + c>>32
+	r[12] = uint32(c)
+	c = uint64(r[13]) + c>>32
+	r[13] = uint32(c)
+	c = uint64(x[7])*uint64(x[7]) + uint64(r[14]) + c>>32
+	r[14] = uint32(c)
+	c = uint64(r[15]) + c>>32
+	r[15] = uint32(c)
+
+	reduce32(res, &r)
+}
+
+//reduce32 reduces 512-bit product r mod 2^251-9 and stores result (smaller than 2^251+2^17) in res
+func reduce32(res *FieldElement, r *[16]uint32) {
+	//2^256 == 288
+	c := uint64(r[0]) + uint64(r[8])*288
+	r[0] = uint32(c)
+	c = uint64(r[1]) + uint64(r[9])*288 + c>>32
+	r[1] = uint32(c)
+	c = uint64(r[2]) + uint64(r[10])*288 + c>>32
+	r[2] = uint32(c)
+	c = uint64(r[3]) + uint64(r[11])*288 + c>>32
+	r[3] = uint32(c)
+	c = uint64(r[4]) + uint64(r[12])*288 + c>>32
+	r[4] = uint32(c)
+	c = uint64(r[5]) + uint64(r[13])*288 + c>>32
+	r[5] = uint32(c)
+	c = uint64(r[6]) + uint64(r[14])*288 + c>>32
+	r[6] = uint32(c)
+	c = uint64(r[7]) + uint64(r[15])*288 + c>>32
+	r[7] = uint32(c)
+
+	//fold carry and bits above 2^251 with 2^251 == 9
+	c = (c>>32<<5 | uint64(r[7]>>27)) * 9
+	r[7] &= 1<<27 - 1
+	c += uint64(r[0])
+	r[0] = uint32(c)
+	c = uint64(r[1]) + c>>32
+	r[1] = uint32(c)
+	c = uint64(r[2]) + c>>32
+	r[2] = uint32(c)
+	c = uint64(r[3]) + c>>32
+	r[3] = uint32(c)
+	c = uint64(r[4]) + c>>32
+	r[4] = uint32(c)
+	c = uint64(r[5]) + c>>32
+	r[5] = uint32(c)
+	c = uint64(r[6]) + c>>32
+	r[6] = uint32(c)
+	c = uint64(r[7]) + c>>32
+	r[7] = uint32(c)
+
+	res[0] = uint64(r[0]) | uint64(r[1])<<32
+	res[1] = uint64(r[2]) | uint64(r[3])<<32
+	res[2] = uint64(r[4]) | uint64(r[5])<<32
+	res[3] = uint64(r[6]) | uint64(r[7])<<32
+}
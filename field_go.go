@@ -0,0 +1,73 @@
+//go:build (!amd64 && !arm64) || curve1174_purego || curve1174_radix51
+
+package curve1174
+
+//Without assembly all field operations use pure Go implementations from field_generic.go, field_generic64.go (or
+//field_generic32.go, field_radix51_backend.go) and field_portable.go
+
+func backends() []string {
+	return []string{genericBackend}
+}
+
+func backend() string {
+	return genericBackend
+}
+
+func useBackend(string) {}
+
+func mul(res, x, y *FieldElement) {
+	mulGeneric(res, x, y)
+}
+
+func sqr(res, x *FieldElement) {
+	sqrGeneric(res, x)
+}
+
+func mulD(res, x *FieldElement) {
+	mulDGeneric(res, x)
+}
+
+func mul2(res, x *FieldElement) {
+	mul2Generic(res, x)
+}
+
+func add(res, x, y *FieldElement) {
+	addGeneric(res, x, y)
+}
+
+func sub(res, x, y *FieldElement) {
+	subGeneric(res, x, y)
+}
+
+func mod(res, x *FieldElement) {
+	modGeneric(res, x)
+}
+
+func fastInverse(res, x *FieldElement) {
+	fastInverseGeneric(res, x)
+}
+
+func selectPoint(res *Point, table *[16]Point, index uint64) {
+	countOp(&opCounts.Select)
+	selectPointGeneric(res, table, index)
+}
+
+func selectCachedPoint(res *CachedPoint, table *[16]CachedPoint, index uint64) {
+	countOp(&opCounts.Select)
+	selectCachedPointGeneric(res, table, index)
+}
+
+func selectAffineCachedPoint(res *AffineCachedPoint, table *[16]AffineCachedPoint, index uint64) {
+	countOp(&opCounts.Select)
+	selectAffineCachedPointGeneric(res, table, index)
+}
+
+func selectAffineCachedPoint129(res *AffineCachedPoint, table *[129]AffineCachedPoint, index uint64) {
+	countOp(&opCounts.Select)
+	selectAffineCachedPoint129Generic(res, table, index)
+}
+
+func selectAffineCachedPointSlice(res *AffineCachedPoint, table []AffineCachedPoint, index uint64) {
+	countOp(&opCounts.Select)
+	selectAffineCachedPointSliceGeneric(res, table, index)
+}
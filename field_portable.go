@@ -0,0 +1,138 @@
+package curve1174
+
+import (
+	"crypto/subtle"
+	"math/bits"
+)
+
+//fastInverseGeneric computes inverse of x (0 < x < 2^251-9) using binary extended Euclidean algorithm. b and d are signed
+//(two's complement) coefficients with b*x == u and d*x == v mod 2^251-9, they are kept in (-2^252, 2^252) by
+//reduceSigned so they can't overflow. Execution time depends on x.
+func fastInverseGeneric(res, x *FieldElement) {
+	u := modulus
+	v := *x
+	var b FieldElement
+	d := FieldElement{1}
+	for u[0]|u[1]|u[2]|u[3] != 0 {
+		for u[0]&1 == 0 {
+			if b[0]&1 == 1 {
+				subP(&b)
+			}
+			div2Signed(&b)
+			div2Signed(&u)
+		}
+		for v[0]&1 == 0 {
+			if d[0]&1 == 1 {
+				subP(&d)
+			}
+			div2Signed(&d)
+			div2Signed(&v)
+		}
+		if u.Cmp(&v) >= 0 {
+			subNoMod(&u, &v)
+			subNoMod(&b, &d)
+			reduceSigned(&b)
+		} else {
+			subNoMod(&v, &u)
+			subNoMod(&d, &b)
+			reduceSigned(&d)
+		}
+	}
+	//d can be smaller than -p
+	for int64(d[3]) < 0 {
+		var c uint64
+		d[0], c = bits.Add64(d[0], P0, 0)
+		d[1], c = bits.Add64(d[1], P1, c)
+		d[2], c = bits.Add64(d[2], P2, c)
+		d[3], _ = bits.Add64(d[3], P3, c)
+	}
+	*res = d
+}
+
+//reduceSigned brings signed r from (-2^253, 2^253) back to (-2^252, 2^252) by adding or subtracting 4p = 2^253-36
+func reduceSigned(r *FieldElement) {
+	var c uint64
+	switch t := int64(r[3]) >> 60; {
+	case t > 0:
+		r[0], c = bits.Add64(r[0], 36, 0)
+		r[1], c = bits.Add64(r[1], 0, c)
+		r[2], c = bits.Add64(r[2], 0, c)
+		r[3], _ = bits.Add64(r[3], 0xe000000000000000, c)
+	case t < -1:
+		r[0], c = bits.Sub64(r[0], 36, 0)
+		r[1], c = bits.Sub64(r[1], 0, c)
+		r[2], c = bits.Sub64(r[2], 0, c)
+		r[3], _ = bits.Sub64(r[3], 0xe000000000000000, c)
+	}
+}
+
+//subP subtracts 2^251-9 from r without reduction
+func subP(r *FieldElement) {
+	subNoMod(r, &modulus)
+}
+
+//subNoMod subtracts v from u without reduction (mod 2^256)
+func subNoMod(u, v *FieldElement) {
+	var borrow uint64
+	u[0], borrow = bits.Sub64(u[0], v[0], 0)
+	u[1], borrow = bits.Sub64(u[1], v[1], borrow)
+	u[2], borrow = bits.Sub64(u[2], v[2], borrow)
+	u[3], _ = bits.Sub64(u[3], v[3], borrow)
+}
+
+//div2Signed divides signed (two's complement) number r by 2
+func div2Signed(r *FieldElement) {
+	r[0] = r[0]>>1 | r[1]<<63
+	r[1] = r[1]>>1 | r[2]<<63
+	r[2] = r[2]>>1 | r[3]<<63
+	r[3] = uint64(int64(r[3]) >> 1)
+}
+
+func selectCachedPointGeneric(res *CachedPoint, table *[16]CachedPoint, index uint64) {
+	*res = CachedPoint{}
+	for i := 0; i < 16; i++ {
+		b1 := ^(uint64(subtle.ConstantTimeEq(int32(index), int32(i))) - 1)
+		selectFieldElement(&res.YPlusX, &table[i].YPlusX, b1)
+		selectFieldElement(&res.YMinusX, &table[i].YMinusX, b1)
+		selectFieldElement(&res.T2D, &table[i].T2D, b1)
+		selectFieldElement(&res.Z2, &table[i].Z2, b1)
+	}
+}
+
+func selectAffineCachedPointGeneric(res *AffineCachedPoint, table *[16]AffineCachedPoint, index uint64) {
+	*res = AffineCachedPoint{}
+	for i := 0; i < 16; i++ {
+		b1 := ^(uint64(subtle.ConstantTimeEq(int32(index), int32(i))) - 1)
+		selectFieldElement(&res.YPlusX, &table[i].YPlusX, b1)
+		selectFieldElement(&res.YMinusX, &table[i].YMinusX, b1)
+		selectFieldElement(&res.T2D, &table[i].T2D, b1)
+	}
+}
+
+func selectAffineCachedPoint129Generic(res *AffineCachedPoint, table *[129]AffineCachedPoint, index uint64) {
+	*res = AffineCachedPoint{}
+	for i := 0; i < 129; i++ {
+		b1 := ^(uint64(subtle.ConstantTimeEq(int32(index), int32(i))) - 1)
+		selectFieldElement(&res.YPlusX, &table[i].YPlusX, b1)
+		selectFieldElement(&res.YMinusX, &table[i].YMinusX, b1)
+		selectFieldElement(&res.T2D, &table[i].T2D, b1)
+	}
+}
+
+func selectAffineCachedPointSliceGeneric(res *AffineCachedPoint, table []AffineCachedPoint, index uint64) {
+	*res = AffineCachedPoint{}
+	for i := range table {
+		b1 := ^(uint64(subtle.ConstantTimeEq(int32(index), int32(i))) - 1)
+		selectFieldElement(&res.YPlusX, &table[i].YPlusX, b1)
+		selectFieldElement(&res.YMinusX, &table[i].YMinusX, b1)
+		selectFieldElement(&res.T2D, &table[i].T2D, b1)
+	}
+}
+
+//selectFieldElement ORs x masked with mask into res
+func selectFieldElement(res, x *FieldElement, mask uint64) {
+	res[0] |= x[0] & mask
+	res[1] |= x[1] & mask
+	res[2] |= x[2] & mask
+	res[3] |= x[3] & mask
+}
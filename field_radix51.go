@@ -0,0 +1,160 @@
+package curve1174
+
+import "math/bits"
+
+//Multiplication and squaring in radix 2^51: field element is split into five 51-bit limbs, so partial products can be
+//accumulated in 128-bit sums without carry chains and carried only once at the end (lazy reduction).
+//Limbs above 2^255 are folded back multiplied by 2^255 mod 2^251-9 == 144.
+//FieldElement is still stored in four 64-bit limbs so inputs are unpacked and result is packed in every call, which
+//(together with 25 instead of 16 64-bit multiplications) makes it slower than field_generic64.go on amd64.
+//It's compiled in every build so tests and benchmarks can compare it with other pure Go code, tag curve1174_radix51
+//selects it as pure Go backend (see field_radix51_backend.go).
+
+const mask51 = 1<<51 - 1
+
+//uint128 holds 128-bit partial sums of products
+type uint128 struct {
+	lo, hi uint64
+}
+
+//mul64 returns a*b
+func mul64(a, b uint64) uint128 {
+	hi, lo := bits.Mul64(a, b)
+	return uint128{lo, hi}
+}
+
+//addMul64 returns v + a*b
+func addMul64(v uint128, a, b uint64) uint128 {
+	hi, lo := bits.Mul64(a, b)
+	lo, c := bits.Add64(lo, v.lo, 0)
+	hi, _ = bits.Add64(hi, v.hi, c)
+	return uint128{lo, hi}
+}
+
+//shiftRightBy51 returns v >> 51, v has to be smaller than 2^115
+func shiftRightBy51(v uint128) uint64 {
+	return v.hi<<13 | v.lo>>51
+}
+
+//unpack51 splits p into five 51-bit limbs, bit 255 is folded into the lowest limb
+func unpack51(p *FieldElement) (l0, l1, l2, l3, l4 uint64) {
+	l0 = p[0]&mask51 + (p[3]>>63)*144
+	l1 = (p[0]>>51 | p[1]<<13) & mask51
+	l2 = (p[1]>>38 | p[2]<<26) & mask51
+	l3 = (p[2]>>25 | p[3]<<39) & mask51
+	l4 = (p[3] >> 12) & mask51
+	return
+}
+
+//carryPack51 propagates carries of 128-bit limb sums and stores result (smaller than 2^251+144) in res
+func carryPack51(res *FieldElement, r0, r1, r2, r3, r4 uint128) {
+	c0 := shiftRightBy51(r0)
+	c1 := shiftRightBy51(r1)
+	c2 := shiftRightBy51(r2)
+	c3 := shiftRightBy51(r3)
+	c4 := shiftRightBy51(r4)
+
+	l0 := r0.lo&mask51 + c4*144
+	l1 := r1.lo&mask51 + c0
+	l2 := r2.lo&mask51 + c1
+	l3 := r3.lo&mask51 + c2
+	l4 := r4.lo&mask51 + c3
+
+	l0, l1, l2, l3, l4 = carry51(l0, l1, l2, l3, l4)
+	//bits above 2^251 are folded with 2^251 == 9, second carry pass leaves l4 < 2^47+1
+	l0 += (l4 >> 47) * 9
+	l4 &= 1<<47 - 1
+	l0, l1, l2, l3, l4 = carry51(l0, l1, l2, l3, l4)
+
+	res[0] = l0 | l1<<51
+	res[1] = l1>>13 | l2<<38
+	res[2] = l2>>26 | l3<<25
+	res[3] = l3>>39 | l4<<12
+}
+
+//carry51 propagates carries from lower limbs, after it all limbs except l4 have at most 51 bits
+func carry51(l0, l1, l2, l3, l4 uint64) (uint64, uint64, uint64, uint64, uint64) {
+	l1 += l0 >> 51
+	l0 &= mask51
+	l2 += l1 >> 51
+	l1 &= mask51
+	l3 += l2 >> 51
+	l2 &= mask51
+	l4 += l3 >> 51
+	l3 &= mask51
+	return l0, l1, l2, l3, l4
+}
+
+func mulRadix51(res, p1, p2 *FieldElement) {
+	a0, a1, a2, a3, a4 := unpack51(p1)
+	b0, b1, b2, b3, b4 := unpack51(p2)
+
+	b1x := b1 * 144
+	b2x := b2 * 144
+	b3x := b3 * 144
+	b4x := b4 * 144
+
+	r0 := mul64(a0, b0)
+	r0 = addMul64(r0, a1, b4x)
+	r0 = addMul64(r0, a2, b3x)
+	r0 = addMul64(r0, a3, b2x)
+	r0 = addMul64(r0, a4, b1x)
+
+	r1 := mul64(a0, b1)
+	r1 = addMul64(r1, a1, b0)
+	r1 = addMul64(r1, a2, b4x)
+	r1 = addMul64(r1, a3, b3x)
+	r1 = addMul64(r1, a4, b2x)
+
+	r2 := mul64(a0, b2)
+	r2 = addMul64(r2, a1, b1)
+	r2 = addMul64(r2, a2, b0)
+	r2 = addMul64(r2, a3, b4x)
+	r2 = addMul64(r2, a4, b3x)
+
+	r3 := mul64(a0, b3)
+	r3 = addMul64(r3, a1, b2)
+	r3 = addMul64(r3, a2, b1)
+	r3 = addMul64(r3, a3, b0)
+	r3 = addMul64(r3, a4, b4x)
+
+	r4 := mul64(a0, b4)
+	r4 = addMul64(r4, a1, b3)
+	r4 = addMul64(r4, a2, b2)
+	r4 = addMul64(r4, a3, b1)
+	r4 = addMul64(r4, a4, b0)
+
+	carryPack51(res, r0, r1, r2, r3, r4)
+}
+
+func sqrRadix51(res, p1 *FieldElement) {
+	a0, a1, a2, a3, a4 := unpack51(p1)
+
+	d0 := a0 * 2
+	d1 := a1 * 2
+	d2 := a2 * 2
+	a3x := a3 * 144
+	a4x := a4 * 144
+
+	r0 := mul64(a0, a0)
+	r0 = addMul64(r0, d1, a4x)
+	r0 = addMul64(r0, d2, a3x)
+
+	r1 := mul64(d0, a1)
+	r1 = addMul64(r1, d2, a4x)
+	r1 = addMul64(r1, a3, a3x)
+
+	r2 := mul64(d0, a2)
+	r2 = addMul64(r2, a1, a1)
+	r2 = addMul64(r2, a3*2, a4x)
+
+	r3 := mul64(d0, a3)
+	r3 = addMul64(r3, d1, a2)
+	r3 = addMul64(r3, a4, a4x)
+
+	r4 := mul64(d0, a4)
+	r4 = addMul64(r4, d1, a3)
+	r4 = addMul64(r4, a2, a2)
+
+	carryPack51(res, r0, r1, r2, r3, r4)
+}
@@ -0,0 +1,14 @@
+//go:build curve1174_radix51
+
+package curve1174
+
+//genericBackend is name of pure Go backend
+const genericBackend = "radix51"
+
+func mulGeneric(res, p1, p2 *FieldElement) {
+	mulRadix51(res, p1, p2)
+}
+
+func sqrGeneric(res, p1 *FieldElement) {
+	sqrRadix51(res, p1)
+}
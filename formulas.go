@@ -0,0 +1,65 @@
+package curve1174
+
+//Point formulas below use only FieldElement methods, so they don't depend on parameters of the curve. gen/curve copies
+//this file to packages it generates for other curves (it embeds formulas.go.txt, run go generate there after changes).
+
+//add is Add in Go, formula add-2008-hwcd with a=1
+func (p *Point) add(p1, p2 *Point) *Point {
+	var a, b, c, d, e, e1, f, g, h FieldElement
+	a.Mul(&p1.X, &p2.X)
+	b.Mul(&p1.Y, &p2.Y)
+	c.Mul(&p1.T, &p2.T).MulD(&c)
+	d.Mul(&p1.Z, &p2.Z)
+	e1.Add(&p2.X, &p2.Y)
+	e.Add(&p1.X, &p1.Y).Mul(&e, &e1).Sub(&e, &a).Sub(&e, &b)
+	f.Sub(&d, &c)
+	g.Add(&d, &c)
+	h.Sub(&b, &a)
+	p.X.Mul(&e, &f)
+	p.Y.Mul(&g, &h)
+	p.T.Mul(&e, &h)
+	p.Z.Mul(&f, &g)
+	return p
+}
+
+//double is Double for point (x:y:z), T isn't needed. Formula dbl-2008-hwcd with a=1
+func (p *Point) double(x, y, z *FieldElement) *Point {
+	var a, b, c, e, f, g, h FieldElement
+	a.Sqr(x)
+	b.Sqr(y)
+	c.Sqr(z).Mul2(&c)
+	e.Add(x, y).Sqr(&e).Sub(&e, &a).Sub(&e, &b)
+	g.Add(&a, &b)
+	f.Sub(&g, &c)
+	h.Sub(&a, &b)
+	p.X.Mul(&e, &f)
+	p.Y.Mul(&g, &h)
+	p.T.Mul(&e, &h)
+	p.Z.Mul(&f, &g)
+	return p
+}
+
+//IsOnCurve checks if p is valid point on curve in extended coordinates: (X^2+Y^2)Z^2 == Z^4+dX^2Y^2, XY == ZT and
+//Z != 0
+func (p *Point) IsOnCurve() bool {
+	if !p.isOnCurveProjective() {
+		return false
+	}
+	var xy, zt FieldElement
+	xy.Mul(&p.X, &p.Y)
+	zt.Mul(&p.Z, &p.T)
+	return xy.Equals(&zt)
+}
+
+//isOnCurveProjective checks if p is valid point on curve in projective coordinates (p.T is ignored)
+func (p *Point) isOnCurveProjective() bool {
+	var x2, y2, z2, lhs, rhs FieldElement
+	x2.Sqr(&p.X)
+	y2.Sqr(&p.Y)
+	z2.Sqr(&p.Z)
+	lhs.Add(&x2, &y2).Mul(&lhs, &z2)
+	rhs.Mul(&x2, &y2).MulD(&rhs)
+	z2.Sqr(&z2)
+	rhs.Add(&rhs, &z2)
+	return !p.Z.IsZero() && lhs.Equals(&rhs)
+}
@@ -0,0 +1,280 @@
+package curve1174
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"math/bits"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/probakowski/curve1174/internal/ref"
+)
+
+//Checks of fuzz targets from fuzz_test.go and their seed inputs. Fuzzing needs Go 1.18, TestFuzzSeeds runs the same
+//checks on seeds and on corpus from testdata/fuzz with any Go version.
+
+//fieldOp is field operation checked by FuzzField* target against math/big, refOp returns false if result isn't
+//defined
+type fieldOp struct {
+	op    func(res, x, y *FieldElement)
+	refOp func(res, x, y *big.Int) bool
+}
+
+//fieldOps are field operations of FuzzField* targets by target name
+var fieldOps = map[string]fieldOp{
+	"FuzzFieldAdd": {func(res, x, y *FieldElement) { res.Add(x, y) },
+		func(res, x, y *big.Int) bool { res.Add(x, y); return true }},
+	"FuzzFieldSub": {func(res, x, y *FieldElement) { res.Sub(x, y) },
+		func(res, x, y *big.Int) bool { res.Sub(x, y); return true }},
+	"FuzzFieldMul": {func(res, x, y *FieldElement) { res.Mul(x, y) },
+		func(res, x, y *big.Int) bool { res.Mul(x, y); return true }},
+	"FuzzFieldSqr": {func(res, x, y *FieldElement) { res.Sqr(x) },
+		func(res, x, y *big.Int) bool { res.Mul(x, x); return true }},
+	"FuzzFieldMulD": {func(res, x, y *FieldElement) { res.MulD(x) },
+		func(res, x, y *big.Int) bool { res.Mul(x, big.NewInt(-1174)); return true }},
+	"FuzzFieldMul2": {func(res, x, y *FieldElement) { res.Mul2(x) },
+		func(res, x, y *big.Int) bool { res.Lsh(x, 1); return true }},
+	"FuzzFieldMod": {func(res, x, y *FieldElement) { res.Mod(x) },
+		func(res, x, y *big.Int) bool { res.Set(x); return true }},
+	"FuzzFieldInverse": {func(res, x, y *FieldElement) { res.Inverse(x) },
+		func(res, x, y *big.Int) bool { return res.ModInverse(x, P) != nil }},
+	"FuzzFieldVarTimeInverse": {func(res, x, y *FieldElement) { res.varTimeInverse(x) },
+		func(res, x, y *big.Int) bool { return res.ModInverse(x, P) != nil }},
+}
+
+//fieldSeeds returns seed inputs (x and y) of FuzzField* targets
+func fieldSeeds() [][2][]byte {
+	var seeds [][2][]byte
+	for _, x := range fieldEdgeValues {
+		for _, y := range []FieldElement{{}, {1}, modulus, x} {
+			seeds = append(seeds, [2][]byte{fieldBytes(&x), fieldBytes(&y)})
+		}
+	}
+	return seeds
+}
+
+//pointEncodingSeeds returns seed inputs of FuzzPointEncoding
+func pointEncodingSeeds() [][]byte {
+	return [][]byte{NewBasePoint().Bytes(), NewIdentityPoint().Bytes(), fieldBytes(&modulus), {}}
+}
+
+//scalarMultSeed is seed input of FuzzScalarMult
+type scalarMultSeed struct {
+	k, p  []byte
+	extra byte
+}
+
+//scalarMultSeeds returns seed inputs of FuzzScalarMult
+func scalarMultSeeds() []scalarMultSeed {
+	return []scalarMultSeed{
+		{fieldBytes(&order), []byte{}, 0},
+		{fieldBytes(&FieldElement{0xDEADBEEF, 0xCAFEBABE}), NewBasePoint().Bytes(), 3},
+		{fieldBytes(&FieldElement{P0 - 1, P1, P2, P3}), NewIdentityPoint().Bytes(), 1},
+	}
+}
+
+//fieldFromBytes reads up to 32 bytes of b as little endian number, it can be any value below 2^256 (not reduced)
+func fieldFromBytes(b []byte) FieldElement {
+	var buf [32]byte
+	copy(buf[:], b)
+	var f FieldElement
+	for i := range f {
+		f[i] = binary.LittleEndian.Uint64(buf[i*8:])
+	}
+	return f
+}
+
+func fieldBytes(x *FieldElement) []byte {
+	b := make([]byte, 32)
+	for i := range x {
+		binary.LittleEndian.PutUint64(b[i*8:], x[i])
+	}
+	return b
+}
+
+//checkFieldOp checks field operation against math/big for any two (not reduced) inputs
+func checkFieldOp(t testing.TB, f fieldOp, xb, yb []byte) {
+	x, y := fieldFromBytes(xb), fieldFromBytes(yb)
+	expected := new(big.Int)
+	if !f.refOp(expected, x.ToBigInt(), y.ToBigInt()) {
+		return
+	}
+	expected.Mod(expected, P)
+	var res FieldElement
+	f.op(&res, &x, &y)
+	if res.Mod(&res).ToBigInt().Cmp(expected) != 0 {
+		t.Fatalf("%x, %x: got %x, expected %x", &x, &y, &res, expected)
+	}
+	//result has to be the same when it's aliased with input
+	aliased := x
+	f.op(&aliased, &aliased, &y)
+	if *aliased.Mod(&aliased) != res {
+		t.Fatalf("%x, %x: aliased result %x, expected %x", &x, &y, &aliased, &res)
+	}
+}
+
+//checkPointEncoding checks that SetBytes accepts the same encodings as reference implementation and that Bytes
+//returns accepted encoding unchanged
+func checkPointEncoding(t testing.TB, b []byte) {
+	var p Point
+	expected, ok := ref.Decode(b)
+	_, err := p.SetBytes(b)
+	if ok != (err == nil) {
+		t.Fatalf("SetBytes(%x): %v, reference: %v", b, err, ok)
+	}
+	if !ok {
+		return
+	}
+	if !p.IsOnCurve() || !toRef(&p).Equal(expected) {
+		t.Fatalf("SetBytes(%x) = %x, expected %v", b, &p, expected)
+	}
+	if enc := p.Bytes(); !bytes.Equal(enc, b) {
+		t.Fatalf("Bytes(SetBytes(%x)) = %x", b, enc)
+	}
+}
+
+//checkScalarMult compares scalar multiplications of point decoded from pb (Base if it's not valid encoding) with
+//reference implementation. If extra has bit 0 set coordinates of the point are not reduced (p is added to them),
+//bit 1 multiplies all coordinates by 2 (Z != 1)
+func checkScalarMult(t testing.TB, kb, pb []byte, extra byte) {
+	k := fieldFromBytes(kb)
+	k.Mod(&k)
+	var p Point
+	if _, err := p.SetBytes(pb); err != nil {
+		p = basePoint
+	}
+	rp := toRef(&p)
+	if extra&1 == 1 {
+		for _, c := range []*FieldElement{&p.X, &p.Y, &p.T, &p.Z} {
+			addNoMod(c, &modulus)
+		}
+	}
+	if extra&2 == 2 {
+		for _, c := range []*FieldElement{&p.X, &p.Y, &p.T, &p.Z} {
+			c.Mul2(c)
+		}
+	}
+
+	expected := ref.ScalarMult(rp, k.ToBigInt())
+	var res Point
+	for name, op := range map[string]func(){
+		"ScalarMult":        func() { res.ScalarMult(&p, &k) },
+		"ScalarMultLadder":  func() { res.ScalarMultLadder(&p, &k) },
+		"VarTimeScalarMult": func() { res.VarTimeScalarMult(&p, &k) },
+	} {
+		op()
+		if !toRef(&res).Equal(expected) {
+			t.Fatalf("%s(%x, %x) = %x, expected %v", name, &p, &k, &res, expected)
+		}
+	}
+	if !toRef(res.ScalarBaseMult(&k)).Equal(ref.ScalarBaseMult(k.ToBigInt())) {
+		t.Fatalf("ScalarBaseMult(%x) = %x", &k, &res)
+	}
+}
+
+//addNoMod adds y to x without reduction, x+y has to be smaller than 2^256
+func addNoMod(x, y *FieldElement) {
+	var c uint64
+	for i := range x {
+		x[i], c = bits.Add64(x[i], y[i], c)
+	}
+}
+
+//readCorpus reads inputs of fuzz target from testdata/fuzz, it understands only []byte and byte values
+func readCorpus(target string) ([][]interface{}, error) {
+	files, err := filepath.Glob(filepath.Join("testdata", "fuzz", target, "*"))
+	if err != nil {
+		return nil, err
+	}
+	var inputs [][]interface{}
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+		if lines[0] != "go test fuzz v1" {
+			return nil, fmt.Errorf("%s: unknown corpus format %q", file, lines[0])
+		}
+		var input []interface{}
+		for _, line := range lines[1:] {
+			var v interface{}
+			switch {
+			case strings.HasPrefix(line, "[]byte(") && strings.HasSuffix(line, ")"):
+				var s string
+				if s, err = strconv.Unquote(line[len("[]byte(") : len(line)-1]); err == nil {
+					v = []byte(s)
+				}
+			case strings.HasPrefix(line, "byte('") && strings.HasSuffix(line, "')"):
+				var c rune
+				var tail string
+				c, _, tail, err = strconv.UnquoteChar(line[len("byte('"):len(line)-len("')")], '\'')
+				if err == nil && tail == "" && c < 256 {
+					v = byte(c)
+				}
+			}
+			if v == nil {
+				return nil, fmt.Errorf("%s: can't parse %q: %v", file, line, err)
+			}
+			input = append(input, v)
+		}
+		inputs = append(inputs, input)
+	}
+	return inputs, nil
+}
+
+//fuzzTarget is fuzz target's seeds and check run on them and on corpus
+type fuzzTarget struct {
+	seeds [][]interface{}
+	check func(t testing.TB, input []interface{})
+}
+
+//TestFuzzSeeds runs checks of fuzz targets on their seeds and corpus in testdata/fuzz, so they're run by Go versions
+//without fuzzing too
+func TestFuzzSeeds(t *testing.T) {
+	targets := map[string]*fuzzTarget{
+		"FuzzPointEncoding": {check: func(t testing.TB, input []interface{}) {
+			checkPointEncoding(t, input[0].([]byte))
+		}},
+		"FuzzScalarMult": {check: func(t testing.TB, input []interface{}) {
+			checkScalarMult(t, input[0].([]byte), input[1].([]byte), input[2].(byte))
+		}},
+	}
+	for _, seed := range pointEncodingSeeds() {
+		targets["FuzzPointEncoding"].seeds = append(targets["FuzzPointEncoding"].seeds, []interface{}{seed})
+	}
+	for _, seed := range scalarMultSeeds() {
+		targets["FuzzScalarMult"].seeds = append(targets["FuzzScalarMult"].seeds,
+			[]interface{}{seed.k, seed.p, seed.extra})
+	}
+	for name, f := range fieldOps {
+		f := f
+		target := &fuzzTarget{check: func(t testing.TB, input []interface{}) {
+			checkFieldOp(t, f, input[0].([]byte), input[1].([]byte))
+		}}
+		for _, seed := range fieldSeeds() {
+			target.seeds = append(target.seeds, []interface{}{seed[0], seed[1]})
+		}
+		targets[name] = target
+	}
+
+	for name, target := range targets {
+		t.Run(name, func(t *testing.T) {
+			corpus, err := readCorpus(name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(corpus) == 0 {
+				t.Errorf("no corpus in testdata/fuzz/%s", name)
+			}
+			for _, input := range append(target.seeds, corpus...) {
+				target.check(t, input)
+			}
+		})
+	}
+}
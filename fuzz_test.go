@@ -0,0 +1,75 @@
+//go:build go1.18
+
+package curve1174
+
+import "testing"
+
+//Fuzz targets run seed corpus from testdata/fuzz with go test, use e.g. go test -fuzz FuzzFieldMul to fuzz. Checks
+//and seeds are in fuzz_seeds_test.go, TestFuzzSeeds runs them with Go versions without fuzzing.
+
+//fuzzFieldOp fuzzes field operation of target name from fieldOps
+func fuzzFieldOp(f *testing.F, name string) {
+	for _, seed := range fieldSeeds() {
+		f.Add(seed[0], seed[1])
+	}
+	op := fieldOps[name]
+	f.Fuzz(func(t *testing.T, xb, yb []byte) {
+		checkFieldOp(t, op, xb, yb)
+	})
+}
+
+func FuzzFieldAdd(f *testing.F) {
+	fuzzFieldOp(f, "FuzzFieldAdd")
+}
+
+func FuzzFieldSub(f *testing.F) {
+	fuzzFieldOp(f, "FuzzFieldSub")
+}
+
+func FuzzFieldMul(f *testing.F) {
+	fuzzFieldOp(f, "FuzzFieldMul")
+}
+
+func FuzzFieldSqr(f *testing.F) {
+	fuzzFieldOp(f, "FuzzFieldSqr")
+}
+
+func FuzzFieldMulD(f *testing.F) {
+	fuzzFieldOp(f, "FuzzFieldMulD")
+}
+
+func FuzzFieldMul2(f *testing.F) {
+	fuzzFieldOp(f, "FuzzFieldMul2")
+}
+
+func FuzzFieldMod(f *testing.F) {
+	fuzzFieldOp(f, "FuzzFieldMod")
+}
+
+func FuzzFieldInverse(f *testing.F) {
+	fuzzFieldOp(f, "FuzzFieldInverse")
+}
+
+func FuzzFieldVarTimeInverse(f *testing.F) {
+	fuzzFieldOp(f, "FuzzFieldVarTimeInverse")
+}
+
+//FuzzPointEncoding checks point encoding with checkPointEncoding
+func FuzzPointEncoding(f *testing.F) {
+	for _, seed := range pointEncodingSeeds() {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, b []byte) {
+		checkPointEncoding(t, b)
+	})
+}
+
+//FuzzScalarMult checks scalar multiplications with checkScalarMult
+func FuzzScalarMult(f *testing.F) {
+	for _, seed := range scalarMultSeeds() {
+		f.Add(seed.k, seed.p, seed.extra)
+	}
+	f.Fuzz(func(t *testing.T, kb, pb []byte, extra byte) {
+		checkScalarMult(t, kb, pb, extra)
+	})
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/mmcloughlin/avo/build"
+	"github.com/mmcloughlin/avo/ir"
+	"github.com/mmcloughlin/avo/pass"
+	"github.com/mmcloughlin/avo/printer"
+	"github.com/mmcloughlin/avo/reg"
+	"github.com/probakowski/curve1174/gen/internal/amd64"
+)
+
+//fieldAsm generates amd64 assembly for field arithmetic with the same generator as field_amd64.s of package
+//curve1174. Only functions working with any number of limbs are used, multiplication uses MULQ (no BMI2/ADX) so it
+//runs on every amd64 CPU.
+func fieldAsm(c *curve) ([]byte, error) {
+	ctx := build.NewContext()
+	ctx.ConstraintExpr(fmt.Sprintf("!%s_purego", c.Package))
+	g := amd64.New(ctx, amd64.Params{K: c.K, C: c.C, Element: fmt.Sprintf("*[%d]uint64", c.n)})
+
+	g.MulNoAdx("mulAsm")
+	g.Mul2("mul2Asm")
+	g.Add("addAsm")
+	g.Sub("subAsm")
+	g.Mod("modAsm")
+
+	f, err := ctx.Result()
+	if err != nil {
+		return nil, err
+	}
+	if err = compile.Execute(f); err != nil {
+		return nil, err
+	}
+	return printer.NewGoAsm(printer.Config{Name: "gen/curve", Pkg: c.Package}).Print(f)
+}
+
+//compile is pass.Compile with allocateRegisters, so go vet of generated package doesn't report frame pointer
+//clobbered before saving
+var compile = pass.Concat(
+	pass.Verify,
+	pass.FunctionPass(pass.PruneJumpToFollowingLabel),
+	pass.FunctionPass(pass.PruneDanglingLabels),
+	pass.FunctionPass(pass.LabelTarget),
+	pass.FunctionPass(pass.CFG),
+	pass.InstructionPass(pass.ZeroExtend32BitOutputs),
+	pass.FunctionPass(pass.Liveness),
+	pass.FunctionPass(allocateRegisters),
+	pass.FunctionPass(pass.BindRegisters),
+	pass.FunctionPass(pass.VerifyAllocation),
+	pass.Func(pass.IncludeTextFlagHeader),
+	pass.FunctionPass(pass.PruneSelfMoves),
+	pass.FunctionPass(pass.RequiredISAExtensions),
+)
+
+//allocateRegisters is pass.AllocateRegisters which doesn't use BP
+func allocateRegisters(fn *ir.Function) error {
+	as := map[reg.Kind]*pass.Allocator{}
+	for _, i := range fn.Instructions() {
+		for _, r := range i.Registers() {
+			k := r.Kind()
+			if _, found := as[k]; found {
+				continue
+			}
+			var rs []reg.Physical
+			for _, p := range reg.FamilyOfKind(k).Registers() {
+				if p.ID() != reg.RBP.ID() {
+					rs = append(rs, p)
+				}
+			}
+			a, err := pass.NewAllocator(rs)
+			if err != nil {
+				return err
+			}
+			as[k] = a
+		}
+	}
+	for _, i := range fn.Instructions() {
+		for _, r := range i.Registers() {
+			as[r.Kind()].Add(r.ID())
+		}
+		for _, d := range i.OutputRegisters() {
+			out := i.LiveOut.OfKind(d.Kind())
+			out.DiscardRegister(d)
+			as[d.Kind()].AddInterferenceSet(d, out)
+		}
+	}
+
+	fn.Allocation = reg.NewEmptyAllocation()
+	for _, a := range as {
+		al, err := a.Allocate()
+		if err != nil {
+			return err
+		}
+		if err = fn.Allocation.Merge(al); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,240 @@
+//go:generate cp ../../formulas.go formulas.go.txt
+
+//Command curve generates Go package implementing Edwards curve x^2+y^2 = 1+dx^2y^2 over F_p for pseudo-Mersenne
+//prime p = 2^k-c (e.g. E-222, Curve1174, E-382, Curve41417 or E-521) from parameter file. Generated package contains
+//portable Go field arithmetic on 64-bit limbs, amd64 assembly of field arithmetic from the same generator as
+//field_amd64.s of package curve1174 (gen/internal/amd64), point formulas of package curve1174 (formulas.go, they use
+//only FieldElement methods, the generator embeds copy of it updated by go generate), constant time scalar
+//multiplication and tests comparing it with math/big.
+//
+//	go run ./gen/curve -params gen/curve/curves/e521.json -dir <dir>
+//
+//Parameter file is JSON object with fields: package (name of generated package), name (name of the curve used in
+//comments), k and c (p = 2^k-c), d (coefficient of Edwards equation), baseX and baseY (affine coordinates of base
+//point), optional order of base point and cofactor (group order is order*cofactor). Big numbers are strings, decimal
+//or hexadecimal with 0x prefix. Directory curves has files of E-222, Curve1174, E-382, Curve41417 and E-521 with
+//their standard base points.
+//
+//The generator checks that p is prime, d is not a square (so addition formulas are complete), base point is on the
+//curve and (if order is given) that it's prime and order*base == E. Code generated for Curve1174 doesn't use
+//MULX/ADX, fused point formulas or precomputed tables, so it's slower than package curve1174 itself.
+package main
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"math/big"
+	"os"
+	"path/filepath"
+)
+
+//curveParams is content of parameter file
+type curveParams struct {
+	Package  string `json:"package"`
+	Name     string `json:"name"`
+	K        int    `json:"k"`
+	C        uint64 `json:"c"`
+	D        string `json:"d"`
+	BaseX    string `json:"baseX"`
+	BaseY    string `json:"baseY"`
+	Order    string `json:"order"`
+	Cofactor int    `json:"cofactor"`
+}
+
+//curve holds parsed and derived parameters used by templates
+type curve struct {
+	curveParams
+	p, d, baseX, baseY, order *big.Int
+	//n is number of 64-bit limbs
+	n int
+	//topBits is number of bits of p in the highest limb, 64*(n-1)+topBits == k
+	topBits int
+	//r is 2^(64n) mod p
+	r uint64
+}
+
+func parseInt(s string) (*big.Int, error) {
+	v, ok := new(big.Int).SetString(s, 0)
+	if !ok {
+		return nil, fmt.Errorf("invalid number %q", s)
+	}
+	return v, nil
+}
+
+//parse reads parameter file and validates curve
+func parse(data []byte) (*curve, error) {
+	var c curve
+	if err := json.Unmarshal(data, &c.curveParams); err != nil {
+		return nil, err
+	}
+	if c.Package == "" {
+		return nil, errors.New("package name is required")
+	}
+	if c.Name == "" {
+		c.Name = c.Package
+	}
+	if c.K < 129 || c.C == 0 {
+		return nil, fmt.Errorf("invalid p = 2^%d-%d", c.K, c.C)
+	}
+	c.n = (c.K + 63) / 64
+	c.topBits = c.K - 64*(c.n-1)
+	//h*c (h are bits above k) and 2^(64n-k)*c have to fit in one limb
+	if 64-c.topBits+bitLen(c.C) > 63 {
+		return nil, fmt.Errorf("c = %d is too big for 2^%d-c", c.C, c.K)
+	}
+	c.r = c.C << uint(64-c.topBits)
+	c.p = new(big.Int).Lsh(big.NewInt(1), uint(c.K))
+	c.p.Sub(c.p, new(big.Int).SetUint64(c.C))
+	if !c.p.ProbablyPrime(32) {
+		return nil, fmt.Errorf("2^%d-%d is not prime", c.K, c.C)
+	}
+
+	var err error
+	if c.d, err = parseInt(c.D); err != nil {
+		return nil, err
+	}
+	c.d.Mod(c.d, c.p)
+	if big.Jacobi(c.d, c.p) != -1 {
+		return nil, errors.New("d is a square, addition formulas wouldn't be complete")
+	}
+	if c.baseX, err = parseInt(c.BaseX); err != nil {
+		return nil, err
+	}
+	if c.baseY, err = parseInt(c.BaseY); err != nil {
+		return nil, err
+	}
+	base := point{c.baseX.Mod(c.baseX, c.p), c.baseY.Mod(c.baseY, c.p)}
+	if !c.isOnCurve(base) {
+		return nil, errors.New("base point is not on the curve")
+	}
+	if c.Order != "" {
+		if c.order, err = parseInt(c.Order); err != nil {
+			return nil, err
+		}
+		if !c.order.ProbablyPrime(32) {
+			return nil, errors.New("order is not prime")
+		}
+		if !c.scalarMult(base, c.order).isIdentity() {
+			return nil, errors.New("order*base != E")
+		}
+		if c.Cofactor == 0 {
+			return nil, errors.New("cofactor is required when order is given")
+		}
+	}
+	return &c, nil
+}
+
+func bitLen(x uint64) int {
+	return new(big.Int).SetUint64(x).BitLen()
+}
+
+//point is affine point used to validate parameters
+type point struct {
+	x, y *big.Int
+}
+
+func (pt point) isIdentity() bool {
+	return pt.x.Sign() == 0 && pt.y.Cmp(big.NewInt(1)) == 0
+}
+
+//isOnCurve checks if x^2+y^2 == 1+dx^2y^2
+func (c *curve) isOnCurve(pt point) bool {
+	x2 := new(big.Int).Mul(pt.x, pt.x)
+	y2 := new(big.Int).Mul(pt.y, pt.y)
+	l := new(big.Int).Add(x2, y2)
+	r := new(big.Int).Mul(x2, y2)
+	r.Mul(r, c.d).Add(r, big.NewInt(1))
+	return l.Sub(l, r).Mod(l, c.p).Sign() == 0
+}
+
+//add adds two points in affine coordinates:
+//x3 = (x1*y2+y1*x2)/(1+d*x1*x2*y1*y2), y3 = (y1*y2-x1*x2)/(1-d*x1*x2*y1*y2)
+func (c *curve) add(p1, p2 point) point {
+	dxy := new(big.Int).Mul(p1.x, p2.x)
+	dxy.Mul(dxy, p1.y).Mul(dxy, p2.y).Mul(dxy, c.d).Mod(dxy, c.p)
+
+	num := new(big.Int).Add(new(big.Int).Mul(p1.x, p2.y), new(big.Int).Mul(p1.y, p2.x))
+	den := new(big.Int).Add(big.NewInt(1), dxy)
+	x3 := num.Mul(num, den.ModInverse(den.Mod(den, c.p), c.p)).Mod(num, c.p)
+
+	num = new(big.Int).Sub(new(big.Int).Mul(p1.y, p2.y), new(big.Int).Mul(p1.x, p2.x))
+	den = new(big.Int).Sub(big.NewInt(1), dxy)
+	y3 := num.Mul(num, den.ModInverse(den.Mod(den, c.p), c.p)).Mod(num, c.p)
+	return point{x3, y3}
+}
+
+//scalarMult computes k*pt with double-and-add
+func (c *curve) scalarMult(pt point, k *big.Int) point {
+	res := point{big.NewInt(0), big.NewInt(1)}
+	for i := k.BitLen() - 1; i >= 0; i-- {
+		res = c.add(res, res)
+		if k.Bit(i) == 1 {
+			res = c.add(res, pt)
+		}
+	}
+	return res
+}
+
+//generate returns generated files by name
+func generate(c *curve) (map[string][]byte, error) {
+	files, err := executeTemplates(c)
+	if err != nil {
+		return nil, err
+	}
+	files["field_amd64.s"], err = fieldAsm(c)
+	if err != nil {
+		return nil, err
+	}
+	files["formulas.go"], err = formulas(c)
+	if err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+//formulasSrc is copy of formulas.go of package curve1174
+//go:embed formulas.go.txt
+var formulasSrc []byte
+
+//formulas returns point formulas of package curve1174 (formulas.go) for generated package, they use only
+//FieldElement methods
+func formulas(c *curve) ([]byte, error) {
+	clause := []byte("package curve1174\n")
+	if !bytes.HasPrefix(formulasSrc, clause) {
+		return nil, errors.New("formulas.go doesn't start with package clause")
+	}
+	return append([]byte(header+"package "+c.Package+"\n"), formulasSrc[len(clause):]...), nil
+}
+
+func main() {
+	paramsFile := flag.String("params", "", "curve parameter file")
+	out := flag.String("dir", "", "output directory")
+	flag.Parse()
+	if *paramsFile == "" || *out == "" {
+		flag.Usage()
+		log.Fatal("-params and -dir are required")
+	}
+
+	data, err := os.ReadFile(*paramsFile)
+	if err != nil {
+		log.Fatal(err)
+	}
+	c, err := parse(data)
+	if err != nil {
+		log.Fatalf("%s: %v", *paramsFile, err)
+	}
+	files, err := generate(c)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for name, src := range files {
+		if err = os.WriteFile(filepath.Join(*out, name), src, 0644); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
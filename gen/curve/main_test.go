@@ -0,0 +1,90 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	data, err := os.ReadFile("curves/curve1174.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := parse(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.n != 4 || c.topBits != 59 || c.r != 288 {
+		t.Errorf("invalid limbs %d, %d, %d", c.n, c.topBits, c.r)
+	}
+
+	var params curveParams
+	for name, modify := range map[string]func(){
+		"p not prime":       func() { params.C = 11 },
+		"d square":          func() { params.D = "4" },
+		"base not on curve": func() { params.BaseX = "0x1" },
+		"wrong order":       func() { params.Order = "0x7" },
+		"c too big":         func() { params.C = 1 << 60 },
+	} {
+		if err = json.Unmarshal(data, &params); err != nil {
+			t.Fatal(err)
+		}
+		modify()
+		invalid, _ := json.Marshal(params)
+		if _, err = parse(invalid); err == nil {
+			t.Errorf("%s: no error", name)
+		}
+	}
+}
+
+//TestGenerate generates packages for curves in directory curves (4 to 9 limbs) and runs their tests with assembly and
+//pure Go code
+func TestGenerate(t *testing.T) {
+	if testing.Short() {
+		t.Skip("runs go test for every generated package")
+	}
+	for _, name := range []string{"e222", "curve1174", "e382", "curve41417", "e521"} {
+		data, err := os.ReadFile(filepath.Join("curves", name+".json"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Run(name, func(t *testing.T) {
+			c, err := parse(data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			files, err := generate(c)
+			if err != nil {
+				t.Fatal(err)
+			}
+			dir := t.TempDir()
+			files["go.mod"] = []byte("module example.com/" + name + "\n\ngo 1.17\n")
+			for file, src := range files {
+				if err = os.WriteFile(filepath.Join(dir, file), src, 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			for _, args := range [][]string{{"vet"}, {"test"}, {"test", "-tags", name + "_purego"}} {
+				cmd := exec.Command("go", append(args, ".")...)
+				cmd.Dir = dir
+				if out, err := cmd.CombinedOutput(); err != nil {
+					t.Fatalf("go %v: %v\n%s", args, err, out)
+				}
+			}
+		})
+	}
+}
+
+func TestFormulasCopy(t *testing.T) {
+	src, err := os.ReadFile("../../formulas.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(src, formulasSrc) {
+		t.Error("formulas.go.txt differs from formulas.go, run go generate")
+	}
+}
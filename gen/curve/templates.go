@@ -0,0 +1,729 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"math/big"
+	"strings"
+	"text/template"
+)
+
+//templateData is passed to all templates
+type templateData struct {
+	*curve
+	N, TopBits                 int
+	R, P, Order                string
+	Modulus, DElement          string
+	BaseXElement, BaseYElement string
+	BaseT, PMinus2Limbs        string
+	//VectorK*Base == (VectorX, VectorY), computed with math/big
+	VectorK, VectorX, VectorY string
+}
+
+//limbs formats x as FieldElement literal
+func limbs(x *big.Int, n int) string {
+	mask := new(big.Int).SetUint64(^uint64(0))
+	parts := make([]string, n)
+	for i := range parts {
+		limb := new(big.Int).Rsh(x, uint(64*i))
+		parts[i] = fmt.Sprintf("0x%016x", limb.And(limb, mask))
+	}
+	return "FieldElement{" + strings.Join(parts, ", ") + "}"
+}
+
+func executeTemplates(c *curve) (map[string][]byte, error) {
+	data := templateData{
+		curve:        c,
+		N:            c.n,
+		TopBits:      c.topBits,
+		R:            fmt.Sprintf("%#x", c.r),
+		P:            c.p.Text(16),
+		Modulus:      limbs(c.p, c.n),
+		DElement:     limbs(c.d, c.n),
+		BaseXElement: limbs(c.baseX, c.n),
+		BaseYElement: limbs(c.baseY, c.n),
+		PMinus2Limbs: limbs(new(big.Int).Sub(c.p, big.NewInt(2)), c.n),
+	}
+	t := new(big.Int).Mul(c.baseX, c.baseY)
+	data.BaseT = limbs(t.Mod(t, c.p), c.n)
+	if c.order != nil {
+		data.Order = c.order.Text(16)
+	}
+	k := new(big.Int).Rsh(c.p, 3)
+	k.Add(k, big.NewInt(0x2545f4914f6cdd1d))
+	v := c.scalarMult(point{c.baseX, c.baseY}, k)
+	data.VectorK = limbs(k, c.n)
+	data.VectorX, data.VectorY = v.x.Text(16), v.y.Text(16)
+
+	files := map[string][]byte{}
+	for name, text := range map[string]string{
+		"field.go":             fieldTemplate,
+		"field_go.go":          fieldGoTemplate,
+		"field_amd64.go":       fieldAmd64Template,
+		"point.go":             pointTemplate,
+		c.Package + "_test.go": testTemplate,
+		"field_amd64_test.go":  fieldAmd64TestTemplate,
+		"params.go":            paramsTemplate,
+	} {
+		tmpl, err := template.New(name).Funcs(template.FuncMap{
+			"mul64": func(n int) int { return 64 * n },
+		}).Parse(text)
+		if err != nil {
+			return nil, err
+		}
+		var w bytes.Buffer
+		if err = tmpl.Execute(&w, data); err != nil {
+			return nil, err
+		}
+		src, err := format.Source(w.Bytes())
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", name, err)
+		}
+		files[name] = src
+	}
+	return files, nil
+}
+
+const header = `// Code generated by gen/curve. DO NOT EDIT.
+
+`
+
+const fieldTemplate = header + `package {{.Package}}
+
+import (
+	"fmt"
+	"math/big"
+	"math/bits"
+)
+
+//FieldElement is element of finite field F_p, p=2^{{.K}}-{{.C}}, in {{.N}} 64-bit limbs (least significant first).
+//Results of arithmetic operations are only partially reduced (smaller than 2^{{mul64 .N}}), use Mod to get canonical
+//value.
+type FieldElement [{{.N}}]uint64
+
+//reductionConst is 2^{{mul64 .N}} mod p
+const reductionConst = {{.R}}
+
+//topMask masks bits of highest limb below 2^{{.K}}
+const topMask = 1<<{{.TopBits}} - 1
+
+var (
+	one     = FieldElement{1}
+	modulus = {{.Modulus}}
+	d       = {{.DElement}}
+)
+
+//mulGeneric computes x*y with schoolbook multiplication and reduces 2{{.N}}-limb product with reduce
+func mulGeneric(res, x, y *FieldElement) {
+	var t [2 * {{.N}}]uint64
+	for i := 0; i < {{.N}}; i++ {
+		var carry, c uint64
+		for j := 0; j < {{.N}}; j++ {
+			hi, lo := bits.Mul64(x[i], y[j])
+			lo, c = bits.Add64(lo, t[i+j], 0)
+			hi += c
+			lo, c = bits.Add64(lo, carry, 0)
+			hi += c
+			t[i+j] = lo
+			carry = hi
+		}
+		t[i+{{.N}}] = carry
+	}
+	reduce(res, &t)
+}
+
+//reduce folds upper half of t with 2^{{mul64 .N}} == reductionConst (mod p), then folds top limb and final carry
+func reduce(res *FieldElement, t *[2 * {{.N}}]uint64) {
+	var r FieldElement
+	var carry, c uint64
+	for i := 0; i < {{.N}}; i++ {
+		hi, lo := bits.Mul64(t[i+{{.N}}], reductionConst)
+		lo, c = bits.Add64(lo, t[i], 0)
+		hi += c
+		lo, c = bits.Add64(lo, carry, 0)
+		hi += c
+		r[i] = lo
+		carry = hi
+	}
+	hi, lo := bits.Mul64(carry, reductionConst)
+	r[0], c = bits.Add64(r[0], lo, 0)
+	r[1], c = bits.Add64(r[1], hi, c)
+	for i := 2; i < {{.N}}; i++ {
+		r[i], c = bits.Add64(r[i], 0, c)
+	}
+	addCarry(&r, c)
+	*res = r
+}
+
+//addCarry adds 2^{{mul64 .N}} mod p to r if carry == 1 and returns carry of this addition
+func addCarry(r *FieldElement, carry uint64) uint64 {
+	var c uint64
+	r[0], c = bits.Add64(r[0], reductionConst&-carry, 0)
+	for i := 1; i < {{.N}}; i++ {
+		r[i], c = bits.Add64(r[i], 0, c)
+	}
+	return c
+}
+
+//subBorrow subtracts 2^{{mul64 .N}} mod p from r if borrow == 1 and returns borrow of this subtraction
+func subBorrow(r *FieldElement, borrow uint64) uint64 {
+	var b uint64
+	r[0], b = bits.Sub64(r[0], reductionConst&-borrow, 0)
+	for i := 1; i < {{.N}}; i++ {
+		r[i], b = bits.Sub64(r[i], 0, b)
+	}
+	return b
+}
+
+//Mul multiplies two field elements mod p. Execution time doesn't depend on values
+func (out *FieldElement) Mul(x, y *FieldElement) *FieldElement {
+	mul(out, x, y)
+	return out
+}
+
+//Sqr squares field element mod p. Execution time doesn't depend on values
+func (out *FieldElement) Sqr(x *FieldElement) *FieldElement {
+	return out.Mul(x, x)
+}
+
+//MulD multiplies field element by d mod p. Execution time doesn't depend on values
+func (out *FieldElement) MulD(x *FieldElement) *FieldElement {
+	return out.Mul(x, &d)
+}
+
+//Mul2 multiplies field element by 2 mod p. Execution time doesn't depend on values
+func (out *FieldElement) Mul2(x *FieldElement) *FieldElement {
+	mul2(out, x)
+	return out
+}
+
+//Add adds two field elements mod p. Execution time doesn't depend on values
+func (out *FieldElement) Add(x, y *FieldElement) *FieldElement {
+	add(out, x, y)
+	return out
+}
+
+//Sub subtracts two field elements mod p. Execution time doesn't depend on values
+func (out *FieldElement) Sub(x, y *FieldElement) *FieldElement {
+	sub(out, x, y)
+	return out
+}
+
+//Mod reduces field element to canonical value (smaller than p). Execution time doesn't depend on values
+func (out *FieldElement) Mod(x *FieldElement) *FieldElement {
+	mod(out, x)
+	return out
+}
+
+//addGeneric is Add in Go
+func addGeneric(res, x, y *FieldElement) {
+	var r FieldElement
+	var c uint64
+	for i := 0; i < {{.N}}; i++ {
+		r[i], c = bits.Add64(x[i], y[i], c)
+	}
+	//second addition can't overflow
+	addCarry(&r, addCarry(&r, c))
+	*res = r
+}
+
+//mul2Generic is Mul2 in Go
+func mul2Generic(res, x *FieldElement) {
+	addGeneric(res, x, x)
+}
+
+//subGeneric is Sub in Go
+func subGeneric(res, x, y *FieldElement) {
+	var r FieldElement
+	var b uint64
+	for i := 0; i < {{.N}}; i++ {
+		r[i], b = bits.Sub64(x[i], y[i], b)
+	}
+	//second subtraction can't borrow
+	subBorrow(&r, subBorrow(&r, b))
+	*res = r
+}
+
+//modGeneric is Mod in Go
+func modGeneric(res, x *FieldElement) {
+	r := *x
+{{- if lt .TopBits 64}}
+	//bits above 2^{{.K}} are folded twice with 2^{{.K}} == {{.C}}, after that r < 2^{{.K}}+{{.C}}
+	for n := 0; n < 2; n++ {
+		h := r[{{.N}}-1] >> {{.TopBits}}
+		r[{{.N}}-1] &= topMask
+		var c uint64
+		r[0], c = bits.Add64(r[0], h*{{.C}}, 0)
+		for i := 1; i < {{.N}}; i++ {
+			r[i], c = bits.Add64(r[i], 0, c)
+		}
+	}
+{{- end}}
+	//r < 2p, subtract p if r >= p
+	var s FieldElement
+	var b uint64
+	for i := 0; i < {{.N}}; i++ {
+		s[i], b = bits.Sub64(r[i], modulus[i], b)
+	}
+	mask := b - 1
+	for i := 0; i < {{.N}}; i++ {
+		res[i] = s[i]&mask | r[i]&^mask
+	}
+}
+
+//Inverse sets out to inverse of x mod p by raising x to power p-2. Execution time doesn't depend on value
+func (out *FieldElement) Inverse(x *FieldElement) *FieldElement {
+	e := {{.PMinus2Limbs}}
+	r := one
+	b := *x
+	for i := {{.K}} - 1; i >= 0; i-- {
+		r.Sqr(&r)
+		if e[i/64]>>(i%64)&1 == 1 {
+			r.Mul(&r, &b)
+		}
+	}
+	*out = r
+	return out
+}
+
+//Set sets out to x
+func (out *FieldElement) Set(x *FieldElement) *FieldElement {
+	*out = *x
+	return out
+}
+
+//IsZero checks if x == 0 mod p
+func (out *FieldElement) IsZero() bool {
+	var r FieldElement
+	r.Mod(out)
+	var acc uint64
+	for i := 0; i < {{.N}}; i++ {
+		acc |= r[i]
+	}
+	return acc == 0
+}
+
+//Equals checks if two field elements are equal mod p
+func (out *FieldElement) Equals(x *FieldElement) bool {
+	var r FieldElement
+	return r.Sub(out, x).IsZero()
+}
+
+//condSet sets out to x if set == 1 and leaves it unchanged if set == 0. Execution time doesn't depend on set.
+func (out *FieldElement) condSet(x *FieldElement, set uint64) {
+	mask := -set
+	for i := 0; i < {{.N}}; i++ {
+		out[i] ^= (out[i] ^ x[i]) & mask
+	}
+}
+
+//ToBigInt returns canonical value of x as *big.Int
+func (out *FieldElement) ToBigInt() *big.Int {
+	var r FieldElement
+	r.Mod(out)
+	b := new(big.Int)
+	for i := {{.N}} - 1; i >= 0; i-- {
+		b.Lsh(b, 64).Or(b, new(big.Int).SetUint64(r[i]))
+	}
+	return b
+}
+
+//SetBigInt sets out to b mod p
+func (out *FieldElement) SetBigInt(b *big.Int) *FieldElement {
+	v := new(big.Int).Mod(b, P)
+	mask := new(big.Int).SetUint64(^uint64(0))
+	for i := 0; i < {{.N}}; i++ {
+		out[i] = new(big.Int).And(v, mask).Uint64()
+		v.Rsh(v, 64)
+	}
+	return out
+}
+
+//Format implements fmt.Formatter interface
+func (out *FieldElement) Format(s fmt.State, c rune) {
+	out.ToBigInt().Format(s, c)
+}
+`
+
+const fieldGoTemplate = header + `//go:build !amd64 || {{.Package}}_purego
+
+package {{.Package}}
+
+//Without assembly all field operations use pure Go implementations from field.go
+
+func mul(res, x, y *FieldElement) {
+	mulGeneric(res, x, y)
+}
+
+func mul2(res, x *FieldElement) {
+	mul2Generic(res, x)
+}
+
+func add(res, x, y *FieldElement) {
+	addGeneric(res, x, y)
+}
+
+func sub(res, x, y *FieldElement) {
+	subGeneric(res, x, y)
+}
+
+func mod(res, x *FieldElement) {
+	modGeneric(res, x)
+}
+`
+
+const fieldAmd64Template = header + `//go:build !{{.Package}}_purego
+
+package {{.Package}}
+
+//Assembly in field_amd64.s uses only MULQ and ADCQ, so it runs on every amd64 CPU. Its functions take
+//*[{{.N}}]uint64 because generator doesn't load types of the package.
+
+func mul(res, x, y *FieldElement) {
+	mulAsm((*[{{.N}}]uint64)(res), (*[{{.N}}]uint64)(x), (*[{{.N}}]uint64)(y))
+}
+
+func mul2(res, x *FieldElement) {
+	mul2Asm((*[{{.N}}]uint64)(res), (*[{{.N}}]uint64)(x))
+}
+
+func add(res, x, y *FieldElement) {
+	addAsm((*[{{.N}}]uint64)(res), (*[{{.N}}]uint64)(x), (*[{{.N}}]uint64)(y))
+}
+
+func sub(res, x, y *FieldElement) {
+	subAsm((*[{{.N}}]uint64)(res), (*[{{.N}}]uint64)(x), (*[{{.N}}]uint64)(y))
+}
+
+func mod(res, x *FieldElement) {
+	modAsm((*[{{.N}}]uint64)(res), (*[{{.N}}]uint64)(x))
+}
+
+//go:noescape
+func mulAsm(res, x, y *[{{.N}}]uint64)
+
+//go:noescape
+func mul2Asm(res, x *[{{.N}}]uint64)
+
+//go:noescape
+func addAsm(res, x, y *[{{.N}}]uint64)
+
+//go:noescape
+func subAsm(res, x, y *[{{.N}}]uint64)
+
+//go:noescape
+func modAsm(res, x *[{{.N}}]uint64)
+`
+
+const paramsTemplate = header + `package {{.Package}}
+
+import "math/big"
+
+//P is order of F_p, 2^{{.K}}-{{.C}}
+var P, _ = new(big.Int).SetString("{{.P}}", 16)
+
+//CurveParams describes {{.Name}}, Edwards curve x^2+y^2 = 1+dx^2y^2 over F_p
+type CurveParams struct {
+	//P is order of F_p, 2^{{.K}}-{{.C}}
+	P *big.Int
+	//D is coefficient of Edwards equation mod p
+	D *big.Int
+	//N is order of base point, nil if it's not known
+	N *big.Int
+	//Cofactor is ratio of curve's group order and N, 0 if it's not known
+	Cofactor int
+	//Gx and Gy are affine coordinates of base point
+	Gx, Gy *big.Int
+}
+
+//Params returns new copy of curve parameters
+func Params() *CurveParams {
+	c := &CurveParams{
+		P:        new(big.Int).Set(P),
+		D:        d.ToBigInt(),
+		Cofactor: {{.Cofactor}},
+		Gx:       basePoint.X.ToBigInt(),
+		Gy:       basePoint.Y.ToBigInt(),
+	}
+{{- if .Order}}
+	c.N, _ = new(big.Int).SetString("{{.Order}}", 16)
+{{- end}}
+	return c
+}
+`
+
+const pointTemplate = header + `package {{.Package}}
+
+import "crypto/subtle"
+
+//Point represents point on {{.Name}} in extended coordinates: x = X/Z, y = Y/Z, x*y = T/Z
+type Point struct {
+	X FieldElement
+	Y FieldElement
+	T FieldElement
+	Z FieldElement
+}
+
+var basePoint = Point{
+	X: {{.BaseXElement}},
+	Y: {{.BaseYElement}},
+	T: {{.BaseT}},
+	Z: one,
+}
+
+var identity = Point{Y: one, Z: one}
+
+//NewBasePoint returns new copy of base point in affine coordinates (Z == 1)
+func NewBasePoint() *Point {
+	p := basePoint
+	return &p
+}
+
+//NewIdentityPoint returns new copy of identity element of curve's group (x:0, y:1)
+func NewIdentityPoint() *Point {
+	p := identity
+	return &p
+}
+
+//Set sets p to p2
+func (p *Point) Set(p2 *Point) *Point {
+	*p = *p2
+	return p
+}
+
+//Add sets p to p1+p2 (see add in formulas.go). Execution time doesn't depend on points
+func (p *Point) Add(p1, p2 *Point) *Point {
+	return p.add(p1, p2)
+}
+
+//Double sets p to 2*p1 (see double in formulas.go). Execution time doesn't depend on point
+func (p *Point) Double(p1 *Point) *Point {
+	return p.double(&p1.X, &p1.Y, &p1.Z)
+}
+
+//Neg sets p to -p1
+func (p *Point) Neg(p1 *Point) *Point {
+	var zero FieldElement
+	p.X.Sub(&zero, &p1.X)
+	p.Y = p1.Y
+	p.T.Sub(&zero, &p1.T)
+	p.Z = p1.Z
+	return p
+}
+
+//ScalarMult sets p to b*sp, b is little endian 64-bit limbs. It uses 4-bit windows with constant time table
+//lookups, so execution time doesn't depend on b or sp.
+func (p *Point) ScalarMult(sp *Point, b *FieldElement) *Point {
+	var table [16]Point
+	table[0] = identity
+	table[1] = *sp
+	for i := 2; i < 16; i++ {
+		table[i].Add(&table[i-1], sp)
+	}
+	var acc, q Point
+	acc = identity
+	for i := {{mul64 .N}}/4 - 1; i >= 0; i-- {
+		acc.Double(&acc).Double(&acc).Double(&acc).Double(&acc)
+		w := b[i/16] >> (uint(i%16) * 4) & 15
+		for j := range table {
+			set := uint64(subtle.ConstantTimeEq(int32(w), int32(j)))
+			q.X.condSet(&table[j].X, set)
+			q.Y.condSet(&table[j].Y, set)
+			q.T.condSet(&table[j].T, set)
+			q.Z.condSet(&table[j].Z, set)
+		}
+		acc.Add(&acc, &q)
+	}
+	*p = acc
+	return p
+}
+
+//ScalarBaseMult sets p to b*Base
+func (p *Point) ScalarBaseMult(b *FieldElement) *Point {
+	return p.ScalarMult(&basePoint, b)
+}
+
+//ToAffine converts p1 to affine coordinates (Z == 1) with canonical X, Y and T
+func (p *Point) ToAffine(p1 *Point) *Point {
+	var z FieldElement
+	z.Inverse(&p1.Z)
+	p.X.Mul(&p1.X, &z).Mod(&p.X)
+	p.Y.Mul(&p1.Y, &z).Mod(&p.Y)
+	p.T.Mul(&p.X, &p.Y).Mod(&p.T)
+	p.Z = one
+	return p
+}
+
+//Equals checks if p and p2 represent the same point
+func (p *Point) Equals(p2 *Point) bool {
+	var l, r FieldElement
+	if !l.Mul(&p.X, &p2.Z).Equals(r.Mul(&p2.X, &p.Z)) {
+		return false
+	}
+	return l.Mul(&p.Y, &p2.Z).Equals(r.Mul(&p2.Y, &p.Z))
+}
+`
+
+const testTemplate = header + `package {{.Package}}
+
+import (
+	"math/big"
+	"math/bits"
+	"math/rand"
+	"testing"
+)
+
+func randomElement(r *rand.Rand) *FieldElement {
+	var f FieldElement
+	for i := range f {
+		f[i] = r.Uint64()
+	}
+	switch r.Intn(4) {
+	case 0:
+		//limbs close to 2^64 test carries in reduction
+		for i := range f {
+			f[i] |= 0xffffffffffff0000
+		}
+	case 1:
+		f = modulus
+		f[0] -= uint64(r.Intn(3))
+	}
+	return &f
+}
+
+func TestField(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	ops := []struct {
+		name string
+		op   func(res, x, y *FieldElement)
+		ref  func(res, x, y *big.Int)
+	}{
+		{"Mul", func(res, x, y *FieldElement) { res.Mul(x, y) }, func(res, x, y *big.Int) { res.Mul(x, y) }},
+		{"Sqr", func(res, x, y *FieldElement) { res.Sqr(x) }, func(res, x, y *big.Int) { res.Mul(x, x) }},
+		{"MulD", func(res, x, y *FieldElement) { res.MulD(x) }, func(res, x, y *big.Int) { res.Mul(x, d.ToBigInt()) }},
+		{"Add", func(res, x, y *FieldElement) { res.Add(x, y) }, func(res, x, y *big.Int) { res.Add(x, y) }},
+		{"Sub", func(res, x, y *FieldElement) { res.Sub(x, y) }, func(res, x, y *big.Int) { res.Sub(x, y) }},
+		{"Inverse", func(res, x, y *FieldElement) { res.Inverse(x) }, func(res, x, y *big.Int) {
+			res.ModInverse(x, P)
+		}},
+	}
+	for _, op := range ops {
+		for i := 0; i < 2000; i++ {
+			x, y := randomElement(r), randomElement(r)
+			var res FieldElement
+			op.op(&res, x, y)
+			expected := new(big.Int)
+			op.ref(expected, x.ToBigInt(), y.ToBigInt())
+			expected.Mod(expected, P)
+			if res.ToBigInt().Cmp(expected) != 0 {
+				t.Fatalf("%s(%x, %x) = %x, expected %x", op.name, x, y, &res, expected)
+			}
+		}
+	}
+}
+
+func TestScalarMult(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	if !NewBasePoint().IsOnCurve() {
+		t.Fatal("base point not on curve")
+	}
+	for i := 0; i < 20; i++ {
+		a, b := randomElement(r), randomElement(r)
+		a[{{.N}}-1] >>= 4
+		b[{{.N}}-1] >>= 4
+		var sum FieldElement
+		var c uint64
+		for j := range sum {
+			sum[j], c = bits.Add64(a[j], b[j], c)
+		}
+		var pa, pb, ps Point
+		pa.ScalarBaseMult(a)
+		pb.ScalarBaseMult(b)
+		ps.ScalarBaseMult(&sum)
+		if !pa.IsOnCurve() || !pa.Add(&pa, &pb).Equals(&ps) {
+			t.Fatalf("a*G+b*G != (a+b)*G for %x, %x", a, b)
+		}
+		if pa.Add(&pb, &pb); !pb.Double(&pb).Equals(&pa) {
+			t.Fatalf("2*(b*G) != b*G+b*G for %x", b)
+		}
+		pa.ToAffine(&pa)
+		if !pa.IsOnCurve() || pa.Z != one {
+			t.Fatalf("invalid affine point %x", &pa.X)
+		}
+	}
+	var p Point
+	k := {{.VectorK}}
+	p.ScalarBaseMult(&k).ToAffine(&p)
+	if p.X.ToBigInt().Text(16) != "{{.VectorX}}" || p.Y.ToBigInt().Text(16) != "{{.VectorY}}" {
+		t.Errorf("%x*G = (%x, %x)", &k, &p.X, &p.Y)
+	}
+	if !p.ScalarBaseMult(&FieldElement{}).Equals(NewIdentityPoint()) {
+		t.Error("0*G != E")
+	}
+	if !p.ScalarMult(p.Neg(NewBasePoint()), &FieldElement{1}).Add(&p, NewBasePoint()).Equals(NewIdentityPoint()) {
+		t.Error("-G+G != E")
+	}
+{{- if .Order}}
+	var order FieldElement
+	n := Params().N
+	for i := range order {
+		order[i] = new(big.Int).Rsh(n, uint(64*i)).Uint64()
+	}
+	if !p.ScalarBaseMult(&order).Equals(NewIdentityPoint()) {
+		t.Error("l*G != E")
+	}
+{{- end}}
+}
+`
+
+const fieldAmd64TestTemplate = header + `//go:build !{{.Package}}_purego
+
+package {{.Package}}
+
+import (
+	"math/rand"
+	"testing"
+)
+
+//equalMod compares canonical values with modGeneric, results of assembly can be reduced differently (e.g. with shifts
+//instead of multiplication by c)
+func equalMod(x, y *FieldElement) bool {
+	var xm, ym FieldElement
+	modGeneric(&xm, x)
+	modGeneric(&ym, y)
+	return xm == ym
+}
+
+func TestFieldAsm(t *testing.T) {
+	r := rand.New(rand.NewSource(3))
+	ops := []struct {
+		name         string
+		asm, generic func(res, x, y *FieldElement)
+	}{
+		{"Mul", mul, mulGeneric},
+		{"Mul2", func(res, x, y *FieldElement) { mul2(res, x) }, func(res, x, y *FieldElement) { mul2Generic(res, x) }},
+		{"Add", add, addGeneric},
+		{"Sub", sub, subGeneric},
+		{"Mod", func(res, x, y *FieldElement) { mod(res, x) }, func(res, x, y *FieldElement) { modGeneric(res, x) }},
+	}
+	for _, op := range ops {
+		for i := 0; i < 20000; i++ {
+			x, y := randomElement(r), randomElement(r)
+			var asm, generic FieldElement
+			op.asm(&asm, x, y)
+			op.generic(&generic, x, y)
+			if !equalMod(&asm, &generic) {
+				t.Fatalf("%s(%x, %x): %x != %x", op.name, x, y, &asm, &generic)
+			}
+			//aliased arguments
+			asm, generic = *x, *x
+			op.asm(&asm, &asm, &asm)
+			op.generic(&generic, &generic, &generic)
+			if !equalMod(&asm, &generic) {
+				t.Fatalf("aliased %s(%x): %x != %x", op.name, x, &asm, &generic)
+			}
+		}
+	}
+}
+`
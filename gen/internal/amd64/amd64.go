@@ -0,0 +1,152 @@
+//Package amd64 generates amd64 assembly of field arithmetic, constant time table lookups and point formulas with avo
+//for Edwards curve x^2+y^2 = 1+dx^2y^2 over F_p, p = 2^k-c. gen/asm.go uses it for package curve1174 and gen/curve
+//for packages of other curves.
+//
+//Field elements are stored in n = ceil(k/64) 64-bit limbs. Up to 4 limbs the whole 2n-limb product is kept in
+//registers, wider fields keep its upper half on stack. Functions using MULX, ADCX and ADOX (Mul, Sqr, MulD and point
+//formulas) and FastInverse are written for 4 limbs, MulNoAdx, Add, Sub, Mul2, Div2 and Mod work with any n. FourWay
+//(AVX2 point formulas on four field elements at once) works only for 2^251-9.
+package amd64
+
+import (
+	"fmt"
+	"math/bits"
+
+	"github.com/mmcloughlin/avo/attr"
+	"github.com/mmcloughlin/avo/build"
+	. "github.com/mmcloughlin/avo/operand"
+	. "github.com/mmcloughlin/avo/reg"
+)
+
+//Params describes the curve
+type Params struct {
+	//K and C define p = 2^K-C, 2^(64-K%64)*C has to be smaller than 2^63
+	K int
+	C uint64
+	//D is coefficient of Edwards equation, it's used only by MulD
+	D int64
+	//Element is Go type of pointer to field element used in signatures, e.g. *FieldElement or *[6]uint64
+	Element string
+}
+
+//Generator adds functions to avo build context
+type Generator struct {
+	*build.Context
+	Params
+	//n is number of limbs, topBits is number of bits of p in the highest one, 64*(n-1)+topBits == K
+	n, topBits int
+	//r is 2^(64n) mod p
+	r uint64
+	//p holds limbs of p
+	p []uint64
+
+	//regs hold 2n-limb products, only the lower half if n > 4 (upper half is in local, see acc)
+	regs               []Register
+	local              *Mem
+	yPtr, xPtr, resPtr Register
+	zero, flag         Register
+	lastX              int
+	//xInRegs is set by point formulas if x is already in regs[0:n] (it's result of previous operation)
+	xInRegs bool
+	//lastResult is operand currently stored in regs[0:n]
+	lastResult *fieldOp
+	fourWay
+}
+
+//New returns Generator for curve described by params
+func New(ctx *build.Context, params Params) *Generator {
+	g := &Generator{Context: ctx, Params: params}
+	g.n = (params.K + 63) / 64
+	g.topBits = params.K - 64*(g.n-1)
+	if 64-g.topBits+bits.Len64(params.C) > 63 {
+		panic(fmt.Sprintf("c = %d is too big for 2^%d-c", params.C, params.K))
+	}
+	g.r = params.C << uint(64-g.topBits)
+	g.p = make([]uint64, g.n)
+	for i := range g.p {
+		g.p[i] = 0xffffffffffffffff
+	}
+	g.p[0] = -params.C
+	g.p[g.n-1] >>= uint(64 - g.topBits)
+
+	regs := 2 * g.n
+	if g.n > 4 {
+		regs = g.n
+	}
+	g.regs = make([]Register, regs)
+	for i := range g.regs {
+		g.regs[i] = ctx.GP64()
+	}
+	g.zero = ctx.GP64()
+	return g
+}
+
+//TEXT starts new function like build.TEXT
+func (g *Generator) TEXT(name string, a attr.Attribute, signature string) {
+	g.Function(name)
+	g.Attributes(a)
+	g.SignatureExpr(signature)
+	g.local = nil
+}
+
+//signature returns signature of function with field element parameters
+func (g *Generator) signature(params string) string {
+	return fmt.Sprintf("func(%s %s)", params, g.Element)
+}
+
+//modulus returns p as string for comments
+func (g *Generator) modulus() string {
+	return fmt.Sprintf("2^%d-%d", g.K, g.C)
+}
+
+//needRegs panics if function can't be generated for more than 4 limbs
+func (g *Generator) needRegs(name string) {
+	if g.n != 4 {
+		panic(fmt.Sprintf("%s needs 4 limbs, 2^%d-%d has %d", name, g.K, g.C, g.n))
+	}
+}
+
+//acc returns i-th limb of 2n-limb product, register or stack slot of current function
+func (g *Generator) acc(i int) Op {
+	if i < len(g.regs) {
+		return g.regs[i]
+	}
+	if g.local == nil {
+		local := g.AllocLocal(8 * (2*g.n - len(g.regs)))
+		g.local = &local
+	}
+	return g.local.Offset(8 * (i - len(g.regs)))
+}
+
+func (g *Generator) mem(ptr Register, i int) Mem {
+	return Mem{Base: ptr, Disp: 8 * i}
+}
+
+//loadX loads x to regs[0:n] unless it's already there
+func (g *Generator) loadX() {
+	if g.xInRegs {
+		return
+	}
+	for i := 0; i < g.n; i++ {
+		g.MOVQ(g.mem(g.xPtr, i), g.regs[i])
+	}
+}
+
+func (g *Generator) storeResults() {
+	g.Comment("Store results")
+	g.resPtr = g.Load(g.Param("res"), g.GP64())
+	for i := 0; i < g.n; i++ {
+		g.MOVQ(g.regs[i], g.mem(g.resPtr, i))
+	}
+}
+
+//imm32 returns c as immediate of instructions that sign extend 32-bit immediates
+func imm32(c uint64) Constant {
+	if c >= 1<<31 {
+		panic(fmt.Sprintf("%d doesn't fit in 32-bit immediate", c))
+	}
+	if c < 1<<8 {
+		return U8(c)
+	}
+	return U32(c)
+}
@@ -0,0 +1,452 @@
+package amd64
+
+import (
+	"fmt"
+
+	"github.com/mmcloughlin/avo/attr"
+	. "github.com/mmcloughlin/avo/operand"
+	. "github.com/mmcloughlin/avo/reg"
+)
+
+//Four-way functions work on fieldElement4: ten limbs of radix 2^25.1 (26 bits in limb 0, 25 in others), each limb is
+//256-bit vector with the same limb of four field elements (usually X, Y, Z and T of a point) in 64-bit lanes. They are
+//written for p = 2^251-9 and use AVX2.
+
+//fourWay holds constants of four-way functions
+type fourWay struct {
+	//limbMasks are masks of 25 and 26 lowest bits
+	limbMasks [2]Mem
+	//bias4p is 4p in limb form, limb 0 and other limbs, it's added before subtraction of reduced limbs
+	bias4p [2]Mem
+	//doubleShift turns (A, B, Z^2, (X+Y)^2) permuted to (Z^2, Z^2, Z^2, B) into (0, 0, 2Z^2, B)
+	doubleShift Mem
+	//addShift turns A broadcast to all lanes into (0, 2A, 0, 0)
+	addShift Mem
+}
+
+//FourWay generates mul4, sqr4, double4 and addCached4 with constants they use
+func (g *Generator) FourWay() {
+	if g.K != 251 || g.C != 9 {
+		panic(fmt.Sprintf("four-way functions are written for 2^251-9, not 2^%d-%d", g.K, g.C))
+	}
+	g.limbMasks = [2]Mem{g.ConstData("mask25", U64(1<<25-1)), g.ConstData("mask26", U64(1<<26-1))}
+	g.mul4Func()
+	g.sqr4Func()
+	g.point4Consts()
+	g.double4Func()
+	g.addCached4Func()
+}
+
+//limbWidth is number of bits in limb i of fieldElement4
+func limbWidth(i int) int {
+	if i == 0 {
+		return 26
+	}
+	return 25
+}
+
+//limbCoef returns multiplier of product of limbs i and j of fieldElement4 added to limb (i+j)%10 of result. Limb i > 0
+//starts at bit 25i+1 so product of two such limbs is one bit above limb i+j, products above 2^251 are folded with
+//2^251 == 9 (limb 10 starts at bit 252, so it gets 18)
+func limbCoef(i, j int) uint64 {
+	k := i + j
+	switch {
+	case k < 10 && (i == 0 || j == 0):
+		return 1
+	case k < 10:
+		return 2
+	case k == 10:
+		return 18
+	}
+	return 9
+}
+
+//limbMem returns limb i of fieldElement4
+func limbMem(ptr Register, i int) Mem {
+	return Mem{Base: ptr, Disp: 32 * i}
+}
+
+//limbTerm is product x[i] * left * y[j] * right added to limb (i+j)%10 of result
+type limbTerm struct {
+	i, j        int
+	left, right uint64
+}
+
+//newLimbTerm splits coefficient between x and y: x is multiplied in register (only by 2) and y is multiplied once and
+//stored on stack, so scaled limbs of y (smaller than 2^27.6 * 18) still fit in 32 bits
+func newLimbTerm(i, j int, c uint64) limbTerm {
+	if c%2 == 0 {
+		return limbTerm{i, j, 2, c / 2}
+	}
+	return limbTerm{i, j, 1, c}
+}
+
+//limbProducts computes sums of products from terms and returns them (not carried)
+func (g *Generator) limbProducts(xPtr, yPtr Register, terms []limbTerm) []VecVirtual {
+	g.Comment("Scaled limbs")
+	scales := map[[2]int]bool{}
+	for _, t := range terms {
+		if t.right != 1 {
+			scales[[2]int{t.j, int(t.right)}] = true
+		}
+	}
+	scaled := map[[2]int]Mem{}
+	stack := g.AllocLocal(32 * len(scales))
+	for j := 0; j < 10; j++ {
+		var y VecVirtual
+		for _, scale := range []int{2, 9, 18} {
+			key := [2]int{j, scale}
+			if !scales[key] {
+				continue
+			}
+			if y == nil {
+				y = g.YMM()
+				g.VMOVDQU(limbMem(yPtr, j), y)
+			}
+			v := g.YMM()
+			switch scale {
+			case 2:
+				g.VPADDQ(y, y, v)
+			case 9:
+				g.VPSLLQ(Imm(3), y, v)
+				g.VPADDQ(y, v, v)
+			case 18:
+				g.VPSLLQ(Imm(3), y, v)
+				g.VPADDQ(y, v, v)
+				g.VPADDQ(v, v, v)
+			}
+			scaled[key] = stack.Offset(32 * len(scaled))
+			g.VMOVDQU(v, scaled[key])
+		}
+	}
+
+	acc := make([]VecVirtual, 10)
+	for i := 0; i < 10; i++ {
+		for _, left := range []uint64{1, 2} {
+			var x VecVirtual
+			for _, t := range terms {
+				if t.i != i || t.left != left {
+					continue
+				}
+				if x == nil {
+					g.Comment(fmt.Sprintf("x[%d]*%d", i, left))
+					x = g.YMM()
+					g.VMOVDQU(limbMem(xPtr, i), x)
+					if left == 2 {
+						g.VPADDQ(x, x, x)
+					}
+				}
+				var y Op = limbMem(yPtr, t.j)
+				if t.right != 1 {
+					y = scaled[[2]int{t.j, int(t.right)}]
+				}
+				k := (i + t.j) % 10
+				if acc[k] == nil {
+					acc[k] = g.YMM()
+					g.VPMULUDQ(y, x, acc[k])
+					continue
+				}
+				p := g.YMM()
+				g.VPMULUDQ(y, x, p)
+				g.VPADDQ(p, acc[k], acc[k])
+			}
+		}
+	}
+	return acc
+}
+
+func (g *Generator) mul4Func() {
+	g.TEXT("mul4", attr.NOSPLIT, "func(res, x, y *fieldElement4)")
+	g.Pragma("noescape")
+	g.Doc("res=x * y % 2^251-9 in each of four lanes, limbs of x and y have to be smaller than 2^27.6")
+	xPtr := g.Load(g.Param("x"), g.GP64())
+	yPtr := g.Load(g.Param("y"), g.GP64())
+
+	acc := g.limbProducts(xPtr, yPtr, mul4Terms())
+	g.carry4(acc)
+	g.storeLimbs(acc, g.Load(g.Param("res"), g.GP64()))
+	g.VZEROUPPER()
+	g.RET()
+}
+
+//mul4Terms returns terms of x * y
+func mul4Terms() []limbTerm {
+	var terms []limbTerm
+	for i := 0; i < 10; i++ {
+		for j := 0; j < 10; j++ {
+			terms = append(terms, newLimbTerm(i, j, limbCoef(i, j)))
+		}
+	}
+	return terms
+}
+
+func (g *Generator) sqr4Func() {
+	g.TEXT("sqr4", attr.NOSPLIT, "func(res, x *fieldElement4)")
+	g.Pragma("noescape")
+	g.Doc("res=x * x % 2^251-9 in each of four lanes, limbs of x have to be smaller than 2^27.6")
+	xPtr := g.Load(g.Param("x"), g.GP64())
+
+	acc := g.limbProducts(xPtr, xPtr, sqr4Terms())
+	g.carry4(acc)
+	g.storeLimbs(acc, g.Load(g.Param("res"), g.GP64()))
+	g.VZEROUPPER()
+	g.RET()
+}
+
+//sqr4Terms returns terms of x * x, every product of different limbs is counted twice
+func sqr4Terms() []limbTerm {
+	var terms []limbTerm
+	for i := 0; i < 10; i++ {
+		for j := i; j < 10; j++ {
+			c := limbCoef(i, j)
+			if i < j {
+				c *= 2
+			}
+			terms = append(terms, newLimbTerm(i, j, c))
+		}
+	}
+	return terms
+}
+
+//storeLimbs stores limbs from registers in fieldElement4 pointed by ptr
+func (g *Generator) storeLimbs(l []VecVirtual, ptr Register) {
+	for i := range l {
+		g.VMOVDQU(l[i], limbMem(ptr, i))
+	}
+}
+
+//carry4 propagates carries in sums of limb products, after it limbs 0-8 fit in their width and limb 9 has at most 26 bits
+func (g *Generator) carry4(acc []VecVirtual) {
+	mask25, mask26 := g.YMM(), g.YMM()
+	g.VPBROADCASTQ(g.limbMasks[0], mask25)
+	g.VPBROADCASTQ(g.limbMasks[1], mask26)
+
+	carryPass := func() {
+		for i := 0; i < 9; i++ {
+			t := g.YMM()
+			g.VPSRLQ(Imm(uint64(limbWidth(i))), acc[i], t)
+			if limbWidth(i) == 26 {
+				g.VPAND(mask26, acc[i], acc[i])
+			} else {
+				g.VPAND(mask25, acc[i], acc[i])
+			}
+			g.VPADDQ(t, acc[i+1], acc[i+1])
+		}
+	}
+
+	g.Comment("Carry")
+	carryPass()
+	g.Comment("Fold bits above 2^251 with 2^251 == 9")
+	t, t9 := g.YMM(), g.YMM()
+	g.VPSRLQ(Imm(25), acc[9], t)
+	g.VPAND(mask25, acc[9], acc[9])
+	g.VPSLLQ(Imm(3), t, t9)
+	g.VPADDQ(t, t9, t9)
+	g.VPADDQ(t9, acc[0], acc[0])
+	carryPass()
+}
+
+//shiftConst declares vector of four shift counts for VPSLLVQ, counts bigger than 63 zero their lanes
+func (g *Generator) shiftConst(name string, counts [4]uint64) Mem {
+	m := g.StaticGlobal(name)
+	g.DataAttributes(attr.RODATA | attr.NOPTR)
+	for k, c := range counts {
+		g.AddDatum(8*k, U64(c))
+	}
+	return m
+}
+
+func (g *Generator) point4Consts() {
+	g.bias4p = [2]Mem{g.ConstData("bias4p0", U64(4*(1<<26-9))), g.ConstData("bias4p", U64(4*(1<<25-1)))}
+	g.doubleShift = g.shiftConst("doubleShift", [4]uint64{64, 64, 1, 0})
+	g.addShift = g.shiftConst("addShift", [4]uint64{64, 1, 64, 64})
+}
+
+//lanes returns VPERMQ immediate that sets lane k of result to lane l[k] of source
+func lanes(l0, l1, l2, l3 uint64) Op {
+	return Imm(l0 | l1<<2 | l2<<4 | l3<<6)
+}
+
+//blendLanes returns VPBLENDD immediate that takes 64-bit lanes with set bits in mask from first operand
+func blendLanes(mask uint64) Op {
+	var imm uint64
+	for k := 0; k < 4; k++ {
+		if mask&(1<<k) != 0 {
+			imm |= 3 << (2 * k)
+		}
+	}
+	return Imm(imm)
+}
+
+//limbBias broadcasts limb i of 4p (or 2p if half is true)
+func (g *Generator) limbBias(i int, half bool) VecVirtual {
+	b := g.YMM()
+	if i == 0 {
+		g.VPBROADCASTQ(g.bias4p[0], b)
+	} else {
+		g.VPBROADCASTQ(g.bias4p[1], b)
+	}
+	if half {
+		g.VPSRLQ(Imm(1), b, b)
+	}
+	return b
+}
+
+//localElement allocates fieldElement4 on stack and returns pointer to it
+func (g *Generator) localElement() Register {
+	ptr := g.GP64()
+	g.LEAQ(g.AllocLocal(32*10), ptr)
+	return ptr
+}
+
+//round multiplies (or squares if x == y) fieldElement4 in all lanes and stores carried result in local fieldElement4
+func (g *Generator) round(xPtr, yPtr Register) Register {
+	terms := mul4Terms()
+	if xPtr == yPtr {
+		terms = sqr4Terms()
+	}
+	acc := g.limbProducts(xPtr, yPtr, terms)
+	g.carry4(acc)
+	resPtr := g.localElement()
+	g.storeLimbs(acc, resPtr)
+	return resPtr
+}
+
+//combineEFGH computes (G, H, F, E) + multiple of p as P + Q + 4p - A, where P and Q are lanes of src permuted with
+//pLanes and qLanes (lanes of Q in negQ are subtracted instead), A is lane of srcA permuted with aLanes and shifted by
+//aShift. Result is normalized and multiplied as (E, G, F, E) * (F, H, G, H) so (X, Y, Z, T) of result point end up in
+//resPtr.
+func (g *Generator) combineEFGH(resPtr, src, srcA Register, pLanes, qLanes, aLanes Op, negQ uint64, aShift Mem) {
+	g.Comment("Combine E, F, G and H")
+	wPtr := g.localElement()
+	for i := 0; i < 10; i++ {
+		s, p, q, qn, a := g.YMM(), g.YMM(), g.YMM(), g.YMM(), g.YMM()
+		g.VMOVDQU(limbMem(src, i), s)
+		g.VPERMQ(pLanes, s, p)
+		g.VPERMQ(qLanes, s, q)
+		if srcA == src {
+			g.VPERMQ(aLanes, s, a)
+		} else {
+			g.VPERMQ(aLanes, limbMem(srcA, i), a)
+		}
+		g.VPSLLVQ(aShift, a, a)
+		bias := g.limbBias(i, false)
+		g.VPSUBQ(q, bias, qn)
+		g.VPBLENDD(blendLanes(negQ), qn, q, q)
+		g.VPADDQ(q, p, p)
+		g.VPADDQ(bias, p, p)
+		g.VPSUBQ(a, p, p)
+		g.VMOVDQU(p, limbMem(wPtr, i))
+	}
+
+	g.Comment("Normalize limbs (one parallel carry pass)")
+	mask25, mask26 := g.YMM(), g.YMM()
+	g.VPBROADCASTQ(g.limbMasks[0], mask25)
+	g.VPBROADCASTQ(g.limbMasks[1], mask26)
+	w := make([]VecVirtual, 10)
+	for i := range w {
+		w[i] = g.YMM()
+		g.VMOVDQU(limbMem(wPtr, i), w[i])
+	}
+	t, t9 := g.YMM(), g.YMM()
+	g.VPSRLQ(Imm(25), w[9], t)
+	g.VPAND(mask25, w[9], w[9])
+	g.VPSLLQ(Imm(3), t, t9)
+	g.VPADDQ(t, t9, t9)
+	g.VPADDQ(t9, w[0], w[0])
+	for i := 8; i >= 0; i-- {
+		t := g.YMM()
+		g.VPSRLQ(Imm(uint64(limbWidth(i))), w[i], t)
+		if limbWidth(i) == 26 {
+			g.VPAND(mask26, w[i], w[i])
+		} else {
+			g.VPAND(mask25, w[i], w[i])
+		}
+		g.VPADDQ(t, w[i+1], w[i+1])
+	}
+
+	uPtr, vPtr := g.localElement(), g.localElement()
+	for i := range w {
+		u, v := g.YMM(), g.YMM()
+		g.VPERMQ(lanes(3, 0, 2, 3), w[i], u)
+		g.VPERMQ(lanes(2, 1, 0, 1), w[i], v)
+		g.VMOVDQU(u, limbMem(uPtr, i))
+		g.VMOVDQU(v, limbMem(vPtr, i))
+	}
+
+	g.Comment("(X, Y, Z, T) = (EF, GH, FG, EH)")
+	acc := g.limbProducts(uPtr, vPtr, mul4Terms())
+	g.carry4(acc)
+	g.storeLimbs(acc, resPtr)
+}
+
+func (g *Generator) double4Func() {
+	g.TEXT("double4", 0, "func(res, p *fieldElement4)")
+	g.Pragma("noescape")
+	g.Doc("res=p+p for point p with lanes (X, Y, Z, T), T of p isn't used")
+	pPtr := g.Load(g.Param("p"), g.GP64())
+
+	g.Comment("(X, Y, Z, X+Y)")
+	tPtr := g.localElement()
+	for i := 0; i < 10; i++ {
+		x, a, b := g.YMM(), g.YMM(), g.YMM()
+		g.VMOVDQU(limbMem(pPtr, i), x)
+		g.VPERMQ(lanes(0, 1, 2, 0), x, a)
+		g.VPERMQ(lanes(0, 0, 0, 1), x, b)
+		g.VPADDQ(b, a, a)
+		g.VPBLENDD(blendLanes(8), a, x, x)
+		g.VMOVDQU(x, limbMem(tPtr, i))
+	}
+
+	g.Comment("(A, B, Z^2, (X+Y)^2)")
+	sqPtr := g.round(tPtr, tPtr)
+
+	//G = A+B, H = A-B, F = A+B-2Z^2, E = (X+Y)^2-A-B
+	g.combineEFGH(g.Load(g.Param("res"), g.GP64()), sqPtr, sqPtr, lanes(0, 0, 0, 3), lanes(1, 1, 1, 0), lanes(2, 2, 2, 1),
+		0b1010, g.doubleShift)
+	g.VZEROUPPER()
+	g.RET()
+}
+
+func (g *Generator) addCached4Func() {
+	g.TEXT("addCached4", 0, "func(res, p, q *fieldElement4)")
+	g.Pragma("noescape")
+	g.Doc("res=p+q for point p with lanes (X, Y, Z, T) and cached point q with lanes (Y+X, Y-X, 2Z, 2dT)")
+	pPtr := g.Load(g.Param("p"), g.GP64())
+	qPtr := g.Load(g.Param("q"), g.GP64())
+
+	g.Comment("(Y+X, Y-X, Z, T) and 2X of q")
+	lPtr, dPtr := g.localElement(), g.localElement()
+	zeros := g.YMM()
+	g.VPXOR(zeros, zeros, zeros)
+	for i := 0; i < 10; i++ {
+		x, a, b, bn := g.YMM(), g.YMM(), g.YMM(), g.YMM()
+		g.VMOVDQU(limbMem(pPtr, i), x)
+		g.VPERMQ(lanes(1, 1, 2, 3), x, a)
+		g.VPERMQ(lanes(0, 0, 0, 0), x, b)
+		bias := g.limbBias(i, true)
+		g.VPSUBQ(b, bias, bn)
+		g.VPBLENDD(blendLanes(2), bn, b, b)
+		g.VPBLENDD(blendLanes(12), zeros, b, b)
+		g.VPADDQ(b, a, a)
+		g.VMOVDQU(a, limbMem(lPtr, i))
+
+		y, ym := g.YMM(), g.YMM()
+		g.VPERMQ(lanes(0, 0, 0, 0), limbMem(qPtr, i), y)
+		g.VPERMQ(lanes(1, 1, 1, 1), limbMem(qPtr, i), ym)
+		g.VPADDQ(bias, y, y)
+		g.VPSUBQ(ym, y, y)
+		g.VMOVDQU(y, limbMem(dPtr, i))
+	}
+
+	g.Comment("((Y1+X1)(Y2+X2), (Y1-X1)(Y2-X2), 2Z1Z2, 2dT1T2)")
+	rPtr := g.round(lPtr, qPtr)
+	g.Comment("2X1X2 in lane 0")
+	aPtr := g.round(pPtr, dPtr)
+
+	//G = 2Z1Z2+C, H = PP+MM-2A, F = 2Z1Z2-C, E = PP-MM
+	g.combineEFGH(g.Load(g.Param("res"), g.GP64()), rPtr, aPtr, lanes(2, 0, 2, 0), lanes(3, 1, 3, 1), lanes(0, 0, 0, 0),
+		0b1100, g.addShift)
+	g.VZEROUPPER()
+	g.RET()
+}
@@ -0,0 +1,296 @@
+package amd64
+
+import (
+	"fmt"
+	"math/bits"
+
+	"github.com/mmcloughlin/avo/attr"
+	. "github.com/mmcloughlin/avo/operand"
+	. "github.com/mmcloughlin/avo/reg"
+)
+
+//Add generates res=x + y % p
+func (g *Generator) Add(name string) {
+	g.TEXT(name, attr.NOSPLIT, g.signature("res, x, y"))
+	g.Pragma("noescape")
+	g.Doc(fmt.Sprintf("res=x + y %% %s", g.modulus()))
+	g.xPtr = g.Load(g.Param("x"), g.GP64())
+	g.yPtr = g.Load(g.Param("y"), g.GP64())
+	g.resPtr = g.Load(g.Param("res"), g.GP64())
+
+	g.addCore()
+
+	g.storeResults()
+	g.RET()
+}
+
+//addCore computes x + y (pointed by xPtr and yPtr) in regs[0:n]
+func (g *Generator) addCore() {
+	g.loadX()
+
+	g.ADDQ(g.mem(g.yPtr, 0), g.regs[0])
+	for i := 1; i < g.n; i++ {
+		g.ADCQ(g.mem(g.yPtr, i), g.regs[i])
+	}
+
+	g.addR()
+}
+
+//addR adds r (2^64n mod p) to regs[0:n] if last addition overflowed (twice, second one can't overflow)
+func (g *Generator) addR() {
+	q := g.GP64()
+	g.SBBQ(q, q)
+	g.andR(q)
+
+	g.ADDQ(q, g.regs[0])
+	for i := 1; i < g.n; i++ {
+		g.ADCQ(Imm(0), g.regs[i])
+	}
+
+	g.SBBQ(q, q)
+	g.andR(q)
+	g.ADDQ(q, g.regs[0])
+}
+
+//andR computes q & r
+func (g *Generator) andR(q GPVirtual) {
+	if g.r < 1<<32 {
+		g.ANDL(U32(g.r), q.As32())
+		return
+	}
+	r := g.GP64()
+	g.MOVQ(U64(g.r), r)
+	g.ANDQ(r, q)
+}
+
+//Sub generates res=x - y % p
+func (g *Generator) Sub(name string) {
+	g.TEXT(name, attr.NOSPLIT, g.signature("res, x, y"))
+	g.Pragma("noescape")
+	g.Doc(fmt.Sprintf("res=x - y %% %s", g.modulus()))
+	g.xPtr = g.Load(g.Param("x"), g.GP64())
+	g.yPtr = g.Load(g.Param("y"), g.GP64())
+
+	g.subCore()
+
+	g.storeResults()
+
+	g.RET()
+}
+
+//subCore computes x - y (pointed by xPtr and yPtr) in regs[0:n]
+func (g *Generator) subCore() {
+	g.loadX()
+
+	g.SUBQ(g.mem(g.yPtr, 0), g.regs[0])
+	for i := 1; i < g.n; i++ {
+		g.SBBQ(g.mem(g.yPtr, i), g.regs[i])
+	}
+
+	//subtract r if subtraction borrowed (twice, second one can't borrow)
+	q := g.GP64()
+	g.SBBQ(q, q)
+	g.andR(q)
+
+	g.SUBQ(q, g.regs[0])
+	for i := 1; i < g.n; i++ {
+		g.SBBQ(Imm(0), g.regs[i])
+	}
+
+	g.SBBQ(q, q)
+	g.andR(q)
+	g.SUBQ(q, g.regs[0])
+}
+
+//MulD generates res=x * d % p, d has to fit in 64 bits. It uses MULX, ADCX and ADOX and needs 4 limbs.
+func (g *Generator) MulD(name string) {
+	g.needRegs("MulD")
+	g.TEXT(name, attr.NOSPLIT, g.signature("res, x"))
+	g.Doc(fmt.Sprintf("res=x * %d %% %s", g.D, g.modulus()))
+	g.Pragma("noescape")
+
+	g.yPtr = g.Load(g.Param("x"), g.GP64())
+	g.mulDCore()
+	g.storeResults()
+	g.RET()
+}
+
+//mulDCore computes x * d (x pointed by yPtr) in regs[0:n] with MULX, ADCX and ADOX
+func (g *Generator) mulDCore() {
+	d := uint64(g.D)
+	if g.D < 0 {
+		d = -d
+	}
+	g.XORQ(g.zero, g.zero)
+	for i := 2; i < 2*g.n; i++ {
+		g.XORQ(g.regs[i], g.regs[i])
+	}
+	g.MOVQ(U64(d), RDX)
+	g.MULXQ(g.mem(g.yPtr, 0), g.regs[0], g.regs[1])
+	g.lastX = 0
+	for i := 1; i < g.n; i++ {
+		g.mulAdd(0, i)
+	}
+	g.carry(g.n, g.n+2)
+
+	if g.D < 0 {
+		g.negMod()
+	} else {
+		g.reduce()
+	}
+}
+
+//negMod computes -(regs[0:2n] % p) in regs[0:n]
+func (g *Generator) negMod() {
+	g.reduce()
+
+	p := make([]Register, g.n)
+	for i := range p {
+		p[i] = g.GP64()
+	}
+	for i := range p {
+		g.MOVQ(U64(g.p[i]), p[i])
+	}
+
+	g.SUBQ(g.regs[0], p[0])
+	for i := 1; i < g.n; i++ {
+		g.SBBQ(g.regs[i], p[i])
+	}
+
+	for i := range p {
+		g.MOVQ(p[i], g.regs[i])
+	}
+}
+
+//Div2 generates res=x >> 1 (arithmetic shift)
+func (g *Generator) Div2(name string) {
+	g.TEXT(name, attr.NOSPLIT, g.signature("res, x"))
+	g.Doc("res=x >> 1")
+	g.Pragma("noescape")
+	g.xPtr = g.Load(g.Param("x"), g.GP64())
+	g.resPtr = g.Load(g.Param("res"), g.GP64())
+
+	for i := 0; i < g.n; i++ {
+		g.MOVQ(g.mem(g.xPtr, i), g.regs[i])
+	}
+
+	for i := 0; i < g.n-1; i++ {
+		g.SHRQ(Imm(1), g.regs[i+1], g.regs[i])
+	}
+	g.SARQ(Imm(1), g.regs[g.n-1])
+
+	g.storeResults()
+
+	g.RET()
+}
+
+//Mul2 generates res=x * 2 % p
+func (g *Generator) Mul2(name string) {
+	g.TEXT(name, attr.NOSPLIT, g.signature("res, x"))
+	g.Doc(fmt.Sprintf("res=x * 2 %% %s", g.modulus()))
+	g.Pragma("noescape")
+	g.xPtr = g.Load(g.Param("x"), g.GP64())
+
+	g.mul2Core()
+
+	g.storeResults()
+
+	g.RET()
+}
+
+//mul2Core computes 2x (pointed by xPtr) in regs[0:n]
+func (g *Generator) mul2Core() {
+	g.loadX()
+
+	g.ADDQ(g.regs[0], g.regs[0])
+	for i := 1; i < g.n; i++ {
+		g.ADCQ(g.regs[i], g.regs[i])
+	}
+
+	g.addR()
+}
+
+//Mod generates res=x % p (canonical value)
+func (g *Generator) Mod(name string) {
+	g.TEXT(name, attr.NOSPLIT, g.signature("res, x"))
+	g.Doc(fmt.Sprintf("res=x %% %s", g.modulus()))
+	g.Pragma("noescape")
+	g.xPtr = g.Load(g.Param("x"), g.GP64())
+
+	for i := 0; i < g.n; i++ {
+		g.MOVQ(g.mem(g.xPtr, i), g.regs[i])
+	}
+	g.mod()
+
+	g.storeResults()
+
+	g.RET()
+}
+
+//mod reduces regs[0:n] to canonical value. Bits above 2^k are folded with 2^k == c, after that value is smaller than
+//2p so p is subtracted if it's not smaller than p. Copy of value is in acc(n:2n).
+func (g *Generator) mod() {
+	n := g.n
+	top := g.GP64()
+	if g.topBits < 64 {
+		//the highest limb of product is free, so use it if it's register
+		h, ok := g.acc(2*n - 1).(Register)
+		if !ok {
+			h = g.GP64()
+		}
+		g.MOVQ(g.regs[n-1], h)
+		g.SHRQ(Imm(uint64(g.topBits)), h)
+
+		g.MOVQ(U64(g.p[n-1]), top)
+		g.ANDQ(top, g.regs[n-1])
+
+		if ok {
+			g.Comment(fmt.Sprintf("regs[%d] = regs[%d]*%d", 2*n-1, 2*n-1, g.C))
+		} else {
+			g.Comment(fmt.Sprintf("h = h*%d", g.C))
+		}
+		g.mulC(h)
+
+		g.ADDQ(h, g.regs[0])
+		for i := 1; i < n; i++ {
+			g.ADCQ(Imm(0), g.regs[i])
+		}
+	} else {
+		g.MOVQ(U64(g.p[n-1]), top)
+	}
+
+	//all limbs of p except the lowest and the highest one are 2^64-1
+	var mid Register
+	if n > 2 {
+		mid = g.GP64()
+		g.MOVQ(U64(0xffffffffffffffff), mid)
+	}
+	low := g.GP64()
+	g.MOVQ(U64(g.p[0]), low)
+
+	for i := 0; i < n; i++ {
+		g.MOVQ(g.regs[i], g.acc(i+n))
+	}
+	g.SUBQ(low, g.acc(n))
+	for i := 1; i < n-1; i++ {
+		g.SBBQ(mid, g.acc(i+n))
+	}
+	g.SBBQ(top, g.acc(2*n-1))
+
+	for i := 0; i < n; i++ {
+		g.CMOVQCC(g.acc(i+n), g.regs[i])
+	}
+}
+
+//mulC multiplies register by c
+func (g *Generator) mulC(r Register) {
+	switch c := g.C; {
+	case c == 1:
+	case c == 3 || c == 5 || c == 9:
+		g.LEAQ(Mem{Base: r, Index: r, Scale: uint8(c - 1)}, r)
+	case c&(c-1) == 0:
+		g.SHLQ(Imm(uint64(bits.Len64(c)-1)), r)
+	default:
+		g.IMUL3Q(imm32(c), r, r)
+	}
+}
@@ -0,0 +1,197 @@
+package amd64
+
+import (
+	"fmt"
+
+	"github.com/mmcloughlin/avo/attr"
+	. "github.com/mmcloughlin/avo/operand"
+	. "github.com/mmcloughlin/avo/reg"
+)
+
+//FastInverse generates res=1/x % p with binary extended Euclidean algorithm (not constant time). It needs 4 limbs and
+//all general purpose registers.
+func (g *Generator) FastInverse(name string) {
+	g.needRegs("FastInverse")
+	if g.topBits > 61 {
+		panic(fmt.Sprintf("FastInverse needs 3 free bits in the highest limb, 2^%d-%d has %d", g.K, g.C, 64-g.topBits))
+	}
+	g.TEXT(name, attr.NOSPLIT, g.signature("res, x"))
+	g.Pragma("noescape")
+	v1 := []Register{R8, R9, R10, R11}
+	v := []Op{v1[0], v1[1], v1[2], v1[3]}
+	g.xPtr = g.Load(g.Param("x"), g.GP64())
+	x := g.Param("x").Dereference(g.xPtr)
+	for i := 0; i < 4; i++ {
+		g.Load(x.Index(i), v1[i])
+	}
+	local := g.AllocLocal(8)
+	g.MOVQ(RSP, X0)
+	u := []Op{RAX, RBX, RCX, RDX}
+	d1 := []Register{R12, R13, R14, R15}
+	d := []Op{d1[0], d1[1], d1[2], d1[3]}
+	b := []Op{local, RSI, RDI, RBP}
+
+	for i := 0; i < 4; i++ {
+		g.MOVQ(Imm(g.p[i]), u[i])
+	}
+
+	for i := 0; i < 4; i++ {
+		g.MOVQ(U32(0), b[i])
+		g.XORQ(d[i], d[i])
+	}
+
+	g.MOVQ(U32(1), d[0])
+
+	g.Label("mainloop")
+	{
+		for i := 0; i < 3; i++ {
+			g.CMPQ(u[i], Imm(0))
+			g.JNZ(LabelRef("notzero"))
+		}
+		g.CMPQ(u[3], Imm(0))
+		g.JZ(LabelRef("aftermain"))
+
+		g.Label("notzero")
+		g.TESTQ(U32(1), u[0])
+		g.JNZ(LabelRef("afteru"))
+		g.Label("uloop")
+		{
+			g.TESTQ(U32(1), b[0])
+			g.JZ(LabelRef("divu"))
+
+			g.subNN(b)
+
+			g.Label("divu")
+			g.div2(b)
+			g.div2(u)
+		}
+		g.TESTQ(U32(1), u[0])
+		g.JZ(LabelRef("uloop"))
+		g.Label("afteru")
+
+		g.TESTQ(U32(1), v[0])
+		g.JNZ(LabelRef("afterv"))
+		g.Label("vloop")
+		{
+			g.TESTQ(U32(1), d[0])
+			g.JZ(LabelRef("divv"))
+
+			g.subNN(d)
+
+			g.Label("divv")
+			g.div2(d)
+			g.div2(v)
+		}
+		g.TESTQ(U32(1), v[0])
+		g.JZ(LabelRef("vloop"))
+		g.Label("afterv")
+		for i := 3; i >= 0; i-- {
+			g.CMPQ(u[i], v[i])
+			g.JB(LabelRef("greaterv"))
+			g.JNE(LabelRef("greateru"))
+		}
+		g.Label("greateru")
+		g.subNoMod(u, v)
+		g.subNoMod(b, d)
+		g.reduceSigned(b, "b")
+		g.JMP(LabelRef("mainloop"))
+		g.Label("greaterv")
+		g.subNoMod(v, u)
+		g.subNoMod(d, b)
+		g.reduceSigned(d, "d")
+		g.JMP(LabelRef("mainloop"))
+	}
+	g.Label("aftermain")
+
+	g.MOVQ(X0, RSP)
+
+	g.resPtr = g.Load(g.Param("res"), g.GP64())
+	res := g.Param("res").Dereference(g.resPtr)
+	//d can be smaller than -p, add p until it's not negative
+	g.Label("fixsign")
+	g.CMPQ(d[3], Imm(0))
+	g.JNS(LabelRef("store"))
+
+	//d+p == d-(2^256-p) mod 2^256, 2^256-p == 2^256-2^k+c fits in immediates and one register
+	g.SUBQ(imm32(g.C), d[0])
+	g.SBBQ(U8(0), d[1])
+	g.SBBQ(U8(0), d[2])
+	g.MOVQ(Imm(-(g.p[3] + 1)), u[0])
+	g.SBBQ(u[0], d[3])
+	g.JMP(LabelRef("fixsign"))
+
+	g.Label("store")
+	for i := 0; i < 4; i++ {
+		g.Store(d1[i], res.Index(i))
+	}
+
+	g.RET()
+}
+
+func (g *Generator) subNoMod(u []Op, v []Op) {
+	g.SUBQ(v[0], u[0])
+	for i := 1; i < g.n; i++ {
+		g.SBBQ(v[i], u[i])
+	}
+}
+
+//reduceSigned brings signed r from (-2^(k+2), 2^(k+2)) back to (-2^(k+1), 2^(k+1)) by adding or subtracting
+//4p = 2^(k+2)-4c, so coefficients in FastInverse can't overflow. RSI is temporarily stored in X1 like in subNN
+func (g *Generator) reduceSigned(r []Op, name string) {
+	//2^64-2^(k+2-192), the highest limb of 2^256-4p
+	top := uint64(0) - 1<<uint(g.topBits+2)
+	g.MOVQ(RSI, X1)
+	g.MOVQ(r[3], RSI)
+	g.SARQ(U8(g.topBits+1), RSI)
+	//r[3]>>(k+1-192) in [-2, 1], shifted to [0, 3] so it can be compared with unsigned immediates
+	g.ADDQ(U8(2), RSI)
+	g.CMPQ(RSI, U8(2))
+	g.JG(LabelRef("sub4p" + name))
+	g.CMPQ(RSI, U8(1))
+	g.JL(LabelRef("add4p" + name))
+	g.MOVQ(X1, RSI)
+	g.JMP(LabelRef("reduced" + name))
+
+	//r-4p == r+2^256-2^(k+2)+4c mod 2^256
+	g.Label("sub4p" + name)
+	g.MOVQ(X1, RSI)
+	g.ADDQ(imm32(4*g.C), r[0])
+	g.ADCQ(U8(0), r[1])
+	g.ADCQ(U8(0), r[2])
+	g.MOVQ(RSI, X1)
+	g.MOVQ(U64(top), RSI)
+	g.ADCQ(RSI, r[3])
+	g.MOVQ(X1, RSI)
+	g.JMP(LabelRef("reduced" + name))
+
+	//r+4p == r-4c-(2^256-2^(k+2)) mod 2^256
+	g.Label("add4p" + name)
+	g.MOVQ(X1, RSI)
+	g.SUBQ(imm32(4*g.C), r[0])
+	g.SBBQ(U8(0), r[1])
+	g.SBBQ(U8(0), r[2])
+	g.MOVQ(RSI, X1)
+	g.MOVQ(U64(top), RSI)
+	g.SBBQ(RSI, r[3])
+	g.MOVQ(X1, RSI)
+	g.Label("reduced" + name)
+}
+
+//subNN subtracts p from r (r-p == r+(2^256-p) mod 2^256). All general purpose registers are used by FastInverse so RSI
+//is temporarily stored in X1
+func (g *Generator) subNN(r []Op) {
+	g.ADDQ(imm32(g.C), r[0])
+	g.ADCQ(U8(0), r[1])
+	g.ADCQ(U8(0), r[2])
+	g.MOVQ(RSI, X1)
+	g.MOVQ(Imm(-(g.p[3] + 1)), RSI)
+	g.ADCQ(RSI, r[3])
+	g.MOVQ(X1, RSI)
+}
+
+func (g *Generator) div2(r []Op) {
+	for i := 0; i < g.n-1; i++ {
+		g.SHRQ(Imm(1), r[i+1], r[i])
+	}
+	g.SARQ(Imm(1), r[g.n-1])
+}
@@ -0,0 +1,408 @@
+package amd64
+
+import (
+	"fmt"
+
+	"github.com/mmcloughlin/avo/attr"
+	. "github.com/mmcloughlin/avo/operand"
+	. "github.com/mmcloughlin/avo/reg"
+)
+
+//Mul generates res=x * y % p with MULX, ADCX and ADOX. If package variable cpuSupported isn't true it jumps to MULQ
+//code (see MulNoAdx). It needs 4 limbs.
+func (g *Generator) Mul(name string) {
+	g.needRegs("Mul")
+	g.TEXT(name, attr.NOSPLIT, g.signature("res, x, y"))
+	g.Pragma("noescape")
+	g.Doc(fmt.Sprintf("res=x * y %% %s", g.modulus()))
+
+	g.CMPB(Mem{Base: StaticBase, Symbol: Symbol{Name: "·cpuSupported"}}, U8(1))
+	g.JNE(LabelRef("mulNoAdx"))
+
+	g.xPtr = g.Load(g.Param("x"), g.GP64())
+	g.yPtr = g.Load(g.Param("y"), g.GP64())
+
+	g.mulCore()
+
+	g.storeResults()
+
+	g.RET()
+
+	g.Label("mulNoAdx")
+	g.mulNoAdx(g.Load(g.Param("x"), g.GP64()), g.Load(g.Param("y"), g.GP64()))
+}
+
+//MulNoAdx generates res=x * y % p with MULQ and ADCQ, so it runs on every amd64 CPU
+func (g *Generator) MulNoAdx(name string) {
+	g.TEXT(name, attr.NOSPLIT, g.signature("res, x, y"))
+	g.Pragma("noescape")
+	g.Doc(fmt.Sprintf("res=x * y %% %s", g.modulus()))
+
+	g.mulNoAdx(g.Load(g.Param("x"), g.GP64()), g.Load(g.Param("y"), g.GP64()))
+}
+
+//mulNoAdx multiplies with MULQ and ADCQ (for CPUs without BMI2 and ADX), x and y can be the same register.
+//Products are added in chains, carry flag is kept in flag register during MULQ.
+func (g *Generator) mulNoAdx(x, y Register) {
+	g.xPtr = x
+	g.yPtr = y
+	g.flag = g.GP8()
+
+	first, chains := g.mulNoAdxSchedule()
+	for _, p := range first {
+		g.mulq(p[0], p[1])
+	}
+	for _, chain := range chains {
+		g.MOVB(Imm(1), g.flag)
+		last := 0
+		for _, p := range chain {
+			g.mulqAdd(p[0], p[1])
+			last = p[0] + p[1]
+		}
+		for i := last + 2; i < 2*g.n; i++ {
+			g.ADCQ(Imm(0), g.acc(i))
+		}
+	}
+
+	g.reduce()
+
+	g.storeResults()
+
+	g.RET()
+}
+
+//mulNoAdxSchedule returns order of products x[i]*y[j] in mulNoAdx. The first ones fill the whole product, every
+//chain adds products to limbs i+j, i+j+2, ... and carry to the highest limb.
+func (g *Generator) mulNoAdxSchedule() (first [][2]int, chains [][][2]int) {
+	if g.n == 4 {
+		return [][2]int{{0, 0}, {0, 2}, {3, 1}, {3, 3}}, [][][2]int{
+			{{1, 0}, {0, 3}, {2, 3}},
+			{{0, 1}, {1, 2}, {3, 2}},
+			{{1, 1}, {1, 3}},
+			{{2, 0}, {2, 2}},
+			{{2, 1}},
+			{{3, 0}},
+		}
+	}
+	var rest [][2]int
+	for i := 0; i < g.n; i++ {
+		first = append(first, [2]int{i, i})
+		for j := 0; j < g.n; j++ {
+			if i != j {
+				rest = append(rest, [2]int{i, j})
+			}
+		}
+	}
+	//take product with the lowest i+j and continue with products 2 limbs higher while there are any
+	take := func(sum int) ([2]int, bool) {
+		best := -1
+		for k, p := range rest {
+			if (sum < 0 || p[0]+p[1] == sum) && (best < 0 || p[0]+p[1] < rest[best][0]+rest[best][1]) {
+				best = k
+			}
+		}
+		if best < 0 {
+			return [2]int{}, false
+		}
+		p := rest[best]
+		rest = append(rest[:best], rest[best+1:]...)
+		return p, true
+	}
+	for len(rest) > 0 {
+		p, _ := take(-1)
+		chain := [][2]int{p}
+		for {
+			next, ok := take(p[0] + p[1] + 2)
+			if !ok {
+				break
+			}
+			chain = append(chain, next)
+			p = next
+		}
+		chains = append(chains, chain)
+	}
+	return first, chains
+}
+
+func (g *Generator) mulqAdd(x, y int) {
+	g.MOVQ(g.mem(g.xPtr, x), RAX)
+	g.MULQ(g.mem(g.yPtr, y))
+	g.CMPB(g.flag, Imm(1))
+	g.ADCQ(RAX, g.acc(x+y))
+	g.ADCQ(RDX, g.acc(x+y+1))
+	g.SETCC(g.flag)
+}
+
+func (g *Generator) mulq(x, y int) {
+	g.MOVQ(g.mem(g.xPtr, x), RAX)
+	g.MULQ(g.mem(g.yPtr, y))
+	g.MOVQ(RAX, g.acc(x+y))
+	g.MOVQ(RDX, g.acc(x+y+1))
+}
+
+//Sqr generates res=x * x % p with MULX, ADCX and ADOX. It needs 4 limbs.
+func (g *Generator) Sqr(name string) {
+	g.needRegs("Sqr")
+	g.TEXT(name, attr.NOSPLIT, g.signature("res, x"))
+	g.Pragma("noescape")
+	g.Doc(fmt.Sprintf("res=x * x %% %s", g.modulus()))
+
+	g.xPtr = g.Load(g.Param("x"), g.GP64())
+
+	g.sqrCore()
+
+	g.storeResults()
+
+	g.RET()
+}
+
+//sqrCore computes x * x (pointed by xPtr) in regs[0:n] with MULX, ADCX and ADOX
+func (g *Generator) sqrCore() {
+	g.lastX = -1
+	x0, x1, x2, x3 := g.GP64(), g.GP64(), g.GP64(), g.GP64()
+
+	g.Comment("load x to registers")
+	for i, r := range []Register{x0, x1, x2, x3} {
+		if g.xInRegs {
+			g.MOVQ(g.regs[i], r)
+		} else {
+			g.MOVQ(g.mem(g.xPtr, i), r)
+		}
+	}
+
+	g.Comment("clear flags")
+	g.XORQ(g.zero, g.zero)
+
+	g.Comment("fill registers")
+
+	g.Comment("x[3]*x[2]")
+	g.mulS(3, x3, x2, g.regs[5], g.regs[6])
+	g.Comment("x[0]*x[3]")
+	g.mulS(0, x0, x3, g.regs[3], g.regs[4])
+	g.Comment("x[0]*x[1]")
+	g.mulS(0, x0, x1, g.regs[1], g.regs[2])
+
+	g.Comment("2-4 pass")
+	g.mulAddS(0, 2, x0, x2)
+	g.mulAddS(1, 2, x1, x2)
+	g.mulAddS(1, 3, x1, x3)
+	g.carry(5, 8)
+
+	g.Comment("clear 7")
+	g.XORQ(g.regs[7], g.regs[7])
+
+	g.Comment("multiply by 2 by shifting")
+	for i := 7; i > 1; i-- {
+		g.SHLQ(Imm(1), g.regs[i-1], g.regs[i])
+	}
+	g.SHLQ(Imm(1), g.regs[1])
+
+	g.Comment("add all z*z")
+	lo, hi := g.GP64(), g.GP64()
+	g.mulS(0, x0, x0, g.regs[0], hi)
+	g.ADDQ(hi, g.regs[1])
+	g.mulS(1, x1, x1, lo, hi)
+	g.ADCQ(lo, g.regs[2])
+	g.ADCQ(hi, g.regs[3])
+	g.mulS(2, x2, x2, lo, hi)
+	g.ADCQ(lo, g.regs[4])
+	g.ADCQ(hi, g.regs[5])
+	g.mulS(3, x3, x3, lo, hi)
+	g.ADCQ(lo, g.regs[6])
+	g.ADCQ(hi, g.regs[7])
+
+	g.reduce()
+}
+
+//mulCore computes x * y (pointed by xPtr and yPtr) in regs[0:n] with MULX, ADCX and ADOX
+func (g *Generator) mulCore() {
+	g.lastX = -1
+	g.Comment("Fill all regs")
+	g.mul(3, 1, g.regs[4], g.regs[5])
+	g.mul(3, 3, g.regs[6], g.regs[7])
+	g.mul(0, 0, g.regs[0], g.regs[1])
+	g.mul(0, 2, g.regs[2], g.regs[3])
+
+	g.XORQ(g.zero, g.zero)
+	g.Comment("First 1-5 chain")
+	g.mulAdd(0, 1)
+	g.mulAdd(2, 0)
+	g.mulAdd(2, 1)
+	g.mulAdd(2, 2)
+	g.mulAdd(2, 3)
+	g.carry(6, 8)
+
+	g.Comment("Second 1-5 chain")
+	g.mulAdd(1, 0)
+	g.mulAdd(1, 1)
+	g.mulAdd(1, 2)
+	g.mulAdd(1, 3)
+	g.mulAdd(3, 2)
+	g.carry(6, 8)
+
+	g.mulAdd(3, 0)
+	g.carry(4, 8)
+
+	g.mulAdd(0, 3)
+	g.carry(4, 8)
+
+	g.reduce()
+}
+
+//reduce reduces 2n-limb product in acc to n limbs in regs[0:n] (smaller than 2^64n, but not fully reduced)
+func (g *Generator) reduce() {
+	if j, ok := g.shiftFold(); ok {
+		g.reduceShift(j)
+		return
+	}
+	g.reduceMul()
+}
+
+//shiftFold checks if reduceShift can be used: product is in registers and c == 2^j+1, so multiplication by c is
+//shift and addition. Bits above 2^k have to fit in one more limb after multiplication by c.
+func (g *Generator) shiftFold() (int, bool) {
+	if len(g.regs) < 2*g.n || g.topBits == 64 || g.C < 3 || (g.C-1)&(g.C-2) != 0 {
+		return 0, false
+	}
+	j := 0
+	for c := g.C - 1; c > 1; c >>= 1 {
+		j++
+	}
+	//sum of low part, high part and high part shifted by j
+	sumBits := 128*g.n - g.K + j + 1
+	if sumBits < g.K {
+		sumBits = g.K
+	}
+	sumBits++
+	return j, j < g.topBits && sumBits <= 64*(g.n+1) && sumBits-g.K+j+1 <= 63
+}
+
+//reduceShift computes low + high*c, where low is lower k bits of product and high are bits above them. high*c is
+//computed as high + high<<j, top bits of the sum are folded the same way again.
+func (g *Generator) reduceShift(j int) {
+	n := g.n
+	shift := uint64(64 - g.topBits)
+	top, topA := g.GP64(), g.GP64()
+
+	g.MOVQ(g.regs[2*n-1], top)
+	g.SHRQ(Imm(uint64(g.topBits)), top)
+	for i := 2*n - 1; i >= n; i-- {
+		g.SHLQ(Imm(shift), g.regs[i-1], g.regs[i])
+	}
+
+	andReg := g.GP64()
+	g.MOVQ(U64(g.p[n-1]), andReg)
+	g.ANDQ(andReg, g.regs[n-1])
+
+	g.XORQ(topA, topA)
+
+	g.ADDQ(g.regs[n], g.regs[0])
+	for i := 1; i < n; i++ {
+		g.ADCQ(g.regs[n+i], g.regs[i])
+	}
+	g.ADCQ(top, topA)
+
+	g.SHLQ(Imm(uint64(j)), g.regs[2*n-1], top)
+	for i := 2*n - 1; i > n; i-- {
+		g.SHLQ(Imm(uint64(j)), g.regs[i-1], g.regs[i])
+	}
+	g.SHLQ(Imm(uint64(j)), g.regs[n])
+
+	g.ADDQ(g.regs[n], g.regs[0])
+	for i := 1; i < n; i++ {
+		g.ADCQ(g.regs[n+i], g.regs[i])
+	}
+	g.ADCQ(top, topA)
+
+	g.SHLQ(Imm(shift), g.regs[n-1], topA)
+
+	g.ANDQ(andReg, g.regs[n-1])
+
+	g.mulC(topA)
+
+	g.ADDQ(topA, g.regs[0])
+	for i := 1; i < n; i++ {
+		g.ADCQ(Imm(0), g.regs[i])
+	}
+}
+
+//reduceMul folds upper half of product with 2^64n == r (mod p) using MULQ, then folds the highest limb and final
+//carry
+func (g *Generator) reduceMul() {
+	n := g.n
+	r, hi := g.GP64(), g.GP64()
+	g.Comment(fmt.Sprintf("Fold upper half, 2^%d == %#x", 64*n, g.r))
+	g.MOVQ(U64(g.r), r)
+	g.XORQ(hi, hi)
+	for i := 0; i < n; i++ {
+		g.MOVQ(g.acc(n+i), RAX)
+		g.MULQ(r)
+		g.ADDQ(g.regs[i], RAX)
+		g.ADCQ(Imm(0), RDX)
+		g.ADDQ(hi, RAX)
+		g.ADCQ(Imm(0), RDX)
+		g.MOVQ(RAX, g.regs[i])
+		g.MOVQ(RDX, hi)
+	}
+
+	g.Comment("Fold the highest limb and final carry")
+	g.MOVQ(hi, RAX)
+	g.MULQ(r)
+	g.ADDQ(RAX, g.regs[0])
+	g.ADCQ(RDX, g.regs[1])
+	for i := 2; i < n; i++ {
+		g.ADCQ(Imm(0), g.regs[i])
+	}
+	g.SBBQ(hi, hi)
+	g.ANDQ(r, hi)
+	g.ADDQ(hi, g.regs[0])
+	for i := 1; i < n; i++ {
+		g.ADCQ(Imm(0), g.regs[i])
+	}
+}
+
+func (g *Generator) carry(start, end int) {
+	g.Comment(fmt.Sprintf("Carry %d-%d", start, end))
+	g.ADCXQ(g.zero, g.regs[start])
+
+	for i := start + 1; i < end; i++ {
+		g.ADOXQ(g.zero, g.regs[i])
+		g.ADCXQ(g.zero, g.regs[i])
+	}
+}
+
+func (g *Generator) mulAdd(x, y int) {
+	g.Comment(fmt.Sprintf("x[%d]*y[%d]", x, y))
+	hi := g.GP64()
+	low := g.GP64()
+	xy := x + y
+	g.mul(x, y, low, hi)
+	g.ADCXQ(low, g.regs[xy])
+	g.ADOXQ(hi, g.regs[xy+1])
+}
+
+func (g *Generator) mul(x, y int, low, hi Register) {
+	if x != g.lastX {
+		g.MOVQ(g.mem(g.xPtr, x), RDX)
+		g.lastX = x
+	}
+	g.MULXQ(g.mem(g.yPtr, y), low, hi)
+}
+
+func (g *Generator) mulAddS(xn, yn int, x, y Register) {
+	g.Comment(fmt.Sprintf("x[%d]*y[%d]", xn, yn))
+	hi := g.GP64()
+	low := g.GP64()
+	g.mulS(xn, x, y, low, hi)
+	xy := xn + yn
+	g.ADCXQ(low, g.regs[xy])
+	g.ADOXQ(hi, g.regs[xy+1])
+}
+
+func (g *Generator) mulS(xn int, x, y, low, hi Register) {
+	if xn != g.lastX {
+		g.MOVQ(x, RDX)
+		g.lastX = xn
+	}
+	g.MULXQ(y, low, hi)
+}
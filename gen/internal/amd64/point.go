@@ -0,0 +1,255 @@
+package amd64
+
+import (
+	"github.com/mmcloughlin/avo/attr"
+	. "github.com/mmcloughlin/avo/operand"
+	. "github.com/mmcloughlin/avo/reg"
+)
+
+//fieldOp is field element used by point formulas: coordinate of point passed as parameter or local variable
+type fieldOp struct {
+	param  string
+	offset int
+	local  *Mem
+}
+
+//ptr loads address of f into new register
+func (g *Generator) ptr(f fieldOp) Register {
+	r := g.GP64()
+	if f.local != nil {
+		g.LEAQ(*f.local, r)
+		return r
+	}
+	g.Load(g.Param(f.param), r)
+	if f.offset != 0 {
+		g.LEAQ(Mem{Base: r, Disp: f.offset}, r)
+	}
+	return r
+}
+
+//coords returns four coordinates of Point (X, Y, T, Z) or CachedPoint (Y+X, Y-X, 2dT, 2Z) passed as param
+func (g *Generator) coords(param string) (fieldOp, fieldOp, fieldOp, fieldOp) {
+	size := 8 * g.n
+	return fieldOp{param: param}, fieldOp{param: param, offset: size}, fieldOp{param: param, offset: 2 * size},
+		fieldOp{param: param, offset: 3 * size}
+}
+
+//projectiveCoords returns coordinates of ProjectivePoint (X, Y, Z) passed as param
+func (g *Generator) projectiveCoords(param string) (fieldOp, fieldOp, fieldOp) {
+	size := 8 * g.n
+	return fieldOp{param: param}, fieldOp{param: param, offset: size}, fieldOp{param: param, offset: 2 * size}
+}
+
+//locals allocates n field elements on stack
+func (g *Generator) locals(n int) []fieldOp {
+	size := 8 * g.n
+	stack := g.AllocLocal(size * n)
+	res := make([]fieldOp, n)
+	for i := range res {
+		m := stack.Offset(size * i)
+		res[i] = fieldOp{local: &m}
+	}
+	return res
+}
+
+//Field operations of point formulas. Every result is stored in memory and stays in regs[0:n], so if it's first
+//argument of the next addition, subtraction, doubling or squaring it isn't loaded again. Multiplications use MULX,
+//ADCX and ADOX so point formulas can be called only if cpuSupported.
+
+//setX sets xPtr to x or xInRegs if x is in regs[0:n]
+func (g *Generator) setX(x fieldOp) {
+	g.xInRegs = g.lastResult != nil && *g.lastResult == x
+	if !g.xInRegs {
+		g.xPtr = g.ptr(x)
+	}
+}
+
+func (g *Generator) fMul(res, x, y fieldOp) {
+	g.xPtr, g.yPtr = g.ptr(x), g.ptr(y)
+	g.mulCore()
+	g.store(res)
+}
+
+func (g *Generator) fSqr(res, x fieldOp) {
+	g.setX(x)
+	g.sqrCore()
+	g.store(res)
+}
+
+func (g *Generator) fMulD(res, x fieldOp) {
+	g.yPtr = g.ptr(x)
+	g.mulDCore()
+	g.store(res)
+}
+
+func (g *Generator) fAdd(res, x, y fieldOp) {
+	if g.lastResult != nil && *g.lastResult == y {
+		x, y = y, x
+	}
+	g.setX(x)
+	g.yPtr = g.ptr(y)
+	g.addCore()
+	g.store(res)
+}
+
+func (g *Generator) fSub(res, x, y fieldOp) {
+	g.setX(x)
+	g.yPtr = g.ptr(y)
+	g.subCore()
+	g.store(res)
+}
+
+func (g *Generator) fMul2(res, x fieldOp) {
+	g.setX(x)
+	g.mul2Core()
+	g.store(res)
+}
+
+func (g *Generator) store(res fieldOp) {
+	g.xInRegs = false
+	g.resPtr = g.ptr(res)
+	for i := 0; i < g.n; i++ {
+		g.MOVQ(g.regs[i], g.mem(g.resPtr, i))
+	}
+	g.lastResult = &res
+}
+
+//pointFunc starts point formula function, all of them are straight translations of Go methods
+func (g *Generator) pointFunc(name, signature, doc string) {
+	g.needRegs(name)
+	g.TEXT(name, attr.NOSPLIT, signature)
+	g.Pragma("noescape")
+	g.Doc(doc)
+	g.lastResult = nil
+}
+
+//PointAdd generates addition of extended points, if z1 is true p2.Z has to be 1
+func (g *Generator) PointAdd(name, doc string, z1 bool) {
+	g.pointFunc(name, "func(res, p1, p2 *Point)", doc)
+	x1, y1, t1, z1p := g.coords("p1")
+	x2, y2, t2, z2 := g.coords("p2")
+	x, y, t, z := g.coords("res")
+	l := g.locals(8)
+	a, b, c, d, e, e1, f, gg := l[0], l[1], l[2], l[3], l[4], l[5], l[6], l[7]
+	h := e1
+
+	g.fMul(a, x1, x2)
+	g.fMul(b, y1, y2)
+	g.fMul(c, t1, t2)
+	g.fMulD(c, c)
+	if z1 {
+		d = z1p
+	} else {
+		g.fMul(d, z1p, z2)
+	}
+	g.fAdd(e1, x2, y2)
+	g.fAdd(e, x1, y1)
+	g.fMul(e, e, e1)
+	g.fSub(e, e, a)
+	g.fSub(e, e, b)
+	g.fSub(f, d, c)
+	g.fAdd(gg, d, c)
+	g.fSub(h, b, a)
+	g.fMul(x, e, f)
+	g.fMul(y, gg, h)
+	g.fMul(t, e, h)
+	g.fMul(z, f, gg)
+	g.RET()
+}
+
+//PointDouble generates doubling with result in extended coordinates, p is Point or ProjectivePoint (T isn't used)
+func (g *Generator) PointDouble(name, signature, doc string, projective bool) {
+	g.pointFunc(name, signature, doc)
+	px, py, _, pz := g.coords("p")
+	if projective {
+		px, py, pz = g.projectiveCoords("p")
+	}
+	x, y, t, z := g.coords("res")
+	l := g.locals(7)
+	a, b, c, e, f, gg, h := l[0], l[1], l[2], l[3], l[4], l[5], l[6]
+
+	g.fSqr(a, px)
+	g.fSqr(b, py)
+	g.fSqr(c, pz)
+	g.fMul2(c, c)
+	g.fAdd(e, px, py)
+	g.fSqr(e, e)
+	g.fSub(e, e, a)
+	g.fSub(e, e, b)
+	g.fAdd(gg, a, b)
+	g.fSub(f, gg, c)
+	g.fSub(h, a, b)
+	g.fMul(x, e, f)
+	g.fMul(y, gg, h)
+	g.fMul(t, e, h)
+	g.fMul(z, f, gg)
+	g.RET()
+}
+
+//ProjectiveDouble generates doubling of ProjectivePoint
+func (g *Generator) ProjectiveDouble() {
+	g.pointFunc("projectiveDouble", "func(res, p *ProjectivePoint)", "res=p+p, see ProjectivePoint.Double")
+	px, py, pz := g.projectiveCoords("p")
+	x, y, z := g.projectiveCoords("res")
+	l := g.locals(6)
+	b, c, d, f, h, j := l[0], l[1], l[2], l[3], l[4], l[5]
+
+	g.fAdd(b, px, py)
+	g.fSqr(b, b)
+	g.fSqr(c, px)
+	g.fSqr(d, py)
+	g.fAdd(f, c, d)
+	g.fSqr(h, pz)
+	g.fMul2(j, h)
+	g.fSub(j, f, j)
+	g.fSub(x, b, c)
+	g.fSub(x, x, d)
+	g.fMul(x, x, j)
+	g.fSub(y, c, d)
+	g.fMul(y, y, f)
+	g.fMul(z, f, j)
+	g.RET()
+}
+
+//PointAddCached generates addition of cached point, if projective is true result is ProjectivePoint, if affine is
+//true p2 is AffineCachedPoint (Z == 1, 2Z isn't stored)
+func (g *Generator) PointAddCached(name, signature, doc string, projective, affine bool) {
+	g.pointFunc(name, signature, doc)
+	x1, y1, t1, z1 := g.coords("p1")
+	yPlusX, yMinusX, t2d, z2 := g.coords("p2")
+	x, y, t, z := g.coords("res")
+	if projective {
+		x, y, z = g.projectiveCoords("res")
+	}
+	l := g.locals(8)
+	zz, pp, mm, a, c, e, f, gg := l[0], l[1], l[2], l[3], l[4], l[5], l[6], l[7]
+	h := zz
+
+	if affine {
+		g.fMul2(zz, z1)
+	} else {
+		g.fMul(zz, z1, z2)
+	}
+	//cachedSum
+	g.fAdd(pp, y1, x1)
+	g.fMul(pp, pp, yPlusX)
+	g.fSub(mm, y1, x1)
+	g.fMul(mm, mm, yMinusX)
+	g.fSub(a, yPlusX, yMinusX)
+	g.fMul(a, a, x1)
+	g.fMul(c, t1, t2d)
+	g.fSub(e, pp, mm)
+	g.fSub(f, zz, c)
+	g.fAdd(gg, zz, c)
+	g.fAdd(h, pp, mm)
+	g.fSub(h, h, a)
+	g.fSub(h, h, a)
+
+	g.fMul(x, e, f)
+	g.fMul(y, gg, h)
+	if !projective {
+		g.fMul(t, e, h)
+	}
+	g.fMul(z, f, gg)
+	g.RET()
+}
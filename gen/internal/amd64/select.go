@@ -0,0 +1,116 @@
+package amd64
+
+import (
+	"github.com/mmcloughlin/avo/attr"
+	. "github.com/mmcloughlin/avo/operand"
+	. "github.com/mmcloughlin/avo/reg"
+)
+
+//Select generates constant time table lookup. Table has n entries (or it's a slice if n == 0),
+//each of them is size*16 bytes long
+func (g *Generator) Select(name, signature string, n, size int) {
+	g.TEXT(name, attr.NOSPLIT, signature)
+	g.Pragma("noescape")
+	targetIndex := g.Load(g.Param("index"), g.XMM())
+	if n > 0 {
+		g.xPtr = g.Load(g.Param("table"), g.GP64())
+	} else {
+		g.xPtr = g.Load(g.Param("table").Base(), g.GP64())
+	}
+	g.resPtr = g.Load(g.Param("res"), g.GP64())
+	res := make([]VecVirtual, size)
+	for i := 0; i < size; i++ {
+		res[i] = g.XMM()
+	}
+	currentIndex, k, one := g.XMM(), g.XMM(), g.XMM()
+	index := g.GP64()
+	g.PSHUFD(Imm(0), targetIndex, targetIndex)
+	for i := 0; i < size; i++ {
+		g.PXOR(res[i], res[i])
+	}
+
+	if n > 0 {
+		g.MOVQ(U64(uint64(n)), index)
+	} else {
+		g.Load(g.Param("table").Len(), index)
+		g.TESTQ(index, index)
+		g.JZ(LabelRef("store"))
+	}
+	g.PCMPEQL(currentIndex, currentIndex)
+	g.PXOR(one, one)
+	g.PSUBL(currentIndex, one)
+	g.PXOR(currentIndex, currentIndex)
+	g.Label("loop")
+	g.MOVO(currentIndex, k)
+	g.PCMPEQL(targetIndex, k)
+
+	for j := 0; j < size; j++ {
+		v := g.XMM()
+		g.MOVOU(Mem{Base: g.xPtr, Disp: j * 16}, v)
+		g.PAND(k, v)
+		g.POR(v, res[j])
+	}
+
+	g.ADDQ(Imm(uint64(size*16)), g.xPtr)
+	g.PADDL(one, currentIndex)
+	g.SUBQ(Imm(1), index)
+	g.JNZ(LabelRef("loop"))
+	g.Label("store")
+	for i := 0; i < size; i++ {
+		g.MOVOU(res[i], Mem{Base: g.resPtr, Disp: i * 16})
+	}
+	g.RET()
+}
+
+//Shl generates res=x << 1 for 512-bit x with SHLQ
+func (g *Generator) Shl() {
+	g.needRegs("Shl")
+	g.TEXT("shl", attr.NOSPLIT, "func(res, x *[8]uint64)")
+	g.Pragma("noescape")
+	g.xPtr = g.Load(g.Param("x"), g.GP64())
+	g.resPtr = g.Load(g.Param("res"), g.GP64())
+	regs := g.regs[:8]
+	for i := range regs {
+		g.MOVQ(g.mem(g.xPtr, i), regs[i])
+	}
+	for i := 7; i > 1; i-- {
+		g.SHLQ(Imm(1), regs[i-1], regs[i])
+	}
+	g.SHLQ(Imm(1), regs[1])
+	for i := range regs {
+		g.MOVQ(regs[i], g.mem(g.resPtr, i))
+	}
+
+	g.RET()
+}
+
+//Shl2 generates res=x << 1 for 512-bit x with SHRQ, SHLQ and ORQ
+func (g *Generator) Shl2() {
+	g.needRegs("Shl2")
+	g.TEXT("shl2", attr.NOSPLIT, "func(res, x *[8]uint64)")
+	g.Pragma("noescape")
+	g.xPtr = g.Load(g.Param("x"), g.GP64())
+	g.resPtr = g.Load(g.Param("res"), g.GP64())
+	regs := g.regs[:8]
+	for i := range regs {
+		g.MOVQ(g.mem(g.xPtr, i), regs[i])
+	}
+
+	for i := 7; i > 1; i-- {
+		g.shl(i)
+	}
+	g.SHLQ(Imm(1), regs[1])
+	for i := range regs {
+		g.MOVQ(regs[i], g.mem(g.resPtr, i))
+	}
+
+	g.RET()
+}
+
+func (g *Generator) shl(i int) {
+	tmp := g.GP64()
+	g.MOVQ(g.regs[i-1], tmp)
+	g.SHRQ(Imm(63), tmp)
+	g.SHLQ(Imm(1), g.regs[i])
+	g.ORQ(g.regs[i], tmp)
+}
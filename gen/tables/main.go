@@ -0,0 +1,158 @@
+//go:generate go run main.go -small ../../precompute_small_table.go -big ../../precompute_big_table.go
+
+//Command tables generates precomputed base point tables used by ScalarBaseMult as Go source, so they don't have to be
+//computed when process starts. Points are computed with math/big (independently of curve1174 package).
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"math/big"
+	"os"
+)
+
+var p, _ = new(big.Int).SetString("7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff7", 16)
+var d = new(big.Int).Mod(big.NewInt(-1174), p)
+var baseX, _ = new(big.Int).SetString("37fbb0cea308c479343aee7c029a190c021d96a492ecd6516123f27bce29eda", 16)
+var baseY, _ = new(big.Int).SetString("6b72f82d47fb7cc6656841169840e0c4fe2dee2af3f976ba4ccb1bf9b46360e", 16)
+
+type point struct {
+	x, y *big.Int
+}
+
+func identity() point {
+	return point{big.NewInt(0), big.NewInt(1)}
+}
+
+//add adds two points in affine coordinates:
+//x3 = (x1*y2+y1*x2)/(1+d*x1*x2*y1*y2), y3 = (y1*y2-x1*x2)/(1-d*x1*x2*y1*y2)
+func add(p1, p2 point) point {
+	x1y2 := new(big.Int).Mul(p1.x, p2.y)
+	y1x2 := new(big.Int).Mul(p1.y, p2.x)
+	x1x2 := new(big.Int).Mul(p1.x, p2.x)
+	y1y2 := new(big.Int).Mul(p1.y, p2.y)
+	dxy := new(big.Int).Mul(x1x2, y1y2)
+	dxy.Mul(dxy, d).Mod(dxy, p)
+
+	num := new(big.Int).Add(x1y2, y1x2)
+	den := new(big.Int).Add(big.NewInt(1), dxy)
+	x3 := num.Mul(num, den.ModInverse(den, p)).Mod(num, p)
+
+	num = new(big.Int).Sub(y1y2, x1x2)
+	den = new(big.Int).Sub(big.NewInt(1), dxy)
+	den.Mod(den, p)
+	y3 := num.Mul(num, den.ModInverse(den, p)).Mod(num, p)
+	return point{x3, y3}
+}
+
+//cached returns (y+x, y-x, 2dxy), the form used by AffineCachedPoint
+func cached(pt point) [3]*big.Int {
+	yPlusX := new(big.Int).Add(pt.y, pt.x)
+	yMinusX := new(big.Int).Sub(pt.y, pt.x)
+	t2d := new(big.Int).Mul(pt.x, pt.y)
+	t2d.Mul(t2d, d).Lsh(t2d, 1)
+	return [3]*big.Int{yPlusX.Mod(yPlusX, p), yMinusX.Mod(yMinusX, p), t2d.Mod(t2d, p)}
+}
+
+//row returns n first multiples of pt (0*pt, 1*pt, ..., (n-1)*pt)
+func row(pt point, n int) []point {
+	res := []point{identity(), pt}
+	for len(res) < n {
+		res = append(res, add(res[len(res)-1], pt))
+	}
+	return res
+}
+
+//mul returns pt multiplied by 2^bits
+func mul(pt point, bits int) point {
+	for i := 0; i < bits; i++ {
+		pt = add(pt, pt)
+	}
+	return pt
+}
+
+func writeHeader(w *bytes.Buffer, constraint string) {
+	_, _ = fmt.Fprintf(w, "// Code generated by gen/tables. DO NOT EDIT.\n\n")
+	if constraint != "" {
+		_, _ = fmt.Fprintf(w, "//go:build %s\n\n", constraint)
+	}
+	_, _ = fmt.Fprintf(w, "package curve1174\n\n")
+}
+
+func writePoint(w *bytes.Buffer, pt point) {
+	_, _ = fmt.Fprintf(w, "{")
+	for _, v := range cached(pt) {
+		_, _ = fmt.Fprintf(w, "FieldElement{")
+		for i := 0; i < 4; i++ {
+			limb := new(big.Int).Rsh(v, uint(64*i))
+			_, _ = fmt.Fprintf(w, "0x%016x, ", new(big.Int).And(limb, new(big.Int).SetUint64(^uint64(0))))
+		}
+		_, _ = fmt.Fprintf(w, "}, ")
+	}
+	_, _ = fmt.Fprintf(w, "},\n")
+}
+
+//small generates table with 4-bit windows: precomputedBaseSmallTable[i][j] == j*16^i*Base
+func small() ([]byte, error) {
+	var w bytes.Buffer
+	writeHeader(&w, "")
+	_, _ = fmt.Fprintf(&w, "//precomputedBaseSmallTable[i][j] is j*16^i*Base\n")
+	_, _ = fmt.Fprintf(&w, "var precomputedBaseSmallTable = [64][16]AffineCachedPoint{\n")
+	sp := point{baseX, baseY}
+	for i := 0; i < 64; i++ {
+		_, _ = fmt.Fprintf(&w, "{\n")
+		for _, pt := range row(sp, 16) {
+			writePoint(&w, pt)
+		}
+		_, _ = fmt.Fprintf(&w, "},\n")
+		sp = mul(sp, 4)
+	}
+	_, _ = fmt.Fprintf(&w, "}\n")
+	return format.Source(w.Bytes())
+}
+
+//bigTable generates table with 8-bit signed windows: rows[i][j] == j*256^i*Base, top == 2^256*Base
+func bigTable() ([]byte, error) {
+	var w bytes.Buffer
+	writeHeader(&w, "")
+	_, _ = fmt.Fprintf(&w, "//precomputedBaseBigTable.rows[i][j] is j*256^i*Base, precomputedBaseBigTable.top is 2^256*Base\n")
+	_, _ = fmt.Fprintf(&w, "var precomputedBaseBigTable = bigBaseTable{\nrows: [32][129]AffineCachedPoint{\n")
+	sp := point{baseX, baseY}
+	for i := 0; i < 32; i++ {
+		_, _ = fmt.Fprintf(&w, "{\n")
+		for _, pt := range row(sp, 129) {
+			writePoint(&w, pt)
+		}
+		_, _ = fmt.Fprintf(&w, "},\n")
+		sp = mul(sp, 8)
+	}
+	_, _ = fmt.Fprintf(&w, "},\ntop: AffineCachedPoint")
+	writePoint(&w, sp)
+	_, _ = fmt.Fprintf(&w, "}\n")
+	return format.Source(w.Bytes())
+}
+
+func main() {
+	smallOut := flag.String("small", "", "output file for table with 4-bit windows")
+	bigOut := flag.String("big", "", "output file for table with 8-bit signed windows")
+	flag.Parse()
+
+	for _, t := range []struct {
+		out string
+		gen func() ([]byte, error)
+	}{{*smallOut, small}, {*bigOut, bigTable}} {
+		if t.out == "" {
+			continue
+		}
+		src, err := t.gen()
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err = os.WriteFile(t.out, src, 0644); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
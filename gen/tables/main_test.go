@@ -0,0 +1,26 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"testing"
+)
+
+func TestGeneratedTablesUpToDate(t *testing.T) {
+	for _, tc := range []struct {
+		file string
+		gen  func() ([]byte, error)
+	}{{"../../precompute_small_table.go", small}, {"../../precompute_big_table.go", bigTable}} {
+		expected, err := tc.gen()
+		if err != nil {
+			t.Fatal(err)
+		}
+		actual, err := os.ReadFile(tc.file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(expected, actual) {
+			t.Errorf("%s is out of date, run go generate in gen/tables", tc.file)
+		}
+	}
+}
@@ -0,0 +1,396 @@
+//go:generate go run main.go -dir ../../testdata/vectors
+
+//Command vectors generates Wycheproof-style JSON test vectors in testdata/vectors from math/big reference
+//implementation in internal/ref, so they don't depend on the code they test. Every file has list of test groups, every
+//test has id, comment, flags and result: valid, invalid (has to be rejected) or acceptable (edge case that this
+//package accepts, but protocols may reject it, e.g. low order points). Meaning of flags is described in notes.
+//
+//Field elements, scalars and points are hex encoded 32 byte little endian numbers, points are encoded as by
+//(*Point).Bytes. Inputs of field operations don't have to be reduced, outputs are. Files:
+//
+//	field.json     Add, Sub, Mul, Sqr, MulD, Mul2, Inverse and Mod: inputs a and b, output out
+//	encoding.json  SetBytes of encoded, affine coordinates x and y of decoded point
+//	ecdh.json      shared = Bytes(private*SetBytes(public)) for private < 2^251-9, without cofactor clearing
+//
+//There are no signature vectors, the package doesn't implement any signature scheme.
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"log"
+	"math/big"
+	"math/rand"
+	"os"
+	"path/filepath"
+
+	"github.com/probakowski/curve1174/internal/ref"
+)
+
+//testFile is content of single JSON file
+type testFile struct {
+	Algorithm     string            `json:"algorithm"`
+	NumberOfTests int               `json:"numberOfTests"`
+	Header        []string          `json:"header"`
+	Notes         map[string]string `json:"notes"`
+	TestGroups    []testGroup       `json:"testGroups"`
+}
+
+type testGroup struct {
+	Type  string     `json:"type"`
+	Tests []testCase `json:"tests"`
+}
+
+//testCase is single vector, only fields used by given group type are set
+type testCase struct {
+	TcID    int      `json:"tcId"`
+	Comment string   `json:"comment"`
+	Flags   []string `json:"flags"`
+	A       string   `json:"a,omitempty"`
+	B       string   `json:"b,omitempty"`
+	Out     string   `json:"out,omitempty"`
+	Encoded *string  `json:"encoded,omitempty"`
+	X       string   `json:"x,omitempty"`
+	Y       string   `json:"y,omitempty"`
+	Private string   `json:"private,omitempty"`
+	Public  *string  `json:"public,omitempty"`
+	Shared  string   `json:"shared,omitempty"`
+	Result  string   `json:"result"`
+}
+
+var notes = map[string]string{
+	"EdgeCase":          "Input is one of the edge values (0, 1, p-1, p, 2^251, 2^255, 2^256-1 etc.)",
+	"Unreduced":         "Input of field operation is not reduced mod p",
+	"Random":            "Input is random",
+	"ZeroInverse":       "Inverse of 0 mod p doesn't exist, Inverse returns 0 (0^(p-2))",
+	"NonCanonical":      "Encoded y is not reduced mod p",
+	"NegativeZero":      "Encoding of point with x == 0 has sign bit set",
+	"NotOnCurve":        "There's no point with encoded y",
+	"InvalidLength":     "Encoding is not 32 bytes long",
+	"LowOrder":          "Point has order 1, 2 or 4 (it's in torsion subgroup)",
+	"NonSubgroup":       "Point is not in subgroup generated by base point (it has torsion component)",
+	"InvalidPublic":     "Public key is not valid encoding",
+	"LowOrderPublic":    "Public key has low order, shared secret doesn't depend on most bits of private key",
+	"NonSubgroupPublic": "Public key is not in subgroup generated by base point, shared secret has torsion component",
+	"ZeroSharedSecret":  "Shared secret is identity element",
+	"EdgeCaseScalar":    "Private key is one of edge values (0, 1, order-1, order, p-1 etc.)",
+}
+
+var p = ref.P
+
+//le returns hex encoded 32 byte little endian x (0 <= x < 2^256)
+func le(x *big.Int) string {
+	b := make([]byte, 32)
+	for i, v := range x.Bytes() {
+		b[len(x.Bytes())-1-i] = v
+	}
+	return hex.EncodeToString(b)
+}
+
+//randInt returns random number from [0, limit), limit <= 2^256. It's computed from bytes (big.Int.Rand depends on
+//size of big.Word), so vectors are the same on every platform.
+func randInt(r *rand.Rand, limit *big.Int) *big.Int {
+	b := make([]byte, 32)
+	_, _ = r.Read(b)
+	return new(big.Int).Mod(new(big.Int).SetBytes(b), limit)
+}
+
+func pow2(n uint) *big.Int {
+	return new(big.Int).Lsh(big.NewInt(1), n)
+}
+
+func add(x, y *big.Int) *big.Int {
+	return new(big.Int).Add(x, y)
+}
+
+func sub(x, y *big.Int) *big.Int {
+	return new(big.Int).Sub(x, y)
+}
+
+//builder collects tests of one file numbering them
+type builder struct {
+	file  testFile
+	group *testGroup
+	id    int
+}
+
+func newBuilder(algorithm string, header ...string) *builder {
+	return &builder{file: testFile{Algorithm: algorithm, Header: header, Notes: map[string]string{}}}
+}
+
+func (b *builder) startGroup(typ string) {
+	b.file.TestGroups = append(b.file.TestGroups, testGroup{Type: typ})
+	b.group = &b.file.TestGroups[len(b.file.TestGroups)-1]
+}
+
+func (b *builder) add(tc testCase) {
+	b.id++
+	tc.TcID = b.id
+	if tc.Flags == nil {
+		tc.Flags = []string{}
+	}
+	for _, f := range tc.Flags {
+		b.file.Notes[f] = notes[f]
+	}
+	b.group.Tests = append(b.group.Tests, tc)
+}
+
+func (b *builder) json() ([]byte, error) {
+	b.file.NumberOfTests = b.id
+	data, err := json.MarshalIndent(b.file, "", "  ")
+	return append(data, '\n'), err
+}
+
+//fieldEdgeValues are inputs of field operations, they're not reduced mod p from p on
+var fieldEdgeValues = []*big.Int{big.NewInt(0), big.NewInt(1), big.NewInt(288), sub(p, big.NewInt(1)), p,
+	add(p, big.NewInt(1)), pow2(251), sub(pow2(252), big.NewInt(1)), pow2(255), sub(pow2(256), big.NewInt(1))}
+
+//fieldOp computes reduced result of field operation, it returns false if it doesn't exist
+type fieldOp struct {
+	name   string
+	binary bool
+	op     func(x, y *big.Int) (*big.Int, bool)
+}
+
+var fieldOps = []fieldOp{
+	{"Add", true, func(x, y *big.Int) (*big.Int, bool) { return add(x, y), true }},
+	{"Sub", true, func(x, y *big.Int) (*big.Int, bool) { return sub(x, y), true }},
+	{"Mul", true, func(x, y *big.Int) (*big.Int, bool) { return new(big.Int).Mul(x, y), true }},
+	{"Sqr", false, func(x, y *big.Int) (*big.Int, bool) { return new(big.Int).Mul(x, x), true }},
+	{"MulD", false, func(x, y *big.Int) (*big.Int, bool) { return new(big.Int).Mul(x, ref.D), true }},
+	{"Mul2", false, func(x, y *big.Int) (*big.Int, bool) { return new(big.Int).Lsh(x, 1), true }},
+	{"Inverse", false, func(x, y *big.Int) (*big.Int, bool) {
+		inv := new(big.Int).ModInverse(x, p)
+		return inv, inv != nil
+	}},
+	{"Mod", false, func(x, y *big.Int) (*big.Int, bool) { return x, true }},
+}
+
+func field(r *rand.Rand) ([]byte, error) {
+	b := newBuilder("Curve1174 field", "Arithmetic in F_p, p = 2^251-9, on unreduced inputs")
+	for _, op := range fieldOps {
+		b.startGroup("Field" + op.name)
+		type pair struct {
+			x, y  *big.Int
+			flags []string
+		}
+		var inputs []pair
+		for i, x := range fieldEdgeValues {
+			if !op.binary {
+				inputs = append(inputs, pair{x, nil, []string{"EdgeCase"}})
+				continue
+			}
+			//all pairs would be too many, pair every value with itself and with few others
+			for _, y := range []*big.Int{fieldEdgeValues[0], fieldEdgeValues[1], fieldEdgeValues[4], x,
+				fieldEdgeValues[(i+3)%len(fieldEdgeValues)]} {
+				inputs = append(inputs, pair{x, y, []string{"EdgeCase"}})
+			}
+		}
+		for i := 0; i < 8; i++ {
+			//half of random inputs uses all 256 bits
+			limit := p
+			if i%2 == 1 {
+				limit = pow2(256)
+			}
+			inputs = append(inputs, pair{randInt(r, limit), randInt(r, limit), []string{"Random"}})
+		}
+
+		for _, in := range inputs {
+			tc := testCase{Comment: op.name, A: le(in.x), Flags: in.flags, Result: "valid"}
+			y := big.NewInt(0)
+			if op.binary {
+				tc.B = le(in.y)
+				y = in.y
+			}
+			if in.x.Cmp(p) >= 0 || y.Cmp(p) >= 0 {
+				tc.Flags = append(tc.Flags, "Unreduced")
+			}
+			out, ok := op.op(in.x, y)
+			if !ok {
+				out = big.NewInt(0)
+				tc.Flags = append(tc.Flags, "ZeroInverse")
+				tc.Result = "acceptable"
+			}
+			tc.Out = le(new(big.Int).Mod(out, p))
+			b.add(tc)
+		}
+	}
+	return b.json()
+}
+
+//torsion returns points of order 1, 2 and 4
+func torsion() []ref.Point {
+	return []ref.Point{ref.Identity(), ref.NewPoint(big.NewInt(0), big.NewInt(-1)),
+		ref.NewPoint(big.NewInt(1), big.NewInt(0)), ref.NewPoint(big.NewInt(-1), big.NewInt(0))}
+}
+
+//subgroupPoints returns base point and n random points from subgroup generated by it
+func subgroupPoints(r *rand.Rand, n int) []ref.Point {
+	res := []ref.Point{ref.Base()}
+	for i := 0; i < n; i++ {
+		res = append(res, ref.ScalarBaseMult(randInt(r, ref.Order)))
+	}
+	return res
+}
+
+//invalidEncoding is encoding rejected by decoding with flag saying why
+type invalidEncoding struct {
+	comment string
+	enc     []byte
+	flag    string
+}
+
+func encodeY(y *big.Int, sign byte) []byte {
+	b, _ := hex.DecodeString(le(y))
+	b[31] |= sign << 7
+	return b
+}
+
+func invalidEncodings() []invalidEncoding {
+	res := []invalidEncoding{
+		{"y == p", encodeY(p, 0), "NonCanonical"},
+		{"y == p+1", encodeY(add(p, big.NewInt(1)), 0), "NonCanonical"},
+		{"y == p+1 with sign bit", encodeY(add(p, big.NewInt(1)), 1), "NonCanonical"},
+		{"y == 2^255-1", encodeY(sub(pow2(255), big.NewInt(1)), 0), "NonCanonical"},
+		{"y == 1+2^251", encodeY(add(pow2(251), big.NewInt(1)), 0), "NonCanonical"},
+		{"y == 1+2^254", encodeY(add(pow2(254), big.NewInt(1)), 0), "NonCanonical"},
+		{"identity with sign bit", encodeY(big.NewInt(1), 1), "NegativeZero"},
+		{"(0, -1) with sign bit", encodeY(sub(p, big.NewInt(1)), 1), "NegativeZero"},
+		{"empty", []byte{}, "InvalidLength"},
+		{"31 bytes", ref.Encode(ref.Base())[:31], "InvalidLength"},
+		{"33 bytes", append(ref.Encode(ref.Base()), 0), "InvalidLength"},
+	}
+	//the smallest y without point and the largest one below p
+	for _, y := range []*big.Int{big.NewInt(2), sub(p, big.NewInt(2))} {
+		step := big.NewInt(1)
+		if y.Cmp(big.NewInt(2)) != 0 {
+			step = big.NewInt(-1)
+		}
+		for ; ; y = add(y, step) {
+			if _, ok := ref.Decode(encodeY(y, 0)); !ok {
+				res = append(res, invalidEncoding{"no point with y", encodeY(y, 0), "NotOnCurve"},
+					invalidEncoding{"no point with y, sign bit", encodeY(y, 1), "NotOnCurve"})
+				break
+			}
+		}
+	}
+	return res
+}
+
+func encoding(r *rand.Rand) ([]byte, error) {
+	b := newBuilder("Curve1174 point encoding",
+		"Point is encoded as 32 byte little endian affine y with the lowest bit of x in the highest bit")
+	b.startGroup("PointDecode")
+	validPoint := func(comment string, pt ref.Point, result string, flags ...string) {
+		enc := hex.EncodeToString(ref.Encode(pt))
+		b.add(testCase{Comment: comment, Encoded: &enc, X: le(pt.X), Y: le(pt.Y), Flags: flags, Result: result})
+	}
+	for i, pt := range subgroupPoints(r, 6) {
+		comment := "random point"
+		if i == 0 {
+			comment = "base point"
+		}
+		validPoint(comment, pt, "valid")
+		validPoint("negated "+comment, ref.Neg(pt), "valid")
+	}
+	for _, pt := range torsion() {
+		validPoint("low order point", pt, "acceptable", "LowOrder")
+	}
+	for _, pt := range subgroupPoints(r, 3) {
+		for _, tp := range torsion()[1:] {
+			validPoint("point with torsion component", ref.Add(pt, tp), "acceptable", "NonSubgroup")
+		}
+	}
+	for _, inv := range invalidEncodings() {
+		enc := hex.EncodeToString(inv.enc)
+		b.add(testCase{Comment: inv.comment, Encoded: &enc, Flags: []string{inv.flag}, Result: "invalid"})
+	}
+	return b.json()
+}
+
+func ecdh(r *rand.Rand) ([]byte, error) {
+	b := newBuilder("Curve1174 ECDH", "Shared secret is Bytes(private*public) without cofactor clearing",
+		"private is little endian scalar smaller than 2^251-9, public is encoded point")
+	b.startGroup("EcdhComp")
+	test := func(comment string, k *big.Int, pub ref.Point, result string, flags ...string) {
+		enc := hex.EncodeToString(ref.Encode(pub))
+		shared := ref.ScalarMult(pub, k)
+		if shared.Equal(ref.Identity()) {
+			flags = append(flags, "ZeroSharedSecret")
+			result = "acceptable"
+		}
+		b.add(testCase{Comment: comment, Private: le(k), Public: &enc,
+			Shared: hex.EncodeToString(ref.Encode(shared)), Flags: flags, Result: result})
+	}
+	randomScalar := func() *big.Int {
+		return randInt(r, p)
+	}
+
+	for _, pub := range subgroupPoints(r, 8) {
+		test("random private key", randomScalar(), pub, "valid", "Random")
+	}
+	//scalars of TestSpecificScalarBaseMult
+	for _, k := range []int64{0, 1, 2, 3, 16, 17, 256, 257, 1050, 1000000006} {
+		test("small private key", big.NewInt(k), ref.Base(), "valid", "EdgeCaseScalar")
+	}
+	four := big.NewInt(4)
+	for _, k := range []*big.Int{sub(ref.Order, big.NewInt(1)), ref.Order, add(ref.Order, big.NewInt(1)),
+		new(big.Int).Mul(ref.Order, four), sub(p, big.NewInt(1)), sub(pow2(250), big.NewInt(1))} {
+		for _, pub := range subgroupPoints(r, 1) {
+			test("edge case private key", k, pub, "valid", "EdgeCaseScalar")
+		}
+	}
+	for _, pub := range torsion() {
+		test("low order public key", randomScalar(), pub, "acceptable", "LowOrderPublic")
+		test("low order public key, private key multiple of 4", new(big.Int).Mul(big.NewInt(r.Int63()), four), pub,
+			"acceptable", "LowOrderPublic")
+	}
+	for _, pub := range subgroupPoints(r, 3) {
+		for _, tp := range torsion()[1:] {
+			test("public key with torsion component", randomScalar(), ref.Add(pub, tp), "acceptable",
+				"NonSubgroupPublic")
+		}
+	}
+	for _, inv := range invalidEncodings() {
+		enc := hex.EncodeToString(inv.enc)
+		b.add(testCase{Comment: inv.comment, Private: le(randomScalar()), Public: &enc,
+			Flags: []string{"InvalidPublic", inv.flag}, Result: "invalid"})
+	}
+	return b.json()
+}
+
+//files returns generated vector files by name. Random inputs use fixed seed so output is always the same.
+func files() (map[string][]byte, error) {
+	r := rand.New(rand.NewSource(1174))
+	res := map[string][]byte{}
+	for _, f := range []struct {
+		name string
+		gen  func(*rand.Rand) ([]byte, error)
+	}{{"field.json", field}, {"encoding.json", encoding}, {"ecdh.json", ecdh}} {
+		data, err := f.gen(r)
+		if err != nil {
+			return nil, err
+		}
+		res[f.name] = data
+	}
+	return res, nil
+}
+
+func main() {
+	dir := flag.String("dir", "", "output directory")
+	flag.Parse()
+	if *dir == "" {
+		flag.Usage()
+		log.Fatal("-dir is required")
+	}
+	generated, err := files()
+	if err != nil {
+		log.Fatal(err)
+	}
+	for name, data := range generated {
+		if err = os.WriteFile(filepath.Join(*dir, name), data, 0644); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,24 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestGeneratedVectorsUpToDate(t *testing.T) {
+	generated, err := files()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, expected := range generated {
+		actual, err := os.ReadFile(filepath.Join("../../testdata/vectors", name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(expected, actual) {
+			t.Errorf("%s is out of date, run go generate in gen/vectors", name)
+		}
+	}
+}
@@ -0,0 +1,118 @@
+//Package dudect detects timing leaks like dudect (O. Reparaz, J. Balasch, I. Verbauwhede, "Dude, is my code constant
+//time?"): execution time of tested code is measured for two classes of inputs (usually one fixed and random ones)
+//and Welch's t-test is applied to measurements, also cropped at different percentiles to remove outliers. |t| above
+//Threshold means that execution time depends on input.
+package dudect
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"time"
+)
+
+//Threshold is |t| above which execution time is considered to depend on input class, the same as in dudect
+const Threshold = 10
+
+const percentilesNumber = 100
+
+//enoughMeasurements is minimal number of measurements for which t is computed
+const enoughMeasurements = 10000
+
+//Timer measures execution time of code between Start and End, in CPU cycles on amd64 and nanoseconds elsewhere
+type Timer struct {
+	startCount uint64
+	endCount   uint64
+}
+
+//Count returns time measured by Start and End
+func (t *Timer) Count() uint64 {
+	return t.endCount - t.startCount
+}
+
+func preparePercentiles(ticks []uint64) (percentiles []uint64) {
+	percentiles = make([]uint64, percentilesNumber)
+	ticks = append([]uint64{}, ticks...)
+	sort.Slice(ticks, func(i, j int) bool {
+		return ticks[i] < ticks[j]
+	})
+	for i := 0; i < percentilesNumber; i++ {
+		index := (1 - math.Pow(0.5, 10*(float64(i)+1)/percentilesNumber)) * float64(len(ticks))
+		percentiles[i] = ticks[int(index)]
+	}
+	return percentiles
+}
+
+//Context collects measurements, the same context can be used with many calls to Measure to get more of them
+type Context struct {
+	stats       [1 + percentilesNumber]stat
+	percentiles []uint64
+}
+
+//Measure calls f iterations times with random class and index of random vector (< randomVectors), f should prepare
+//input (fixed one if random is false) and call Start and End on timer just around tested code. It returns the largest
+//|t| of all measurements collected so far (0 if there's less than 10000 of them).
+func (c *Context) Measure(f func(timer *Timer, random bool, vector int), randomVectors, iterations int) float64 {
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	ticks := make([]uint64, iterations)
+	tests := make([]int, iterations)
+	classes := make([]int, iterations)
+	for i := 0; i < iterations; i++ {
+		tests[i] = r.Intn(randomVectors)
+		classes[i] = r.Intn(2)
+	}
+	for i := 0; i < iterations; i++ {
+		var timer Timer
+		f(&timer, classes[i] == 1, tests[i])
+		ticks[i] = timer.Count()
+	}
+	if c.percentiles == nil {
+		c.percentiles = preparePercentiles(ticks)
+	}
+	for i := 0; i < iterations; i++ {
+		c.stats[0].push(ticks[i], classes[i])
+
+		for crop := 0; crop < percentilesNumber; crop++ {
+			if ticks[i] < c.percentiles[crop] {
+				c.stats[crop+1].push(ticks[i], classes[i])
+			}
+		}
+	}
+
+	max := 0.0
+	for i := 0; i < len(c.stats); i++ {
+		if c.stats[i].count[0]+c.stats[i].count[1] < enoughMeasurements {
+			continue
+		}
+		tt := math.Abs(c.stats[i].compute())
+		if tt > max {
+			max = tt
+		}
+	}
+
+	return max
+}
+
+//stat computes mean and variance of both classes online (Welford's method)
+type stat struct {
+	mean  [2]float64
+	m2    [2]float64
+	count [2]float64
+}
+
+func (s *stat) push(ticks uint64, class int) {
+	s.count[class]++
+	delta := float64(ticks) - s.mean[class]
+	s.mean[class] += delta / s.count[class]
+	s.m2[class] += delta * (float64(ticks) - s.mean[class])
+}
+
+//compute returns Welch's t statistic
+func (s *stat) compute() float64 {
+	v0 := s.m2[0] / (s.count[0] - 1)
+	v1 := s.m2[1] / (s.count[1] - 1)
+	num := s.mean[0] - s.mean[1]
+	den := math.Sqrt(v0/s.count[0] + v1/s.count[1])
+	t := num / den
+	return t
+}
@@ -0,0 +1,42 @@
+package dudect
+
+import (
+	"math"
+	"testing"
+)
+
+func TestStat(t *testing.T) {
+	var s stat
+	for _, v := range []uint64{1, 2, 3, 4} {
+		s.push(v, 0)
+	}
+	for _, v := range []uint64{3, 4, 5, 6} {
+		s.push(v, 1)
+	}
+	//means 2.5 and 4.5, both variances 5/3
+	if tt := s.compute(); math.Abs(tt+2/math.Sqrt(5.0/6)) > 1e-9 {
+		t.Errorf("t = %f", tt)
+	}
+}
+
+func TestMeasureLeak(t *testing.T) {
+	if testing.Short() {
+		t.Skip("timing test")
+	}
+	var sink uint64
+	var c Context
+	tt := c.Measure(func(timer *Timer, random bool, vector int) {
+		n := 10
+		if random {
+			n += 100 * vector
+		}
+		timer.Start()
+		for i := 0; i < n; i++ {
+			sink += sink*uint64(i) + 1
+		}
+		timer.End()
+	}, 10, 20000)
+	if tt < Threshold {
+		t.Errorf("leak not detected, t=%f", tt)
+	}
+}
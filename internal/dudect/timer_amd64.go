@@ -0,0 +1,13 @@
+package dudect
+
+import "github.com/dterei/gotsc"
+
+//Start starts measurement
+func (t *Timer) Start() {
+	t.startCount = gotsc.BenchStart()
+}
+
+//End ends measurement
+func (t *Timer) End() {
+	t.endCount = gotsc.BenchEnd()
+}
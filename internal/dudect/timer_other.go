@@ -0,0 +1,19 @@
+//go:build !amd64
+
+package dudect
+
+import "time"
+
+//gotsc reads cycle counter only on amd64, other platforms use monotonic clock
+
+var start = time.Now()
+
+//Start starts measurement
+func (t *Timer) Start() {
+	t.startCount = uint64(time.Since(start))
+}
+
+//End ends measurement
+func (t *Timer) End() {
+	t.endCount = uint64(time.Since(start))
+}
@@ -0,0 +1,148 @@
+//Package ref is slow but simple reference implementation of Curve1174 in affine coordinates with math/big. It doesn't
+//share any code with package curve1174 and it's used only to test it. Nothing here is constant time.
+package ref
+
+import (
+	"math/big"
+)
+
+//P is order of F_p, 2^251-9
+var P = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 251), big.NewInt(9))
+
+//D is coefficient of curve equation x^2+y^2 = 1+dx^2y^2, -1174 mod p
+var D = new(big.Int).Sub(P, big.NewInt(1174))
+
+//Order is order of subgroup generated by base point, 2^249-11332719920821432534773113288178349711
+var Order, _ = new(big.Int).SetString("1fffffffffffffffffffffffffffffff77965c4dfd307348944d45fd166c971", 16)
+
+//EncodedLen is length of encoded point
+const EncodedLen = 32
+
+var baseX, _ = new(big.Int).SetString("37fbb0cea308c479343aee7c029a190c021d96a492ecd6516123f27bce29eda", 16)
+var baseY, _ = new(big.Int).SetString("6b72f82d47fb7cc6656841169840e0c4fe2dee2af3f976ba4ccb1bf9b46360e", 16)
+
+//Point is point on curve in affine coordinates, X and Y are always reduced mod p
+type Point struct {
+	X, Y *big.Int
+}
+
+//Base returns base point of the curve
+func Base() Point {
+	return Point{new(big.Int).Set(baseX), new(big.Int).Set(baseY)}
+}
+
+//Identity returns identity element (0, 1)
+func Identity() Point {
+	return Point{big.NewInt(0), big.NewInt(1)}
+}
+
+//NewPoint returns point with coordinates x and y reduced mod p, it doesn't check if it's on the curve
+func NewPoint(x, y *big.Int) Point {
+	return Point{mod(new(big.Int).Set(x)), mod(new(big.Int).Set(y))}
+}
+
+func mod(x *big.Int) *big.Int {
+	return x.Mod(x, P)
+}
+
+func mul(x, y *big.Int) *big.Int {
+	return mod(new(big.Int).Mul(x, y))
+}
+
+func div(x, y *big.Int) *big.Int {
+	return mul(x, new(big.Int).ModInverse(y, P))
+}
+
+//Equal checks if p and q are the same point
+func (p Point) Equal(q Point) bool {
+	return p.X.Cmp(q.X) == 0 && p.Y.Cmp(q.Y) == 0
+}
+
+//IsOnCurve checks if x^2+y^2 == 1+dx^2y^2
+func (p Point) IsOnCurve() bool {
+	x2, y2 := mul(p.X, p.X), mul(p.Y, p.Y)
+	l := mod(new(big.Int).Add(x2, y2))
+	r := mod(new(big.Int).Add(big.NewInt(1), mul(D, mul(x2, y2))))
+	return l.Cmp(r) == 0
+}
+
+//Add returns p+q: x3 = (x1y2+y1x2)/(1+dx1x2y1y2), y3 = (y1y2-x1x2)/(1-dx1x2y1y2)
+func Add(p, q Point) Point {
+	dxy := mul(D, mul(mul(p.X, q.X), mul(p.Y, q.Y)))
+	x := mod(new(big.Int).Add(mul(p.X, q.Y), mul(p.Y, q.X)))
+	y := mod(new(big.Int).Sub(mul(p.Y, q.Y), mul(p.X, q.X)))
+	return Point{
+		div(x, mod(new(big.Int).Add(big.NewInt(1), dxy))),
+		div(y, mod(new(big.Int).Sub(big.NewInt(1), dxy))),
+	}
+}
+
+//Double returns 2p using doubling formula that doesn't depend on d: x3 = 2xy/(x^2+y^2), y3 = (y^2-x^2)/(2-x^2-y^2)
+func Double(p Point) Point {
+	x2, y2 := mul(p.X, p.X), mul(p.Y, p.Y)
+	x := mod(new(big.Int).Lsh(mul(p.X, p.Y), 1))
+	y := mod(new(big.Int).Sub(y2, x2))
+	sum := mod(new(big.Int).Add(x2, y2))
+	return Point{div(x, sum), div(y, mod(new(big.Int).Sub(big.NewInt(2), sum)))}
+}
+
+//Neg returns -p
+func Neg(p Point) Point {
+	return Point{mod(new(big.Int).Neg(p.X)), new(big.Int).Set(p.Y)}
+}
+
+//ScalarMult returns k*p (k >= 0) computed with double-and-add
+func ScalarMult(p Point, k *big.Int) Point {
+	res := Identity()
+	for i := k.BitLen() - 1; i >= 0; i-- {
+		res = Double(res)
+		if k.Bit(i) == 1 {
+			res = Add(res, p)
+		}
+	}
+	return res
+}
+
+//ScalarBaseMult returns k*Base
+func ScalarBaseMult(k *big.Int) Point {
+	return ScalarMult(Base(), k)
+}
+
+//Encode returns 32 byte encoding of p: y as little endian number with the lowest bit of x in the highest bit
+func Encode(p Point) []byte {
+	b := make([]byte, EncodedLen)
+	y := p.Y.Bytes()
+	for i, v := range y {
+		b[len(y)-1-i] = v
+	}
+	b[EncodedLen-1] |= byte(p.X.Bit(0)) << 7
+	return b
+}
+
+//Decode decodes point encoded by Encode. It returns false if encoding isn't canonical or there's no point with such y
+func Decode(b []byte) (Point, bool) {
+	if len(b) != EncodedLen {
+		return Point{}, false
+	}
+	be := make([]byte, EncodedLen)
+	for i, v := range b {
+		be[EncodedLen-1-i] = v
+	}
+	sign := uint(be[0] >> 7)
+	be[0] &= 0x7f
+	y := new(big.Int).SetBytes(be)
+	if y.Cmp(P) >= 0 {
+		return Point{}, false
+	}
+	//x^2 = (1-y^2)/(1-dy^2)
+	y2 := mul(y, y)
+	x2 := div(mod(new(big.Int).Sub(big.NewInt(1), y2)), mod(new(big.Int).Sub(big.NewInt(1), mul(D, y2))))
+	x := new(big.Int).ModSqrt(x2, P)
+	if x == nil || (x.Sign() == 0 && sign == 1) {
+		return Point{}, false
+	}
+	if x.Bit(0) != sign {
+		x.Sub(P, x)
+	}
+	return Point{x, y}, true
+}
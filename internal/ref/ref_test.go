@@ -0,0 +1,25 @@
+package ref
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestReference(t *testing.T) {
+	b := Base()
+	if !b.IsOnCurve() || !ScalarBaseMult(Order).Equal(Identity()) {
+		t.Fatal("invalid base point")
+	}
+	p := ScalarBaseMult(big.NewInt(0xDEADBEEF))
+	if !p.IsOnCurve() || !Double(p).Equal(Add(p, p)) || !Add(p, Neg(p)).Equal(Identity()) {
+		t.Errorf("invalid doubling or negation of %v", p)
+	}
+	if !ScalarMult(p, big.NewInt(3)).Equal(Add(Double(p), p)) {
+		t.Errorf("3*p != 2*p+p")
+	}
+	for _, p := range []Point{b, p, Neg(p), Identity()} {
+		if d, ok := Decode(Encode(p)); !ok || !d.Equal(p) {
+			t.Errorf("Decode(Encode(%v)) = %v, %v", p, d, ok)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package curve1174
+
+import "sync/atomic"
+
+//OpCounts holds numbers of field operations and constant time table lookups. They're counted only in builds with
+//curve1174_opcount tag (otherwise they're always zero), which also disables assembly point formulas and AVX2 code,
+//so counts are the same for every backend. Mul and Sqr include operations done by Inverse (13 multiplications and
+//250 squarings each). Counters are global, so they're meaningful only if one goroutine uses the package.
+type OpCounts struct {
+	Mul            uint64
+	Sqr            uint64
+	MulD           uint64
+	Inverse        uint64
+	VarTimeInverse uint64
+	Select         uint64
+}
+
+var opCounts OpCounts
+
+//countOp increments counter c if operations are counted, it's no-op otherwise
+func countOp(c *uint64) {
+	if opCountEnabled {
+		atomic.AddUint64(c, 1)
+	}
+}
+
+//SnapshotOpCounts returns operations counted since the last ResetOpCounts
+func SnapshotOpCounts() OpCounts {
+	return OpCounts{
+		Mul:            atomic.LoadUint64(&opCounts.Mul),
+		Sqr:            atomic.LoadUint64(&opCounts.Sqr),
+		MulD:           atomic.LoadUint64(&opCounts.MulD),
+		Inverse:        atomic.LoadUint64(&opCounts.Inverse),
+		VarTimeInverse: atomic.LoadUint64(&opCounts.VarTimeInverse),
+		Select:         atomic.LoadUint64(&opCounts.Select),
+	}
+}
+
+//ResetOpCounts sets all counters to zero
+func ResetOpCounts() {
+	for _, c := range []*uint64{&opCounts.Mul, &opCounts.Sqr, &opCounts.MulD, &opCounts.Inverse,
+		&opCounts.VarTimeInverse, &opCounts.Select} {
+		atomic.StoreUint64(c, 0)
+	}
+}
@@ -0,0 +1,5 @@
+//go:build curve1174_opcount
+
+package curve1174
+
+const opCountEnabled = true
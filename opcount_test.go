@@ -0,0 +1,54 @@
+//go:build curve1174_opcount
+
+package curve1174
+
+import "testing"
+
+//TestOpCounts checks numbers of field operations done by point operations, so changes of formulas are deliberate.
+//Run it with go test -tags curve1174_opcount -run OpCounts
+func TestOpCounts(t *testing.T) {
+	defer SetBasePrecomputation(GetBasePrecomputation())
+	k1 := FieldElement{0x1234, 5, 6, 7}
+	k2 := FieldElement{^uint64(0), 0, ^uint64(0), P3 >> 1}
+	var p Point
+	for _, tc := range []struct {
+		name     string
+		op       func(k *FieldElement)
+		expected OpCounts
+	}{
+		{"Add", func(k *FieldElement) { p.Add(&basePoint, &basePoint) }, OpCounts{Mul: 9, MulD: 1}},
+		{"Double", func(k *FieldElement) { p.Double(&basePoint) }, OpCounts{Mul: 4, Sqr: 4}},
+		//Inverse is 250 squarings and 13 multiplications, ToAffine multiplies 3 coordinates by 1/Z
+		{"ToAffine", func(k *FieldElement) { p.ToAffine(&basePoint) }, OpCounts{Mul: 16, Sqr: 250, Inverse: 1}},
+		//table of 16 points (7 Add, 7 Double, 16 SetPoint) and 63 windows with 4 doublings and addition
+		{"ScalarMult", func(k *FieldElement) { p.ScalarMult(&basePoint, k) },
+			OpCounts{Mul: 1415, Sqr: 1036, MulD: 23, Select: 64}},
+		{"ScalarMultLadder", func(k *FieldElement) { p.ScalarMultLadder(&basePoint, k) },
+			OpCounts{Mul: 3328, Sqr: 1024, MulD: 256}},
+		{"ScalarBaseMult/none", func(k *FieldElement) {
+			SetBasePrecomputation(PrecomputeNone)
+			p.ScalarBaseMult(k)
+		}, OpCounts{Mul: 1415, Sqr: 1036, MulD: 23, Select: 64}},
+		//64 4-bit windows, one addition of affine cached point per window
+		{"ScalarBaseMult/small", func(k *FieldElement) {
+			SetBasePrecomputation(PrecomputeSmall)
+			p.ScalarBaseMult(k)
+		}, OpCounts{Mul: 505, Select: 64}},
+		//32 signed 8-bit windows (31 additions) and addition of 0 or 2^256*Base for the carry
+		{"ScalarBaseMult/big", func(k *FieldElement) {
+			SetBasePrecomputation(PrecomputeBig)
+			p.ScalarBaseMult(k)
+		}, OpCounts{Mul: 257, Select: 32}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			//constant time operations do the same operations for every scalar
+			for _, k := range []*FieldElement{&k1, &k2} {
+				ResetOpCounts()
+				tc.op(k)
+				if counts := SnapshotOpCounts(); counts != tc.expected {
+					t.Errorf("%x: %+v, expected %+v", k, counts, tc.expected)
+				}
+			}
+		})
+	}
+}
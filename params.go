@@ -0,0 +1,79 @@
+package curve1174
+
+import "math/big"
+
+//CurveParams describes Curve1174 and its birationally equivalent Montgomery and short Weierstrass forms. All values
+//are reduced modulo P (except orders).
+type CurveParams struct {
+	//P is order of F_p, 2^251-9
+	P *big.Int
+	//D is coefficient of Edwards equation x^2+y^2 = 1+dx^2y^2, d = -1174 mod p
+	D *big.Int
+	//N is l, order of subgroup generated by base point
+	N *big.Int
+	//Cofactor is ratio of curve's group order and N
+	Cofactor int
+	//Gx and Gy are affine coordinates of base point
+	Gx, Gy *big.Int
+
+	//MontgomeryA and MontgomeryB are coefficients of equivalent Montgomery curve Bv^2 = u^3+Au^2+u, point (x, y) maps
+	//to u = (1+y)/(1-y), v = u/x
+	MontgomeryA, MontgomeryB *big.Int
+	//MontgomeryGu and MontgomeryGv are coordinates of base point on Montgomery curve
+	MontgomeryGu, MontgomeryGv *big.Int
+
+	//WeierstrassA and WeierstrassB are coefficients of equivalent short Weierstrass curve y^2 = x^3+ax+b, Montgomery
+	//point (u, v) maps to x = u/B+A/3B, y = v/B
+	WeierstrassA, WeierstrassB *big.Int
+	//WeierstrassGx and WeierstrassGy are coordinates of base point on Weierstrass curve
+	WeierstrassGx, WeierstrassGy *big.Int
+}
+
+//GroupOrder returns order of curve's group, Cofactor*N
+func (c *CurveParams) GroupOrder() *big.Int {
+	return new(big.Int).Mul(c.N, big.NewInt(int64(c.Cofactor)))
+}
+
+//Params returns new copy of curve parameters, it can be modified freely by the caller
+func Params() *CurveParams {
+	p := modulus.ToBigInt()
+	c := &CurveParams{
+		P:        p,
+		D:        new(big.Int).Sub(p, big.NewInt(1174)),
+		N:        order.ToBigInt(),
+		Cofactor: 4,
+		Gx:       basePoint.X.ToBigInt(),
+		Gy:       basePoint.Y.ToBigInt(),
+	}
+
+	mod := func(x *big.Int) *big.Int {
+		return x.Mod(x, p)
+	}
+	inv := func(x *big.Int) *big.Int {
+		return new(big.Int).ModInverse(x, p)
+	}
+	mul := func(x, y *big.Int) *big.Int {
+		return mod(new(big.Int).Mul(x, y))
+	}
+	one := big.NewInt(1)
+	three := big.NewInt(3)
+
+	//A = 2(1+d)/(1-d), B = 4/(1-d)
+	oneMinusD := mod(new(big.Int).Sub(one, c.D))
+	c.MontgomeryA = mul(mod(new(big.Int).Lsh(new(big.Int).Add(one, c.D), 1)), inv(oneMinusD))
+	c.MontgomeryB = mul(big.NewInt(4), inv(oneMinusD))
+	c.MontgomeryGu = mul(mod(new(big.Int).Add(one, c.Gy)), inv(mod(new(big.Int).Sub(one, c.Gy))))
+	c.MontgomeryGv = mul(c.MontgomeryGu, inv(c.Gx))
+
+	//a = (3-A^2)/3B^2, b = (2A^3-9A)/27B^3
+	a, b := c.MontgomeryA, c.MontgomeryB
+	a2 := mul(a, a)
+	b2 := mul(b, b)
+	c.WeierstrassA = mul(mod(new(big.Int).Sub(three, a2)), inv(mul(three, b2)))
+	c.WeierstrassB = mul(mod(new(big.Int).Sub(new(big.Int).Lsh(mul(a2, a), 1), mul(big.NewInt(9), a))),
+		inv(mul(big.NewInt(27), mul(b2, b))))
+	invB := inv(b)
+	c.WeierstrassGx = mod(new(big.Int).Add(mul(c.MontgomeryGu, invB), mul(a, inv(mul(three, b)))))
+	c.WeierstrassGy = mul(c.MontgomeryGv, invB)
+	return c
+}
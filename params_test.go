@@ -0,0 +1,94 @@
+package curve1174
+
+import (
+	"math/big"
+	"testing"
+)
+
+//TestParamsOrder checks that l*Base == E and that 4*l is order of the whole group
+func TestParamsOrder(t *testing.T) {
+	c := Params()
+	if c.P.Cmp(P) != 0 || c.Cofactor != 4 || !c.N.ProbablyPrime(20) {
+		t.Fatalf("invalid params %v", c)
+	}
+	if d := new(big.Int).Add(c.D, big.NewInt(1174)); d.Cmp(c.P) != 0 {
+		t.Errorf("d != -1174: %v", c.D)
+	}
+	if c.GroupOrder().Cmp(groupOrder.ToBigInt()) != 0 {
+		t.Errorf("4*l != group order: %v", c.GroupOrder())
+	}
+
+	//Hasse bound: |p+1-#E| <= 2*sqrt(p)
+	trace := new(big.Int).Sub(new(big.Int).Add(c.P, big.NewInt(1)), c.GroupOrder())
+	if new(big.Int).Mul(trace, trace).Cmp(new(big.Int).Lsh(c.P, 2)) > 0 {
+		t.Errorf("group order outside of Hasse bound: %v", c.GroupOrder())
+	}
+
+	base := &Point{X: *FromBigInt(c.Gx), Y: *FromBigInt(c.Gy), Z: one}
+	base.T.Mul(&base.X, &base.Y)
+	if !base.IsOnCurve() || !base.Equals(NewBasePoint()) {
+		t.Fatalf("invalid base point %v, %v", c.Gx, c.Gy)
+	}
+	var p Point
+	if !p.ScalarMult(base, FromBigInt(c.N)).ToAffine(&p).Equals(NewIdentityPoint()) {
+		t.Errorf("l*Base != E: %x", &p)
+	}
+	if !p.ScalarMultLadder(base, FromBigInt(c.N)).ToAffine(&p).Equals(NewIdentityPoint()) {
+		t.Errorf("l*Base != E: %x", &p)
+	}
+
+	//(1, 0) has order 4, so Base+(1, 0) is outside of subgroup generated by Base, but still in the group
+	var q Point
+	q.X = one
+	q.Z = one
+	q.Add(&q, base)
+	if p.ScalarMult(&q, FromBigInt(c.N)).ToAffine(&p).Equals(NewIdentityPoint()) {
+		t.Errorf("l*(Base+(1, 0)) == E")
+	}
+	if !p.ScalarMult(&q, FromBigInt(c.GroupOrder())).ToAffine(&p).Equals(NewIdentityPoint()) {
+		t.Errorf("4l*(Base+(1, 0)) != E: %x", &p)
+	}
+}
+
+//TestParamsEquivalentCurves checks that base point satisfies equations of Edwards, Montgomery and Weierstrass curves
+func TestParamsEquivalentCurves(t *testing.T) {
+	c := Params()
+	mod := func(x *big.Int) *big.Int {
+		return x.Mod(x, c.P)
+	}
+	sqr := func(x *big.Int) *big.Int {
+		return mod(new(big.Int).Mul(x, x))
+	}
+
+	//x^2+y^2 = 1+dx^2y^2
+	x2, y2 := sqr(c.Gx), sqr(c.Gy)
+	l := mod(new(big.Int).Add(x2, y2))
+	r := mod(new(big.Int).Add(big.NewInt(1), mod(new(big.Int).Mul(c.D, mod(new(big.Int).Mul(x2, y2))))))
+	if l.Cmp(r) != 0 {
+		t.Errorf("base point not on Edwards curve")
+	}
+
+	//Bv^2 = u^3+Au^2+u
+	u, v := c.MontgomeryGu, c.MontgomeryGv
+	u2 := sqr(u)
+	l = mod(new(big.Int).Mul(c.MontgomeryB, sqr(v)))
+	r = mod(new(big.Int).Add(new(big.Int).Add(new(big.Int).Mul(u2, u), new(big.Int).Mul(c.MontgomeryA, u2)), u))
+	if l.Cmp(r) != 0 {
+		t.Errorf("base point not on Montgomery curve")
+	}
+
+	//y^2 = x^3+ax+b
+	x, y := c.WeierstrassGx, c.WeierstrassGy
+	l = sqr(y)
+	r = mod(new(big.Int).Add(new(big.Int).Add(new(big.Int).Mul(sqr(x), x), new(big.Int).Mul(c.WeierstrassA, x)),
+		c.WeierstrassB))
+	if l.Cmp(r) != 0 {
+		t.Errorf("base point not on Weierstrass curve")
+	}
+
+	c.P.SetInt64(5)
+	c.Gx.SetInt64(5)
+	if c2 := Params(); c2.P.Cmp(P) != 0 || c2.Gx.Cmp(basePoint.X.ToBigInt()) != 0 {
+		t.Errorf("params returned by previous call are shared")
+	}
+}
@@ -0,0 +1,38 @@
+//go:build !curve1174_purego && !curve1174_radix51
+
+package curve1174
+
+//Point formulas generated by gen/asm.go as single functions: field operations are the same as in Go methods, only
+//function calls between them are removed. They use MULX, ADCX and ADOX, so they are used only if cpuSupported.
+
+//usePointAsm can be cleared by tests and benchmarks to use Go methods with the same field arithmetic. They're always
+//used when operations are counted (curve1174_opcount tag)
+var usePointAsm = true
+
+func fusedPointOps() bool {
+	return usePointAsm && cpuSupported && !opCountEnabled
+}
+
+//go:noescape
+func pointAdd(res, p1, p2 *Point)
+
+//go:noescape
+func pointAddZ1(res, p1, p2 *Point)
+
+//go:noescape
+func pointDouble(res, p *Point)
+
+//go:noescape
+func pointDoubleProjective(res *Point, p *ProjectivePoint)
+
+//go:noescape
+func projectiveDouble(res, p *ProjectivePoint)
+
+//go:noescape
+func pointAddCached(res, p1 *Point, p2 *CachedPoint)
+
+//go:noescape
+func projectiveAddCached(res *ProjectivePoint, p1 *Point, p2 *CachedPoint)
+
+//go:noescape
+func pointAddAffineCached(res, p1 *Point, p2 *AffineCachedPoint)
@@ -0,0 +1,116 @@
+//go:build !curve1174_purego && !curve1174_radix51
+
+package curve1174
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+)
+
+//TestFusedPointOps checks that point formulas in assembly give exactly the same results as Go methods
+func TestFusedPointOps(t *testing.T) {
+	saved := backend()
+	if !setBackend("adx") {
+		t.Skip("BMI2/ADX not supported")
+	}
+	defer func() {
+		setBackend(saved)
+		usePointAsm = true
+	}()
+
+	//every op is called with fresh result and with result aliased to first argument
+	ops := map[string]func(res, p1, p2 *Point){
+		"Add":    func(res, p1, p2 *Point) { res.Add(p1, p2) },
+		"AddZ1":  func(res, p1, p2 *Point) { res.AddZ1(p1, new(Point).ToAffine(p2)) },
+		"Double": func(res, p1, p2 *Point) { res.Double(p1) },
+		"DoubleProjective": func(res, p1, p2 *Point) {
+			res.DoubleProjective(new(ProjectivePoint).SetPoint(p1))
+		},
+		"ProjectivePoint.Double": func(res, p1, p2 *Point) {
+			var pp ProjectivePoint
+			pp.SetPoint(p1).Double(&pp)
+			*res = Point{X: pp.X, Y: pp.Y, Z: pp.Z}
+		},
+		"AddCached": func(res, p1, p2 *Point) {
+			res.AddCached(p1, new(CachedPoint).SetPoint(p2))
+		},
+		"AddAffineCached": func(res, p1, p2 *Point) {
+			res.AddAffineCached(p1, new(AffineCachedPoint).SetPoint(new(Point).ToAffine(p2)))
+		},
+		"ProjectivePoint.AddCached": func(res, p1, p2 *Point) {
+			var pp ProjectivePoint
+			pp.AddCached(p1, new(CachedPoint).SetPoint(p2))
+			*res = Point{X: pp.X, Y: pp.Y, Z: pp.Z}
+		},
+	}
+
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	var p1, p2 Point
+	var b FieldElement
+	for n := 0; n < 200; n++ {
+		for i := range b {
+			b[i] = r.Uint64()
+		}
+		b[3] &= P3
+		p1.ScalarMult(Base, &b)
+		b[0]++
+		p2.ScalarMult(Base, &b)
+		for name, op := range ops {
+			var fused, generic, aliased Point
+			usePointAsm = true
+			op(&fused, &p1, &p2)
+			aliased.Set(&p1)
+			op(&aliased, &aliased, &p2)
+			usePointAsm = false
+			op(&generic, &p1, &p2)
+			usePointAsm = true
+			if fused != generic || aliased != generic {
+				t.Fatalf("%s\n%x\n%x\n%x\n%x", name, &p1, &p2, &fused, &generic)
+			}
+		}
+	}
+}
+
+func BenchmarkFusedPointOps(b *testing.B) {
+	if !setBackend("adx") {
+		b.Skip("BMI2/ADX not supported")
+	}
+	defer func() { usePointAsm = true }()
+	var p, q Point
+	var pp ProjectivePoint
+	var c CachedPoint
+	var ac AffineCachedPoint
+	p.Double(Base)
+	q.Double(&p)
+	c.SetPoint(&q)
+	ac.SetPoint(new(Point).ToAffine(&q))
+	pp.SetPoint(&q)
+	ops := []struct {
+		name string
+		op   func()
+	}{
+		{"Add", func() { p.Add(&p, &q) }},
+		{"AddZ1", func() { p.AddZ1(&p, Base) }},
+		{"Double", func() { p.Double(&p) }},
+		{"DoubleProjective", func() { p.DoubleProjective(&pp) }},
+		{"ProjectivePoint.Double", func() { pp.Double(&pp) }},
+		{"AddCached", func() { p.AddCached(&p, &c) }},
+		{"AddAffineCached", func() { p.AddAffineCached(&p, &ac) }},
+		{"ProjectivePoint.AddCached", func() { pp.AddCached(&p, &c) }},
+	}
+	for _, op := range ops {
+		for _, fused := range []bool{true, false} {
+			name := op.name + "/go"
+			if fused {
+				name = op.name + "/asm"
+			}
+			b.Run(name, func(b *testing.B) {
+				usePointAsm = fused
+				for i := 0; i < b.N; i++ {
+					op.op()
+				}
+			})
+		}
+	}
+}
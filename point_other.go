@@ -0,0 +1,42 @@
+//go:build !amd64 || curve1174_purego || curve1174_radix51
+
+package curve1174
+
+//Point formulas in assembly are available only on amd64, fusedPointOps is always false here and functions below
+//are never called
+
+func fusedPointOps() bool {
+	return false
+}
+
+func pointAdd(res, p1, p2 *Point) {
+	panic("curve1174: fused point formulas not available")
+}
+
+func pointAddZ1(res, p1, p2 *Point) {
+	panic("curve1174: fused point formulas not available")
+}
+
+func pointDouble(res, p *Point) {
+	panic("curve1174: fused point formulas not available")
+}
+
+func pointDoubleProjective(res *Point, p *ProjectivePoint) {
+	panic("curve1174: fused point formulas not available")
+}
+
+func projectiveDouble(res, p *ProjectivePoint) {
+	panic("curve1174: fused point formulas not available")
+}
+
+func pointAddCached(res, p1 *Point, p2 *CachedPoint) {
+	panic("curve1174: fused point formulas not available")
+}
+
+func projectiveAddCached(res *ProjectivePoint, p1 *Point, p2 *CachedPoint) {
+	panic("curve1174: fused point formulas not available")
+}
+
+func pointAddAffineCached(res, p1 *Point, p2 *AffineCachedPoint) {
+	panic("curve1174: fused point formulas not available")
+}
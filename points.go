@@ -0,0 +1,130 @@
+package curve1174
+
+//Point (ExtendedPoint) is the type used by all public operations. Other representations below are cheaper to
+//compute or to add, but they lack some coordinates, so they have their own types with explicit conversions and only
+//operations that are valid for them.
+
+//ExtendedPoint is point in extended coordinates (X:Y:Z:T) with x == X/Z, y == Y/Z and XY == ZT
+type ExtendedPoint = Point
+
+//AffinePoint is point in affine coordinates (x, y). Converting Point to it needs inversion, but additions and
+//doublings of affine points are cheaper.
+type AffinePoint struct {
+	X FieldElement
+	Y FieldElement
+}
+
+//ProjectivePoint is point in projective coordinates (X:Y:Z) with x == X/Z and y == Y/Z. Doubling doesn't have to
+//compute T, so it's used for sequences of doublings (e.g. in ScalarMult).
+type ProjectivePoint struct {
+	X FieldElement
+	Y FieldElement
+	Z FieldElement
+}
+
+//SetPoint sets a to affine coordinates of p (both reduced modulo 2^251-9)
+func (a *AffinePoint) SetPoint(p *Point) *AffinePoint {
+	var zInv FieldElement
+	zInv.Inverse(&p.Z)
+	a.X.Mul(&p.X, &zInv).Mod(&a.X)
+	a.Y.Mul(&p.Y, &zInv).Mod(&a.Y)
+	return a
+}
+
+//SetAffine sets p to extended coordinates of a (p.Z == 1)
+func (p *Point) SetAffine(a *AffinePoint) *Point {
+	p.T.Mul(&a.X, &a.Y).Mod(&p.T)
+	p.X.Set(&a.X)
+	p.Y.Set(&a.Y)
+	p.Z.Set(&one)
+	return p
+}
+
+//SetPoint sets pp to projective coordinates of p (it just drops p.T)
+func (pp *ProjectivePoint) SetPoint(p *Point) *ProjectivePoint {
+	pp.X.Set(&p.X)
+	pp.Y.Set(&p.Y)
+	pp.Z.Set(&p.Z)
+	return pp
+}
+
+//SetProjective sets p to extended coordinates of pp, (XZ:YZ:Z^2:XY) is the same point with valid T
+func (p *Point) SetProjective(pp *ProjectivePoint) *Point {
+	var x, y, z FieldElement
+	x.Mul(&pp.X, &pp.Z)
+	y.Mul(&pp.Y, &pp.Z)
+	z.Sqr(&pp.Z)
+	p.T.Mul(&pp.X, &pp.Y)
+	p.X.Set(&x)
+	p.Y.Set(&y)
+	p.Z.Set(&z)
+	return p
+}
+
+//SetAffine sets c to affine cached form of a
+func (c *AffineCachedPoint) SetAffine(a *AffinePoint) *AffineCachedPoint {
+	c.YPlusX.Add(&a.Y, &a.X).Mod(&c.YPlusX)
+	c.YMinusX.Sub(&a.Y, &a.X).Mod(&c.YMinusX)
+	c.T2D.Mul(&a.X, &a.Y).MulD(&c.T2D).Mul2(&c.T2D).Mod(&c.T2D)
+	return c
+}
+
+//AddAffine adds point p1 and affine point p2 and stores result in p
+//It's AddAffineCached with the cached form computed on the fly (without reducing it, it's not stored anywhere).
+func (p *Point) AddAffine(p1 *Point, p2 *AffinePoint) *Point {
+	var yPlusX, yMinusX, t2d, e, f, g, h, zz FieldElement
+	yPlusX.Add(&p2.Y, &p2.X)
+	yMinusX.Sub(&p2.Y, &p2.X)
+	t2d.Mul(&p2.X, &p2.Y).MulD(&t2d).Mul2(&t2d)
+	zz.Mul2(&p1.Z)
+	cachedSum(&e, &f, &g, &h, p1, &yPlusX, &yMinusX, &t2d, &zz)
+	return p.setEFGH(&e, &f, &g, &h)
+}
+
+//DoubleAffine doubles affine point a and stores result in p (p = a+a)
+func (p *Point) DoubleAffine(a *AffinePoint) *Point {
+	//DoubleZ1 doesn't use T
+	q := Point{X: a.X, Y: a.Y, Z: one}
+	return p.DoubleZ1(&q)
+}
+
+//Double doubles point dp and stores result in pp (pp = dp+dp)
+//Formula based on https://www.hyperelliptic.org/EFD/g1p/auto-twisted-projective.html#doubling-dbl-2008-bbjlp
+func (pp *ProjectivePoint) Double(dp *ProjectivePoint) *ProjectivePoint {
+	if fusedPointOps() {
+		projectiveDouble(pp, dp)
+		return pp
+	}
+	var b, c, d, f, h, j FieldElement
+	b.Add(&dp.X, &dp.Y).Sqr(&b)
+	c.Sqr(&dp.X)
+	d.Sqr(&dp.Y)
+	f.Add(&c, &d)
+	h.Sqr(&dp.Z)
+	j.Mul2(&h).Sub(&f, &j)
+	pp.X.Sub(&b, &c).Sub(&pp.X, &d).Mul(&pp.X, &j)
+	pp.Y.Sub(&c, &d).Mul(&pp.Y, &f)
+	pp.Z.Mul(&f, &j)
+	return pp
+}
+
+//AddCached adds point p1 and cached point p2 and stores result in pp. It's Point.AddCached without computing T.
+func (pp *ProjectivePoint) AddCached(p1 *Point, p2 *CachedPoint) *ProjectivePoint {
+	if fusedPointOps() {
+		projectiveAddCached(pp, p1, p2)
+		return pp
+	}
+	var e, f, g, h, zz FieldElement
+	zz.Mul(&p1.Z, &p2.Z2)
+	cachedSum(&e, &f, &g, &h, p1, &p2.YPlusX, &p2.YMinusX, &p2.T2D, &zz)
+	pp.X.Mul(&e, &f)
+	pp.Y.Mul(&g, &h)
+	pp.Z.Mul(&f, &g)
+	return pp
+}
+
+//IsOnCurve checks if pp is valid point on curve: (X^2+Y^2)Z^2 == Z^4-1174X^2Y^2 and Z != 0
+func (pp *ProjectivePoint) IsOnCurve() bool {
+	p := Point{X: pp.X, Y: pp.Y, Z: pp.Z}
+	return p.isOnCurveProjective()
+}
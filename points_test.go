@@ -0,0 +1,75 @@
+package curve1174
+
+import "testing"
+
+func TestAffinePoint(t *testing.T) {
+	var p, q, expected Point
+	var a AffinePoint
+	p.ScalarBaseMult(&FieldElement{12345, 678})
+	a.SetPoint(&p)
+	q.SetAffine(&a)
+	expected.ToAffine(&p)
+	if !q.IsOnCurve() || !q.Equals(&expected) || !q.T.Equals(&expected.T) {
+		t.Errorf("not equal %x %x", &q, &expected)
+	}
+
+	var c, expectedCached AffineCachedPoint
+	c.SetAffine(&a)
+	expectedCached.SetPoint(&expected)
+	if c != expectedCached {
+		t.Errorf("not equal %x %x", &c, &expectedCached)
+	}
+
+	var sum Point
+	sum.AddAffine(&p, &a).ToAffine(&sum)
+	expected.Add(&p, &p).ToAffine(&expected)
+	if !sum.Equals(&expected) {
+		t.Errorf("AddAffine %x %x", &sum, &expected)
+	}
+	sum.DoubleAffine(&a).ToAffine(&sum)
+	if !sum.Equals(&expected) {
+		t.Errorf("DoubleAffine %x %x", &sum, &expected)
+	}
+	var r Point
+	r.ScalarBaseMult(&FieldElement{5})
+	for _, p1 := range []Point{identity, basePoint, r} {
+		sum.AddAffine(&p1, &a).ToAffine(&sum)
+		expected.Add(&p1, &q).ToAffine(&expected)
+		if !sum.Equals(&expected) {
+			t.Errorf("AddAffine %x %x", &sum, &expected)
+		}
+	}
+}
+
+func TestProjectivePoint(t *testing.T) {
+	var p, q, r, expected Point
+	var pp ProjectivePoint
+	p.ScalarBaseMult(&FieldElement{12345, 678})
+	q.ScalarBaseMult(&FieldElement{999, 0, 1})
+	pp.SetPoint(&p)
+	if !pp.IsOnCurve() {
+		t.Errorf("not on curve %x", &pp)
+	}
+	r.SetProjective(&pp)
+	if !r.IsOnCurve() || !r.ToAffine(&r).Equals(expected.ToAffine(&p)) {
+		t.Errorf("SetProjective %x %x", &r, &expected)
+	}
+
+	expected.Double(&p).ToAffine(&expected)
+	r.DoubleProjective(&pp)
+	if !r.IsOnCurve() || !r.ToAffine(&r).Equals(&expected) {
+		t.Errorf("DoubleProjective %x %x", &r, &expected)
+	}
+	pp.Double(&pp)
+	r.SetProjective(&pp).ToAffine(&r)
+	if !pp.IsOnCurve() || !r.Equals(&expected) {
+		t.Errorf("Double %x %x", &r, &expected)
+	}
+
+	expected.Add(&p, &q).ToAffine(&expected)
+	pp.AddCached(&p, new(CachedPoint).SetPoint(&q))
+	r.SetProjective(&pp).ToAffine(&r)
+	if !pp.IsOnCurve() || !r.Equals(&expected) {
+		t.Errorf("AddCached %x %x", &r, &expected)
+	}
+}
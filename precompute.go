@@ -0,0 +1,45 @@
+package curve1174
+
+import "sync/atomic"
+
+//BasePrecomputation selects precomputed table used by ScalarBaseMult
+type BasePrecomputation int32
+
+const (
+	//PrecomputeNone disables precomputed table, ScalarBaseMult costs the same as ScalarMult
+	PrecomputeNone BasePrecomputation = iota
+	//PrecomputeSmall uses table with 4-bit windows (~98kB)
+	PrecomputeSmall
+	//PrecomputeBig uses table with 8-bit signed windows (~396kB)
+	PrecomputeBig
+)
+
+var basePrecomputation = int32(defaultBasePrecomputation)
+
+//SetBasePrecomputation selects precomputed table used by ScalarBaseMult. Tables are generated by gen/tables and
+//compiled into the binary. It's safe to call it concurrently with ScalarBaseMult. Default level is PrecomputeSmall,
+//it can be changed with tags curve1174_no_precompute and curve1174_precompute_big.
+func SetBasePrecomputation(level BasePrecomputation) {
+	if level < PrecomputeNone || level > PrecomputeBig {
+		panic("curve1174: invalid base precomputation level")
+	}
+	atomic.StoreInt32(&basePrecomputation, int32(level))
+}
+
+//GetBasePrecomputation returns precomputed table level currently used by ScalarBaseMult
+func GetBasePrecomputation() BasePrecomputation {
+	return BasePrecomputation(atomic.LoadInt32(&basePrecomputation))
+}
+
+//ScalarBaseMult multiplies base point Base by scalar b (b<2^251-9) and stores result in p. Execution time doesn't depend on b.
+//Precomputed table selected with SetBasePrecomputation is used for speedup.
+func (p *Point) ScalarBaseMult(b *FieldElement) *Point {
+	switch GetBasePrecomputation() {
+	case PrecomputeSmall:
+		return p.scalarBaseMultSmall(b)
+	case PrecomputeBig:
+		return p.scalarBaseMultBig(b)
+	default:
+		return p.ScalarMult(&basePoint, b)
+	}
+}
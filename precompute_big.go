@@ -1,34 +1,38 @@
-//go:build !curve1174_no_precompute && curve1174_precompute_big
-
 package curve1174
 
-var precomputedBase [32][256]Point
-
-func init() {
-	var p Point
-	p.Set(Base)
-	sp := &p
-	for i := 0; i < 32; i++ {
-		el := &precomputedBase[i]
-		el[0].Set(E)
-		el[1].Set(sp)
-		for i := 2; i < 255; i += 2 {
-			el[i].Double(&el[i-1]).ToAffine(&el[i])
-			el[i+1].AddZ1(&el[i], sp).ToAffine(&el[i+1])
-		}
-		sp.Double(&el[128]).ToAffine(sp)
-	}
+//bigBaseTable holds j*256^i*Base in rows[i][j], scalar is recoded to signed digits from [-128, 127] so only
+//non-negative multiples have to be stored
+type bigBaseTable struct {
+	rows [32][129]AffineCachedPoint
+	//top is 2^256*Base, it's needed when recoding 256-bit scalar to signed digits produces final carry
+	top AffineCachedPoint
 }
 
-//ScalarBaseMult multiplies base point Base by scalar b (b<2^251-9) and stores result in p. Execution time doesn't depend on b.
-func (p *Point) ScalarBaseMult(b *FieldElement) *Point {
-	index := b[0] & 0xFF
-	p.Set(&precomputedBase[0][index])
+//scalarBaseMultBig multiplies base point Base by scalar b using table with 8-bit signed windows
+func (p *Point) scalarBaseMultBig(b *FieldElement) *Point {
+	table := &precomputedBaseBigTable
+	var pp AffineCachedPoint
+	var digits [32]int64
+	carry := signedWindows(b, 8, digits[:])
+	selectSignedDigit(&pp, &table.rows[0], digits[0])
+	p.setAffineCached(&pp)
 
 	for i := 1; i < 32; i++ {
-		index = (b[i/256] >> ((i % 256) * 8)) & 0xFF
-		p.AddZ1(p, &precomputedBase[i][index])
+		selectSignedDigit(&pp, &table.rows[i], digits[i])
+		p.AddAffineCached(p, &pp)
 	}
 
-	return p
+	pp = affineCachedE
+	pp.condSet(&table.top, carry)
+	return p.AddAffineCached(p, &pp)
+}
+
+//selectSignedDigit sets res to digit*table[1], table has to contain 0..128 multiples of the point.
+//Execution time doesn't depend on digit.
+func selectSignedDigit(res *AffineCachedPoint, table *[129]AffineCachedPoint, digit int64) {
+	neg := uint64(digit) >> 63
+	mask := -neg
+	abs := (uint64(digit) ^ mask) - mask
+	selectAffineCachedPoint129(res, table, abs)
+	res.condNeg(neg)
 }
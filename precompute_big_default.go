@@ -0,0 +1,5 @@
+//go:build !curve1174_no_precompute && curve1174_precompute_big
+
+package curve1174
+
+const defaultBasePrecomputation = PrecomputeBig
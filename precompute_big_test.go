@@ -0,0 +1,28 @@
+package curve1174
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+	"time"
+)
+
+func TestSignedWindows(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	for i := 0; i < 1000; i++ {
+		b := FieldElement{r.Uint64(), r.Uint64(), r.Uint64(), r.Uint64()}
+		var digits [32]int64
+		carry := signedWindows(&b, 8, digits[:])
+		res := new(big.Int).Lsh(big.NewInt(int64(carry)), 256)
+		for j := 0; j < 32; j++ {
+			if digits[j] < -128 || digits[j] > 127 {
+				t.Fatalf("digit out of range %d", digits[j])
+			}
+			d := big.NewInt(digits[j])
+			res.Add(res, d.Lsh(d, uint(j*8)))
+		}
+		if res.Cmp(b.ToBigInt()) != 0 {
+			t.Errorf("\n%x\n%x", &b, res)
+		}
+	}
+}
@@ -0,0 +1,1159 @@
+// Code generated by gen/tables. DO NOT EDIT.
+
+package curve1174
+
+// precomputedBaseSmallTable[i][j] is j*16^i*Base
+var precomputedBaseSmallTable = [64][16]AffineCachedPoint{
+	{
+		{FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}},
+		{FieldElement{0xbadef0e75828d4f1, 0x1004b84cf86e64d0, 0xf99a32f929adaf9d, 0x0236ea8fbeb04413}, FieldElement{0x8eba7297de639734, 0x8fc105786610ca06, 0xd312d529a95a6c7b, 0x03377475ea4f2b84}, FieldElement{0xc1f7af65d749fb7a, 0x4d81fbcb3bd6011c, 0x8cfe5b90f25c0745, 0x04f365488557dad1}},
+		{FieldElement{0x4284ae615e3aabf6, 0x6d4c279b21115ce3, 0xc308cf7ddf128327, 0x02ac65ba450517ab}, FieldElement{0xfaa53f965442e858, 0x737c2a9c7031bf61, 0x11512d0d96c7fb6d, 0x03776527e0f94254}, FieldElement{0x7c691d66b5018f26, 0xcffab2c3eba248a4, 0x49aae938a34e178b, 0x0704c95a4758ac59}},
+		{FieldElement{0x5e32cc0a84555e42, 0x44ef713029da885d, 0x27d4fd1c5bf453fb, 0x023996adc5d51d6a}, FieldElement{0xdf5b92af0277d245, 0xddf6689a4a746e0b, 0x5ba6826897229d2b, 0x0291665a6d1e7672}, FieldElement{0x1505070b88d73d5a, 0x30f9cee406296296, 0xe214af43fc26a6c0, 0x00c42f994583061c}},
+		{FieldElement{0x6474998167bead60, 0x7f17f5e0fc75576d, 0xa6b1a86618e48aa7, 0x010f024898d1a6d1}, FieldElement{0x10d9d040df57085f, 0x8b08c0f247ced4aa, 0x7c09e2808afed5df, 0x0763d02030d55515}, FieldElement{0xa540542a71c083ce, 0x99efe806ced9a4b0, 0xee1ab240e8a1245e, 0x00bb2ebbd5463913}},
+		{FieldElement{0x25ea8d14638fea07, 0x5ebd2b0c667a0506, 0x59d3e25152a25d19, 0x0619d1dad09fc1de}, FieldElement{0xc6b3bcf08d07f638, 0x08a96a675677c2f0, 0xb09ea0d22d34893b, 0x02d39ac827494b8f}, FieldElement{0x2dc2640ec5d0ad29, 0x72fbc640e3f99ff1, 0x47d804a2cade3681, 0x0029d42f951bee2a}},
+		{FieldElement{0x1c2d682cdebc5be0, 0x756657ec384de055, 0x63091b75a031db55, 0x04e15789a4962859}, FieldElement{0xdba7931f9335becb, 0xd2b15bfd73b625c5, 0xf093ea8f9ead0df3, 0x03334fb2ecd1c594}, FieldElement{0xd38ec9debed8a653, 0x8e771e954a4e1619, 0x3d53ea9ab60c18ad, 0x0623f627ade586d1}},
+		{FieldElement{0xaf6262842b5937dd, 0xc205608c43e734d1, 0x1f2faedc67008a81, 0x03f9df15825655be}, FieldElement{0x6d8b33273dc917ac, 0x6b11b33378c3ddb8, 0xe92687a6fc654879, 0x01a38f3f44996487}, FieldElement{0xd359a492f182ed1b, 0xe7725b69da088781, 0xece04100a80a168b, 0x0794340dcabdfd64}},
+		{FieldElement{0x8ebb3a82321f27e8, 0x7f3a72014c4b1a00, 0x3986da197b563858, 0x05bc1d54c8bf9be7}, FieldElement{0x8a3bda29c74314f7, 0x0db6eed8aa8f7518, 0xd1ad7c0c824ff645, 0x00e884fd2da31159}, FieldElement{0xf61fcc578bb49f4f, 0xd7ff536813a02e93, 0x0ddbe8ea67a20138, 0x03a48f120dde4891}},
+		{FieldElement{0xdde665493f194500, 0xef452368b2b15553, 0x14e9d45c2bc190df, 0x04598b492aee0ce5}, FieldElement{0x680d4442ba54352d, 0x57e517e1a79ea773, 0x3aea47c62cf44f30, 0x0618e71a5f091825}, FieldElement{0x89561ed3f7c2ac96, 0xf2a4cf258061b741, 0xb5be48202296375e, 0x06129669c240908b}},
+		{FieldElement{0x6b147b5b408397f5, 0xc47295d355346987, 0xdcf7106f2255569c, 0x038fee87573af7fe}, FieldElement{0x3381c6ba27821d5b, 0x1f68097d74dceb5c, 0x01302cd4606c721d, 0x003146e769f25b22}, FieldElement{0xe4edfd23b092135a, 0x46c0d47c5aeef67b, 0x4ac920535dbf025c, 0x042f3e3b7d6a4d51}},
+		{FieldElement{0x275ccc6d9acfb33c, 0xd05d879749bc4233, 0xa59865f8c874a167, 0x04dff9e9cc174753}, FieldElement{0x590057a1fd9e4746, 0xf60c81c73dd877cc, 0x9ff91709df7eb19c, 0x06d8bf12a15bdc5a}, FieldElement{0x7a4396b75e17e93b, 0xee7510adf981f86d, 0x494d6abc8c2d5dfe, 0x07f7c13b9cf7c657}},
+		{FieldElement{0xe7097f7689f583e6, 0x8610300d386dad77, 0xf195b6e9459ae586, 0x0791fe651252a21f}, FieldElement{0x59b92bd605034a27, 0xe3b59a22b3d9da6d, 0x9e79f5267916949a, 0x04fccdf552ba6e20}, FieldElement{0xc4ecea1fde441835, 0xef06bee64829a49f, 0xceb73560873fc59a, 0x04378fc3b4084e1b}},
+		{FieldElement{0xd6bb0e1e78d15955, 0x3e7de8f9a3da0504, 0x87278f7bb8545069, 0x02b34c04d61c1597}, FieldElement{0x976dfa052545916c, 0x678b37e471cebc0f, 0xb6a7dcdca12f7759, 0x009a8aabadcdbd70}, FieldElement{0x283e24c90d87ccb7, 0xff2f6ad7949b6cff, 0x22c8fab93f08c0b0, 0x0191030882afa0c1}},
+		{FieldElement{0x2eddb00b3a91980a, 0xd41b1d616538b6cb, 0xbdd503776b3a6f5e, 0x07f579b705a906f4}, FieldElement{0x18948d3ed7e05d5f, 0x21a6e098f1b547c4, 0x50a9c5fe48470365, 0x004d26620214a678}, FieldElement{0x083cb3c2fbf19fc0, 0x9ccdf9c292183337, 0x8c6f8b8764730757, 0x01789bf8ce4a038c}},
+		{FieldElement{0x1b74adffcf182052, 0xf3857e14b6a69cf6, 0xd54297e9974f5e51, 0x0102a3137d25e492}, FieldElement{0xd28f877f85ca8e68, 0x4c89d8db4d3e05d5, 0x444cef61deb31427, 0x023ce73eac4f7b22}, FieldElement{0x60dff12421c1dd6e, 0x28cf768a45a919be, 0x56056cadb9c143e2, 0x046047fa46462e7a}},
+	},
+	{
+		{FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}},
+		{FieldElement{0x62e4649524a2053e, 0x936393f174718c4a, 0x19339ca0b90a942b, 0x07de19a6fbc2d17f}, FieldElement{0xa1b06723047c2a6a, 0x958bfff5f116b44e, 0xdf8acba478fc3c54, 0x07423d8375e13363}, FieldElement{0x5d520b06994b1e21, 0x4942c9a1e0e7b01d, 0x0ba85bfceb9261b4, 0x073802f26d489404}},
+		{FieldElement{0xc90f22e3bf969fb3, 0xd16e0ef8e053719b, 0xac86dc80bc0c3e65, 0x058042d0c15e3338}, FieldElement{0x2bd917b757b10be8, 0x0befe7decb2f0dfd, 0x534c019785310aeb, 0x067a18cdf4c750c7}, FieldElement{0x90117534e88c222f, 0x39c2c090a516aa68, 0xae48c78f6cdaef7a, 0x015b85defd7db084}},
+		{FieldElement{0x73d82cdef64888fe, 0x9fdcbcadb641dec4, 0xdd70eaee09146613, 0x07c89380f9f75c24}, FieldElement{0xb3462031f451c1a5, 0xb97b9195b3c42a2a, 0x09c090a2a19a6544, 0x03d7cd7525d6004d}, FieldElement{0xf58552b59be0020d, 0xa7c55d74bffa0e75, 0xd982941c35400abc, 0x07c4aefedf7c6e02}},
+		{FieldElement{0x9419d2f393554034, 0xe23feba680437706, 0x276b51225f1b069d, 0x01c5320853a624e9}, FieldElement{0xc00daff28592d8be, 0xaecfb4cf30e6a431, 0x1f5221a51e34da97, 0x05846634a391dfeb}, FieldElement{0x7e1df0f4f1b8271a, 0xf28bee4239e76bb8, 0x8f0e423abc183a94, 0x0356d54ccbf4a11e}},
+		{FieldElement{0x7f837bc859cef41f, 0xaeb41f650680489c, 0xe990024c3da3c8ec, 0x02d105f1c3cd37e7}, FieldElement{0xde0a31fdcb19a365, 0x7f982ce325ea78e2, 0xb98a620749c9b7da, 0x068059f109f0a154}, FieldElement{0x025d01319a848bed, 0x00e1ff9773db6b78, 0xf7473af5ac1b975d, 0x002841aefd75b39d}},
+		{FieldElement{0xf2fe3aa99d7913de, 0x9b98594c2e773402, 0x122760113a60ec06, 0x061f00f7fc0ab77d}, FieldElement{0xd461abb887d7e790, 0x91d70c629144d1cf, 0x71fda5ef4109349a, 0x05eac9041ba0f45c}, FieldElement{0x5d641c0f7e2c0eac, 0xf535b07038c7975d, 0x0380412d194b3e9d, 0x03b05d0a2e92bac4}},
+		{FieldElement{0x202fc2c9f9e98462, 0xf0649871ca4f6273, 0x127ca9737e2dcece, 0x0658a0caf1ffd8ae}, FieldElement{0x3c1c5abb38edc696, 0xcfa0e06fc0b586d2, 0x8f35906a250870d6, 0x07f6883937035aaf}, FieldElement{0x5abc8ed5d6f1a2b9, 0xe2729f77d5ef8cd2, 0x80cc50c52c482ac3, 0x067fef13416f5fcb}},
+		{FieldElement{0x6b0992bd2aec8ca1, 0xaa66ef093aa81938, 0x2d1689baf0e7ef92, 0x031e54d73e661707}, FieldElement{0xa3639f250575151f, 0x37e0c72467b7887b, 0x4d233f73dd5886eb, 0x0518d637cc7a38f3}, FieldElement{0xbaf6a60c20c65d31, 0xc40dfdd86348bad3, 0xa42cc427ea6562bb, 0x0450e65202d722d8}},
+		{FieldElement{0x28ca03ab2bba4cb2, 0x7e87899f33e76e1d, 0x6270df509016176b, 0x07b1ff0af48ad82e}, FieldElement{0x66f714314ed96fa1, 0x590c69d0fe9932c3, 0xf5652fd40deae149, 0x004656dc9681b131}, FieldElement{0xa6dbb089085fbd8e, 0xa97e82ed5e7493e6, 0x9013d502e1e5dd52, 0x0509a372d9e5822b}},
+		{FieldElement{0x762da51911af08c9, 0xd5a3d4cce4699b34, 0xda53836dbaeea955, 0x0335e2b1efc41a29}, FieldElement{0x6cb1bb2f3b1cbe73, 0x5ccd9deb29822a13, 0xdec0f9044edcd6dd, 0x0095b7deabea3f7b}, FieldElement{0xde5cb17995841344, 0x0c81ff544b79d351, 0x09f8202cca459409, 0x051b4f71c92dcd26}},
+		{FieldElement{0x1f6910b509284088, 0xbd0113a8b6a1a7b9, 0xfc5e579ea5eef97b, 0x042debc4e3403d7d}, FieldElement{0x0be408cb72bc54ca, 0x828c997dd04eff6b, 0x2e0f161b75224050, 0x062bbd144f342c28}, FieldElement{0xc96bc6b15eb82842, 0x75e8d06dc8d8dd80, 0x9def20ba47d3b0f0, 0x0189b886e8ba55b1}},
+		{FieldElement{0x2953bbacfa6bb2f3, 0xf74fd8a7b7da45f6, 0x62e9e3b3b50e8257, 0x05adf23a8daef110}, FieldElement{0x9db4403ce9c583db, 0xdb0c19456b75ce15, 0x0d9e221b635656bc, 0x046c9653a840d647}, FieldElement{0x867f2eb618d32686, 0xaa1c20d8cbbcaf0a, 0x5398f1d7e19843de, 0x021c09acf1ce2722}},
+		{FieldElement{0xdca222308e772899, 0x3602402481a36327, 0xf9669893676eb6f0, 0x0774eb0570acb69d}, FieldElement{0xaf2bd4a7c151020d, 0xf5139b3441f51512, 0x7d10c41698e208da, 0x02644df127038575}, FieldElement{0xf8bd744fb2a48845, 0x2ba84c17163665a5, 0x30433f70e467a49a, 0x059295476a0e07f1}},
+		{FieldElement{0x8584b7291d87c71d, 0xdc4506cd0253b535, 0x4aedc5c553f57c27, 0x076124035d227932}, FieldElement{0x1fe417ceae35b96f, 0x12342f956ef3ff13, 0x7113a3467959ecd5, 0x068cca3396de45ea}, FieldElement{0x91fcd0d0c6ed30c9, 0xbf895c8c26442275, 0x4293d3520e720c55, 0x0019532b07c784bf}},
+		{FieldElement{0xff083130bc198494, 0xa8c7da9449e6803c, 0x25061df1b55fda0f, 0x039147a36fe46980}, FieldElement{0x54e5458fc97acc0a, 0x923f29ebbc9c0408, 0x4a1dcf583f913c2b, 0x00f5b355f9844023}, FieldElement{0x5e785f5c0be2fc7c, 0x676f6c2a508f0df7, 0xcc57306b2d94bda1, 0x01759fc4ee39e0a1}},
+	},
+	{
+		{FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}},
+		{FieldElement{0x876f97b8154b9e07, 0xb2d72cddc962239b, 0x14eef67a26491b2a, 0x07137c78d3b78c51}, FieldElement{0xcabd93aa45546ad7, 0x68e75fe4c39fa4f8, 0xd7fd7630a043cb8b, 0x04ba1b1e09eeb840}, FieldElement{0x8b2d0e9a3a448323, 0x61ae3601dc99b0c8, 0x08e3ea3c7f8bf410, 0x029e21e29f1552d1}},
+		{FieldElement{0x5862dc51c9ac914e, 0x4765b1382211deb7, 0x8ce7b38a7f452b90, 0x06af3026f4d5880c}, FieldElement{0x48f10747eb2d37b8, 0x452ba21d61cf5f05, 0x65d7a995d1e053a5, 0x014bd441a15aa03a}, FieldElement{0x5337cac153390742, 0x583d295211c5333a, 0x4adb62bf5a16daf1, 0x038defac103f4212}},
+		{FieldElement{0x8c288785984290f5, 0x652ae8cc0631ece1, 0x077990604abd5497, 0x01b2ac68f36771ac}, FieldElement{0x7b65d1db1d4b4f21, 0xb04b395768ec3ee2, 0x0640d7ad54cd9330, 0x01a7de12080b2238}, FieldElement{0xd27aa5d8e5894842, 0x969866105683767f, 0x9c52c57760f3297c, 0x00f408d382169cb6}},
+		{FieldElement{0x07ba65bbaf3dccdc, 0x599ddcea7de02a72, 0xd7930d9a89f33092, 0x06b407993cd33518}, FieldElement{0x29109ae6585b92a3, 0x6da44d7c291e7c39, 0xec1dc428f081f8e9, 0x0731259f937a5718}, FieldElement{0xaa561a1cb7ebedaa, 0x463990cf7c54eeb3, 0xc3ff6a362632f9da, 0x078a03b74e340b8b}},
+		{FieldElement{0xcf13dc8165096fd7, 0x6581498670007c32, 0xcbac105c683e6e94, 0x024f3f5311721823}, FieldElement{0xedc5d68d38765750, 0x69a7290ba42ec3ef, 0xd609c1068af687ca, 0x0363d5b4e5553e43}, FieldElement{0xb861671ae962eb8e, 0x72d45d73ce3b16f8, 0xd14e78a5af840341, 0x03da630d20a582bb}},
+		{FieldElement{0x27d24c712b43648c, 0x05b6592c49c1964f, 0xdedbf22651dfc0af, 0x030ccce24ce34cd5}, FieldElement{0x970466edd1781534, 0x80495f60a59db6ac, 0xcecae37657ae58dc, 0x037e1c92bc429773}, FieldElement{0xb47a9dd4bacd5103, 0x8a529b8eea1163a3, 0xd50fea3f0e54eefc, 0x07d2a51316a126fe}},
+		{FieldElement{0x2d882b80b97f5524, 0x6583cda2a762b97e, 0xa89a11d276e161a3, 0x078226bb3749b0b1}, FieldElement{0xb2867b85d027c681, 0x77b79c2d67568b07, 0x53092e4550a0c5fa, 0x04180c599cd46234}, FieldElement{0xc916eac563981db1, 0xcf014912d61de9ef, 0x5dd6d866fa2c9c8b, 0x06c402f7c28c23c7}},
+		{FieldElement{0x5c2c47ce5de9d73e, 0xc31a30eb9eefb7bb, 0x93563b3495dffd71, 0x038b9eb4b0fb2c3e}, FieldElement{0xf75268a5bcc84f0e, 0x229850897d44d44c, 0xd801bc522cb7e7bf, 0x071e5fea36f734a8}, FieldElement{0x4b8813bf8f65b639, 0x61094e7409d3c28c, 0x695be82ed6345ce8, 0x05bb8c0d883da8cd}},
+		{FieldElement{0x9e1b5ca22e0fa298, 0x244b18abf35dfeb4, 0x2ae29038d73a960d, 0x0634a2954265f660}, FieldElement{0x49455d7815874f07, 0x7ff997aa5acf6207, 0xf74e8611ba5d3a25, 0x072633d065c413e0}, FieldElement{0x98c62a6ef9ae36cc, 0xa37051e83aa94f63, 0x84e84421964f5bc0, 0x00a2c96c76651724}},
+		{FieldElement{0x1373a2ef4397f615, 0xbb9500a600de067e, 0x14f2bf3b7c63a729, 0x017be35d5d129c05}, FieldElement{0x23fb164aeae0cbdc, 0xa2a1df8969699562, 0x65a6025e3983b3b7, 0x02bbcf898462eb66}, FieldElement{0x999904f520ae1ee7, 0x4afcf68d96d62b6b, 0x8818257dbc99b21a, 0x058a702e194b65a2}},
+		{FieldElement{0x97602f1f0921b886, 0xbc2113534c3919f0, 0x163f8e31d3499edb, 0x0445e44ad6c1d051}, FieldElement{0x00bea76076c3c16a, 0x3b162f5c23ab725d, 0x1c61be9d82d0981b, 0x05b9d95819ae7afb}, FieldElement{0x0af7c84543bb7df2, 0xc93b1ca01ce080fa, 0x860e0091e25dde90, 0x02b164b4ca718ca1}},
+		{FieldElement{0x287f4c8783419b91, 0x757a99aa5b9d10ec, 0x1a1d7f0d21089e9e, 0x068f83b61d980b9f}, FieldElement{0x6f7bddbc9b7fe1c8, 0x2aa8a13722c244f6, 0x77b9d9d17ed19d9d, 0x00f84373564ba71b}, FieldElement{0x1ccaff94b033811d, 0x81157877f380141c, 0x0694aa7f4a4818f1, 0x06e1668ae0565a8e}},
+		{FieldElement{0x8103749581cb838c, 0xd66d23d9d5a1af3b, 0x748f6a9b2d6d3fa0, 0x0702325cdcafc398}, FieldElement{0xd358797925fe642b, 0xd00467ff2ab40a9e, 0xdd71e5857986e57d, 0x047e7b04799ed61d}, FieldElement{0x6807bbb1e2e0a662, 0xc9813a521eb198e3, 0x1083ae0f00c8529a, 0x069de3a4dfefd96b}},
+		{FieldElement{0x4a297bae0929f78b, 0x059b905b0576de16, 0x3e0030fb4d3d7973, 0x077cad8c5ed5830f}, FieldElement{0x7e3e671c2d80556b, 0x26256e8bc7ea709e, 0x1dca2c9275fe262f, 0x03c2c61b5b14c744}, FieldElement{0x634bf6939269e328, 0x6cd2d6fdd6b52179, 0xae028a377562d26a, 0x026af5c276c73e7e}},
+		{FieldElement{0xa4acfc247a319cda, 0x706e685bdcda7441, 0x23b7809f27f8bd88, 0x07da3633dedb3bf4}, FieldElement{0xf372c42c0c5aeb76, 0x8b04003231314d18, 0xf5636d06f04785cc, 0x0423128d4fe1eb48}, FieldElement{0xffba504807c1bd3e, 0xd4a5a0198a268cf5, 0xe10cdeb60329eb58, 0x06076675dc27cbd3}},
+	},
+	{
+		{FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}},
+		{FieldElement{0x9da0953a5d3334c1, 0xc91dfa1a5f3fb1c9, 0x86fb5ff218c5a16b, 0x01ed57973499a464}, FieldElement{0xebf4c73643a9aa87, 0x6d1b6bb75bd0aa48, 0xa42823814b66f9a7, 0x055f5708ff1fa879}, FieldElement{0x7a80b913347ccf6f, 0xc2647427b835a926, 0xadbd3f48757c2ba6, 0x07c670e64f14bddd}},
+		{FieldElement{0xe515beca52e92f62, 0x9f03785cb1685687, 0x93785b1576267bb1, 0x00b32c2807943eab}, FieldElement{0x492060e774107fba, 0xa62273c72f37edcd, 0x35bdced070ba1c0d, 0x0375cdde465ce228}, FieldElement{0xc8a441ee91381996, 0x438e9c499ef79691, 0x732124b0ea645490, 0x078c5066e0d5eff6}},
+		{FieldElement{0x2492f1da802588ec, 0xd977eb2f52fc031d, 0x58eb5190e612f1dd, 0x02a55afd4cdd801e}, FieldElement{0x9735ac86afbd9704, 0xe9f1be73cf3a0df4, 0xb57f47da1d47417b, 0x0277ccc0a9e68d18}, FieldElement{0x2e9af5f46d2e6578, 0x63242130528bb2cb, 0x00833e97e90a764b, 0x02b2cb8ad1fa585f}},
+		{FieldElement{0x59b19257c1704f74, 0x4d8f575169fdc6f6, 0xfe3552fa516a78c0, 0x075993c88b7e11c5}, FieldElement{0xdf5819b57624b87b, 0x33c9470ef7aaa8ec, 0x679aa93ead9e74ea, 0x03e0e816dea5c552}, FieldElement{0x3db15d19cdcada73, 0xad86f80cf65d0d09, 0xd6bc63bab1c296f9, 0x033a253b3ab59d54}},
+		{FieldElement{0x81e9dd7cd8466a56, 0xb33ccd0a6d12fcb6, 0x15d6d11402f92521, 0x012ac26eccf4213d}, FieldElement{0xb35b9aac3a449edd, 0x36efe78d9aaff9a8, 0xd87bd73a51e2ee02, 0x0650bb3d17804a53}, FieldElement{0x4b506e195a7e81da, 0x9e8a14dc19d11b35, 0xb62b5b033a1d9e3d, 0x02150e7452a7f415}},
+		{FieldElement{0xfa9e7825149f10ea, 0xfe71457a44dbaf68, 0x61d4d2a227126ce8, 0x05d5b170c6dec733}, FieldElement{0x50471c4afa07aba4, 0xc3d899efa22762ab, 0xf1ce90ad9c088e77, 0x01a9210d88056b99}, FieldElement{0x726f9e1bba6faa8a, 0x8a929881458b7f45, 0x3469ce24dc59a499, 0x072089845dcf4653}},
+		{FieldElement{0xd1af8acd70188e60, 0x6839942e6b816c05, 0x0dc6676186d609c6, 0x04f7b1cacdb5accf}, FieldElement{0x8dc6e24773900e23, 0xc8c92f89d2f54b84, 0xa5ba6f92a1f23ff8, 0x06717a3f60211a18}, FieldElement{0x9fcca02e0ce3f07f, 0x4d4e03e4c40b8bc1, 0x4e0aef50443124ba, 0x05b984c818d1a102}},
+		{FieldElement{0x899a45e9702d633c, 0x17fc079991537528, 0xa3cc9e5aa032b740, 0x042babcc8158e860}, FieldElement{0x445e0cc20070e577, 0x2d7ac19ab1304b68, 0xb5369dae466142d9, 0x06b1a3de4a8efb4b}, FieldElement{0xb9b58960d935a44a, 0xdbd5d7139d2ca3e7, 0x0357723faa864645, 0x01a8bd7f219df7e9}},
+		{FieldElement{0x89c00b1ddb4fa937, 0x4a8922993216564a, 0x56589ed4cd24e3d7, 0x04cbb5a7c0be9510}, FieldElement{0xaba5236a399b78ed, 0x5c2c98129fa6c404, 0xe909e14cfe09221d, 0x046bea83634a4f93}, FieldElement{0x36d9cc5a66e959fe, 0xfc49b7d5dcf8e114, 0x9088bdabf10e2f7b, 0x00527e75f0cebfe9}},
+		{FieldElement{0xbc1136f9a33b4a59, 0x910657520733334e, 0xe886bd3a61092a21, 0x063bca670b34ab89}, FieldElement{0xb56754fb2076c67f, 0xaf992899f30a6fac, 0xb458bdbeaca8c79b, 0x04691ec12110ffcd}, FieldElement{0x42649c38caaf0ebb, 0x02395a01fca578ab, 0xa823a44a715488fb, 0x04cd071390d50266}},
+		{FieldElement{0x610cfcdad420efbb, 0x12b80ff628b04f81, 0x7fe524578dc31d68, 0x0110a70aaba78c9d}, FieldElement{0x119340e49e6879b4, 0x602a107d0d0b759d, 0x6857453feeead29c, 0x067f8c193dd1718c}, FieldElement{0x0c6b00a30283acbb, 0x91690eba38133608, 0xedeca375d81841ae, 0x050616198da70a82}},
+		{FieldElement{0x300093c745ad15d1, 0x530307a7805901ca, 0x745667b52f37b4e8, 0x066e5b48502d975c}, FieldElement{0xeda2db51887674d1, 0x84b4416a077b35f4, 0x0ce521b73ef6d0a1, 0x05e0ca5056b24fc8}, FieldElement{0x47141aea12a846ed, 0x49f6600f5df3fc06, 0x0392f8b8b392f5b1, 0x035e07601e47e874}},
+		{FieldElement{0xffbbf051ac55be2d, 0xcd2407b05e76c73d, 0xd9e4b7e48c099f2b, 0x0299cbe676104d5a}, FieldElement{0xd1f16f1845ea9774, 0x8b7881c62c9f1b06, 0x2870af2907667499, 0x00fc420b760ec370}, FieldElement{0xb34dc22c9661ee8f, 0x2dc1987c6edcc005, 0xc83612ce04324a31, 0x01bd61c7be52b664}},
+		{FieldElement{0x03b3a9e04ad970b3, 0xe5320e81d6886fb8, 0x5572d9ac54aeae89, 0x003b7c5e82951f33}, FieldElement{0x886e451abc37d0fc, 0x82ec482f5b2249ed, 0x69c9a58088a75fbe, 0x039171c991dfe1ae}, FieldElement{0x6422390f93053c3e, 0x378b13a93b74fad5, 0xa598c8cb74b7fcc2, 0x00967f93d50bf705}},
+		{FieldElement{0x2da910d36bde7a28, 0x78beb2f5cabd1d27, 0x7b6994a9f0c9aab6, 0x018a74cc20c4baa2}, FieldElement{0xdc8a95bfc164a8f9, 0xb5042abbdab3ebc2, 0x795e88a9d509361b, 0x065c56b7028ccd07}, FieldElement{0x038198aae0b3cdea, 0x89123e2890dab435, 0x8dfe24cec4547539, 0x070b77f82d4f0982}},
+	},
+	{
+		{FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}},
+		{FieldElement{0xdb9b314d998702f2, 0x8427d7287a3478be, 0xd3bbe0073f99881f, 0x00e5ea5d90bd3230}, FieldElement{0xa1207a091aad6485, 0x70bcf865de1538ca, 0x9cd71dee3541702f, 0x02563127ba00f4ca}, FieldElement{0x86d526ac50e355eb, 0x0b4614b7fa5dbf7a, 0x0d0d26c2410f5f9f, 0x04dcace784c153a1}},
+		{FieldElement{0xf4c64be061dd0c84, 0x638303657e6e58f2, 0x2ff578af618686bf, 0x04c1b44c12835e74}, FieldElement{0xd4f64d64509a0138, 0x6506c1f77df9e756, 0x687e092eb54ceda7, 0x01c7268092d12256}, FieldElement{0xdbf4e2818dc48757, 0xdfb790c919e506b1, 0x2a4e76fc61f58f42, 0x030990e90e252e04}},
+		{FieldElement{0xb9c2820da6f990fd, 0x7f7221e654c71ac7, 0x2f1b8584c1d0434f, 0x00627272be340739}, FieldElement{0x18fe95c0bdab5d7e, 0xc19641c2134a9847, 0x47d35f81981d68f4, 0x071280d6530ead0f}, FieldElement{0xc8e03d4f7ef20473, 0x2ced538b94865eeb, 0x64ff17ad862ab44b, 0x037791171afe7e3f}},
+		{FieldElement{0xd644a5420c41115c, 0xd01b414cd09e091c, 0xf95d2f8c8ec6a219, 0x06ea85c90e495b70}, FieldElement{0xfd6f28a1022f87ef, 0x15a286b32fb69c2f, 0x11a1b7f9850faf57, 0x05d30cd722344e5c}, FieldElement{0x3f2a4b72b4cb7a97, 0xfa00e7f0e4f7e388, 0xa931e6c837af76ae, 0x07154e79f2e82bef}},
+		{FieldElement{0x01d9ef388d7bebe5, 0xccfc2ef1226e382d, 0x944bbbfeceb90f2b, 0x01d820491d154523}, FieldElement{0xc1d5c40cc02cb5ba, 0x982bc1c2bbc8380c, 0xb8413f93f5a745a2, 0x04fb7c8f4323f14a}, FieldElement{0x9c88a7e508bb2a05, 0xe589adb5dae74780, 0x416f9079192237f3, 0x044a61850636890a}},
+		{FieldElement{0x9e7d608920342c65, 0xe0aaf491a9842f26, 0x08b6a6e049e5a5bc, 0x0018a6dd5093bc9a}, FieldElement{0x2989438263be4c70, 0x41a7b9c24c83376d, 0x6d7c3b42ef731164, 0x02786409166a1610}, FieldElement{0xf7eac771d19955db, 0x614a673aeea419a3, 0xd87808600a48052e, 0x02cb117df63e6d20}},
+		{FieldElement{0xd0902492bdccc348, 0xf72a57fe63312662, 0xd06a477c7f40adae, 0x036cd59f60f0774c}, FieldElement{0xdbb2ccc9124027cc, 0xb7db1d8094b76fcc, 0xb0720c98f1894411, 0x0542af2694082dc9}, FieldElement{0x7f29ed1b8a2aed03, 0xc4fca1873f8430b7, 0x9c47ef1558cfb4b7, 0x00aedc50a13aa507}},
+		{FieldElement{0x58fa3553635c2490, 0x554c3006c33e3f3d, 0xbdad17ddb7664b71, 0x025a3ca66b07411f}, FieldElement{0x2476e631eda06b09, 0x0b95db750bef612f, 0x9b7a23ff37e56481, 0x07c4335ea0b27b2d}, FieldElement{0x087d949b1b206877, 0x31a012a7415d4e62, 0xa14e07cecf15a1a1, 0x045406a9e6746cc8}},
+		{FieldElement{0x5ae982a5af2fd49e, 0x41508e3d1d7d87f7, 0x7c30e6ff47c3bcc0, 0x008eb3bedb8d1929}, FieldElement{0xf86094f49dbe8cb0, 0x8c177e5ba3813116, 0xa2e49aa76b4219d4, 0x062b28df95157f58}, FieldElement{0xb51fd409a2bcb12b, 0xe7712bfaca42d8ad, 0x9bd8387e9204b5cf, 0x02f16b131e59dbf2}},
+		{FieldElement{0x267cdc8a5a997387, 0x56e686a4d9a5caf8, 0x2798b524de6044c6, 0x01ff8170f85b63f5}, FieldElement{0xcf856b17e7caf552, 0x0897b6bfee14a706, 0x22d9be36b60e03c5, 0x015af0a2dbb8ed5b}, FieldElement{0xd0a517662bcd2663, 0x6bfd48c00b05f94b, 0x0d17dd0c1b426c98, 0x0512bfe86315a058}},
+		{FieldElement{0x8a9a45219cceeb46, 0x28ef36732b78a36e, 0xd63a09f938a49b52, 0x066b0f098b15f95f}, FieldElement{0x6174771c0cf91182, 0x9e7b5e43d346d6fc, 0xba1113e07be078e1, 0x03a6c4af42e819b6}, FieldElement{0x088625f5fbfc4174, 0x5aae2c5820fb09d5, 0x43ff8d8c867182f7, 0x068457ea36d6392b}},
+		{FieldElement{0xd5ccce27d89b9979, 0xd74fe04fe88ecac3, 0x37a99d1f43f3743b, 0x03113335ad92e94b}, FieldElement{0xe570a351878d90bf, 0x05f67236cffa21a2, 0x6c526b75fa1fddcc, 0x0646d35db3c1810c}, FieldElement{0x2d7ea0e9048c8860, 0xb62d8a557f477b03, 0xa56d4fd4b66ba4fc, 0x05dfc619e454f37a}},
+		{FieldElement{0xb4e4ae73f6bfabdb, 0x016ca033bfbe256a, 0x7a14620f96d667fd, 0x017b584fff622b6b}, FieldElement{0xae060d025f00e357, 0x09187097530f5838, 0x8436bed151ff44e9, 0x031df28eb5b25d6d}, FieldElement{0xbb28daf12bd0c31c, 0x99d1fdcd108889b9, 0x513af09b98c4a35a, 0x05db27bdaf2a019b}},
+		{FieldElement{0x1136830b39d7d00e, 0x85d148f387f803ed, 0x799d33c9480752ee, 0x04f4444c54bc433f}, FieldElement{0xe7b88def5b9c122b, 0xe3ad900f9d3362d1, 0x07412eae800513db, 0x030acd62cc2b8ab5}, FieldElement{0x8e9370de54b8b37e, 0x8e089046309061b2, 0x9cd4ce760530a19c, 0x04a93de292e92242}},
+		{FieldElement{0x6863464d528d05ef, 0x1a0c3dc0cd9ca2b8, 0xd3f727fbf40e91a1, 0x00ff389f58b52841}, FieldElement{0xdea674e4e4bd0b52, 0xa5004fd172020acd, 0xe50e744281d67a91, 0x055f0932555ba1e4}, FieldElement{0xb9bb2ac7257dac15, 0x9eecc0246447ed85, 0xe0934f4504ba12b4, 0x02884234534d05d8}},
+	},
+	{
+		{FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}},
+		{FieldElement{0x9b5ade73130bc4bf, 0xc640160bbb077dbc, 0x76dfd11a16d9158e, 0x0052ba085c7c0de8}, FieldElement{0x7cb2592ac5d5d44b, 0x61095224abfe7e24, 0xc7c2ab2be1ce2f4d, 0x05cac80111d0c3ae}, FieldElement{0x38f1d69ead63d1a1, 0xcc7de34b9a314715, 0x4796d343a1e41da2, 0x052905fbdeef64ac}},
+		{FieldElement{0x4fac18fdaac6bb6b, 0x5c54a7c2264b1a08, 0x025dbf452234fb8f, 0x037070f71621c671}, FieldElement{0xf4b05a0ad6dba2c9, 0x90201c67094c9272, 0xdb6b504864ae5200, 0x067704e0c6aba9cc}, FieldElement{0xf072b7a1df7fc9ea, 0x4589a489463f8414, 0x24f88b234132988e, 0x02c51e7a1a7c6eff}},
+		{FieldElement{0xee871a69d21e6081, 0x479bcc438cd3f3dd, 0xb8b5a74bda020931, 0x06f68386fc30abbc}, FieldElement{0xbb3575f7bad68c5c, 0xd08dee65ae942082, 0x16d41f71c6c06c63, 0x00902b00bd9796fa}, FieldElement{0x12e07b1803dc32b5, 0x99742f68eae90114, 0x0a6044c3fdd7613d, 0x05e6229fac822ba9}},
+		{FieldElement{0x1a6fbbe9b034f4de, 0xda646dc9448ac942, 0x1df4d3d023d5830e, 0x001fe1da03f1840f}, FieldElement{0xaf1cb7639f37e207, 0x0a55b378ab0e1bb8, 0x574420ec066609f3, 0x04f4f183fd19c6ae}, FieldElement{0x63acf2a12eed2553, 0x1fd4c7cdd38af255, 0xb0ac995b4457cde1, 0x02830cdcfb54b974}},
+		{FieldElement{0x9aa3835b968a59b7, 0xcd1dcd9440d6b501, 0xf16934c2595e1162, 0x0314b39388486865}, FieldElement{0x29edf6f58f200c90, 0x869ef6f3bd62f982, 0xbec6ba8cf1d254b5, 0x0696fc3c12f7cb39}, FieldElement{0x736986ca5f6813b1, 0xb8fb2abaf1a0f468, 0x5368481be32cfc57, 0x04a8ef8a8a0dd377}},
+		{FieldElement{0x9108b4420b48a9ab, 0xb2399db519d3737b, 0xf35a7a2f4bdffe43, 0x01d3ca3fca1eee04}, FieldElement{0xcf19812929dd1132, 0xe3f48315963b81d0, 0x75848168ec57b293, 0x05e770a52127f9c8}, FieldElement{0xd52973eeb29eeb0e, 0xbf7a376d849321f4, 0x352a047b87fa1020, 0x021b8b2ed197e228}},
+		{FieldElement{0x5fb1ff1722fd3dd2, 0x41c20ca66ee327f5, 0xa04f68451aab9ebc, 0x05208396e11012a0}, FieldElement{0xd4bf0ad7c1dd66cd, 0xd193f755353ceaa4, 0x1a4f3a37f798378e, 0x03300231827c251e}, FieldElement{0x1557f97f8b894a05, 0x2cbb7df0ee47f388, 0x94a0e33c8183ebb0, 0x0674396687d7bae6}},
+		{FieldElement{0x441dbb9746025c89, 0x6fbdb6eb6fc76647, 0xcb150f72743cf510, 0x071bc740e2b2cba1}, FieldElement{0x5009b91b82c825e5, 0xde30af9bc716959f, 0xa44fcb37cda45c10, 0x02c82f71faf5ba1a}, FieldElement{0xf230b448eabe5c27, 0xd6834738d099eeb0, 0x2588fe281c459594, 0x07e52d7d6bbb38bc}},
+		{FieldElement{0x12efed6a4504b2a0, 0xcfc56c866368e276, 0x8402ca2413826c62, 0x022f21e6e80450e4}, FieldElement{0x2bc5b92cc504aea6, 0x6ba11c9dfa19dcf8, 0xd1197a888926622a, 0x05536f38096db978}, FieldElement{0x41020bba924b0149, 0xab451398490438c4, 0x3e7e62d26f2c779c, 0x07143e24860d95ad}},
+		{FieldElement{0x6b50403a60068b0a, 0x37c185f246204d57, 0xed868ccfab62a7e3, 0x00b80412c107051f}, FieldElement{0x08ef82bb3f327fcf, 0xd6040dbb0c4166ff, 0x08323b278c820538, 0x02bbb3c6fe7174c1}, FieldElement{0xc4409911838e46d6, 0x5743512194b42b21, 0xee377b92aec2bec7, 0x06de1185aa25a94a}},
+		{FieldElement{0x286e5eb80d21f474, 0x5ad2d5c8e43c6d3c, 0xde828e35e220d593, 0x0153aa91876c2881}, FieldElement{0x79973bf5ee16f6da, 0x540ec091f9adbdcf, 0x9513467ebc2fff18, 0x02ab3179013ee0dd}, FieldElement{0x0dc062fb67271a81, 0x6045dda358742ca2, 0x2f202e3ad2aa6710, 0x00bb54c89da7fa2f}},
+		{FieldElement{0x4e76b246b31fb64f, 0xb8d9983b87e89140, 0x5eb07b517be20a37, 0x007f7264659335a8}, FieldElement{0xb4b2535833ad3ccd, 0x57722d6abbc51998, 0xe6aac90bb8abce1c, 0x02fe1ba1015b9199}, FieldElement{0x1308e9d2b0af60a5, 0x9dda681ff63e7906, 0x4f261ed3c6ce93f2, 0x00edccc35078bf56}},
+		{FieldElement{0x39df3e5365641592, 0x2561d083f49151f7, 0x2d704c2188f8f9cf, 0x01ab4956739b5279}, FieldElement{0x815e28b898ceb6b1, 0x4343502be2d7d960, 0x7cd7172a103afbad, 0x03808335df933719}, FieldElement{0x3ec480a105ee060a, 0xe6f9cffe9f4e7bbb, 0xdffbd103ce2c9814, 0x027597e20da2d16a}},
+		{FieldElement{0xfd88a1ffd8097d12, 0x8316c5b3408fb37e, 0x04dfc5eaa8d77919, 0x01308b2b15775e90}, FieldElement{0xce7e3356fdf6bda1, 0x7b17a86ca731d839, 0x59eae4860ebd28b1, 0x0335b325750da148}, FieldElement{0xd83458251e131f88, 0xce721bd2b7a1031b, 0x35f0326c7c7631f1, 0x0077ecd8a842c10e}},
+		{FieldElement{0x9e43cd23c3405b28, 0xf430588bb813379e, 0x924b174688eb8cfc, 0x0293ab44d1ed6067}, FieldElement{0xe2d5558f732e464d, 0x971889047622d8f3, 0x365c364dd3e4cfac, 0x02652d09ee9c9e64}, FieldElement{0x4091dfe3e668c09c, 0x198a1a8bdda408e2, 0xcd274f993340fd91, 0x0526eb9056bb5bfe}},
+	},
+	{
+		{FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}},
+		{FieldElement{0x9949c5977d8e6f80, 0x3a77da8c3b7df318, 0xee4ce8ad30eaa918, 0x02820f9fac14b1ee}, FieldElement{0x97e2c817a7297f92, 0x540c5c412589771f, 0x4e37e1b4e7b8f16b, 0x06d06e583d719c20}, FieldElement{0x76943d882b79f799, 0x5f1d31fb840a3ce2, 0x01bd45d474ffb9e2, 0x04fd1a11d79fcdd3}},
+		{FieldElement{0x8cff2658e1f27bed, 0x812f378beda94d46, 0xc2cd6be88794b0c7, 0x061585b6a5e8e60c}, FieldElement{0xe1a44ab806178a11, 0x832fdab6ee0d6156, 0x6235fd3a51b2645f, 0x0052c08588eaa581}, FieldElement{0x14472413a6d6276d, 0x28c23cb1a349d630, 0x030f6e6ed023a6a2, 0x022037afcfd9f8e8}},
+		{FieldElement{0x38be0ac74d0ea74d, 0xd7078e649374f18b, 0x79bb9d641c99cd21, 0x03188905215eb04c}, FieldElement{0xa1ac391a7162909d, 0x7c16f6c754d8cd85, 0x530b0b3b947575f6, 0x05b8778527afaaa6}, FieldElement{0x1ce8296441352ab6, 0x55a9780469715bbc, 0xf075efe95fc5a081, 0x0594a398d421f98c}},
+		{FieldElement{0xda6643342e1c514f, 0x1c5616548344442c, 0x11f042cce529a896, 0x067bee8700e07891}, FieldElement{0x6c14116f4256a271, 0xfac8bbbdb0b9a473, 0xa265dccb792182fc, 0x04fc31ab3da0147e}, FieldElement{0x1e5d460ec8c9ccf5, 0xa67c38c3d942b63c, 0xb7203572dd83424f, 0x06367248296fe1d1}},
+		{FieldElement{0xe33c5c71ef156db1, 0xfd7f6bec994fdc8d, 0x49e52499cce1324c, 0x004da22bfade1071}, FieldElement{0x5895b82c7eab6d9d, 0x1c06b6a628e0adc9, 0x148c96e04d9651c9, 0x016f299f586618f4}, FieldElement{0x50afcd03325280a7, 0x978d86e94ec5c6d5, 0x210a68d1066b82dc, 0x01ce52376e8423ee}},
+		{FieldElement{0xd025b89d1f222521, 0xb6c124a6ab93adde, 0xed5e320eeb4b3b3a, 0x038a77bce0c2ddd6}, FieldElement{0x2e24a048f54f36bd, 0x2d08ea0819d8b6c1, 0x6787eced2e0b5b10, 0x06dab39484b0b400}, FieldElement{0x883a35a78b19efa7, 0xaf5fcd5f056baba6, 0x7b9cbc98b621dfff, 0x040ea9340bd8940a}},
+		{FieldElement{0x0e5782352decf63b, 0xfd9bdbd929885de7, 0x07c8b978299dcb37, 0x0263b4c79a3540c5}, FieldElement{0x2c2bb6e107fe974c, 0x7439246a3c839abc, 0x3abc7ad4fe4cff55, 0x0187f8786f5d89a5}, FieldElement{0x84e92bab18fe0e52, 0xbc13f74f0988e00b, 0x63bd3bfe3c718bc5, 0x01d013d0ea387079}},
+		{FieldElement{0x8c4f77f98f66d275, 0x9e7d5920f037abfe, 0x8b84334a3a4ab32a, 0x0452dbd0c2dfe5ad}, FieldElement{0x3fa16a576d805d54, 0x0590d3da13e59d0a, 0x510ed5347b734d21, 0x01910b2564f3fb89}, FieldElement{0xd2a69d835df757a5, 0xf97de6818510c641, 0x743b3cb2d73d6927, 0x01aded7b765f617a}},
+		{FieldElement{0x608bb978e526db76, 0x0e19cbac4b2e1d0a, 0x24aa66787b537ae9, 0x0578951baf79cae8}, FieldElement{0xbd1305d82541d5ff, 0x1ad76c8279d36e82, 0x036e1716376e86df, 0x00cc1e6608e33c38}, FieldElement{0x9fcb909b56f3763e, 0x11520df50ede951f, 0x45b04c3f84c07df1, 0x01364ee9a44615ec}},
+		{FieldElement{0x58d31107dc0b8474, 0xdb062ec03b98f377, 0x3954381b6a88d945, 0x05f144e693bb81de}, FieldElement{0x8381235b6e9178aa, 0x80af09afa7557e05, 0xe53936430bfe05a4, 0x06c011068e7020d1}, FieldElement{0x50319a16eabef69f, 0x01219d101eafea60, 0x7470621632699fd5, 0x01078676cdc80a27}},
+		{FieldElement{0xde76ffcd11de3b59, 0x7135b624e1aa830e, 0x97f9606ff73c44ac, 0x0576e9eac8e97fa9}, FieldElement{0x455cd487227077b2, 0x67a57cfd91e59920, 0xad8fdd8aa0b2372a, 0x003cb465038b8fff}, FieldElement{0x0e16011fa6bf57ca, 0x6067de1ea0392e07, 0xf4c58368be312176, 0x07e04851374ae69b}},
+		{FieldElement{0x1e55d6ef68f86973, 0x95c6fe8a14fe3217, 0x9b7c61f07a7cd97d, 0x06ffad82732b455d}, FieldElement{0x556332718c1270f0, 0xfd151fd70eecad6a, 0x8e7495e608539d8c, 0x04c064405d6836e9}, FieldElement{0xfc57b06b0907140d, 0x5aea235118245250, 0xe3625dea8587be98, 0x05464925b54dd4eb}},
+		{FieldElement{0xa358cad07dec158b, 0x3a00b44da1214e55, 0xf6a6721073049661, 0x04a5ff095859b0cc}, FieldElement{0x695b65fda9102103, 0xe2abee49ef7e07d0, 0x8fc4ebf3f6c80e0e, 0x027989a344756122}, FieldElement{0x6d1e9d64e101b81a, 0x663a7aaa9137076d, 0x7419c452c74a43db, 0x06b99a80ae33d84b}},
+		{FieldElement{0x5ae95b1f13250f7f, 0x5251d551c5f9211a, 0x9eaa8b8e6d537456, 0x02cf2dfb8aecb56e}, FieldElement{0x657ff5de1f2badbf, 0x07593a0943ac42d3, 0x9cdd0f2d448b2cb5, 0x07a290af28045cd1}, FieldElement{0x4f082c588d54c182, 0x8898fe09a33ceb4a, 0xa0f71605660e1cd3, 0x0102f08c198188a4}},
+		{FieldElement{0x5d0b28a432eb74a3, 0x71f244a7434ca1e9, 0xc24721975919787f, 0x00eb64ba4bc8c0b1}, FieldElement{0x637e6cfd557e0cc1, 0xd457b989ea672373, 0x8079f0520177f22b, 0x03740e45bdd7436d}, FieldElement{0x4dfd610dd1f90ad7, 0xf3c63741f6ea863e, 0x6d38cbc8bfd1af2c, 0x062071034cf60467}},
+	},
+	{
+		{FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}},
+		{FieldElement{0x3478fed460f54cde, 0xc9df125edcecc815, 0x194b424095bad97f, 0x06c3799dd45f67b3}, FieldElement{0xbce36959e5fb58d3, 0x5c33944687ab49cc, 0x2ce07ffbbb1d2ed9, 0x0176e3962145f472}, FieldElement{0x905474abd0af257b, 0x83f46d313ba1fff1, 0xa62b03d69efb99a2, 0x03822ffa1aa39e3d}},
+		{FieldElement{0xf14a2e3ce8fde771, 0xc28395db48bae0a6, 0xf0a1e72862f1ed63, 0x00f896c291503242}, FieldElement{0x93bb69ecd6feafeb, 0x40ca041eb34f9cd5, 0xb84a58ed91cea81f, 0x063ee842802e2c6a}, FieldElement{0x9ddfa3e9be16126b, 0xf88ce1c4f0e126cf, 0x4cded90fe75bb484, 0x001f238228e71a8b}},
+		{FieldElement{0x37f49e7bdc80f3a9, 0x77d09244b3e3ee40, 0xdb50061513aa3b23, 0x0315d69c7f2ba993}, FieldElement{0xb46edb2c514b4e2f, 0x8dee4151e5746841, 0xd070f4a28c37d7e9, 0x017d0c70d4b895a1}, FieldElement{0x2432fe6b27e8c583, 0xf45407256df5c0bf, 0xf494887484373db5, 0x0210e3e18d4af891}},
+		{FieldElement{0x89bb978959f9890c, 0x86853c0382725694, 0x19642f48570455e2, 0x06e03466af75a020}, FieldElement{0xbd5323853aa22a33, 0xc195a43428dd18b9, 0xe720bd530e3cfc63, 0x07073fac512f511c}, FieldElement{0xcdf853eabca09be3, 0x54bd1f4bbf342204, 0x12c5352f43e177b2, 0x02cd552ad8d66ea2}},
+		{FieldElement{0x9fc41fe95eb21aec, 0x5559c805271932c4, 0x720534c3f91f8403, 0x050f99938f1402aa}, FieldElement{0x1fb3060625b0f452, 0xdbef17951ec55be9, 0x25e13c4898ef5a9a, 0x044b3d83b9200ec4}, FieldElement{0x3a1a9534ec5a6922, 0xa53813482ec02995, 0xb05d8d38e956c5fa, 0x00fffc34d6dfb4fe}},
+		{FieldElement{0x151b64a762ad37c9, 0xc82b265c465f09f5, 0xd722de26a93c21e7, 0x05629f57a4a2df8b}, FieldElement{0xc733f726a22d7ba7, 0x829269aa4a4d90d3, 0xf733b566d9bb8ac4, 0x01f76a16a0c09bb9}, FieldElement{0x93cfc02560d5aec9, 0xd9652a3a5ad05664, 0x85e00bbb10dc2a25, 0x00099e71b3f1fbe6}},
+		{FieldElement{0xc5115dbc7413393b, 0xa3d094ceee555f0d, 0x25340335e9ace19e, 0x079100a8c4573c3a}, FieldElement{0x7741b71c91cbaadb, 0xe29c85148f654cbe, 0x3b9e29de7e086129, 0x05df35c7182dd1aa}, FieldElement{0xa77aa7629d6c2724, 0xbc049dae3c75617d, 0x7b0e4f0a44980e90, 0x04b8103193081f69}},
+		{FieldElement{0xb5163a3dd439c1e7, 0x1199137c8c6803d8, 0xb3da79f3385fa6dd, 0x03f138a97562ceeb}, FieldElement{0x5e5c9c14df820d74, 0xfbb54ac6108e00d1, 0x6f1fe8b2b61699c8, 0x00598db83c639acf}, FieldElement{0x088dfc8f1537003b, 0x736dcb6b7627836a, 0xabea65f86f518b03, 0x021375f8349e61bd}},
+		{FieldElement{0x38a76b42727dbbc7, 0x1ca1b8e5097fbd6e, 0x7ec9e299a7e78d9e, 0x0530c327c94cc840}, FieldElement{0x51c900d1efc9359d, 0x55ce2561f61295e3, 0x5a00dd2a70d549f7, 0x06b6522445c67169}, FieldElement{0x131659b16bafa62c, 0x83747243027324f4, 0xc922b395c67a2d16, 0x0081f85a21525384}},
+		{FieldElement{0xadce046b70ace184, 0x6b7e12eb1520c44c, 0x1a93a310e278f3a9, 0x03fff8bb92c8bc12}, FieldElement{0x04fdd5cee197a5a9, 0x24eb7eb8ad9e376f, 0xf163864313478560, 0x057e33e3875eb2b6}, FieldElement{0x6898d57d4619e925, 0x37a12b3db74e0c01, 0xb4c9b9e2aece887e, 0x04cb3dd41b72508a}},
+		{FieldElement{0xb4fb569900ac081e, 0x3f5f7a6b77735945, 0xe500339bc1426f9e, 0x02a9da5a629ecf20}, FieldElement{0x82f99da82cf4115e, 0xd6eabd7decfc6d54, 0x3da8add759c34bb7, 0x0707b969acfaacde}, FieldElement{0xd4924337d8245d6f, 0xa8e5493093852ff8, 0x39efd016c2a8ffdb, 0x02d93657da8d2b43}},
+		{FieldElement{0x13b1117f1a0c7bed, 0xf4fefa2cbc381747, 0xae735ec75c7d0666, 0x0678d1c3d6ac745b}, FieldElement{0x8dda40a97e1377a2, 0x43245f9687953549, 0x48df27cebb1dbdc3, 0x00d8856400593554}, FieldElement{0xa0794298ebc32d1b, 0xd82a913022e648ef, 0x68c76f5e1b227ce0, 0x0670247ae4b38a39}},
+		{FieldElement{0x31f9487d3f4c36cf, 0x29cd7f93202dd641, 0xe8da00e9e43ce815, 0x05408282af0f942d}, FieldElement{0x058fd39259fab853, 0x321bb7fa912932a6, 0x96dda2b6a7a06e59, 0x07ab488a831c4d19}, FieldElement{0xff2a912138a0a257, 0x30c44b80f41d1b41, 0x8207c404ab214434, 0x006badda36e479bc}},
+		{FieldElement{0x4a9849164ae882a5, 0x67592fae834d4589, 0x4763cc0f62ff254c, 0x065f2a5e9bd6f81a}, FieldElement{0x58783ba847b737da, 0x2cb132c0ea27c689, 0x32ad79e9e9003fe5, 0x02d32f757f623526}, FieldElement{0x3b5926d77b39a4e3, 0x9acdceb7a7bd20a8, 0x0b87b451199f7cac, 0x06ee6a86232b364b}},
+		{FieldElement{0xee336d45ca6d21e3, 0x4047e911cd0cee4a, 0xc7142d46f183b1d7, 0x07d27a38d3cca6e3}, FieldElement{0x69293019a97ac030, 0xe2fd9f0a33c6b60f, 0x2cfb5696343e54c4, 0x071619f2014b4474}, FieldElement{0xa83531327819a5f7, 0x7667521aa96a8775, 0x4897dbd9b3aae95f, 0x00ef869e13944a2a}},
+	},
+	{
+		{FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}},
+		{FieldElement{0x759ed1fbef0f0c48, 0x8693a2a08417a7ac, 0x8e742241d69e9288, 0x03530fe3f856ae6a}, FieldElement{0xcd730c2a1111c1a3, 0x70068c55f1d7318c, 0xa71a3b52d31b5fbd, 0x07a23805334273bf}, FieldElement{0xcfc258473de9cdf9, 0x189416f65f98cfa1, 0xea8b9fd741886701, 0x06701cf7a7be6176}},
+		{FieldElement{0xfa267bf9931829e4, 0x1cca726b82d9f90f, 0xef6fef1a7b96dd61, 0x05ca3bc8db233916}, FieldElement{0xe0f192b46b97a60d, 0x261b3aed777182f1, 0xfe418858da8f572e, 0x04d619a6cce70437}, FieldElement{0xc66c7408ca2d1457, 0x6064d5bf56aba645, 0x0ea0f27f9dce6ec7, 0x0483a41184b40a2f}},
+		{FieldElement{0x6b8e4c0ced73c27c, 0x77c28e4c1c76abcf, 0x282230ffdbacecc8, 0x04e256a9fc94f7bf}, FieldElement{0xbae8c33ec29958f7, 0xd9e5a742525fbfa3, 0xe0f5eadfaaefe972, 0x0731400c40be43ab}, FieldElement{0xce5934b65008f9c9, 0x27cef613ad0f19ad, 0x99a6c13ee02b2627, 0x07c8183b7972b5dc}},
+		{FieldElement{0xe2ce5ad92b8c7136, 0x96285b02c81ca5e8, 0x9785ac83ecd9c3f8, 0x07b0a0a91902872a}, FieldElement{0xd2b49676502ec6a7, 0x4eaa80d6755762af, 0x6bc94d3c8e97ede1, 0x003558711affe7d9}, FieldElement{0x5c231fe84c9832ad, 0x1403e646aa0c3c9c, 0xeeb813a29412b697, 0x07478427c15e8ca4}},
+		{FieldElement{0x7c1bb3c54713d90e, 0x3076c30f49c0bbad, 0x40dfaad72f9d8a23, 0x035d62048bbdd74a}, FieldElement{0xa4fc0c27d4b6d1d7, 0x570bef380298328c, 0x6509f1573bfd9dde, 0x024d3e7df6a228d8}, FieldElement{0xee77e8dfc157b42e, 0x0d1b02980a72e853, 0xd09d9057b87c314e, 0x04b22d46c91e2589}},
+		{FieldElement{0x66cb7cf1b4d3feb0, 0xcbfba9c00e575f5f, 0x92fb60132c8ae4fe, 0x04e4f45c0a1e888f}, FieldElement{0x642091c64518700c, 0x1574e31e84c0fcf8, 0xb134e16e35e003a5, 0x01599acf3fab0991}, FieldElement{0x52fd9115df85eb00, 0x3bbcf945d0fd2d46, 0x4425675043f864e4, 0x02b116f8dd556d5b}},
+		{FieldElement{0x5b4db5e4b80b8274, 0x63ca6dab1bcfcdcd, 0xbb5893538930dc4d, 0x05c021f9185276fe}, FieldElement{0xc550f59c9cb3e85a, 0x977dc7286f8f7ae2, 0x79f0be152d612e42, 0x0274a6abd5960a73}, FieldElement{0xf9628669b782f07f, 0xe555c0e10e5a70d9, 0xfdd76f1a1b0b4be6, 0x05d9e9277d0bfa6e}},
+		{FieldElement{0x08267c4db591b789, 0xcf31876916ef193f, 0x5da4a629a3631c36, 0x06b2c77ef0ee0633}, FieldElement{0x617008102a7b58de, 0xc153dd92c32d796e, 0x7477b27ccbfee42f, 0x07f76b5077829af5}, FieldElement{0x5e47849dc7682cb8, 0x26f46f31221f933c, 0xa506c3ce2924fe54, 0x03a48f54e702aa05}},
+		{FieldElement{0x0c3588eefcb2d234, 0x99cd3a4a63d7c874, 0x6deb1b5806bec131, 0x02aa1469e82e682e}, FieldElement{0x931a453804acfd9d, 0x00c606e9cbb7b79a, 0xe62d91be133c125b, 0x039974fc3730cd28}, FieldElement{0x79906afc9fc7dd37, 0x886b314b86197140, 0xd13888ccbea0b270, 0x02b76970ea5ec017}},
+		{FieldElement{0x6a879685c4c5c2b3, 0xde855a9317120b42, 0x505910d08d271b22, 0x0100544a50127599}, FieldElement{0x0b8f405eb542c913, 0xdec5cf1a7849e610, 0xaaa0d945f5a3a09b, 0x02103961dd7c8d9a}, FieldElement{0xcf3a80c611e1ace0, 0x4704662c6deeb583, 0x277332cf73b38825, 0x04faa8ae5e7286f4}},
+		{FieldElement{0xfe3670cd95f40df7, 0x216cbaae7957f09e, 0xecd7b436e10623e0, 0x0086ab2826b5b176}, FieldElement{0x178fb9937e482bcb, 0xb51bb13fcb58a68d, 0xfad7362732a573d6, 0x06e9bff7676f5939}, FieldElement{0x671286696c0c4700, 0xad4a51a072467d6d, 0x2d217fb730f63edc, 0x02f2c34e4d23ee65}},
+		{FieldElement{0xc45f3a2d4ea051d3, 0x55dbbe620a3c7be0, 0x025a485a31224b0f, 0x033b25501b69c9ce}, FieldElement{0x52903144a24ff224, 0x24dc430a7e136351, 0xed0604e0869a09c5, 0x015a14f610483fb4}, FieldElement{0xc4ebf0c6898855f6, 0x225824ea42005082, 0x02b83179cf8284fa, 0x07fc99337b0b3b14}},
+		{FieldElement{0x9c98f9e56e812036, 0x1bdd354c1e05d0a6, 0x9d36e115fa587555, 0x0097b6a45064431f}, FieldElement{0x8353cf97b5605b07, 0x3c26c32d3dd88dbd, 0x34fb55ea9277ccd1, 0x00b3d7ffe64acaa1}, FieldElement{0xd7350cc0c4e95421, 0x17ab161f5e9312fe, 0x04bc13bee28a915a, 0x0510ed33712f366c}},
+		{FieldElement{0x7dce3adc62f9a8e2, 0xa513017b062bb699, 0xec294f7fff311608, 0x018dd12be71369ba}, FieldElement{0x3c58f44cb31a3c41, 0xac420c88f3b3eed3, 0xa0078d3eaa01d1c1, 0x03cbdef59af3d857}, FieldElement{0xdf4ed1634c77d206, 0x5138cb23828cced3, 0xc3ce33fdf7b0f561, 0x03ba96f981c4e67b}},
+		{FieldElement{0xe41c4bd2f915d351, 0xdc31fb6c9d09d6ca, 0xbb2578633f2321ba, 0x0363de7207f42781}, FieldElement{0x4851bfb01f1ac64a, 0xc0ccc6f154071ac3, 0x5dc7d97c572583f0, 0x00830f88484111f6}, FieldElement{0xfb48f7014482a9a2, 0x6be01d42a121baf5, 0xa692770caceed3d4, 0x02da032455e07b92}},
+	},
+	{
+		{FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}},
+		{FieldElement{0xc0c0da40c6798c1c, 0x1986a4c2c4c9aeed, 0xe4b0b4dc506cc43b, 0x06cf68bd44abbcf3}, FieldElement{0x5d2bcd8dd116d08e, 0xe4a6051e60c15042, 0xb5346f7562e7e87d, 0x027d86f0180fd57a}, FieldElement{0x85b2a9da93aba5d5, 0xc1aec35e0aa591db, 0xf1d0abc0836cacfe, 0x015f0c6aa45baed6}},
+		{FieldElement{0x79f32a188b712453, 0x5a1ddfaf2dc61a6e, 0xd155b73565794bdf, 0x04a3f787c204eba0}, FieldElement{0x29449c605c4c4e68, 0xc9ca159acbafba5a, 0x754801f1fb07963b, 0x065301fb193f1063}, FieldElement{0x3440fd46bce746e2, 0x0007f1ed315c66fc, 0xeba0cb4117b7a55f, 0x035918d0e6ada5e1}},
+		{FieldElement{0x20f63cc1f0419d07, 0x21ff96f2feebf967, 0x7baf802383749ba5, 0x0498e7ec8612a19e}, FieldElement{0x3fb6c5e3c74eb8fd, 0x7dc29750411e2823, 0x1ac5e2139964c20f, 0x0723a5bf0979818b}, FieldElement{0xfefacd48e4e5186f, 0x33eb9f70de0afd4b, 0x6d251cc1f8c33a39, 0x06dfc5ef87374d34}},
+		{FieldElement{0x7bccfa83120cd900, 0x3824a96d8848a5c3, 0x49020e9f982f38c8, 0x0432a91831b7ef76}, FieldElement{0x6527ed5b0058219e, 0xd458146fa8f4c798, 0x5c766b23e051ecf7, 0x05466c842d40748b}, FieldElement{0xd4ae1eeaa247f0ce, 0xe451a3d51c26e5da, 0xdebca836a5dbc28f, 0x0347a9e70f9b511a}},
+		{FieldElement{0xc58a8c85f12eb441, 0x4d2989262c42b1f4, 0x472b8eac2ab3d48c, 0x043bf12b5061d2f1}, FieldElement{0x33a1bd9dd2a3c645, 0x35db79dadb39f532, 0x0bbeb613d164b026, 0x0070012842dbda34}, FieldElement{0xc261ba1f7cae397f, 0x1f6fa0f42919ef5b, 0xfafef51e56a4e51a, 0x067fa983742bb17e}},
+		{FieldElement{0x32e2bb8b8d27944f, 0x07f27bcbbe9a8d4c, 0x58ef3b4bed6da10d, 0x062066e7f384d9ea}, FieldElement{0xfed127029529d096, 0xc23f94b802d166b8, 0x434283b2456ceac9, 0x0380c8b27355ad5c}, FieldElement{0x41e2eb0527353b04, 0xa296c1f04469e297, 0x59c0adc489b19325, 0x07899855c998d673}},
+		{FieldElement{0x5ade113bac421b65, 0xc9917fadcf4c2549, 0x732277723553c588, 0x0661a5208507515b}, FieldElement{0x1689de19a201d90c, 0x57201d56e7e877b8, 0x1350055b0b620068, 0x04861b7e0f51a41d}, FieldElement{0xab73c6cd3a6fb82c, 0x52559ab2f3645a97, 0x7eda1846d6f20f65, 0x002871cc5ba1ecb8}},
+		{FieldElement{0x5ab076864934c750, 0x8944827dac9faf51, 0xe63529eae57533e2, 0x01937e44ecfe88b3}, FieldElement{0xaed6f8e84d88dec4, 0x9ab0f7d0b0915a7b, 0xcd7fff91de09fd84, 0x00c59332ef095547}, FieldElement{0x01df1557080c6350, 0x380f91794248441d, 0x9e9d7792594fe1f5, 0x036d42562de2d8b8}},
+		{FieldElement{0x10e0cf3387bfeb29, 0x6eba4381bd51819e, 0xbd4cbc423953f4b4, 0x069430a5286b1f95}, FieldElement{0x837c157be639cf26, 0x44b52b2062259c80, 0xfe41fd4ab268be6e, 0x07ead84f066a3205}, FieldElement{0xaf7501260ff8eba5, 0x35a584cb555d849d, 0x9107b4bde6bf8978, 0x04751835623b1ddc}},
+		{FieldElement{0x92bc0eb1eac8867a, 0x0c49170678e22238, 0xf077c92e9ff2bde8, 0x079f20a89e701dcc}, FieldElement{0x972cde4be40dfab6, 0x655b6f59c53ff96d, 0x6ca3509f29b58051, 0x06f7a0068f70f306}, FieldElement{0xb4aad0d1b794c71b, 0x6f5eb857a04d1dc9, 0x197daf40fa2973c3, 0x002185ac92ae9db2}},
+		{FieldElement{0x3f61ebf6b6a4e784, 0x20ee34dd323dd300, 0xe3f387d30cf480d5, 0x068398cc4e8615a0}, FieldElement{0x5c01ddccdac97078, 0x66e570410016816b, 0xea77d4af50446625, 0x00a513dc0f31f47e}, FieldElement{0x2007966fc0152439, 0x506b73e784eebfc6, 0xb89b995b962d1348, 0x008f30459868fa8d}},
+		{FieldElement{0x5ad47783f82b616e, 0xc36039658c82f4ad, 0x1b4dcf0509d783d5, 0x06d149e78448807c}, FieldElement{0x5aaa309543d4aa9e, 0x72e774e41f89b01b, 0x528e41ea87b46ed7, 0x00c6f8ceee02868d}, FieldElement{0x339848654260676b, 0x8a8bd0d32cea82a3, 0x5419f530793f7013, 0x01298ed57723912a}},
+		{FieldElement{0x505b57622203208e, 0x5b7fd43ea59a9a79, 0xc3a3e38a5e30567b, 0x03187bdefd50600e}, FieldElement{0x10ae7353a399878e, 0x7705b4b744278109, 0x24d9b56dcc8bbc2c, 0x03e7718cfc56c045}, FieldElement{0x51a22654b093721f, 0x83752c7899695107, 0x801ec30bcb02665c, 0x07d6b8ecc1071b25}},
+		{FieldElement{0x9844f1b1d90357ac, 0x0cdeceb565d3129d, 0x1612963e9b4e7626, 0x03999f324fec9a5c}, FieldElement{0x9fc907fe3bfefef8, 0x75b9ac8977939f75, 0xb0dde386901f6518, 0x06e4590c2a075609}, FieldElement{0xfdabbda08b7f6be4, 0xd99979158b397c50, 0x7d81b5ad6c54b50c, 0x06bc7f995a464d27}},
+		{FieldElement{0x93906070c3064b20, 0x1a9215555370e863, 0xccc1a767814668a2, 0x016d045876515e04}, FieldElement{0x485cdc2765501781, 0x002ab93c1d47396e, 0xa97e0318254c7c6f, 0x07eb2042c04a48de}, FieldElement{0xd5998b406e97af43, 0x548a4696cfcf8d69, 0x1325f51d2a07f9d4, 0x01a09f5a437f8b1e}},
+	},
+	{
+		{FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}},
+		{FieldElement{0x89cd82e3cc227829, 0x46f200bffdaac833, 0xa724af22f9afa2db, 0x0751b2ded1c9503e}, FieldElement{0xbd6a25ac6e038952, 0xad72f3c26a5ff87c, 0xafeaa07ceaa44ca7, 0x0112b65969cb0611}, FieldElement{0xd3472422128fe43a, 0x80ef76a496a47b5b, 0x18f2d714958e513e, 0x07c624912fc82013}},
+		{FieldElement{0x9be5db30fe6d81c8, 0x2fe9dd36ef11c1ec, 0x77c162b21f092e0f, 0x051ab23b57d589cb}, FieldElement{0xafd29e9c76470ed1, 0x0b3bfddc119ecac1, 0x68fd5fd5b08c5870, 0x0782c1ad06766412}, FieldElement{0x086e14fff01d6b72, 0xfebcd98f1c50d307, 0x6f03a5381ae0f74c, 0x016394c7b668fa02}},
+		{FieldElement{0xb3819aeecd330127, 0x82594667ec73991a, 0x619467165a05d204, 0x00f01d31575c3fe4}, FieldElement{0x1544eb36d1c3230d, 0x859229036eb2505a, 0x81d3dc7cea2c6139, 0x0008d190c139586c}, FieldElement{0xf956f8b2c1737473, 0xeb88a05669c57fe0, 0x2d90785e39e7d1cb, 0x006bc2a8746a6e0e}},
+		{FieldElement{0x493c73a6e101dfc2, 0x4f7a3a078cf7002c, 0x916bf0605e8a7760, 0x00690e5e119cacdf}, FieldElement{0xd4b6ff7df73ef5cd, 0x81a89fad4fc726cd, 0x8291b8e686d8f4e3, 0x07794d515cdb1a95}, FieldElement{0x71aae4fd4e787a11, 0x4c6497f53a75c312, 0xf19ec1d9a6bdf35d, 0x0483058b3e2af2a8}},
+		{FieldElement{0xd538d62948bd35ae, 0x3597f4d355280d6a, 0x9852e7196d85d762, 0x00044bd3ca30ef89}, FieldElement{0x07e2c3584a73a12f, 0x9f9d68a359c42b14, 0x03d5fd287992586d, 0x01da5a869ee0ecc4}, FieldElement{0x39b0b7be534f802f, 0x530c46f28ea9bbd7, 0x090399813b964bbc, 0x07295f40fb5bae26}},
+		{FieldElement{0x6cd6aaaf21790667, 0x2a912774b8e6d50c, 0x6b0ee5f2edcf2024, 0x03614290257cbada}, FieldElement{0x526d74e780f9c83b, 0x6feca94200801699, 0x68cf295f73bf13c5, 0x055d7b5d952701ce}, FieldElement{0xd68d83d13d486c8f, 0x085ebe76bac4f370, 0x8f6838b5fa261653, 0x067058ec6bb8f68e}},
+		{FieldElement{0x231528f704dee273, 0x62fdee5221f6792d, 0x1fd16e7702ab418f, 0x02ab7edcb50543f4}, FieldElement{0x73a70bc3f1b2897d, 0x8e3dffa5ceea0ee1, 0xaed487e014005650, 0x0749784dfebed091}, FieldElement{0x94ff0f96f68534dd, 0xeb0113d4b35af252, 0x38eadbe22edc4df2, 0x0265b97c2aa8b201}},
+		{FieldElement{0x1b83ad6d023d9065, 0xf78ad3c6c09859cd, 0x0ff7adbf5967f721, 0x01316a0a44714f04}, FieldElement{0xf3303af88294573e, 0x40fe05f98a367d01, 0x192b86206a9b9ad1, 0x07748fd84ed81215}, FieldElement{0x0c25d9cc463171bf, 0x70a5c6f5babfecef, 0x9a1fd536dda87d47, 0x01ac51aa1fda60ff}},
+		{FieldElement{0x218c4e7de6c294b4, 0xa1d4f1a272238561, 0x46fb39b9c8213ffe, 0x06da394faf7ff98e}, FieldElement{0xbf859955df875576, 0x3f28fa3e72d938e6, 0xc8af0508f108da28, 0x003c1ec65da640f1}, FieldElement{0x10f96d6569735f51, 0xa26604d705510dbf, 0x72cc9b86bb29b13c, 0x01aea05926614127}},
+		{FieldElement{0x44423831528958d4, 0x2681b542094280f9, 0xee994feb186896b1, 0x0735fd08ad42da90}, FieldElement{0x5565148de09be22b, 0xed72210712adf7f1, 0x41b30b3a71ff0c08, 0x01331827673986fe}, FieldElement{0x511da4482c5b6efb, 0x85ee3db85068f30a, 0x0c36116ab3de351e, 0x0505972c9ce10522}},
+		{FieldElement{0x9fc2dde3564cfff5, 0xdeab11dcc4883d5c, 0xb2d218c9785486cb, 0x03dc23a09f771e21}, FieldElement{0x5cd82f9b65a27070, 0x9ea6867b9de70311, 0xb3d54d41c5ff0454, 0x0624da578541dee1}, FieldElement{0xa0817b90d4fcbcc6, 0x34f416638e1d5c38, 0x94c4fb82f3fae551, 0x009a305eb71518dc}},
+		{FieldElement{0xf78b9147f22094fe, 0x6ba33e9aec0ae6ba, 0x546fa253fd63a981, 0x02d7c91ddba6a4d5}, FieldElement{0x07d9046306a4ca9f, 0x199b8f16e5cfc362, 0xd953970d51acb7f4, 0x077abb969bcec16d}, FieldElement{0xd334631ab3f7fd3d, 0x0bc1637d3379cf29, 0x2fe68cfbcfd913db, 0x06369dfec256552a}},
+		{FieldElement{0x0204cab0602bd7d0, 0xe803bd685a695c12, 0xec11e11337806aff, 0x0599fbe6cf639129}, FieldElement{0x0237f645436e6d70, 0xfbd625877d548af9, 0xddbc6fc65bbc6306, 0x0505214c7ba7f9ed}, FieldElement{0x277376a70903a3e8, 0x3676694f6c68f7a6, 0x43a6bcb675db1ab9, 0x01b83a467839f1cf}},
+		{FieldElement{0x23c8b1eebd3fd041, 0xae39fd728a4fb844, 0x1ef874354d6a9a29, 0x04aa27712b44edf4}, FieldElement{0x025fbe00a588fb6f, 0xb167d790b8255e24, 0x884c980ca4cc2275, 0x0275498957b7d043}, FieldElement{0xeb3838385ccbf13a, 0x2849f400ae835640, 0x16738a5aaacbcdb8, 0x06ad45f1a06e1655}},
+		{FieldElement{0x2562ee330234b033, 0x8ed920053c9ce54c, 0x096c3daa5d9c4055, 0x006d6951811dea83}, FieldElement{0x2132ada798a887cc, 0x3694796e67991471, 0xe111c230df6fb337, 0x06641834e4361dc2}, FieldElement{0x7b630e2b4cc2f8e5, 0xccadbc4b728b008b, 0xc2b59eb5998872fc, 0x0782f9a74a60ab31}},
+	},
+	{
+		{FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}},
+		{FieldElement{0xec42fbb6cb0906c3, 0x323ff277cd217b35, 0x9edf126d50c23ad9, 0x06685758773a468d}, FieldElement{0xf91c2408257ff9b1, 0x5df7e06e179421c2, 0x179d9e442b885917, 0x007f7a9bf8a7cab6}, FieldElement{0x0bb9fbde27822bc1, 0xfab8aaf41df6b079, 0x5eb8f16dfb8045bc, 0x06619c684b591fd3}},
+		{FieldElement{0xe9cdeca9c29cd15e, 0xd6e430ed671f7f1f, 0x200528d902c6ca5b, 0x03fca0e8ed489e3c}, FieldElement{0xd8157a4f30ba7943, 0x0171d7f077262521, 0xceedba7a651eb842, 0x011287514289fe2e}, FieldElement{0x6405d2d1d39d934a, 0xa92cb4738a68f3bb, 0xecb7d7c65a8899da, 0x01239035fdb3b559}},
+		{FieldElement{0x40cf7224eb27a536, 0x9ba41db786e4ab04, 0x711fb32a014ffd7b, 0x021a7f78f0ca85ed}, FieldElement{0xfc5b7d166324de6b, 0x43212f49e88a0d28, 0xd11e3730531fe542, 0x077d2b4e8502177c}, FieldElement{0x38859dd34771c7ee, 0x3f2aaf2cc76da1cb, 0xe2f7828b35994ace, 0x04223ea87f96d7bf}},
+		{FieldElement{0x3d791fb1c76fd7de, 0x99a06ee1c809534d, 0x43ed239e7accff8c, 0x029e8e6fc5c0de88}, FieldElement{0x31ae1e391aa67e68, 0x5930f46963a25f96, 0x06fb6e6666439c08, 0x063597bba105d589}, FieldElement{0xee6033f88d04862f, 0x1c134976c23f7bbd, 0x5f3d1e153525edd0, 0x04331f138c16a481}},
+		{FieldElement{0x83d5981943e60a30, 0x465eb16dbe57af5b, 0x7669c1640410128e, 0x00f7c41f66dc0e1a}, FieldElement{0x528bbe181920367c, 0x524a342640c89793, 0x4f6dd170cba352b8, 0x02c38c0b8b4d683c}, FieldElement{0xfcc593328876b24e, 0x44da20d67d73b4c9, 0x87c54a3f2d39e4b1, 0x00f46be5f0d27d5c}},
+		{FieldElement{0x6d848d4194651e55, 0xba058b8d8835e99f, 0x68dcd7b527a2315e, 0x004738da3931112a}, FieldElement{0x8bb4178af7f59fa6, 0x583ab883309f7f5e, 0x3e8a39f195492f8d, 0x00f4aa01b9fb38f9}, FieldElement{0x1aa6e69123fa4ba5, 0xc8f86bcb745bc05d, 0x616785eb2e580492, 0x01d128436d734bb8}},
+		{FieldElement{0x6f1f6a43b9aa8bfa, 0x4ebb26d0bed606db, 0xfe73b2777fa87f54, 0x05bdd2aa9a1534d9}, FieldElement{0xcf8e8d30e71dee20, 0xe8cc5b3b6afcedf6, 0xcc68f94658b3d549, 0x01e47e4d04f524f0}, FieldElement{0xfd55d3c08785e33a, 0xc23a4bb437c86a13, 0x18344ecb04569a7d, 0x05a7df7ef8a569b4}},
+		{FieldElement{0xf9fb47a67095762b, 0x5cdbcf6bc11c4b13, 0xca6bf16d054f1c40, 0x07309c1abe00d790}, FieldElement{0x631b81123b74f53f, 0x97e0e3b5a2e2cfe2, 0x3ba8ac079dab84f6, 0x019aea074ac04012}, FieldElement{0x429d7021237a680e, 0xe0fa68a793eab01b, 0x94f547200d1c7a0a, 0x005a51e5f0b7408d}},
+		{FieldElement{0xcedece22ab053ba1, 0x178a7c01f403bce1, 0x3f995ea628792864, 0x07f85733451df339}, FieldElement{0x476696915cb8a402, 0x1a3bbdc32a53b271, 0xe62a137dd45a9436, 0x03af4b5e77f6eb87}, FieldElement{0xaf831493425e349b, 0x1e872f8f2996bb25, 0xaa5831f8b30eeaae, 0x031a0a0d3ec6e25b}},
+		{FieldElement{0x03e4a68a4d88c48d, 0xee4e81b3b7d5d122, 0x0d36e4fb261be6f6, 0x0213c29a1764a748}, FieldElement{0x9c68b72af9bf0dbf, 0x2e7786842f435bbe, 0x570b30b50e910be8, 0x06105f81a9c1e4cc}, FieldElement{0x5624154ee3f6f73e, 0xdc4c7a93520721fb, 0x697f85fc84c90791, 0x07e4b4d82d94aaf9}},
+		{FieldElement{0xf0cf5f2b3f5a802c, 0xa2383cbc2dc61c14, 0x6feb04cb8501d0a8, 0x0700ad917fcef9f8}, FieldElement{0x4c97bb3d1c9cc07d, 0x5e166372ecfef351, 0xa7386f4e7c1d1d6e, 0x0531f0693a5e4d71}, FieldElement{0xee50933e8bc4c9fc, 0x4005e09f427fa4cc, 0x06b4f38ba7943327, 0x04f2b1de5083739e}},
+		{FieldElement{0x74b0d69f9f60b9d5, 0x383879d330514754, 0xc949a44290babab4, 0x015a731e3cb8760a}, FieldElement{0x4e7713cbf131dbef, 0xeff60576e45d7d2c, 0x368d90b5be6e62bd, 0x03ce2cb8f10b7e58}, FieldElement{0x5c7df15cab5f5182, 0x124c4443d9c96a40, 0x9f08d84bac559db6, 0x04b1c3aa0ce6a207}},
+		{FieldElement{0x068dbc45b5e6b473, 0x64c242eae0719a9b, 0xbdbe05dc78e737cd, 0x03322d664bb0c149}, FieldElement{0x8160c7a7e0a92e47, 0x1ff99424d1c06497, 0xd1cb7a12fbf37aa9, 0x0685e1b036f073f0}, FieldElement{0x6f8f4fbf771f13e5, 0xeb2717662322d4c1, 0xe847e75483f3e5ea, 0x014054e696dd50b4}},
+		{FieldElement{0xecc6fb9fed712de3, 0x90244b39c819c3ff, 0xdf97a0fc459dfba6, 0x02cc335729100aa8}, FieldElement{0xaf359535c767634f, 0x13f95d1a6026b64a, 0xe2048d033b2d6309, 0x0045e5c025cc8c39}, FieldElement{0x44322283482193c0, 0x50f41b4f281e3a9e, 0xbad53af1e710dcc0, 0x029bc80fa514a644}},
+		{FieldElement{0x1781b9682bb71492, 0x9f1a025b292d7978, 0xfd36c784abbcb424, 0x04020e9cea3fd803}, FieldElement{0xb794058e6288e3d1, 0x10ff62cc4ffed74d, 0x385ad02d3b39402d, 0x054bb58719103c40}, FieldElement{0xdedbf60035fdb168, 0x74f5e45a2e5fec72, 0x213780a83aba8d19, 0x00bc2a690a2eb69d}},
+	},
+	{
+		{FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}},
+		{FieldElement{0x6c171ec934a9ae0b, 0x854810a18e2b2abb, 0xe005e0609cc37bca, 0x00e746abd6709dcc}, FieldElement{0x56bab1a724914d02, 0x922329fcfabce2bf, 0xb9481c93d8dc5eee, 0x06088ac218ef9a78}, FieldElement{0xc79f13ff72306084, 0xbdea8340f7cfbabb, 0xd040d0c69fb720ca, 0x04475073dd1ae4c6}},
+		{FieldElement{0x7bd0b4bbf4eb9a09, 0x0f42013488fb53a9, 0xeb4ca68bf5ea7c56, 0x071728b2358ad9f9}, FieldElement{0x3be234802aebf9bd, 0x5d7ab4e5949b1368, 0x48ce1a06cbab2df3, 0x0522649933364815}, FieldElement{0x7436ca5a04f3fa08, 0x799f66fa767b95ee, 0x3012868a6e2af8b0, 0x072b5fac78f4115d}},
+		{FieldElement{0x66b2a1f56cd5e123, 0x83600deb16f606ea, 0x7c05b3ec5f010812, 0x05f02c911b94e48f}, FieldElement{0x7304a612690867da, 0x173da59a3c90ae12, 0xe5cc140ffdae3874, 0x01dbad6798555bcd}, FieldElement{0xa79fea021f2a7e37, 0x4f7bc62abca09bc8, 0x9c28108b6788a07a, 0x0301e01e1d001121}},
+		{FieldElement{0x8e217ad467dcc51d, 0xb7a557e750f2db47, 0xee546f8b06d76462, 0x00982f7535bb8dbd}, FieldElement{0x7dddfb507c2854a1, 0x3eba4131ed01e6bf, 0xf6bf6b241ef0a91e, 0x0712f5d5b052b067}, FieldElement{0xffbfb5247ac7f802, 0xd8fd48fe8f3f97ce, 0xc00d59e31ad0462d, 0x03422623d6ab2822}},
+		{FieldElement{0x74a5ab37b5053a5d, 0x81c64cc49be10dc2, 0x8111cd85cfbfce33, 0x0696334de8614f33}, FieldElement{0x69ec34d6c5663241, 0x84d59abc9048e1de, 0x0a7b9da4963ca957, 0x03ee343872ee2167}, FieldElement{0x23d8dfde7f05ae28, 0xaff583a28c588cfa, 0x2dec997e9bdd6d2b, 0x0159279deff8a38f}},
+		{FieldElement{0x2de4874e2768c3e1, 0x3fac40ea3e794766, 0x91aba6d68c7fc2dc, 0x04635e70ac50ca7a}, FieldElement{0x0d6aedd215c403ba, 0xd167617ee0a8338a, 0xea3779d0cb9aded1, 0x022ef6d068965e86}, FieldElement{0x50f06579ec04920d, 0x0ea8cdcdf7da801b, 0x8de6eae2283fb5ac, 0x03d41b7cbb67a03b}},
+		{FieldElement{0xf90bb2bde9bd0c7a, 0xd72021c27888b84a, 0x8c3737dd1e094f8e, 0x01bcd247fc9a5593}, FieldElement{0x743730b2e8124016, 0xb8a3e28da73e2d39, 0x436c9990b59bc7ca, 0x05d8852a8fabeda5}, FieldElement{0x65690d54459b42b0, 0xa40563bf423afe2e, 0x9efd0c476ff17288, 0x0212821b574695b9}},
+		{FieldElement{0xcd930f8ee2ad7797, 0x9532d5eb69096ab8, 0x933571067b185677, 0x04502597e0b9822e}, FieldElement{0xcb30467b4e4d525e, 0xe023665b399e75e0, 0x2f5281813f7ca574, 0x076846652090010e}, FieldElement{0x92267016e93506fb, 0xdb69841a11061280, 0x1abbeb27add3777f, 0x07062dff7ec47266}},
+		{FieldElement{0xebc7b9d25a7941db, 0x26b1841aeb654a1a, 0xbd006feb01e91f00, 0x018369e5363496e7}, FieldElement{0xa8365dc293aa7e26, 0x1e18b42509c16dc0, 0x3f008284886b0aac, 0x0584a71c207da02e}, FieldElement{0x6057538371c76ae0, 0x8addf928cbbf6662, 0xcce176427ff9a854, 0x02a99338cd2bf13f}},
+		{FieldElement{0x054687b5b2a1d9c8, 0x560b35377b7b01b3, 0x0ccd4d54c999b45f, 0x03f85ee0144868bf}, FieldElement{0x8fd2dedfe77f0bb7, 0xc68c6c11c18f1b00, 0xd0fd5e774fa5363e, 0x04f1240ca6c7c616}, FieldElement{0x7d9020417e995b5b, 0x82e812e48f974e4c, 0x38f1baba8535cc1d, 0x0221dd561633a3ec}},
+		{FieldElement{0xdb39452debb1ce38, 0xa7738b1eb7eb0c43, 0x1c4e4c9ef0d6c2bc, 0x001f64c691d4b7de}, FieldElement{0xb2d046891ddfadc6, 0x4f0bdf7d8a02e147, 0x3bda54bd7a6d8e5f, 0x019f4b71c5a7a85e}, FieldElement{0xaeab4274d03264b2, 0x483a94621a1ccb12, 0x3db6e9e5924e85c2, 0x0600cce24c1cbb56}},
+		{FieldElement{0x96c0d10626d3c3a4, 0x1f919bc4b49b7f29, 0x1be7fd9886315ec2, 0x07e3272955646c44}, FieldElement{0x5096df1c12780798, 0x976cc41476a0f1f5, 0x8382221c0167622f, 0x06a47da0f2e5d720}, FieldElement{0xfd5d24d5817a1da4, 0xc0373fd1df1c9ff3, 0x40409d0d7842fa97, 0x03fcc8653e1b0666}},
+		{FieldElement{0xcb90be1359250c43, 0xe24f0780487821ed, 0x0c4e80ffbca10103, 0x05d9049423daefb9}, FieldElement{0x5dd9c65989c2e7e0, 0xf633d66f430d4317, 0xccdd099b3363c22b, 0x072b6bd50bffe5bb}, FieldElement{0x6d3e2b6d7f463fb6, 0x9333f9d6ef3171bf, 0x7a8a5c9a07dcb35d, 0x046273edf4349095}},
+		{FieldElement{0xe5ca1be70f78898e, 0xb2e8952ea136d4c4, 0x43e28450cd45e72c, 0x0551bf93396a76bf}, FieldElement{0x20f40f9b8c4ede66, 0x99838892874ea7bb, 0x87d0a8d9fd7b738a, 0x01adaa808c1caf81}, FieldElement{0xa0ce7517c75c7e62, 0xd3a0bd9f558c9d31, 0x33956425d2b09573, 0x01a3310c28a7daed}},
+		{FieldElement{0x85b69fdcff631740, 0x9d20dbce2356b699, 0x772b518caf66556f, 0x072f482a0da9b9a6}, FieldElement{0x44a60bab1afd4cd9, 0xb525f73f6204cf1d, 0xd9539dd13db034e8, 0x039c40b985aeef3d}, FieldElement{0xeb53b4f7817c42e6, 0x7aee9f150f61359c, 0x41d3d46da0f13586, 0x05baab4f79d00636}},
+	},
+	{
+		{FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}},
+		{FieldElement{0x7c7c3cec5c05bec0, 0xeb74f326ffb57bb0, 0x032efb04c1c9f40a, 0x057dc2752a49557c}, FieldElement{0x0b6eb9a1a0a1c746, 0x3dd64b775cef367c, 0x490805440d8094bf, 0x0265644ba5f0a51c}, FieldElement{0xd428fb1011ea6c52, 0x8755384434638cfe, 0x475a432b3f28406f, 0x07333719a4d8b912}},
+		{FieldElement{0x9d33531f2f14a047, 0x9efb583f20306a65, 0x14590c0b27e61613, 0x03a2db8e19022070}, FieldElement{0xb417f750e42d28e2, 0x0a59b1b3300a74a0, 0xcdf86af161a9658d, 0x04d3baab9bebccd5}, FieldElement{0x9aa412a84b398305, 0x139d7395c9f7f49c, 0x0522518cba17621e, 0x07365fb2bc6e946a}},
+		{FieldElement{0xdd96ce9f4087ec8c, 0x7c29b21a5d441b3c, 0xc58cbbe170929de9, 0x0690b16af5af80fb}, FieldElement{0x8de73d9713727240, 0x1fef130671529205, 0x5e9b2e7772e42dcb, 0x0236131be65899a6}, FieldElement{0x9535e45a2d745f8f, 0x7ce6b036713aaa42, 0xc7923f7bb249871b, 0x0140f1070af77db7}},
+		{FieldElement{0xef8b9d5a0ca4298e, 0xc7ee9117ab0da270, 0x29945f159d653134, 0x03a4d77ecc712931}, FieldElement{0x9949f4d307ce0175, 0xb9f8090550cb0bd0, 0x5f99ad2bfb2657b9, 0x068d820f003f0296}, FieldElement{0xe35c56ac56245615, 0xc30a1b66ef1ceb03, 0x6a53942b9b47ce8d, 0x02d7097012ac20f6}},
+		{FieldElement{0xf5cd8ddb83c1f4e4, 0xbc267efe0526f5ed, 0xd0e612c5f0b72f2e, 0x053cbb87d8ef76af}, FieldElement{0x0416f47f9826a6e1, 0xd33fbefa9ff4e1cc, 0xabeaa345ad72ae6c, 0x04828712e2c6bc28}, FieldElement{0x5394b7a7c154b719, 0xabb5464432e5713e, 0xa697b87adf261b42, 0x00fb46442dda2532}},
+		{FieldElement{0x245f324e207843d1, 0x7ff6c0c2a136f422, 0x6453509b2c972832, 0x016b916f157058c2}, FieldElement{0x2fde0773c8d34e5d, 0xfd0c89773f4dde06, 0xc329b52003d49ec4, 0x03e4c2c1fe17ae3a}, FieldElement{0x97b761bc8c633d14, 0x25d9b7bc8a35d8f0, 0xe20f7bf049740a87, 0x07bb42ce7531cc78}},
+		{FieldElement{0xa78f224e1ea75faf, 0x91e789fb3cc71a15, 0x368484d73eef1e27, 0x04b7d8f62dec8407}, FieldElement{0x7a8e6987b15a5fa4, 0x5db546cb3817479a, 0x14bd0d6cbb6d68a7, 0x01536e1e7ea31c29}, FieldElement{0x2495f4b662c9a335, 0x91702400cc771b78, 0xeedf3a339b1289ce, 0x07b04e701207ac61}},
+		{FieldElement{0x97098ed5e88a10b4, 0x93842e7d4c2182ef, 0x50ded0a88666f4a3, 0x02e087499333c993}, FieldElement{0x3662ab1d489d428f, 0x003e9a4a62dfdd5f, 0x75f0bc5cc1997c7b, 0x02ec5a1f60de2a47}, FieldElement{0x7f2c34a4afc1b8d1, 0x41c167ea9ef8b024, 0xb753c303b57fca7d, 0x029d15a63549022c}},
+		{FieldElement{0xdcc9671c31ef0da7, 0x32dfcdefaffe059d, 0xe82269618791b604, 0x01355bc5cb09d10d}, FieldElement{0x5cca548b44a0bb8c, 0xd4997a30230b1f26, 0xa50399edd6f62dbc, 0x06bcba40feccbe23}, FieldElement{0xa177830ec57f9ad3, 0xa1160a05bc116257, 0x6c368b83baf6cbc1, 0x011a6b34c39058cd}},
+		{FieldElement{0xb7d52d2f38c01c77, 0xdf52929d44108704, 0x696ce87e560a444e, 0x03d29a9b9b99fd46}, FieldElement{0x5fd70c952f44363e, 0x614ef9f7543b262c, 0xda791924d9fc6dca, 0x07556b9a9063c86e}, FieldElement{0x2ff1e775ff1600d0, 0x6e4cca8e106f0982, 0x1bdce29e218f4a79, 0x075ecf19b756fb4d}},
+		{FieldElement{0x2197fa7ccb4d54e4, 0x75b7dc46c09e302e, 0x6e6525a46a0f6c77, 0x06c89374b02c9833}, FieldElement{0xbf553b7806c771aa, 0x71b85390ffe11b91, 0xbfb15f7a1d51261c, 0x07798d1905c5b39d}, FieldElement{0x229f2cd92bcca4bd, 0x1fac84b30e2f1fdf, 0xf27ef6b4c69f808f, 0x00180c78fd414586}},
+		{FieldElement{0xc57e954901817d46, 0x0f91630426939659, 0x04bd8ce0f2f4cdfb, 0x06cd9ac07a5102a0}, FieldElement{0x90385f5002dfdb07, 0xecd3b1c4abc341b5, 0x6a19490dcf652835, 0x05090df26dee430e}, FieldElement{0xb91753f43972f3ab, 0xb633649280d654b2, 0x1570731919ccaddb, 0x01fc6da592748373}},
+		{FieldElement{0x76cde45ebe6533b0, 0x8dfcb816ab05049c, 0xc8f43e2bdb820a68, 0x01797565ef9def4d}, FieldElement{0x6316404456b2b85c, 0x652d3362e24ac3de, 0xc2f3d4d5a12b4932, 0x07bf870cee339512}, FieldElement{0xc928661e39afce6e, 0x3f579232e65612c1, 0x8a5aa3519a8fb162, 0x069c2e95f4672cd8}},
+		{FieldElement{0x05ba3dd7f589bbdd, 0x5e980abdd03ff4af, 0xf5ac20717b57eba6, 0x05e3015ee82d2721}, FieldElement{0xa5f669f9463aca0f, 0x75a0b1acbcc8ea1b, 0x77746a9afe72b1fc, 0x033aad4f72cd7a1d}, FieldElement{0x118ad299732d5008, 0xdbe123ebbb74c3d4, 0xc31550462b0424ea, 0x02c437f668b68985}},
+		{FieldElement{0x45f3f47034b5e9a7, 0xfc3e258625552ab5, 0x65c09b00fabb6e27, 0x019489416bb8432b}, FieldElement{0x7d2b9c6784c947ab, 0x2dd50af88827bf99, 0x5dfd47970a57fbf2, 0x0113b87575914562}, FieldElement{0x3c534eefc2276aa3, 0x30d13ec2c01c9cb0, 0xc85278ee6d0f5992, 0x054db2802747b109}},
+	},
+	{
+		{FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}},
+		{FieldElement{0xaafa74d4546c7648, 0x834343e35e9b54ec, 0x489a5f233f06c76e, 0x03843b5355fd4b7a}, FieldElement{0x0d66c3ce04b7172a, 0xb47e723608b732fe, 0x5b46746a1670039a, 0x07f664d706b4d589}, FieldElement{0xd8aab362ab7e03e6, 0x76a06136897b2550, 0xf0f1ebf55d7435cc, 0x01a4c353133860d9}},
+		{FieldElement{0x3ee379d602f557f3, 0x5d3ff305fd585a9e, 0x972d4de90468c975, 0x0597818019d64b70}, FieldElement{0x8df5fb4df9686347, 0x520a90c2e0a69b4f, 0xf5d8e3ec842cd4db, 0x05a79273cb954b83}, FieldElement{0x0f9693891d46c660, 0xa085bb7410ee77ab, 0x089d869b7694bda3, 0x07393f3af5cd1739}},
+		{FieldElement{0x2b1b68f309a6c110, 0xcea4f06fe7ce7c91, 0x58aedeaf7933302d, 0x0057f7df40256e30}, FieldElement{0xa12c7108bda578a4, 0x84e3ff7c7b2fa3f2, 0x44ef56a52079ad10, 0x00a47e177cce7ded}, FieldElement{0xadd9a4762fa9369d, 0x376c455b05ce4ee0, 0xbac1e7ff3a507e20, 0x041ead1b384d2e0e}},
+		{FieldElement{0x3cd06fb948447346, 0xcef64397a9bd4c9a, 0x541c6f244b6246b8, 0x050ec2a84b38a725}, FieldElement{0xcc3a328d5b48ae49, 0x75d5e9e36a898418, 0x42867e7b1e9d78cb, 0x048f50b692a7d41a}, FieldElement{0x6919e80b8410aa62, 0x1b7023dcc9218a5a, 0x5942150721ef583a, 0x034a6c12e88080d3}},
+		{FieldElement{0x0676f4c15d18f771, 0x283b8d3a28f49d39, 0x65b0907a568d1e3d, 0x06ed9ab241d9bfec}, FieldElement{0xa46629d21c52c747, 0x97880a28a6ce5f0e, 0xb8d598026d32530c, 0x008ca776db2e6fc0}, FieldElement{0x7a04354087a0d118, 0xb9af814c71dbd03b, 0x730dffd2634d4b86, 0x02bd1aef3b9a9d93}},
+		{FieldElement{0xf8517f5db8db6586, 0x9896ff552de5238c, 0xf18230e40a8e4516, 0x05469086c6747904}, FieldElement{0x4fd873a4812a7ab1, 0x906269e5a658a54d, 0x03ed0d6b9442859b, 0x03487cdaae359a69}, FieldElement{0x1b7adfccb9f1cecb, 0x7d3af9d3a42cfb77, 0xcb26bd4e245b7aad, 0x030563d4b6f4fc83}},
+		{FieldElement{0x965aad1c810d69a3, 0x1db5c5c61a6d1538, 0xe8fa5418a85b9067, 0x03365ff470559b0a}, FieldElement{0xd5b06a283725091c, 0xf14cd2fae0b2b08b, 0xc63e0caa80a45fca, 0x069a8370587299c1}, FieldElement{0x4d858e21119d6d49, 0x31d91c74f4d55979, 0x475d10c24050f3bf, 0x068d3e778095560e}},
+		{FieldElement{0xc08f993d79eebe44, 0xdb3ab6ecc780ff4c, 0x65733097c7eb12d8, 0x05485e33a4176bc6}, FieldElement{0x9c24d736e670472c, 0x2862a386ad4b7a3e, 0x7356ce6ab62f1ce6, 0x045361ee91ab8f2d}, FieldElement{0xca69ea7a3dfb818d, 0x1d82cb72c07da5fd, 0xdb4e3fb4229faccc, 0x05b6bf3e4d0bb35b}},
+		{FieldElement{0x55f0b7ca49a3bad8, 0x61f43bc9366471c2, 0xecba6e0ec92bb141, 0x01360ed9dcc20536}, FieldElement{0xbd138932816ec79e, 0xf33d8cfcd1f5a6be, 0x6237aaa1c011dffb, 0x0477739577b013bd}, FieldElement{0x0b3466f4644e8652, 0xcc6cd261ee288385, 0xca58462aa7e50039, 0x004889080b33df82}},
+		{FieldElement{0x7733203022e9b061, 0x7566e877e8a732e5, 0xa3dcc3c2297fb340, 0x0425cd0fc91047da}, FieldElement{0x5038c73e1967b869, 0x7164896d4072918e, 0x0aad40975a3ce1d5, 0x0662d23705a1fb78}, FieldElement{0xeff3d1cb4cd4016b, 0xfb355a8b293764b9, 0xa7fe59c755da7de8, 0x05d79c1702ba503e}},
+		{FieldElement{0x9195b3a5428fe5d3, 0xca62c7e3091c01a5, 0xf664a0b793d7ac6a, 0x057305e90da7a772}, FieldElement{0x2bbfc8a285706b18, 0x851639e3e39ec283, 0x43a9ac0ee2e0e17f, 0x0310beb32eb73c94}, FieldElement{0x1dc3b7d9a2335ddf, 0xb8bf8a0184f74f55, 0x601d9f9ff3d78fd6, 0x04a60c0f1a1d9dec}},
+		{FieldElement{0x3bed0287d07a1d58, 0x75c40c5a8d68d567, 0x67ce471e17d2408c, 0x020358a483ae96d8}, FieldElement{0xaefb47650997de67, 0x7e57c642ff3a7191, 0xc6d5f1d5ef56f27c, 0x02c8483267e04c55}, FieldElement{0x580d4068e4dcfc73, 0x9c21cfd87bb8fc5e, 0x834fe78d64e61c3b, 0x01ad6ec3f78f7593}},
+		{FieldElement{0x541af5f3c61bc430, 0x234eac2cfd61081c, 0x7f013966d5cb145f, 0x05165fc550df870f}, FieldElement{0x6136b1ef54d7ba14, 0x2a6c543275cf6228, 0x906cfbbf0eab2ef6, 0x01c9001b12d8a0aa}, FieldElement{0xec4c0e0f60509d35, 0xce5fc18a02addc36, 0x20c17ef5d359ff26, 0x075be4b55f9c160c}},
+		{FieldElement{0x2463b9705ef87e51, 0xc44868a392e4801e, 0xfd61e715a58f7398, 0x07ef01ac630dca0a}, FieldElement{0xb89bacfb3607d71f, 0xb0b6836ea478a5e5, 0x3f769208b8c46891, 0x01f442e59e93aec3}, FieldElement{0x3e933638bb03a844, 0x07bfcd9dd6c11041, 0xb843300af482860b, 0x0445af6c2d7c93cc}},
+		{FieldElement{0xd9c2298198f22714, 0x205357287b1338a3, 0xfd120d74108fc37d, 0x06cd713e84c8a551}, FieldElement{0xf47e3db17916919e, 0xe13176ac371e2927, 0x6dd12fee4053da77, 0x07df91cae9b1fffa}, FieldElement{0xb668f6d69998e017, 0x87cef93a08fc1887, 0x2c3c1566b3e9d4e2, 0x05072fb3cb48a2f8}},
+	},
+	{
+		{FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}},
+		{FieldElement{0x8ea5a987c5005e9d, 0xe913084a55a0e5cc, 0x6485e049d398aa2d, 0x01bdacc2ebb007f2}, FieldElement{0x0502365191a3df92, 0x4280927b7a95f360, 0x9b3a73d83b8b2990, 0x043172102e8a9fb9}, FieldElement{0xb3b73ce9049cbd30, 0x04ef869dad2a615b, 0x2dbc57f08a832be0, 0x00cae41ee1c90faf}},
+		{FieldElement{0x6478c709226370f8, 0x0a67824482a7add3, 0x909041e79ec41874, 0x00a9df3e78c60c38}, FieldElement{0xa2e13a06672c27ad, 0xf38675fa8ef2945f, 0x570336162629410c, 0x0435cbf05b87e025}, FieldElement{0x8ddab9ff9d8f4bea, 0xe99c27b4ff169fc4, 0x4967dbfb7519e65d, 0x0658edd1ae35da61}},
+		{FieldElement{0x17cdb84f33633ade, 0xcdbd8160c324de63, 0x7cf4bca830785c20, 0x02a9f9f82dec13a4}, FieldElement{0xcb33d6a94edbb1ad, 0x4252219ab8a6bf2a, 0x9f483b46f9dd6d42, 0x069bf44c68807d40}, FieldElement{0xdb6332d03c214136, 0x9a391ff7e9b5c102, 0x3a57c6601672fe48, 0x06d012f41d757fed}},
+		{FieldElement{0x4d4a00d56a7ed4b5, 0xbff6070cfa060119, 0xf5a7e4851efa068f, 0x02a5b20861bbebd9}, FieldElement{0xdb6f5eca911b07cc, 0x1b3332e85c559963, 0x4e73dc34c7a11f9c, 0x027c043a0557255f}, FieldElement{0xb0a2b5dce6eb9d22, 0xe27b948fc8c1c660, 0x546cba1fb989e168, 0x03228d3f9c547880}},
+		{FieldElement{0xc35dfd5e98d0444f, 0x3ae88b05828ac408, 0x1e81d45383cfe616, 0x057286abce975dc1}, FieldElement{0xab10fa6019c0aeee, 0x6e4225ada91cff6d, 0x95a878bea2b36240, 0x03d2e5bb3f577f58}, FieldElement{0x56cdd7451061d540, 0x84bda780cffff912, 0xe362c9c588e420f9, 0x05543885558a4e83}},
+		{FieldElement{0xc1c9fd868fce6883, 0x6c6a55024e7d396c, 0x89e289a30582d15b, 0x0051e78664041015}, FieldElement{0x69bc978af8ffb1b7, 0x4b501c87e4683677, 0x9c0b44e184cd5858, 0x05603d109e813900}, FieldElement{0xbb9407abb94dc87b, 0xff668ff6e300def2, 0x3e72c8284b2e67eb, 0x01e91de0fee011c9}},
+		{FieldElement{0xd6127e31eae014f0, 0xd58ba313f5f6115e, 0xfa2c067a2f6cf90c, 0x00290fa89b9f66ba}, FieldElement{0xaa2584d31acc9e67, 0x417a552c7318e465, 0xb2a62278c08c6ce5, 0x058d0496f20af9fb}, FieldElement{0x3d9b846ed6c3a432, 0xd0c1a68362ebbb0e, 0x7f2bd080799e5594, 0x046a22e0f510399c}},
+		{FieldElement{0xbba71dce33f3b971, 0xfc69c632a093dc6c, 0x37e15e22102526da, 0x074a9197e4a18812}, FieldElement{0xbac6b799e29b2156, 0x3e5178fac39065e8, 0x2686175fb359aab5, 0x02a1399368be7d94}, FieldElement{0x60039753b4697ed1, 0x976cd54609574059, 0xc6dd8c8f0474ea13, 0x06c4c08e50fb5829}},
+		{FieldElement{0x7960896ee0c9fb85, 0x2f4fba6da679b616, 0x6b711737b17f5da9, 0x01c528e3e06f2280}, FieldElement{0x6939758f07d5242f, 0xd64807708166d6f7, 0xe123b782b1502c74, 0x033fbf3283349a6d}, FieldElement{0xd2747dbb92def752, 0xbf112ece17276b47, 0xd8f0529e57443da8, 0x0087f25af4f37043}},
+		{FieldElement{0x95fd12ecbd54cc94, 0xb0251278605292c4, 0x4f7e1ccc4f743eab, 0x04990cc6d4042c12}, FieldElement{0xaa5908de33e36fc6, 0x89b2f5a4db782f64, 0xee8eac1e7f6668dd, 0x06b659cbef1c6916}, FieldElement{0xc385a42622b84e23, 0xd42068c1fba7b947, 0x06a3cade22c89f33, 0x01c37a68b37ac757}},
+		{FieldElement{0xbe3644fdc6d50051, 0x49fab3e0a30db1b0, 0x289b1fde70c43eff, 0x03549b2f48dad0c5}, FieldElement{0x8c8c1af1b4a53f4b, 0xa5eb9b1179bed511, 0xe3462e2cbf9ec8c9, 0x07ddec946f84ca23}, FieldElement{0xe31fc631a4fb14ec, 0x5a208804c2d1dc62, 0x8e6155e5410ac4f5, 0x00be2627f157eab1}},
+		{FieldElement{0x5925c2cf4c9591b2, 0xafff87eef65659f0, 0xa69d308e8c6bba69, 0x051f01b06c762edb}, FieldElement{0x291c0660600c8cda, 0x757d179bc6e854c6, 0x8ad72ec358b639a7, 0x0122fa1725123b21}, FieldElement{0x9844ab7c673f0227, 0xd7354c6c12bef8b1, 0x8dd07d78876a1e76, 0x068e0ab0520a0e62}},
+		{FieldElement{0x0ec52090a54ae992, 0x408f1efdc2625c75, 0xd60850d82c7b776e, 0x01d6509c59e52bbc}, FieldElement{0xdf91c76e1f67bfa8, 0x694414785b3fb2de, 0xd10960885299af34, 0x033bc6368a1ebcac}, FieldElement{0xc0281fe5aa31876d, 0x28ec1e59fc1cf4cd, 0x764e547e1ade437e, 0x01d27e46ed6d8771}},
+		{FieldElement{0xd05ce3844c2f9dd5, 0x0ccccc9f2093e7b6, 0xc44a0b1be460546b, 0x03c08ced763be45e}, FieldElement{0x4c8833030db644c4, 0x3ba3d08885e8ba70, 0xb32eef095683bfd5, 0x03ca5ef46a30f1ff}, FieldElement{0x6417819e98fa431d, 0xf6febcf64159b763, 0x3edcdf8df5c632af, 0x003569efb8c48abd}},
+		{FieldElement{0x13589c7832bbf828, 0x3dd3ad7534479b5e, 0xbd25c1a26a616277, 0x046a771f9a327858}, FieldElement{0x97f26f63a42e237f, 0x34834f831879bebc, 0xe108a9e472159dd8, 0x03388f5fd54df9fa}, FieldElement{0x73fb3a0b8c1085e6, 0x598cf60c0947d157, 0x38cd258617ff6324, 0x036bd61e35a18c26}},
+	},
+	{
+		{FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}},
+		{FieldElement{0xb41c48cbe59a69bd, 0x5a6104d3f2382f97, 0x1fdecb945b599152, 0x0193358ccb6b7fcc}, FieldElement{0x1dd540d409112abd, 0x81303b146f5c2cb3, 0xb33b6cb18ffa6845, 0x0296eba74ad50437}, FieldElement{0xa7559a698171e83f, 0x63b41a1bdde72b77, 0xedb5f937058bceb6, 0x07bda03146b3b22a}},
+		{FieldElement{0xcef2b80854a865ff, 0xf0cba2ab326155dd, 0xf0e41fa889ac14ba, 0x02f69eb72f75b219}, FieldElement{0xb635799e102dda03, 0xb747682a0dce9c44, 0xd4aeec113fbfd201, 0x00cac3412cf782e6}, FieldElement{0x9af4472464b92515, 0xc7d16b4052e860d9, 0x2b56450e01c0b361, 0x00020176048d8432}},
+		{FieldElement{0xa70fa0686690ca7d, 0x1550e0118afed558, 0xba0fe6c4e995e636, 0x0033d10ea53b707c}, FieldElement{0x7d479252a809ea1a, 0x2061746ff9b6331b, 0x1c9f0575a1feac9a, 0x02204b9a6db68196}, FieldElement{0x24b9b8cc3fd2075f, 0x758869eebdb25b29, 0x147024130a9c9b33, 0x06c319b6ec188bfe}},
+		{FieldElement{0x60101648cd6e54dd, 0xe0dcedbd577898a8, 0xe5e8bdba993b0686, 0x01ce27617d4ad51b}, FieldElement{0x85a013776d8acaeb, 0x75581cfb4ac22382, 0x41943a5405b8394a, 0x06ad9c90da564c4e}, FieldElement{0xce658e75b34d1ab0, 0x4bc7377312d37f5a, 0xed4a6de24591283f, 0x049185e2580ad2cf}},
+		{FieldElement{0x6dca3b31b90e96ab, 0x0b1e50ac59c42a75, 0x72d6cefdc279f82c, 0x04962ae5743f2ece}, FieldElement{0x68500cad1d7d58af, 0xa3385c6ab55308b8, 0x7c7c3dc530297cf3, 0x010e4ab68bad1ba2}, FieldElement{0x6827fbdb7956f45e, 0xb77ee6dde47d7633, 0x8ca5785d402fc286, 0x0061392b5d64e842}},
+		{FieldElement{0x9041857d50311000, 0x84ff18cc5f3fcac5, 0x93d1ab82df5adc6d, 0x016bef41747a4305}, FieldElement{0x4b6f5b501ee27705, 0x69542bee2de92b4d, 0x7500a2d03fe112ff, 0x01705294ad5796d3}, FieldElement{0x14b6192c3478d957, 0xa7a535593bf4f165, 0x503537b4ab2f4a33, 0x02c2bd1dfca7946a}},
+		{FieldElement{0x335036a229c6a540, 0xf511a8ac23538eea, 0x9c57b7d8298b419d, 0x0669ce098205b1de}, FieldElement{0xb1474dd2a6b982fb, 0x9c8f171b3652b839, 0x79de9996b7172915, 0x074161a1da149da2}, FieldElement{0x6530d093155fec60, 0xbaa70bec043ae4b1, 0x9884344dd98a9351, 0x0583fcf089d1f483}},
+		{FieldElement{0xeb2ef2797fe03864, 0x0444c45e488602ef, 0x4868a91c67708dc5, 0x0742ef2e9355c5b1}, FieldElement{0x043cb3437abff0e5, 0x9ad619b97bf431bf, 0x6e870523bf40a2ff, 0x0039d32c185ea4e9}, FieldElement{0x2c77f9df2ebd535f, 0xdde566cd2b96aa06, 0x9a09580863994218, 0x015391d9106abcdc}},
+		{FieldElement{0x822612dca07cf9ee, 0x148227c85a583672, 0x32668eeb1628e360, 0x059fccfc8abbcfc0}, FieldElement{0x63d57b0325296f9e, 0xf0e68d5109a40e2e, 0x7ed604c25a8bf2b9, 0x07d479dc367a56be}, FieldElement{0xd89dc55cfb8381c7, 0xbec68b587bacc4d7, 0xc12e855f7efdfc47, 0x05302dfac5da5b6e}},
+		{FieldElement{0x1402405414f32de6, 0x15f46bc149fadbc2, 0x721d055953245467, 0x052229b9d6b8fd45}, FieldElement{0x3ee816aeec5f28f3, 0xe161c59a489ee61f, 0x70a3eeec33631d35, 0x07072d13200102ad}, FieldElement{0x0685918c65c4276e, 0x51800f49113c7faa, 0xe1b052cabedc5287, 0x074eccf5bb3f8783}},
+		{FieldElement{0x97b633c8337f1022, 0x468ca09b361484af, 0xb2e773747f772f15, 0x06e96fb057bff973}, FieldElement{0x66043a0bb78f503e, 0x726d1b5e24a75c88, 0x0d7a1f9b00dd1bf8, 0x02baefd9b966c63a}, FieldElement{0xe0f8dd7ac27a0f3b, 0x2548046a6fec7c46, 0xf3571b754180f1ea, 0x011e47977a4cc246}},
+		{FieldElement{0x1c61e9093423b4bf, 0xeadc8982713ca155, 0x4f9b70beaf11ba9a, 0x01ad8c419449d0ab}, FieldElement{0x62f4961100d544d4, 0x5c8df87e35ad2c03, 0x36d1e8daf7629b8d, 0x077110a8127c7376}, FieldElement{0x2e04d5eda8faa52a, 0x98796c2af3e3cf07, 0xea30cddc70acdfcc, 0x0452f8477cb8e1ac}},
+		{FieldElement{0x759c5fbd1fb3bfbc, 0xbf516ab64bb76069, 0x267b839b8c45c00f, 0x027fb8bf63314ba8}, FieldElement{0xb662ddc21a61b37c, 0x7a5f73d3ed7aca8d, 0xe1ad08947e2101cf, 0x07e35b1e3a95c0ca}, FieldElement{0x1da7b886301cb1f1, 0x103f603973c25c73, 0x762b24bba13ad0e4, 0x0158e97f32930dda}},
+		{FieldElement{0x286bf30bffb17fd9, 0xd9028fd3ab40768e, 0xf267f193c7081cc5, 0x0072376d1ab299b9}, FieldElement{0x11157b281a69317a, 0x982eefcde52b9d4a, 0x80753c974129ba76, 0x06870a43d414a880}, FieldElement{0x2d0f43a8286b0992, 0x255989680356d592, 0xfc0c31f327e79842, 0x01c623dc4438cab6}},
+		{FieldElement{0xad1e4d5b94103bff, 0xeaa11a805be73bd3, 0x3db389cf69664cfb, 0x0081f39b7b1fa1f6}, FieldElement{0xc428dc5ff32ab8a3, 0x663184920d7dae3e, 0xe47986beee2227d0, 0x01560fbc2e59ac53}, FieldElement{0x9e1e4d75df41cf07, 0x2717b3ab0f2c49f8, 0xf91affc225499546, 0x0324831afdd76d60}},
+	},
+	{
+		{FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}},
+		{FieldElement{0x901a11cfb244df16, 0x1f2dce807d9c8070, 0xc5d9696e7dc71a60, 0x01d43120d12f0bc7}, FieldElement{0x3d4d8df05385da5e, 0x3b6673e030ab0634, 0x6f682962eabfa2e7, 0x03155f54794288a3}, FieldElement{0x91af15fe266b4bc1, 0x09fa80a8c901afeb, 0x9216997344d3840c, 0x00b6e9bb021124bd}},
+		{FieldElement{0x11bf740502af24f5, 0xba611cde3cbb3fe1, 0xad0ba07cb9134de0, 0x028c2ab1be824a8a}, FieldElement{0x0278e63156d434dd, 0x814f97961e99c980, 0xa4893df3fb08274a, 0x0771ed274dd9c8a6}, FieldElement{0x6295884443b0dd5e, 0x66126195b5126312, 0x1b527d7d4e86bf5c, 0x07bf7dcbb66814b4}},
+		{FieldElement{0xed82d8c207b3df75, 0x0d2168a101700e28, 0x593d36ee0d76179f, 0x06161b4ea704a389}, FieldElement{0x302b7acd14ec27d4, 0x16cbdff44c8a7e7d, 0xfc7716e1177fb59f, 0x00ec4ca354c8b9a6}, FieldElement{0x525f52fc7ed68e9a, 0xc92f6ba760684634, 0xdfe8340b28fc65c6, 0x03f3fcb6db9ebb40}},
+		{FieldElement{0x88d0816ee6b22489, 0x5f0c291ce3355983, 0x8972991c2fc9b166, 0x01b21f2bb769fc43}, FieldElement{0xe83c581e01cef98e, 0xa9420095f7d33a20, 0x0b15245aeef911ce, 0x01c66ea4e2fe0ce6}, FieldElement{0x2726700ba0a458ca, 0x8107d0980fb6ecd3, 0x4495f0d38ee53502, 0x004fce6955a8d063}},
+		{FieldElement{0x6fd2825b7db51ef3, 0x8c0adfb64e7f2685, 0x5d9807524a8bd0f5, 0x00e40772d931fdb2}, FieldElement{0x257c31504eff8f90, 0x2b585e0ed5ee4e73, 0x83142ef6212f0452, 0x041d8c68ba621e1e}, FieldElement{0x7c554c36acb857b6, 0x4c1958779d5891e5, 0x4538493fd495fda2, 0x002a9906e8338c9e}},
+		{FieldElement{0x19cf2d2ef0e8772c, 0xf15ea688937fb10c, 0x01424abd0bd8ef31, 0x04cf156d40b4bf28}, FieldElement{0xb21f5652166563aa, 0xe657e641b92100e7, 0xa3729bd792b34bd4, 0x064973f21ce461c2}, FieldElement{0x2bc13e844fb7cbd9, 0xe5005c59713d2b89, 0x1d670d2b3cd48246, 0x002b45ef58372ad6}},
+		{FieldElement{0x9f8aa3cdb875d0a6, 0x2f1767dec43cccf7, 0x369e2a28e97243b5, 0x0760aee976030cd7}, FieldElement{0x0aa0b6ecc09a79a3, 0xb550496df7169de3, 0xac8f22b43bfb416c, 0x00e29ec26c5cb188}, FieldElement{0x1988575b27d27714, 0x32d584468c626fa2, 0x6f2c068c0398f6e2, 0x079ea291db5d8f80}},
+		{FieldElement{0x82dbdb77819ec87a, 0x4331862cda284d35, 0x9e4449498dd362a0, 0x0388dbc9d690acb4}, FieldElement{0xae289af576c03e05, 0x7f2084e46737fb2d, 0xda5bf6a034bd17f3, 0x0363aca29255d0ca}, FieldElement{0xa17781a5279d35d7, 0xe122fb157fc3c661, 0xbd78a9e0c7b76498, 0x00befc1ae2f28e2d}},
+		{FieldElement{0xeb19192d51b572cc, 0x6dee0e89b72d8c5f, 0x4935ec7a3919f0ca, 0x04a05667c292fdcd}, FieldElement{0x192b95aa849a50d1, 0x8cdfaae950a5ead1, 0xf3c6073927a05ff4, 0x02c5752123b3e995}, FieldElement{0x1494665551cfb4c3, 0xf8003e95d2b1f193, 0x957b925fd1271ce4, 0x0683f05107564dd0}},
+		{FieldElement{0xc88c74cd6b5efe5b, 0x063fef1c3c9b1c67, 0x12842f4cae98da5e, 0x00122d12923898c6}, FieldElement{0x80219bc63fe60bd3, 0x87e5bcb08655e9b2, 0xf75b89953dfc34a0, 0x07191b04267e1276}, FieldElement{0xdf8fa140041fa49f, 0xfe5bb3cecd186127, 0x09cc587732959a39, 0x04032ffc58a54e47}},
+		{FieldElement{0xa23fac496a45dc5e, 0xc9f08c2a56e04472, 0xa8b3680fa99d4938, 0x053d6ab2e9c3aae6}, FieldElement{0xcae66b785088e005, 0x9fd60a63ac6734c7, 0xeac7d74fd7422ab4, 0x04e5007d0d2e3d40}, FieldElement{0xa1cb8e5a4c1808d4, 0xac4539510e77be90, 0xd0850b8ad1535267, 0x06d2dc183a66a57b}},
+		{FieldElement{0x53c81af08ab967e7, 0xd7a7f0de19208831, 0x7bbe80e7a1f06134, 0x07918a492d04bba5}, FieldElement{0xbd355f9e00bee848, 0x953ff5fd49b19fb3, 0x7cba1a64845617c6, 0x00023adb5fd3af1c}, FieldElement{0xea7fd4a4d20264b6, 0xecc9638d2fd61f55, 0x04fbdfcd03710e5f, 0x046bd139ac85f180}},
+		{FieldElement{0xcacdb5e6bec0398f, 0x811336e82eb239bf, 0xc41f31d11cd62152, 0x00daea1cbdf2aca0}, FieldElement{0x5fbde754c5b52f16, 0xc3d6c04807ff273d, 0x30f0f9ac0a395e4a, 0x002b6681a745cdc9}, FieldElement{0x1ba0138ac99d8f25, 0x884105404477738f, 0xc5d3dd4bab304bf4, 0x035aea0030b193db}},
+		{FieldElement{0x995fb8eb548b06e2, 0x7755cf9e06c327e2, 0x236fa1594162cc24, 0x06cfd793c4e42962}, FieldElement{0xb823ad0f1c10beb7, 0xcec8b9c4c234c5ce, 0xfb8d60d6024ba9b6, 0x054fce867f525677}, FieldElement{0x6501cd07effcbf8e, 0x938a7ea28088426e, 0x1a15e40f805f7ee1, 0x04fe4a66a1df2a18}},
+		{FieldElement{0xd600be9ca7381acb, 0xe8ddf36b19a49350, 0x6373288fab6fda6a, 0x06b5abd1f41ed647}, FieldElement{0xd08c11a8d19657cf, 0xbb8da21eea876f13, 0x26af55739429afac, 0x0482e104c3c77abc}, FieldElement{0xcf45c4df07a9a402, 0xdde7ebfad396cbcd, 0xac95335f10696526, 0x03bfb5f6288afc0c}},
+	},
+	{
+		{FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}},
+		{FieldElement{0xd11c4cfcafb8734c, 0x683e2c983e530f98, 0xe5443c98d7efabcf, 0x038904d28a09dd2d}, FieldElement{0x4142dce23e0868be, 0x882ffb91366261d2, 0x6a3d3970f84ff06f, 0x0659de90f9fb9342}, FieldElement{0xe74f5f80f7f7b0b2, 0x28c20a1c15903eb4, 0x310db8a5a8bbf10d, 0x0427dbaf4df344d0}},
+		{FieldElement{0x28c2be079bc3bfb9, 0x39d0fe410893209c, 0xd0e5acb7a58feb33, 0x056e6dcc4cd861d4}, FieldElement{0x424547063566372c, 0x601d6ae9e7267d46, 0xdcc4cb2c12f90009, 0x04d69f1ef128d2bf}, FieldElement{0x0fb5f520031d2b85, 0x91a31baa1ee4cf33, 0x8b42dbf161b3af44, 0x02c5e41f1f976cfd}},
+		{FieldElement{0xcedd840f4e12b38c, 0x8b5e44c6a68d01c0, 0x4c5722c5bea60344, 0x07ae80adfdf5092b}, FieldElement{0x890befe9c11663b1, 0xfdb7a90ce9ddee96, 0x9d573830d597343e, 0x04521c7c795f44b8}, FieldElement{0x79bac5c16c113fd6, 0x7f1f7e80479e5d28, 0x1114f15ba46dda4a, 0x00b5279b07302356}},
+		{FieldElement{0xfae461fac7651ad8, 0xb88ca9edb98ad210, 0x160294bdb559ad5a, 0x04ccc4ea529cde5c}, FieldElement{0xe4c59144cfb12ff8, 0x1021d02bb88b7928, 0x19729b9da92e0907, 0x072bfa33c28b0963}, FieldElement{0xc01fe38efc92104c, 0x3867db6379be048e, 0xfec8bd463256f146, 0x005f3ef85bc026d2}},
+		{FieldElement{0xfbb7b3afa6f0f5f1, 0x5638c79f84b7f78f, 0x6dcd3f0cb56ae324, 0x01437372ac9ac5cc}, FieldElement{0x55a7cb0a48416aa2, 0x48952e4814432c91, 0x7a1f8a2a8b1744c0, 0x005ba35087317ce8}, FieldElement{0x70ac1b5798c3dd77, 0x27dd59c28d2b4c5a, 0x75460fcd73190aab, 0x056cdf441b9d8d04}},
+		{FieldElement{0x266a6551f3b327e7, 0xdf1bec963064e1ec, 0x99d4cee3a4b996ae, 0x072e5655e94c926d}, FieldElement{0xb08ff9950f244873, 0x9afc7f7b7327be5d, 0x1fdc800283181c15, 0x0638fdc4fd67f881}, FieldElement{0x9893892a55622f13, 0xca54c570dc323b39, 0xf5437d3c44fa2599, 0x0212fb31a02d6683}},
+		{FieldElement{0xcac060711056fcf9, 0x851a090e3290647f, 0x3e32f218e05941df, 0x0150e0ca14f699e2}, FieldElement{0xf9caf7686e9d916b, 0x207ab8926a56f11b, 0xcc279396a2316ad6, 0x01cee0e32a5f6917}, FieldElement{0x349ac3235984e919, 0xf300bac2ff618242, 0x8642493dd317b8c4, 0x0057ce8600392d96}},
+		{FieldElement{0x4b58c2d0d599df26, 0x4ad22f4a367b58ae, 0xad0c038e9c91b751, 0x005ebd37113d5926}, FieldElement{0x9e6878bbf4ba9964, 0xbee465ee05ac0b06, 0x0b072d5ff6d55964, 0x0515d583d7b30f76}, FieldElement{0x3cc788d367a2b562, 0x2534503ab6cba329, 0xe75ffc358c68570a, 0x03cce05151156192}},
+		{FieldElement{0xb845067bc2420db3, 0xe71d26297a3a37e5, 0x5594293bad27a27d, 0x06221f9b4c2b0b4d}, FieldElement{0x3fee73f69de0798f, 0xf29f8c7ba06605d5, 0x52d1eaccbb1f2a71, 0x01cdf4c446bb4606}, FieldElement{0x7e7a884aa0cda18a, 0x9fc6d120cc4eb617, 0xeae5be374a3a1e91, 0x001b7595cd8cfaaa}},
+		{FieldElement{0x0ff953933665cf24, 0x48064d7c82ecfd99, 0x637f76599fdd9b78, 0x0243227b83071199}, FieldElement{0x48d2c50aa0babd95, 0x70bfaf66e2f39c95, 0x92669f9150213c51, 0x01c71cf438633630}, FieldElement{0x9c08c444566f44d2, 0xe7e4cd21711705bd, 0x86fb1a0f57328451, 0x00eeab1ae333b372}},
+		{FieldElement{0xe9fb4facaacb6f9a, 0xa98a494e40ee8eb9, 0x1a0c66d250b48e19, 0x057a3b4cc201cd51}, FieldElement{0xab02fbfce90b725a, 0x4ddb450047cafc4c, 0x620a9c8bc764e33d, 0x06b22f11abb6bc72}, FieldElement{0xd958cca0f49df2e1, 0x863a3388cd4d06b3, 0xe84d2776d62a69e4, 0x030fd04297eb049d}},
+		{FieldElement{0x17ea1dc0a692838d, 0x139045f5dd736a14, 0x301fe03fa9c0362d, 0x055ec98fa3c3a1b4}, FieldElement{0x2c43771e47a5462e, 0xec5a7fbe17ad2dbe, 0x606aa39b7b1e0d51, 0x04a4cfb82b639b82}, FieldElement{0xb010c55ca67f56a2, 0x2a2b472f2baf6442, 0xcfb81b389084da10, 0x049dd2c3a43b0281}},
+		{FieldElement{0xce31ce44a9ac64bc, 0x9873c69c0cecb9cc, 0xa8678a82450ab284, 0x04831aa64dc5aa5a}, FieldElement{0xb0ae4eb97e7c3a41, 0x90151ccbac36c3d5, 0x6e2cd52e11e486a6, 0x0606e58fcb202aca}, FieldElement{0x21da4e49b85208c5, 0x11d5cfd131e02428, 0x4af3e28312563bd5, 0x048c590e028ae591}},
+		{FieldElement{0x76b740e21716b22c, 0x5b384dfb73c06ce2, 0x44e31aae4c571c2c, 0x0691372d9d2fdecb}, FieldElement{0xa7342dfe140ad20f, 0xcb59714a1f4fa8ab, 0x2ac5efe1d9361591, 0x01793e0e8acb9382}, FieldElement{0x36128d9a098f8f8d, 0x4470908c082fc1a8, 0x9e4e097d2f1f58db, 0x04ca0ff763b245b5}},
+		{FieldElement{0x5270e48b62ea13d9, 0xabdb72fcae41c0b8, 0x2be5daa2f939e983, 0x0569b13b40be615a}, FieldElement{0xb2aab2fe6e949703, 0x79086484555ce33f, 0x980fbefb79dc290a, 0x052120a39de0cd0d}, FieldElement{0x2e8efbd5583c323c, 0x6fde8a03250ae5fd, 0xe3d1824cbec93bb0, 0x07012b3c3705ef20}},
+	},
+	{
+		{FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}},
+		{FieldElement{0x56488fe69727e98f, 0xc317aad97ae95a77, 0x1793f3c5e9df251b, 0x02e3860b7b0f6a5d}, FieldElement{0xdc6cd45cb916668e, 0x0e95d2baada4410e, 0x39f1ff4619d9818a, 0x00a961a6c4f55869}, FieldElement{0xc16f6f0d313863d0, 0xf5e4d6b7ee0e89db, 0x4f4700577cb9178c, 0x065a9ce173124686}},
+		{FieldElement{0x96169978e97706c8, 0x33ff8fe150bc895c, 0x43fce8b2e7802726, 0x0191d3424e3aaefe}, FieldElement{0xe34ece8f5ae2865f, 0x06b3cdc4ce856be3, 0x68682f9008bf8f09, 0x0701ff22aa6273bd}, FieldElement{0x7417f5b310ca7200, 0x2e83f08a7dbf5cf6, 0x32f90f28fedb7212, 0x0338bef53535facb}},
+		{FieldElement{0xe12e8e3c6a23a8f6, 0x7674326cb08f6d01, 0x3db9e41a73eb4130, 0x02d76cbe47114859}, FieldElement{0x78e0c180f224bda5, 0xa251461890fe747b, 0x387f762f2b4733f6, 0x060ad5f440740501}, FieldElement{0xbd2f6fc29efa4086, 0xd57ba21abe7609aa, 0x8b50fd1de4815dc1, 0x002296c14a45572b}},
+		{FieldElement{0x1cdec2de02ebe9f7, 0x53d72b582009672d, 0x8c7754adad3fc82d, 0x048c1624dc8acb5d}, FieldElement{0x72827ad66c9609bf, 0xeef6163dc7490fcf, 0xca286369db5d6b6c, 0x0253b1b47e03eca1}, FieldElement{0x86115a8f58101c25, 0x8110c26b2f0622f4, 0x445e5fc305c4e029, 0x06d4f8ae6cea43ab}},
+		{FieldElement{0x6c4f8360652c4fef, 0xdaf0c1129111ec94, 0x5304e75c05122198, 0x05b9204109adc887}, FieldElement{0xb5f4150c8732442d, 0x0c9886543b238c14, 0x5cef5a84f6631167, 0x04c4d815d8727d33}, FieldElement{0xddff3ae3c515d24e, 0x3a8a9082cca0b83b, 0x7efa826d69b462d9, 0x0109177c866ac224}},
+		{FieldElement{0x61b790e5777be4a5, 0x9dcc060b1acecbb9, 0x57bf22e57d49b847, 0x02b6828da509a3e6}, FieldElement{0x261714983d628190, 0xa2d001c987bfe002, 0xc5110fce3d3f8878, 0x0323e48376603d97}, FieldElement{0xc35dca1d73be0c8c, 0x17ccbd8fba6a4d65, 0xfafecc8b02cfbffc, 0x07567f6e2def8f54}},
+		{FieldElement{0xe371ca17afec125f, 0x951bfef9db6f2933, 0xba442e240879c9b2, 0x06c9bd26fd7ff4e3}, FieldElement{0x2e9e65341a50eb7f, 0xcd2246e08cb4c443, 0x11faae521430419a, 0x07ab1e9fa882102b}, FieldElement{0xf49da7afeac2ace9, 0x6bce8533b6c2143d, 0xa00a36a0b1e0aa8f, 0x053b0151fded6d25}},
+		{FieldElement{0xeb88f9f4070fd941, 0x83b37816f1e11b87, 0x4381882e6fa3e3a3, 0x029bbac31f87450d}, FieldElement{0x8ab5276968c0c368, 0xea9aba3553e1f825, 0x98a6bf2ee2a6ed90, 0x075d8508c7178deb}, FieldElement{0xc69e479c889fc3bd, 0x747ae5f0670cfdf4, 0xc471a86e9bc8d8f1, 0x06cf5462d9adfbf0}},
+		{FieldElement{0x8104737c32511e5e, 0xeaed9f836c2166b6, 0x8b2ac484b3767d28, 0x0166ce7b7744021c}, FieldElement{0x64b1f1a8d51f56e1, 0x2716cd049a88d4ee, 0x94596c034f30be8d, 0x0040ebf138f3921f}, FieldElement{0x7f9f44adf2cfc39a, 0x30491b64200e852f, 0xb227e4f06d90711a, 0x00a1fdac046e0ebd}},
+		{FieldElement{0x049b7b6cf30325d3, 0x7c18f0c6221d262f, 0xe93e0b2f42c32e0c, 0x028469df56a9cbcf}, FieldElement{0x6579808e6395c631, 0xb8ede29a9b230838, 0x966b5d29913ccbc1, 0x04853a8da4978c82}, FieldElement{0x16480de8cabef0f3, 0xcf067f2a08aa7b20, 0xce2befbbc76ad497, 0x01b206de3b03f38f}},
+		{FieldElement{0x01e56115695bb2aa, 0xf0b1e32920572cd4, 0xf4436fd9d7b6abdf, 0x02ea08aa3a658a9d}, FieldElement{0xea1521f17a5e0f12, 0x0bf2d9b5e1f1a6f9, 0x1f90ae67809c5358, 0x03913e5ffb165bbf}, FieldElement{0xc774aaac6452de2e, 0xba13769dfdb5fbef, 0x6b03cac3fe5fdd23, 0x07687c066ff481ff}},
+		{FieldElement{0xb1e1b16d88d9844a, 0x96b7d5c6494273e7, 0xc315ea39d14eb344, 0x02d6e07c2d2cea3c}, FieldElement{0x848d75d84dc819cd, 0xe953aa5b59fe078b, 0xf2c133adccbd0bd7, 0x04d913b500710aec}, FieldElement{0x742e7a311cd3f7a6, 0xbcb4c5ac5883d308, 0xe6aac3bd9f23cad7, 0x07d9a81596738060}},
+		{FieldElement{0x2d6acc0b1ce9076b, 0x5568b245749f5e59, 0xd5e09d00ac24ed5b, 0x06e2cd2e0d430080}, FieldElement{0x6c5c82cfce142eaf, 0x0161cd1e167bbaac, 0x397ea61ecaf4663a, 0x05ec0f9536db37a2}, FieldElement{0xf2dac226dcd63697, 0x07490fcd81a74b7b, 0xfcb96cf49784a3f9, 0x009514c0586dad95}},
+		{FieldElement{0xdb4d2d234089ae10, 0x921144b4646802d4, 0xa3712fc4ba83236c, 0x06ddd72eed610a57}, FieldElement{0x30fc89433934c8a4, 0x77690291356b5012, 0x6d3eba3a5547dea6, 0x02a0fec7bc308534}, FieldElement{0x3797632f18bacc5f, 0xdeb053034154eb71, 0x5395dbb20fb8595c, 0x03c12425306e0276}},
+		{FieldElement{0x995853a95d3fcb66, 0xe3f8aadb01002eb6, 0x65851b2570d20f2d, 0x023c92582cb60fed}, FieldElement{0x690fb806790168f8, 0x67f02a108b216d5c, 0x42617aa6986449ac, 0x01266a7ace625aee}, FieldElement{0x12db615e9ea07e46, 0x3d899275841a5ecf, 0xa79aa9cd65958eb9, 0x06869bf9cc2c0883}},
+	},
+	{
+		{FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}},
+		{FieldElement{0xc5b90bbf3a35832a, 0x3e96c59b50956fae, 0xcc4afaca36dcf45a, 0x04199830d42795d4}, FieldElement{0x6189782ce1604c6d, 0xfce5417e00e84abd, 0x4848fd9925318142, 0x034e220952983532}, FieldElement{0x56fc3b03f8349c20, 0x0c57ca41b81aa29c, 0x4fc74b5dfd4cf011, 0x01e327dcabc53329}},
+		{FieldElement{0x33179e86a4ba14f6, 0x79b23c7b52822a6b, 0xd8f4eaf0c306db28, 0x07bd5f4c6fb31c26}, FieldElement{0x3a41ea3c7a6f4702, 0x0af929fd1660e7c2, 0x7ec5b9946d47296b, 0x04f961ec0e2865e4}, FieldElement{0x2861e44541f351a2, 0x6a3c32acadf536e6, 0x374abf19144400c7, 0x06edc7181332496a}},
+		{FieldElement{0xb0539810ab247996, 0xc68045df4f426a4b, 0xc1a7b131304fba27, 0x03fd64daf1820c08}, FieldElement{0xeb2ed6a2702bf319, 0xc1ef5af43d347437, 0xb0dac4f63d1def20, 0x027b01d02ea598d2}, FieldElement{0x8a3f5d6746f73d77, 0x8cf18a5a6a278d94, 0xa1d081698dd2ea0e, 0x0205857ab8558c7c}},
+		{FieldElement{0xd8ca58f684f09fee, 0xfa30d7522873a4bf, 0x8ddcc9ad05eb658c, 0x055fb263182f9dad}, FieldElement{0xf5961f4df85de244, 0xabf99793aa9e43da, 0xd4728c86c7fe7503, 0x04c9999408f63fd3}, FieldElement{0xa9f103760fb80447, 0xabc8cf935c176f95, 0x16648b960868e14b, 0x04a604aa9db4e35c}},
+		{FieldElement{0x38ec3b000a4d139e, 0xdc36ea076c552356, 0xd1b46d33f49bc219, 0x00b18d940990a32e}, FieldElement{0xd3faf2b0babae2c9, 0x8a138ef702b7e07f, 0x8f27119ceae08e25, 0x0482f6cd4c0b6942}, FieldElement{0x79b692a65a7c3a73, 0x8e95a86c286fa9d5, 0x06deb5c39a4ba2ac, 0x07429d7c1ed3b094}},
+		{FieldElement{0x9a0b988981942a0c, 0x05bddec225da9835, 0x05f702e9cb1e02f4, 0x077639503cb24c9a}, FieldElement{0x11118f3e3950ebee, 0xe7696c1d133d45bc, 0x6c5cdb047ee89ab8, 0x05676e6b52ebe6ba}, FieldElement{0x41a2153945040977, 0x9eb95ea3547c32c2, 0x7ca19eb8e2a79ec4, 0x00daea1747b880d6}},
+		{FieldElement{0x06a1be90601fa32f, 0x8ed6388dcc290a1a, 0xc95b4d287cb1a243, 0x05a5c407094d3208}, FieldElement{0x763cd9f1f5590d68, 0x1322e6065025db6f, 0xff589e872309c1c7, 0x03bd61420da59fe3}, FieldElement{0xd51f9bd719bdfc05, 0x92c45445346bfaba, 0xa762555f5f948135, 0x0374ab6a76117317}},
+		{FieldElement{0x1ea68ffaadf9d6c2, 0xdc902e313f0cecbd, 0x95ba19d372a3a48c, 0x04be3a1f151a0600}, FieldElement{0xac06b56e43ee91a7, 0x4a939b306f31f128, 0x11184f06f2dcb16c, 0x0436519235b0571a}, FieldElement{0x3df6a90da69b29da, 0x1455b6165f09b034, 0x844df812badb7765, 0x079bf00565a24254}},
+		{FieldElement{0xe65e920186c23a26, 0xa5100b000d45d5e8, 0xc89f0061f2232f72, 0x06e30874d94e2fe6}, FieldElement{0xa8dea894eef52eaf, 0x985a22fe32a217db, 0xbdb1af217752a639, 0x03dbd37a4748b8a7}, FieldElement{0xfcd48c418be31eea, 0xe57b59e49fd313a7, 0x5f92478d90d938fa, 0x02edb410c7f1ca71}},
+		{FieldElement{0x144edebf6df93ca7, 0x57e3a488f6c93835, 0x77990b014008a832, 0x05ab8791c3cd70ae}, FieldElement{0x2c466100d24885c0, 0x1290c341728d5e36, 0x8e4eaef94d3f445b, 0x02694a190c61531a}, FieldElement{0x9c21ecf576d30337, 0x02d48ebb8c432f11, 0x945005ab7a686632, 0x0651f27135b5b5f1}},
+		{FieldElement{0x889b614b6665eeb3, 0xfe1c82d65a5a2f4a, 0x40257f072a52e407, 0x07d6e4111ef2068e}, FieldElement{0xf203cb2544574130, 0x4234e028f3a01c80, 0x29e8e723435b16b7, 0x03017d48654b8a73}, FieldElement{0x72cc218049239c8c, 0xa2b5cf17b102a42f, 0x34c22792cc5414c7, 0x06a65a3d156b2aff}},
+		{FieldElement{0x365f47ae8ef75ab1, 0x34c38a8b211dfb8f, 0x1fb394f2e73365b9, 0x003c77da62556428}, FieldElement{0x8d40693b34cdf5a7, 0xf41c678dfe442f48, 0x0fb7776a471f5c50, 0x0774dfaa92cd47f6}, FieldElement{0xf399161af19ea63b, 0x0c0ca0033c6c0d60, 0x70dac8f5a1250abd, 0x05d6e82930cde949}},
+		{FieldElement{0xb0781c942f7de0ec, 0xb774d379ba7327c3, 0x1a9fecf8e621e66e, 0x0280c77e67057d88}, FieldElement{0x5faa66ffd2f10f76, 0x4d6a0f5ea6828bf7, 0x8f01531d0433ae3d, 0x02a7cd51a4d74131}, FieldElement{0xfd2762603ab90ede, 0x6c98c3975092d7bc, 0xd4ff9bb38ad21d88, 0x04ee2744b961809f}},
+		{FieldElement{0x635b51c128e61e58, 0x12b8a8923c022da8, 0x3a4b35b614cf1011, 0x045cc07c49fe9f40}, FieldElement{0xf8a471d50f270bc0, 0x7bd1ed889722ebb3, 0x51814994c866f710, 0x07ef6ad064ad5461}, FieldElement{0x034ea5c09d1ed9f9, 0x49430f7586b116ba, 0xe782e292a7b49a20, 0x02482b1c7fe094ca}},
+		{FieldElement{0x3e7ccdacc645b016, 0x63cf76ccda162165, 0x3576ba817386782e, 0x01aace0c24078dcf}, FieldElement{0xc560f3aaa2f88e8d, 0x21c425f760a3fb1c, 0x4bcfb20aefa31040, 0x06d7c4a8ff20eabf}, FieldElement{0xc4deb8e066e0074e, 0xd0720ad4980679b9, 0xf572862269512508, 0x06352073e51d55ee}},
+	},
+	{
+		{FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}},
+		{FieldElement{0xe65577b437215d15, 0x9bdaed0eab63b3df, 0xd7b46736e557c270, 0x052bf8f38eab2b68}, FieldElement{0x823828bc81dda1fc, 0x93557e7e84b18ec8, 0x1deb2f7326da8410, 0x02bba03bf82bff35}, FieldElement{0x44b28129406f1369, 0x240bb4d7449b8362, 0xe85ad8cef9f7525f, 0x02e7c9c3ec2b5621}},
+		{FieldElement{0x939c6771e6ec4621, 0x88c442ff8d0f417e, 0xda737383fb935b3f, 0x07757b74b9107430}, FieldElement{0x4910721d128e60ed, 0x0df55e57b1fabd87, 0xe35ebc0afe6791f5, 0x0617342535ea61dc}, FieldElement{0xbb793f5f052db843, 0x6955eb7b47f353e0, 0x258f9dfaeb24306c, 0x050ec9e68ef1459b}},
+		{FieldElement{0x77230b70bbed782b, 0x48538ecf04c1aea2, 0x1c1ee58dc6f10265, 0x026620c3b37b44df}, FieldElement{0x69579400e8e38431, 0x9c6339fc96fd35fc, 0xe1759d8dd35b1ccc, 0x0268adfaf48b5afc}, FieldElement{0x4f6951d481257b19, 0xebf42aa8f09adfa7, 0x8ebfe6c0ac9336cb, 0x040e5995075a43da}},
+		{FieldElement{0x4e45039c2d4b02bf, 0x9a24d3354c1a0e70, 0xc5cc5ab61aba5894, 0x03bfaa52439a249e}, FieldElement{0x41cf4cf79f764d24, 0xbbfa31009f0c64fb, 0x72b35103ced7fea6, 0x03310d4933b01d1d}, FieldElement{0xc3990ff0319a438e, 0xeb277d147400cc6e, 0xbdaa78f46549646c, 0x01c1324a06cfada8}},
+		{FieldElement{0x41a5f6b22031f7ba, 0x59f8fab7900487a1, 0xd251aee67f818636, 0x07b7598b52d496f0}, FieldElement{0x368cb9fd47260eb5, 0xf06306ccbb8c2976, 0x1afbab7f125e8e31, 0x052f7591d05e7551}, FieldElement{0xcb51ba6bad588e19, 0xf5cddf5bc385cac5, 0xa4eaff25eb9bd79c, 0x0306e4243a69b381}},
+		{FieldElement{0xc7c4201d7101b53a, 0x96c6a2e2128b760c, 0xa05425ec20183220, 0x046cf24f2d22ffd7}, FieldElement{0x55ed4bffb771513a, 0x0b358b07337553f1, 0xbb0e5a2903fe6436, 0x07680db8b5fa9d1a}, FieldElement{0xe018aa5e60dc61f4, 0xfbfc5d006e595410, 0x5d8dbaf01b98cee7, 0x06a8627b1daa5528}},
+		{FieldElement{0x60a1944ecdc1adb9, 0x5ae99eb0ca465bc8, 0xe907ee28038a4048, 0x0632e9d13ea135be}, FieldElement{0xf2d2ef895a313376, 0xe4e4bdc695b3aeea, 0x137acda0c391332b, 0x01a32f8b0ce74bdf}, FieldElement{0x06a2f74e8b28c092, 0xb64ba68f1630baba, 0x04c3cb88cf6d86f9, 0x069d3e7fea5bab87}},
+		{FieldElement{0xa181643951d5fbb2, 0xfe0c075f71bfcc22, 0xbd28644721af5769, 0x0060ec56a13fb0fb}, FieldElement{0x14381d7fd1c7c646, 0xa8960925a88ef5f0, 0xdd2fcdb61bb12458, 0x07386ecb67da9a35}, FieldElement{0x5416d946d610653d, 0x537b9b578019da7c, 0xaf911805ff11a3a0, 0x01daf71a558933ec}},
+		{FieldElement{0x7ade7057c4c7407c, 0x4faf8f980ef83839, 0x78678d406bbd7318, 0x014616d409997438}, FieldElement{0xb423a8fe1a1d0a72, 0x59f98813bab6b023, 0xa55cd834e3ccee3e, 0x06d9eb5ea82fc450}, FieldElement{0xe53b0d73a4ee1d81, 0x7b14fcc74de7173a, 0x86ea1c98e0217756, 0x07a3a20b9361df0d}},
+		{FieldElement{0x901727bedf29e7ed, 0x9ac9aadb4be550d1, 0x1dcf20394ffe2282, 0x0252c622c66eeeec}, FieldElement{0x3eb5952738badc4e, 0xe49f3219ac41b5cd, 0x08e6923332dd84a3, 0x0628f115b0f108d9}, FieldElement{0x1f85ccb2176de5f8, 0x84706c792966882e, 0x119af684278a1d3a, 0x03cda3822f4b6010}},
+		{FieldElement{0x50899f5bd6a499ff, 0x24e443a7fbc790da, 0xaa358293798cdaf3, 0x05fc89d4ae099ada}, FieldElement{0x05192227a89e7aa2, 0x12d39d27a22e9451, 0x1a9902da5975d67c, 0x02db2890201d11ad}, FieldElement{0x28f3ded5b7abdc82, 0x4abb781460a2b0e1, 0xf22f0f23bfc6b2f0, 0x00cba58fa20721b7}},
+		{FieldElement{0x7aa9ec2bc5bffd07, 0x91c72a6cb48b3024, 0x7be6ae20241866cf, 0x046d5f21abfef6ec}, FieldElement{0x0424455e7781fe17, 0x95cb19870bc896c9, 0xc0b68cf84be1c234, 0x0047ea1e3dbade7d}, FieldElement{0x1807cd0224206c9b, 0xb05161075ebf7c13, 0x89eb7cc0ed58e76b, 0x031b91fcd877f098}},
+		{FieldElement{0x7e1ebb7cde8e937f, 0x15bd9a522e6f1296, 0x589b9da3ebd6b6de, 0x0762c09ba337e114}, FieldElement{0xececf448b745bf31, 0xf7661d240850b1e3, 0x20c546218af72701, 0x0640a706282427a5}, FieldElement{0x2be8eb31e32b9bac, 0x2c16e83a74d51104, 0xefe7de50ba7c134f, 0x05d3852a99514fd3}},
+		{FieldElement{0xc730536c7f8e87a3, 0x9efede9be0acde20, 0x6af881e5a96f70df, 0x01e0417da9dbd1b4}, FieldElement{0xd867af2e2f29f02c, 0x8c4fde1cb0c23ee0, 0x78c8b589b543932a, 0x021507a30ced9ef5}, FieldElement{0xe3cacc178c361f87, 0xb2508ab1c3d77c34, 0x36853a4e3a99c23f, 0x07c26648143148dd}},
+		{FieldElement{0xb721178f63c87d44, 0xd492f047b00d7baa, 0x48ca1186cdf1150c, 0x059e9da69bc3e3e8}, FieldElement{0xe7eb276dea1d1b0b, 0xa5e396e690c5089b, 0x2b0427c3d4acd3ad, 0x066daebc97f608c0}, FieldElement{0xc21af9a0b4e946dd, 0x895d2597e97267cc, 0xbaac540f56f1234e, 0x0737a4b6c4b48765}},
+	},
+	{
+		{FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}},
+		{FieldElement{0x16b32ad3fe8a9b11, 0xc5d877a2d4045dc8, 0xeb537e61b62723a7, 0x0707ea0620153baa}, FieldElement{0xfb1d26fc9d7fde4b, 0x6646c517f20dcc7e, 0xd52c7552e34af873, 0x042d3b820c7543a9}, FieldElement{0x2eedb0be0f40a542, 0x130b3aa662767669, 0xc724b43351028856, 0x06838f042148c04a}},
+		{FieldElement{0x06528c8d0bb9af9b, 0xa8a65d4759d3b84f, 0x7d588abb594a8d77, 0x0612de393115d2e4}, FieldElement{0xc799df08330c31f8, 0xc64a3b7e5060d183, 0x3091c435de85c068, 0x062fc56ed209e1a4}, FieldElement{0x040e474a38343c9f, 0x3fae0fa91ceb19e6, 0x59c1e7f52f254ca9, 0x07d270896ac05337}},
+		{FieldElement{0x202586680b5b9677, 0x11da3b9cf448e310, 0xdfa6ea9f50aaffd8, 0x0250b9741c11b5f4}, FieldElement{0x2edd50c9edcc7548, 0x05d15e504d4127b0, 0xe8c3019680ccb6b5, 0x02790967b2cfdb15}, FieldElement{0x1d2b4b861b16c81d, 0x2fdaf7098c681834, 0xb7faaac614e1b315, 0x0161f8983128427a}},
+		{FieldElement{0x402ac488f73d4991, 0xa37158d766e22825, 0x5f1a48c5943962f1, 0x00ffc4c4993c0f4a}, FieldElement{0xa40214f504db2504, 0x0216d843024d3ae8, 0x7e19b03ade46dd45, 0x02672db7c3d1455f}, FieldElement{0x24e0100af14134c2, 0x9e9ad214d76c1adf, 0x2487beeb9ec16b6f, 0x04bd230887407593}},
+		{FieldElement{0xa5ccdbcdc9c7b78e, 0x08a9ef84a2937151, 0x0ade5fc1c267089e, 0x011b80e82c39fd92}, FieldElement{0x8bf772fdd8cfa468, 0x32add0958796e920, 0x3ca7be3d3b08f11c, 0x01594bb0a7d7acb2}, FieldElement{0x3b29e08db55b4a02, 0xa885c02dea1ab34c, 0xe3eb216b0e6b203c, 0x01180ea10042e962}},
+		{FieldElement{0x5c51fcf4676fe42c, 0x4a90e00cceecd0b5, 0x4f1d176d20083283, 0x07fda558e0ef94f9}, FieldElement{0x1d832272fe9a4c55, 0x2f723b16ecaac6a6, 0x62eeb0d9cb2a21cb, 0x04b0ad7a3340ea2a}, FieldElement{0x837938d2804c3b89, 0x0691b37a2ab492e7, 0xa7e673670f64a348, 0x040e6ef3190f403f}},
+		{FieldElement{0xc102511e9fa4f63f, 0x07d15dd5ce21c0fd, 0xcd96ece0ed46fcae, 0x05f5aeaabe60299a}, FieldElement{0xcfc9e28351b0947c, 0xc479eb5a672d394a, 0xd76f36e1f5a4661f, 0x04a50c6bf32f1279}, FieldElement{0xe92894306638ac97, 0x3c73bda1fc2e8282, 0x53741cc9bab70882, 0x00800184677b1793}},
+		{FieldElement{0x7c7091d5732f869f, 0xc446627cec2b311b, 0x7f1926cc0d77c51d, 0x0674840317d115d2}, FieldElement{0xe50ab06545d03955, 0x5052973e0fa38ca3, 0x76556519664a74b8, 0x055737e4df898fea}, FieldElement{0xc51f61d8c52be511, 0x983a6fdbab0957d9, 0x2c9cf85de1dc4d32, 0x015f9b706b89418f}},
+		{FieldElement{0x26af8eacdc7b1e94, 0x93a02cb4d470567a, 0x1498f2ce4347d6fd, 0x05c200f45858c7ab}, FieldElement{0xd5dd6f8e4f7220fd, 0x87d0cf2571fa71d1, 0x76bd2918978530d8, 0x01a5641a0bf57491}, FieldElement{0xd44db3e4b92efd68, 0x672cff9689313a8c, 0x9bf6515fc21244ff, 0x069a2521313e732a}},
+		{FieldElement{0x4f93ef712e677d08, 0xf8851fdd38a655b1, 0xfd4267757ff28191, 0x00e84c7b1664ba72}, FieldElement{0x3b0d8276d12321f1, 0xd5aaa7517f56bfab, 0x4d2b6f922cf11237, 0x05e3140acbb68309}, FieldElement{0xed5a962641c853a6, 0xf62db24ceae3f308, 0x7ab77ba59f51b0e5, 0x074b042545215774}},
+		{FieldElement{0x3c7a1c6d46ca7fd2, 0xe332433911dbd2a7, 0xbabbd6344ccb365b, 0x06e94a2f6d81f909}, FieldElement{0x14a8290858a1e800, 0x97e2a5fede39ce8c, 0x2eabbfce8aa06f8e, 0x07cd72da94ffaf6f}, FieldElement{0x4ac7b87da29526f0, 0xacebb418177e26e9, 0xc80c27d1f9ff2c4b, 0x0597b5a6f898839d}},
+		{FieldElement{0x8c8a59ad0a547538, 0x6fdd0cbeb2fbaf21, 0x78caf8e115410719, 0x014c5d1540004c7b}, FieldElement{0xf2aa524c391afa7b, 0xbec3d227bfd45508, 0x7e83576a1b08a1c2, 0x07bcdfb157b28e02}, FieldElement{0x49f39dcb397fd32e, 0x3f27473f2b455f0d, 0x0dfd658b5fa6fef8, 0x0653599bf6a18001}},
+		{FieldElement{0x5b0cab8b6f3214ea, 0xdd10de15769bb93a, 0xa427e0c9e6ea3c57, 0x01e0c7f1d4140e48}, FieldElement{0xf62ffe935144fdf1, 0xce8074f7c4708c61, 0xfbb052a99154aee9, 0x0030720432fd2ccc}, FieldElement{0xd9b74804dee69ae5, 0x733204ea25918ad9, 0xd234cc9bda479364, 0x04e0fcd551607325}},
+		{FieldElement{0xd843c3f99ee392e7, 0xedbfc551a4082f7d, 0xeb40638b546076f9, 0x0373fc2fa2a3f61d}, FieldElement{0x99492da2ce726879, 0xc5ccdeeea2d2060a, 0xb32f6afbf968117e, 0x061c47e055023e0a}, FieldElement{0xaed4dc25231cd4f5, 0x6b59c514f3e06e73, 0xee2ebf39ccc873c2, 0x04b419b8743adbf1}},
+		{FieldElement{0x2792fd8d3c3fbe78, 0x530c2038726a3e69, 0x8c6dddc06ae9bda1, 0x00012111551af1f9}, FieldElement{0xb967a3375f878f28, 0xfb2bdd939d0682c3, 0x6ba7fc0bb392edf4, 0x05b4eee5f1a7ebc1}, FieldElement{0xbf65be210bca9438, 0x290dcb0fdc83ad23, 0x4bd201ee7efde385, 0x0078a123d2e10190}},
+	},
+	{
+		{FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}},
+		{FieldElement{0xfbeb42da8fd326ba, 0x2f16511ba898cf88, 0x100288f4f20045c5, 0x026add9fff08f763}, FieldElement{0xfc71ce6697479629, 0xdad2d8a8824c67dc, 0x32588c27929d46df, 0x0033727ccfbf1564}, FieldElement{0x607916766cda8a71, 0x2ef06e2137aacd8f, 0x06910245dc0cec58, 0x06ae237d63d98163}},
+		{FieldElement{0xf7e1b92fc4a891c1, 0x38f71336ce69b650, 0xa35ce675c684ffb7, 0x008e1634c8a271ba}, FieldElement{0x1311d7adde3d4003, 0xa227370ed9ea66b8, 0x9c0ac0155d414483, 0x06fe0612d0fe80ba}, FieldElement{0xf6d5ba91d5a66300, 0x1352344954765c11, 0x3c5eed0ded66fe0c, 0x011f9ddd0c1bc5fc}},
+		{FieldElement{0x284ce64ed1f059e6, 0xb1bc0ee8627c05f3, 0xf0dbaebdbe519e41, 0x0674433281fc6125}, FieldElement{0x0a735d3411cf49b1, 0xc2f6f1ffd3f08ba9, 0xce341e9e57a1eabc, 0x0043e3797ccce9ce}, FieldElement{0x597395b3671d3a62, 0xc550dc29c750365c, 0x1ced722e337083ae, 0x03689fab14d08eab}},
+		{FieldElement{0x28ad534e24eabfe3, 0x176df17eb033ac8f, 0x23a3115bb1c47a92, 0x02063b9afb74fb69}, FieldElement{0x6ec6d38c36a9846e, 0x21411a564d791eb9, 0x16b990416e2976eb, 0x04feebe7f6cfbc09}, FieldElement{0xeaf0109bc10d35f4, 0x2b2a8fc33ac3a452, 0x84642aac2efbbd92, 0x04ed0fe7ac8ef342}},
+		{FieldElement{0x25bea4a4d35abc34, 0x5e7df3ab8b3f78f8, 0x2828d48f28a81c3c, 0x0544c523495302aa}, FieldElement{0x68495a67cef93e1d, 0x1afb122480186e1b, 0x2aa91bd34dc7aa44, 0x035a0c569b3021f8}, FieldElement{0x8a616920962c992f, 0x20c6778af244ea37, 0xd76d3b62c0764742, 0x01d47faf7d4a70d4}},
+		{FieldElement{0xdadd1040f10450db, 0x707eebac8e370076, 0x959d967daf78d0c7, 0x07efa0922d5810bc}, FieldElement{0x51e53b23c6b21542, 0x50f52740dfc5a3af, 0xcca6e404900f91c4, 0x011c83d383459c6a}, FieldElement{0x9cf8148a5f5d8cca, 0x8ee0c25150a8212d, 0x1f39b33563aaff62, 0x039d37e3878b8951}},
+		{FieldElement{0x7f802167aa8348d0, 0xac37bd1bc0905c73, 0x001595665daed674, 0x0251cad9b41f48b6}, FieldElement{0xcfe24e687b4b2b66, 0xbd49e94952f8890f, 0x95d99528b00fa74a, 0x026049483dd56b44}, FieldElement{0xee9b9b0ce4d2b992, 0x32909b42cf3d62f8, 0x8a4fa1d99e5ed196, 0x0367fdb7c4e77c43}},
+		{FieldElement{0xfa3432c45e324392, 0x2f2284ba0a5dcd1a, 0x30a79629a2608aaf, 0x073dba66e04859dc}, FieldElement{0xd8239455a0ef096c, 0xce69a976fc85cd61, 0x6799754ae6b45983, 0x07186299adc2b5c5}, FieldElement{0x9b1a4decb0117bd4, 0xae7dd303463d1625, 0x413581f7246e9b3b, 0x0348654fa886e888}},
+		{FieldElement{0x1feb9fe5caa5b3a3, 0xfd7c7b0c78fec6a5, 0xc1a66ff222e8bc48, 0x05a6f05d19c1fb4f}, FieldElement{0x1b806d492adf18aa, 0x11e27aa9e4358969, 0x778c004fc96ddded, 0x05381b065f2b4b53}, FieldElement{0x605b2b71745e019e, 0x470d8022e5c9a988, 0x6ab10d8360b95188, 0x0009af9ff8688b29}},
+		{FieldElement{0x7b63c85eb9adbc25, 0xbfdc06b3eebc784f, 0x2be095b5eeb96655, 0x039712bf8d605621}, FieldElement{0x9641265b5c982501, 0xdaaa02cec64e45c2, 0x7903b98f8f0417f5, 0x049e0c0854ffca2a}, FieldElement{0xe952699260ee2556, 0xe4ea06bf9c6ba233, 0x5fa269bb02012de9, 0x04941f4024952031}},
+		{FieldElement{0x8fe764cb6da2dc14, 0xc162e85f32e8f1fc, 0x72d385d2dfa917cb, 0x04f97b7f6ed07e7c}, FieldElement{0x159552b5f4e30e6c, 0xdcab3415eec6df6c, 0xa53ce6356a580ca0, 0x05969098b4e495c7}, FieldElement{0x50a84e61d6d2446e, 0x91a1656887cd4411, 0xd8e442c109e210b1, 0x0514195ca2d05dd6}},
+		{FieldElement{0x07c17833ce87f936, 0x13ff519b1f1fca79, 0x657bfd050f525e98, 0x03a0435bdad3be5e}, FieldElement{0x2f06a8bfb743a9d1, 0x59aaebb6e8643df2, 0xd4e37e3711936e0e, 0x00479ee5a450d498}, FieldElement{0xdfa8b5d2d03dfde8, 0x35b8e6472a251db5, 0xc46e7b1fdbe2f576, 0x02939c893ee5aad9}},
+		{FieldElement{0x744ff14a3cde6af6, 0x88f3c51e8dfaeee3, 0x60c30e653053d065, 0x02b16fb7832b1650}, FieldElement{0xe42c8978bed80961, 0x58e565d93e0206b4, 0xca654785e62837b8, 0x064616e06cb5bdbf}, FieldElement{0x722ea8c0f0ed11d0, 0xe55da6f6396fe45f, 0xb0d94aa0c07f0564, 0x07deacbd53a297f5}},
+		{FieldElement{0x669b2aeaada79542, 0x63bfcc48b2658fe5, 0x4ce83d1b65eb0e16, 0x03ab6be8e34500b1}, FieldElement{0x41d116d2f0f5f147, 0xe72940d602d7c8d1, 0x893d34f680288eec, 0x05b87ccd8649a2ef}, FieldElement{0xae0ed193bc3c9520, 0x3d42e73a459ffd1b, 0xaf76c889eece4a16, 0x00465f0e9940da49}},
+		{FieldElement{0x2294776e99d6fca3, 0xf5580ca001ca9275, 0xd456769950a505df, 0x076efcf850416735}, FieldElement{0x4b49dffc17f0ee51, 0x48943a6be2bd2369, 0x21aa15f30a433b43, 0x035b0af186670cd7}, FieldElement{0x64eda850ca09813d, 0x489d126222b838ff, 0xdcec2ebdee7d4c7d, 0x05d9cc35edb144e6}},
+	},
+	{
+		{FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}},
+		{FieldElement{0x1768aa05c097be6f, 0xebb993e3cd1ea7eb, 0x02443bec4a05b34d, 0x06ef6a197eab108e}, FieldElement{0xdc2c31cd9faa9558, 0x494215370b41db18, 0xb1797b2a6efbd481, 0x0516a997e67bd837}, FieldElement{0x805edce16b7891cc, 0x082a63e6cef67b26, 0xf47d9f0a3cffd92b, 0x013c7a1d8144b65e}},
+		{FieldElement{0x5181438563d90cd1, 0xe8181f31db5ff515, 0xf2c3c16598c90089, 0x06b837c65f5da375}, FieldElement{0x3eb0738de95cdd15, 0xfbe645a7ca5dc973, 0x45656425fb5ac1a0, 0x07a7359ec2c5347c}, FieldElement{0x3cd999bd9db07a22, 0xcd747bc3def85c35, 0x8a234ccc13b02998, 0x053a4ca95047bce9}},
+		{FieldElement{0x8bce52a8f5a4061d, 0x5da8526e8e0378c5, 0x28c6175d9b194806, 0x0193acfb839d1c7a}, FieldElement{0x3e32fd6c461b2877, 0xe644ec642bb7fb88, 0xf670cc66c57f09dd, 0x025627f6188f3613}, FieldElement{0xb9a3558ac8a171e1, 0xb7f06a7f275376fb, 0x85c193ef8cc0c1d9, 0x016c215417193a71}},
+		{FieldElement{0x8397d5551fbe87b1, 0x5e1811f4aee4189d, 0x43bda305618208f6, 0x04dc58676a6978f2}, FieldElement{0x5e12f4c905326132, 0xeee3cfa84fd34656, 0xe6173cd43c248365, 0x0444852474b9bd1f}, FieldElement{0xe51c01fb4cdaaf0b, 0xfbfd4b2e9ec651c9, 0xbf1093f90b922d25, 0x0491fbc8c656e41f}},
+		{FieldElement{0x73c1d4657a2058a5, 0x1f2a81ad676ff8b3, 0x598e37883ff76f3d, 0x031759542f7fef3c}, FieldElement{0xcf3df6600d435f97, 0x0f53740b74a62025, 0x94eb695f3a83ca63, 0x051eb4bee978eee3}, FieldElement{0x3dff791a64dd1f09, 0xff9257f5616e87a9, 0x2f72350638d5673a, 0x04c23be95a1acdd9}},
+		{FieldElement{0x699415f6ce600b26, 0xf23b2b242ba34ad6, 0x9de22410c942c627, 0x04cbf852b9aeafaa}, FieldElement{0x821681de63d774a5, 0x1c762ad3e41ad2e8, 0xcde93de00edaae36, 0x005f3ad0d949ca77}, FieldElement{0x574243c29a8b1ad8, 0x68c5b9446f757651, 0x09542c431535fcdb, 0x0036b886ee631216}},
+		{FieldElement{0xffd47841f4b0c86c, 0x4576947296f6a2e8, 0x9d04400f9d5a0a0c, 0x0443a6d2903d8d09}, FieldElement{0xb417c04500c52518, 0xdb50d0cba1f00148, 0x03a50542a1559b22, 0x013d207c0ac945cd}, FieldElement{0xa77d410c8e2fbfcd, 0x68a2301abe8f20f1, 0xd68573f25b73c363, 0x01e2b815fb08eb00}},
+		{FieldElement{0xca707f4cff67bde1, 0xbcc45dbb5b6c7ac4, 0x9c46c5a66aee5a4f, 0x020df3d2bcfdee60}, FieldElement{0xc0a8b058d4c7d2a4, 0x68c0cbdbb44264f5, 0x447117a4513282bd, 0x0362fd7551935b19}, FieldElement{0x477c029f8b1974c9, 0x0f8f0472483322fd, 0x717d2c65d7e04d3e, 0x03ad488d9d1af9d5}},
+		{FieldElement{0xf9cbc2dffb32ffef, 0x8be4be0c5f3f25d7, 0x7018269e5f1a07df, 0x00002d84ec4c3d85}, FieldElement{0x385e82c26ad2d30e, 0x2519eb636d181077, 0xe545bcbfe712965b, 0x0115c30339827ddd}, FieldElement{0xbca1ee8e860f44c9, 0xac3c6524c6941fbf, 0xc49efc7f90eb3cd9, 0x049d5466e8cbf52e}},
+		{FieldElement{0xe9f5150ddd4a3a1e, 0x05b3b0640a6f9768, 0xe188b694b2c2915f, 0x066ee85185c41327}, FieldElement{0x0f248941d43f75f0, 0xcb35812c862d87cc, 0x54a38d5a9354dced, 0x05865087e4a723ad}, FieldElement{0x99e1e12e42ca6e95, 0xd1c5dfdd3b8e8ed2, 0xc7b7fe579d3d6ae8, 0x022055f9ba22f672}},
+		{FieldElement{0x1ebcd1bf07a6bd98, 0x42a5dcc87c5eb24f, 0x0c1b1f8aaffc0c5c, 0x011c1612e4bd62a4}, FieldElement{0xb81e6984262f3176, 0xe398d2272fbff96b, 0xe66a4ce777c9137a, 0x03d68d4effa0d6af}, FieldElement{0xee98ca6e4d03624b, 0x43bcb874f7241f97, 0x92fc89635483b3a4, 0x018bfc1d59d48174}},
+		{FieldElement{0x26b98f5d6dcc1324, 0xad056f5fbba92893, 0xbbfb4cb988c4ec97, 0x021f98a29c90a433}, FieldElement{0xa8ba3187a278cf35, 0x1171d09a0eda3f28, 0x2628f1f26472f671, 0x02c5f22ab11c8f44}, FieldElement{0x9bb8c98f083ba6bb, 0x2b7064e3dd822746, 0xcc2bb794c533a00b, 0x0201b4eea0346a24}},
+		{FieldElement{0x581d437e6974d567, 0x5a19ac93162eaa02, 0xfdcfdba5584b7a20, 0x03c90e1b31e30902}, FieldElement{0x7a30cf500f7c13c4, 0xada961478ed27518, 0xc26efc1943b92ca8, 0x01929972dad2e0e8}, FieldElement{0x2fd5b8ab3cfe13ae, 0x73952d6ebdf0f9a5, 0xc005a72e08cd32e4, 0x0742c43b68677363}},
+		{FieldElement{0x14de427f09e20475, 0xeeaa0e6fb60c50b8, 0x736815f46489d943, 0x0227f0fc5ac21d90}, FieldElement{0x8ebaf2e5e5b3c22e, 0x78d25b3e54d57c1d, 0x263c1a35cf726b23, 0x01f959792f69dcb5}, FieldElement{0x8a2aafbb0829cf2e, 0xa5ec0e1b67403779, 0x700e9b3a69396f3b, 0x034a62b28bec5ace}},
+		{FieldElement{0x460e08ddad65bb3d, 0xa4f2e5dda8caaed4, 0x092d5e6883d98d33, 0x030a4c2938c16f8e}, FieldElement{0x8f35ed4428b95e53, 0x3f6b5bfadde7747b, 0x894a6c5a870d55ff, 0x009f4d4766e0dd3f}, FieldElement{0xb3f7dd6c20f33e75, 0xe118fb917987c81a, 0x276cb5d3168fb205, 0x0542d3fcc583b072}},
+	},
+	{
+		{FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}},
+		{FieldElement{0x4391ca6ab2642913, 0xbdf65d0a0e63e26f, 0x59c40cb04376a0c5, 0x02cb5b9f570214a4}, FieldElement{0x761870bdd39396e5, 0x9772001eed0b33b4, 0x88669d81e3f78870, 0x0442d798871863a8}, FieldElement{0xc1a456c52fc2123a, 0xb3da07b8a5ed0601, 0xb61abbb3b6f98234, 0x073a7aaee3baf956}},
+		{FieldElement{0x6ef2fd7674bc4f24, 0x77bad4646074edea, 0x8fde17829576a536, 0x06907bd3c3204f8b}, FieldElement{0x101dfac0c61a915e, 0x4939631a4ed383e6, 0xe4a1c001df4e0e12, 0x06d94b159c638f5a}, FieldElement{0x66bfc8f3fa060855, 0x5255cb814d81e72e, 0x150bb10f1a3ebb93, 0x02bb30533f367d38}},
+		{FieldElement{0x3ffecd9f05eb6b13, 0x46903ae27d15ff8b, 0x81759643ea33899a, 0x01726e30b2dbdcee}, FieldElement{0x50aefe4ec86aa183, 0x4b4222486f352e75, 0xfe87736ba9ee63b3, 0x079f11c527f2d94c}, FieldElement{0x96ec896918d2ae84, 0x4f8fbd3f4d17c9df, 0xdda94a986c50652b, 0x0022e89cd15d2010}},
+		{FieldElement{0x249514a5fb7bdd60, 0x70252dcff899cfc4, 0x36e65cb8c99d9906, 0x03463fd90ad8e673}, FieldElement{0xdc5f2c957801141a, 0x3e503af379400956, 0x6eef8e2b40036727, 0x0011d2674d7bf6d4}, FieldElement{0x2307203cc0d653fc, 0x3d9a3ab89611054c, 0x95b70af4ac3b390f, 0x05acbb156ca7c419}},
+		{FieldElement{0xb6ea77dc0fcd857f, 0x269231de2149215d, 0xac342b05e84b16c5, 0x0453cade905bf6f5}, FieldElement{0x2293020e1b03cd3c, 0xd2fa5b3523e66182, 0x0b0af0fc7cf124fa, 0x06f320ab5ac07377}, FieldElement{0xa0700ca8319a7852, 0x115cc1a957a86646, 0x27d5dfe42c625bab, 0x02290b55e36e0386}},
+		{FieldElement{0xd876800ddab4985d, 0xf25e7b731fd369e8, 0xb195614bc1e03417, 0x0157695e17cf327c}, FieldElement{0x484cf53e228d8890, 0xec4551305ddddcf7, 0xe31028a044f005cd, 0x01684893ee7bec5b}, FieldElement{0xd865d3848302f970, 0x36bd59d062768b1b, 0xfa8b8dab356d92b1, 0x051768ac1b81f48e}},
+		{FieldElement{0x4a904300d491fd9b, 0x1dfd3aafa46e9135, 0x1cb0e44df267a7e4, 0x03bd7e82c8902001}, FieldElement{0x25e046502de2da3d, 0xe359d4e17a7787bb, 0x3badd0405e9be6f9, 0x04b180f136b74d1f}, FieldElement{0xfea0ff7ea80bb436, 0xf9a56c21f791a799, 0xb82042b7d33e87c6, 0x01a8028a38291919}},
+		{FieldElement{0x685b102265540c6d, 0x8307ef76edf22c09, 0xde484afa24f76cdc, 0x0731ba774ca15ab6}, FieldElement{0xa467daa77c2c7769, 0xef652792487b3e44, 0x59a4e8dd2114267d, 0x02f28112b5c47bad}, FieldElement{0x06f3bc9a53ef77b1, 0x0cfc5add63992661, 0x7cb308719d29f693, 0x017704ed0d16c341}},
+		{FieldElement{0xe96f99e4ddac8081, 0xc50f3ca365579a57, 0xff50e7d4f0022809, 0x024dc0f4901b69b6}, FieldElement{0x88a0d25e0be22c15, 0xdd1bd26662abfca6, 0xd1fd77d6ea8aba9d, 0x027b4a451ba43a0a}, FieldElement{0x5df5b26ba5de342d, 0x94756f1728690225, 0x7edd359c110d9552, 0x000d7b61e0b31a2d}},
+		{FieldElement{0x747a8f032bc69dc5, 0x282254b166b62e61, 0xe7efde405605c17d, 0x005038a411639dd9}, FieldElement{0x5fbc11db544f8878, 0x8a23d155b6c8ea44, 0x4233468cf2d2ce64, 0x03f1b74145fa433a}, FieldElement{0xf6aab284b461a51a, 0x37fa3b09457143a3, 0x1205fd0439527e93, 0x048eb7addb38855e}},
+		{FieldElement{0x563938335ca8aa12, 0x38b54d715f891cdf, 0xeff40417205e14f6, 0x0706a04350c039ac}, FieldElement{0x1ac611536ee7325b, 0xe3ce9caa31b26f7b, 0x5ebe3071e8d4cf97, 0x00d346d16642923a}, FieldElement{0xb55a80b4ee4b3462, 0x4c403d5563da5cc4, 0x1017796be376da77, 0x05031d9822e859bd}},
+		{FieldElement{0x3f85d2d7a7d914d1, 0x8cd74b640b92d189, 0xc078c50528068e3a, 0x04d76975d2d6e7fa}, FieldElement{0x7c688e420e01ae52, 0xebffaff97fd8445e, 0x12c87e27d1164180, 0x05fe0af81ca90ed8}, FieldElement{0xc4feca584d60fada, 0x828ff1d0b5208ab2, 0xeb2b31ca7aaeb1ff, 0x079d3d8668eaaaba}},
+		{FieldElement{0x0c2f9daeda6f1017, 0x3c8bf63ca7e63771, 0xc2679ab230559111, 0x02c74e8b0bd92ef2}, FieldElement{0xcb963ae3bc5f594d, 0x61f4e9f6c8c9f57e, 0xf4708e508dcbde90, 0x07785d4fc25585ea}, FieldElement{0xb9f741b505fe979c, 0x7feded4ffd05ce69, 0x6d1fab08c96b0b5b, 0x008195d9036d720c}},
+		{FieldElement{0xeae8ef54ce0a019e, 0x1db1ce9481c3abf0, 0xee0596b2c9cc0bf4, 0x07e9e75132c6d1ba}, FieldElement{0xf4de83209cd36e0c, 0xfc506133a864ea13, 0x3c8ee087cdda1355, 0x01e1047ddd987949}, FieldElement{0x00715af02be3b095, 0x4fae909f7cb1be57, 0xd22ca507df1c80fa, 0x03e1dea3e5512667}},
+		{FieldElement{0x71804ce3e20a7da1, 0xf9c3c155e0cef41b, 0x79877988a0563644, 0x006ed752ef2b38a9}, FieldElement{0x7434fce6e9b31f28, 0x6c235c6fcae4ad78, 0x78d96e5c050c9ef5, 0x0213d0198ae8fe5f}, FieldElement{0xf980d024653ed87d, 0x5cee5a25759de7cc, 0x270b5e79c9bc9dc2, 0x04ced9f38ce2fc20}},
+	},
+	{
+		{FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}},
+		{FieldElement{0x0c5475ec4124ebc0, 0x5be3daf7e5a2f67e, 0x6a58940b5a13a133, 0x07fd2b98ce004534}, FieldElement{0x7e25a43468c1741a, 0x8af580263c26f344, 0x38ee7bb2ef60aff3, 0x04352b7712d707d5}, FieldElement{0x8218395e7557251f, 0xd8e874247172eda9, 0xa27a89aac804f61a, 0x006eab612527f0e5}},
+		{FieldElement{0x8a025b703ddea6d2, 0x6e7d4d7d05624e31, 0x7b4b3f2418b0b93d, 0x07b50416c0edd238}, FieldElement{0x83b364f68034baf1, 0x3ebdf58500c41aff, 0x9a36134d1ed50f5c, 0x03c3d14db0d832e0}, FieldElement{0x8cac70d5952d69cb, 0x260a0e6f213d0fbf, 0xcd81cd924a34e9cc, 0x0022605490c8d373}},
+		{FieldElement{0xa4265c5fcfecc246, 0x82acb60346467497, 0xc3edca7f8ce35833, 0x007f55b23be7423b}, FieldElement{0x52473e99a1d472e7, 0x2aae418acf4d842c, 0xbe8536ad68ad59d4, 0x048ae7a9ffbd6970}, FieldElement{0xfe1b6e127708e1b5, 0xe0cc3c08e5612546, 0x1e34d2119b655028, 0x04c95411bfd05e12}},
+		{FieldElement{0x6ce571e7e199dbbf, 0x5a7d39cd33ac1db1, 0xaf7b2459b0da5bd6, 0x0378043cfa9839f6}, FieldElement{0x8aa09cfefe8afba1, 0x817e895975a39c04, 0x038710b5d009746a, 0x066bdfd967892b25}, FieldElement{0xa5e88e038d572b59, 0x55dbb0a4ac61784a, 0x4d17c4e58843cf28, 0x03c33b325a2b303a}},
+		{FieldElement{0x56cce2172318ea4d, 0xc0777848913e5819, 0x57cdead4612b15d3, 0x0613da47f719228e}, FieldElement{0x9cfbf68076003634, 0x4e139be0ba76a4b4, 0x44585fdeba6f7e3c, 0x050523c1a1640c78}, FieldElement{0x7580b3806e297dda, 0xe5dfcf9f8d64a57c, 0x3cd5febad35f6791, 0x04b8f96066229882}},
+		{FieldElement{0x67715e9f3ad08588, 0x22a735eff03cdd49, 0x568a597819b30fcb, 0x0182a6deb9757b5b}, FieldElement{0x47c13774182f9d82, 0x74db45f8dc1dc552, 0x36592658b610399b, 0x078450b31eeec6aa}, FieldElement{0xdf88d7354c4d0024, 0xaab625aac722f4be, 0x14157fa5afb096c1, 0x064075b39d67b71f}},
+		{FieldElement{0x383a035d7f11694b, 0x22bb8b5710de4f80, 0xb491baf0a76b56f3, 0x04648bf74d8dd2af}, FieldElement{0xd37293812a191014, 0x72ad125635a7bbf4, 0xa671f7cb15ce306f, 0x036bcf243c378603}, FieldElement{0x94a42b458e74ebb0, 0x33f07ab686c03523, 0xcf9202da46ea39c7, 0x04bd95e659d872a5}},
+		{FieldElement{0x09b286da824a69e9, 0xa095ff056cb47a9a, 0xa0aa725166f416cb, 0x0239a2da3e087641}, FieldElement{0xff268c901bc641f6, 0x32188dd2e964a517, 0xb346bc1609c0d714, 0x010ff62170351f6f}, FieldElement{0x576b6dfa698a87c7, 0xa1d65567bc92e992, 0xe7509053d92d77b1, 0x037d0b45eef5c9e5}},
+		{FieldElement{0x53bf003ce456f5ae, 0x5ee1d729fe51fbad, 0xb123b650428594e0, 0x04c95fed0eb721cb}, FieldElement{0x08270d18ab0b6a71, 0x4e3e8fb55631eee1, 0xd2ed5a71325b31d4, 0x0183ec0def4a40d8}, FieldElement{0xaabe78b0437e2f88, 0x86399ec65c9daa45, 0x313bb6dc783d3632, 0x066ee4da4f882247}},
+		{FieldElement{0x5eeb8ac6baa12ab6, 0x9ecc871a0c9741a7, 0x346e2bb2a617d748, 0x0791f5553f32485b}, FieldElement{0xa25d130da2947b79, 0x619a902884204130, 0x07fe27d605078786, 0x07089ec57311b394}, FieldElement{0xd1ca0897a3689867, 0x9c31e5440c6ddc85, 0x0a4c5d070734f9f8, 0x0362973c4dc76d56}},
+		{FieldElement{0x0e2489d5c158321d, 0xb7696785b2d6a666, 0x5929a061dcc728f7, 0x013b14b757975da5}, FieldElement{0x8e440d26e8f06067, 0xfacc710256762646, 0x69e81935a8c3a98b, 0x05b22f5717e2d197}, FieldElement{0x84bc72f3493df12f, 0x426973711b525db3, 0x76a054cbc16241a1, 0x04ade150160957d7}},
+		{FieldElement{0x1a09c0b17c42e1f5, 0xa2f30f0df6d03778, 0xc51d599550cf8a24, 0x0541f7d887290212}, FieldElement{0xd2cfe6ee04e54f6b, 0xd60859f86723f466, 0xae469701d828b6cb, 0x061c118280812cb1}, FieldElement{0xb9fc93494a2695f4, 0x36aa569b84868ff9, 0x7b29539104ab93bf, 0x0625e6e76adbdeb5}},
+		{FieldElement{0xdd8da07215d74820, 0x28be19614734d9ef, 0xb3b969d3020018de, 0x042599dcbc087190}, FieldElement{0x9293cf72d3a3bae4, 0x27385902a392ed62, 0x67bd0e60ffea4619, 0x0290dd31806a7594}, FieldElement{0xaea2865956c8a3a4, 0x0a133500b74a44a9, 0xe1aa3a5a2d827714, 0x02191a96a8d32456}},
+		{FieldElement{0xd1dbcde25092c0ee, 0xdbea233ee97bc73e, 0xd7f1d5930a61bf61, 0x04902aeeb46272d1}, FieldElement{0x8b9ac6b120c6560f, 0x1c140f184310be14, 0x856077f5e03a3655, 0x0751ea122cc04cbd}, FieldElement{0xdba9b56f7300abf0, 0x58a3d137fea4fd50, 0xe874d4054495edcf, 0x07f9b8c5c7593de3}},
+		{FieldElement{0xe94a975c1ce63764, 0xf95d362d78a42d2a, 0xb521bbfc1ff73882, 0x013fa658fc0713fc}, FieldElement{0xc9c4645f34e56a98, 0xe7895e392c76631b, 0x966189acc37af860, 0x0042e27aaa072f55}, FieldElement{0x3ea434153ec5dd25, 0xd030920de42aded6, 0x4b7d538f2ea14752, 0x0231c16a0a984bbd}},
+	},
+	{
+		{FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}},
+		{FieldElement{0xf681f5fe421e859e, 0x6e717226cb5e749d, 0x10a88b49c042e39d, 0x07e4ba3ea3cbb63e}, FieldElement{0xf0a1a447aa9fc444, 0x38bd30e61361aea1, 0xdea4088703d8a733, 0x00e405447e90b79a}, FieldElement{0xbd6ddd9b4d60552c, 0xe3c671e05541cc3f, 0x935d06d8f57b9760, 0x05e23df5815fa190}},
+		{FieldElement{0x886200976233d6e2, 0xe63fa392c8b91dfc, 0x53b9db2a2bd86366, 0x04ce87e89339ee2e}, FieldElement{0x096d7052c2220c91, 0xc60c49d103fd0000, 0x3608b899259745a5, 0x00d1fd76491915f3}, FieldElement{0x2a873665e41dbb4a, 0xa3ffc971b80ca77b, 0x15b7367af5223a7c, 0x0364dc473ed9df85}},
+		{FieldElement{0xf5b1a4f5469c8a29, 0x38bac29bc368fa46, 0x5b9dcd9463cd167f, 0x005bf9e01d364e91}, FieldElement{0x7557809276a7089a, 0xaaaba2e804b548c0, 0xcf8c6736408b5792, 0x012d330749dd34e3}, FieldElement{0x9fdde781f5888cf5, 0xd8aace3b6e6dc6ea, 0x3a459731ac5b5a1d, 0x02269bbc573b0fa0}},
+		{FieldElement{0x11e11375ef2e7c7d, 0x84569697fbbbe880, 0xe9b0bbc7e5752939, 0x04d0063deedbaeed}, FieldElement{0xc17cc31c4dfb2140, 0xd9963c54a0c87a1b, 0x7d9a2c79b59822e8, 0x078a5397d2507ea3}, FieldElement{0x460e69bab8dea6d4, 0x943a8c355857ee17, 0x0e1b5e662c56a2e3, 0x06f75f4e4fef363d}},
+		{FieldElement{0x792aa5c3cff9fc74, 0x830efa71f9065373, 0x4ee52c129196eee9, 0x041a03e19fb05daa}, FieldElement{0xc81b1b90c3318391, 0x85c2021df0d49cb5, 0x4539bc0f6744fe53, 0x00d802e16bc5751e}, FieldElement{0x4d2a42c2de59e43a, 0x58d96afc81cd8852, 0x3dc095e369495752, 0x057a23afe6dc7e26}},
+		{FieldElement{0x901c05498e65fa19, 0x15dee62f7d648659, 0xf38b89842ee59d1f, 0x016703274a1ffcc3}, FieldElement{0x0c50b5448ff78ffd, 0x9fe74e80d309751d, 0xe7878b84131dd351, 0x04edb060cc706df4}, FieldElement{0x1dfcb9a21aae104f, 0xc173a595d41034a3, 0x9491ee50336c413a, 0x057c4f6453d0dff0}},
+		{FieldElement{0xa1a83f169b393cd1, 0xa20fb72ba20f05b5, 0x06219bfcc56997ee, 0x01a54751d81e5376}, FieldElement{0x34db80b2b479ddf0, 0xacfc8be077284946, 0xd63527d702aab6b9, 0x05195f66bb6213d3}, FieldElement{0xa833363b0f4e44f3, 0x40fec48fa9f66e4f, 0xabe3cec99dbc8f86, 0x042f240ba10ae33e}},
+		{FieldElement{0xe7a161703f4a5729, 0xe9873c82203c83ff, 0xc5ba6cdf31bae211, 0x02be2852083920e2}, FieldElement{0xaa3c4d43fd499032, 0xda3aa3283f5aa81a, 0x3ab230fcf04a86d3, 0x0289dacbf690b533}, FieldElement{0x23a31f21c4983f74, 0xe7c458941f45f125, 0x55af9901e49074b4, 0x01b493d576065e2c}},
+		{FieldElement{0x6005603ac0ca96c4, 0xaceb27b93d00f029, 0x8a649ac75c8506f1, 0x021ce7cdff6dc9cb}, FieldElement{0x515a5f623b1768cf, 0xf34bcc342166cbba, 0xd9c7f0449ea55e18, 0x018e3b60b9d75a2b}, FieldElement{0xd870eee8aaeacf5c, 0x66c425632dad23ac, 0x4a35d7c71cfeb2b8, 0x0463d64b828eff84}},
+		{FieldElement{0xd02d584feb1fa400, 0x53d46588bfdcfdec, 0x264ae011ad55eb79, 0x0325cb7c5622dba3}, FieldElement{0x9e5fd525bada51db, 0x092162f2322564d9, 0x1987ba2126a9e9e6, 0x0713b27fb6a65efd}, FieldElement{0x98baf9a7437e953d, 0xc07778fa7c8664c9, 0x0957b6c0df0aa170, 0x02787fad765526e8}},
+		{FieldElement{0x929915ea421ece71, 0xfa6712fa20481846, 0x8e23e38733a68f9a, 0x03351fe5c49ed9bb}, FieldElement{0x67789174390cfd70, 0x409c6f9121538180, 0x52ed9ce971a7ba26, 0x059cc480ce6cc6d4}, FieldElement{0xef9694f4650d7b3d, 0xbd8ca104ad0ebc28, 0x9e8e475255dbcdb3, 0x0490a058b6218310}},
+		{FieldElement{0xbb5d7c848afc01d0, 0xf8f9b58e3760564c, 0x087946303f534bf0, 0x01cfcb341d574870}, FieldElement{0x2bdc6c86d635bdc5, 0xbbd3dba4d7a06c15, 0x80253adcc32e18fc, 0x02687c803ebaf719}, FieldElement{0x8b8548474e3d1a96, 0xe559cd3c2a508a91, 0x280e77c12d82200e, 0x0135fa1187b6635f}},
+		{FieldElement{0xbf16f2c849bfc829, 0x42a09750f732dd61, 0x387e4b5df050f6cd, 0x003f70c1b736ea36}, FieldElement{0x031f3001738966f1, 0xcf350d6e9d57ed10, 0xab351bc7a92bdd26, 0x00ce5372f18ff12a}, FieldElement{0xf70312007eb22619, 0x764c17f669f4d426, 0x66f28171f5abb712, 0x057f4b1e342e142e}},
+		{FieldElement{0xe10b61a2e84f4e42, 0x54a65d0866958565, 0xfe60b24008676583, 0x01113235d2ea7010}, FieldElement{0x60947e633cbd25c4, 0x9d2a7d8a58b60134, 0x56df8b2d1f68cb2f, 0x02b14ef3bf2ea620}, FieldElement{0xce1d6b5ec06fbd09, 0x32bd0e975c7f7359, 0x9047ded158c42795, 0x0432ea09a6a3c884}},
+		{FieldElement{0xfc1d6257311bd807, 0x5068214183943d4f, 0x7a8038c6d8838a14, 0x011def31b2d8574d}, FieldElement{0x5d5f58b33155b1e7, 0x7db782aa8288a650, 0x9fb19fc1cc415fe2, 0x063a0e901266586c}, FieldElement{0xad9674e7778efb8a, 0x80d17983f6da749e, 0x64d6796949039b8e, 0x04e5fc6f23cb46fe}},
+	},
+	{
+		{FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}},
+		{FieldElement{0x88939247838bc1c6, 0x4274a2c964cba360, 0x2b7be86914d4ad2d, 0x04c8ce6993fd5eaf}, FieldElement{0x2e6270953eef8e7a, 0xae3dc24ec76efebf, 0x0c27def5a7190807, 0x045f5ed18b32a99b}, FieldElement{0xa27d83455479646d, 0xe9ffcffc7dd6b58e, 0x4482854ddb2748d6, 0x0196889571d78c2b}},
+		{FieldElement{0x74890d645b62dbad, 0x2d71c16a7bd8e314, 0xe494597adbe57fd2, 0x01f6beaaa6bf8c59}, FieldElement{0x39b2b44d968b74d6, 0x380c9750453d55b8, 0x71d5996bdad86870, 0x0009c92bafce0a63}, FieldElement{0xd1a19017afa0086f, 0x4df5a176c553ba62, 0x80a7c31506284821, 0x04237b834105a551}},
+		{FieldElement{0x87d18d8e9c726a32, 0x3b1adfc8f5fb684c, 0x3363e69d6b35bb22, 0x04380cd9dae28f15}, FieldElement{0x17a1bc7acda297d0, 0x30894d34c7a907d6, 0x23332d426ce87560, 0x07dacf0dd248d1e2}, FieldElement{0x7040dd197d928912, 0xfd677e46af56b22a, 0x1127f56b36e2be3a, 0x00f1025223fbd874}},
+		{FieldElement{0xd883c0b73dcaee2a, 0x714c549bc130dec3, 0xc0677ab8755be2b4, 0x070063bb075c6219}, FieldElement{0xedb5e95b493d2408, 0x5aff749a6138627f, 0xa262a8c0a6e86b15, 0x073cf48f8ceef484}, FieldElement{0xd06b500a806b2a4f, 0x8a7b56c817bf9af8, 0x9cb2fceeaeda8ad1, 0x076aea022c5b6b50}},
+		{FieldElement{0x6dae80c39c9dd2c0, 0x77250b4c9fac48c6, 0xe23e1605cbc1d8ec, 0x015f0260625319f0}, FieldElement{0x6e7bda8de16e7740, 0xb70d796b332b6ad3, 0xdd0b6af19a5fecd8, 0x02f1dd9e3728454e}, FieldElement{0x4e8cf039d2eebe32, 0xddb0a3c785b9ccd6, 0x2f31be26f970b58e, 0x038ecf5f04782ec4}},
+		{FieldElement{0x7a21df3fd18ba76d, 0xc1a4a1ed34f5331d, 0x2cb028288d1efb93, 0x01f3ee7f5f300ee0}, FieldElement{0xbbbbf9c5f233cfba, 0xab5084e19fe9e18f, 0xc34cab4206338f70, 0x04142c65afd444cf}, FieldElement{0x21d7208ce5f5e05b, 0x8ed7029ff246933b, 0x777bf4cd86e9d8fe, 0x01f5fffe799ecea5}},
+		{FieldElement{0x1f14a40893cb7223, 0xd87ab1ec9d8dbe8d, 0xd7dc3be0d3129b03, 0x01bcef57bc19db5b}, FieldElement{0xaa897ee5ecc00d77, 0x01e035a9776e770b, 0x91665ef73d0ef029, 0x050463d990d39921}, FieldElement{0xa827dde620008189, 0xc4d5798e19897c92, 0x3da4b5ce916db8bb, 0x015dec24e1feac6f}},
+		{FieldElement{0x324b01ceaa618d3c, 0xcac3d217010412ac, 0x2957dc7dc24285f0, 0x03b85f04e2378273}, FieldElement{0xabbb2eb63bae3537, 0x996a57b1330e7e5b, 0xd471a86cf38dcc6a, 0x06dea5ca0cae6b73}, FieldElement{0xfe13af82f7836df1, 0x24adbc27b04d6aae, 0xa2ff56167030c8d8, 0x005e349a78d84f50}},
+		{FieldElement{0xa24037c431945423, 0xc9e59e20aa423947, 0x07a7f86bd5efe46f, 0x065442f0abd0404f}, FieldElement{0xddcbef412a558815, 0xa24d4c5cd522bc32, 0xd5a84b77dc04266b, 0x003d0323d61b5bae}, FieldElement{0x2095a8f729604d78, 0xf1cf5a0ef0da98db, 0x4d3fd2fabd682bd1, 0x012fd3ffa52dfd12}},
+		{FieldElement{0x2930ddd14cb03b8a, 0xc9754907c2672bf1, 0xaf3990f2e084be39, 0x039be23ffa353714}, FieldElement{0x5eb6cd3c5b17213d, 0xcc3b2cdbf5664767, 0x9824fdac7628feaa, 0x0661f0c6a351d853}, FieldElement{0x4aeed3c6c40baf40, 0xf265cb7369144be1, 0xa3db07e7b18968e7, 0x0092ecf58c274612}},
+		{FieldElement{0x6d1ca24cb80df529, 0x7184761d14c4392a, 0xba94879c27794f64, 0x0585295bef9f8cfa}, FieldElement{0x5098bfb2893efda1, 0x043906fac9209616, 0xb8ddfd20623e2b21, 0x07879207e0f461e2}, FieldElement{0x7ad940511e799a3e, 0xc15d20ea0546a4ec, 0xa318fcaf83a64488, 0x045965de5449bc63}},
+		{FieldElement{0xce0f6e9e9f13d611, 0xa9b59f29b06dd702, 0xad491bf9caa4270c, 0x001372797346be77}, FieldElement{0x4b6b8690988df09e, 0x39b0aa64d9cb6a56, 0xc1daf1e286518ff7, 0x06d67f1f80ea88ae}, FieldElement{0x9274f94fe4bd9fdd, 0x3c88ed6e83e736d5, 0xecc3e8016a951b3d, 0x07dd5e03bc217836}},
+		{FieldElement{0x5704390229924b3e, 0xc49fe3c9b8c83ae6, 0x4463adf49a24ca1c, 0x01d91f2353608c69}, FieldElement{0x6cdcf235016c7810, 0xe57edda4abfe9ebe, 0x8d9a99bb16a4c2ef, 0x07aeeea0484fb8df}, FieldElement{0x2aeea720089ccd69, 0x80c61f73b1aff226, 0x305535caee229563, 0x0119391bd3a4e5ae}},
+		{FieldElement{0x6faa3af772725c96, 0xa13b2e1124163ab1, 0xba463c0290c7e00f, 0x07a55296f88fdbcd}, FieldElement{0x353c9a65ab9eb693, 0xc665c8ed13fa940c, 0x75a78640b744b345, 0x079f3037fdb0b650}, FieldElement{0x055bb2ec678f5914, 0xaa495d036903bae4, 0x5e3d55766c86c740, 0x0048989015988c22}},
+		{FieldElement{0x5a02be56f8549547, 0x6cfa75ca695537ee, 0x065eac0ed9969c54, 0x010b7ffc66d256e7}, FieldElement{0xa2168a7b6c645410, 0x673de8ea8a78ddeb, 0xa0ac73cd6744cf0c, 0x00ff097be6e893b0}, FieldElement{0x3bbf359397614e60, 0x7219fe4d7c74df54, 0x8ff68bab26dfa2be, 0x03f7dadacd8822dd}},
+	},
+	{
+		{FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}},
+		{FieldElement{0x6cb5fc2a58f9d6e4, 0x7f764dc5a6d91ea8, 0xa668bc095dbe8c2c, 0x036f497df0df2923}, FieldElement{0x1765ca040f6f8c97, 0x0b9ab530eb0d816c, 0x0dc8e9a6d3f6daeb, 0x00065587c9a1beca}, FieldElement{0x77399e766a25cf32, 0x8e40c61e82f73ab0, 0x8dc378b0699b3c4f, 0x07cdb3884c994782}},
+		{FieldElement{0xfdaef10ba5cc775f, 0x988802c8e18d59c2, 0x52d145457e9df0a0, 0x03dbfafef124a7ff}, FieldElement{0xbac22b3be9c2b1f9, 0x7916887113e8f71f, 0x34932821c9498a7b, 0x00ded8438e518559}, FieldElement{0x756ad13f3535db66, 0x0c66b760f7b01485, 0x1b133f55a84c0b80, 0x01cce1f5e88e1934}},
+		{FieldElement{0x41582ba7177166f5, 0xdf10bcc7efe3f577, 0x48e3ce0e6cf33313, 0x03c92fd47217df6b}, FieldElement{0x87603698707c5545, 0xbe5595194057c755, 0x62b8cf1c7c7a9bbc, 0x068630895a62c92f}, FieldElement{0x89b084274332c261, 0x59cc6b18c86cce5e, 0xd7f6a8a582b410e2, 0x00aabe37933d57e0}},
+		{FieldElement{0xcf43d0668701eb82, 0x35ba269f435af550, 0xb25a2551a48910e9, 0x03f6eabf4f0742d1}, FieldElement{0xa8af660f50ac79af, 0xdebba7c21f7c8d0b, 0xee3760996825cd4b, 0x024141a2ec019db8}, FieldElement{0xcf187557784593ad, 0xe83f2a1daba94ffa, 0x26439d017760f840, 0x0409c53c8d202c85}},
+		{FieldElement{0xcaf65ab146172474, 0x31fe09c0f843485c, 0x36dccf46fa28fad4, 0x0648fb197514f881}, FieldElement{0x6204b0ba61b68403, 0x7d26fee2266ca112, 0x5b7d853c0e109456, 0x077203a86cfae4fd}, FieldElement{0xdc7f1a30f1da78e8, 0xaedf717866d12af9, 0xc4f0a78e5ea00453, 0x029eaf691841de2d}},
+		{FieldElement{0x15a879c3da240b7b, 0x22a81f19dcf7d608, 0x317a600dc522a65c, 0x067f7964bc003346}, FieldElement{0x2dd86cc16684681c, 0x1ff97cf911d66d3b, 0x36804dc0cf30a382, 0x063361b548d9cc32}, FieldElement{0x5b87bfa06ebc56e7, 0x0c187d4d22ea4958, 0x9047085548439375, 0x0469ce199da0fbd2}},
+		{FieldElement{0xac8141e96467960e, 0x1f17d24d24c91420, 0x6430d4b6850d1b23, 0x02f7ff48378de965}, FieldElement{0x55795bad803c08cb, 0x579a0952afb0f2c3, 0xe9b2b6afc3c80b61, 0x0483ffad3ec5b233}, FieldElement{0xd6228d4ff47a6ec2, 0x4c2fc5536b6725ab, 0xccc2ca0e31c90029, 0x054773c05654d0a6}},
+		{FieldElement{0x7c25909896d3822b, 0x0eeb9c67dc8b0727, 0xb5acbc10dfe38207, 0x00971f00d9bea365}, FieldElement{0x4a8e5b20b691e251, 0x6d85e9b06e341508, 0x82defc386537d00b, 0x037b2d019f9e7563}, FieldElement{0x9f68c1d30c59ce92, 0xb37a7b4b65054539, 0xf14bf3563b7b2e0c, 0x00e013972e179276}},
+		{FieldElement{0x9a621ef5d29f52bc, 0x7507a79f63bbd01b, 0xdadeb5c3da797fbd, 0x0467835feba6200c}, FieldElement{0xa5a6ad65a8afbb35, 0x75b7f9a66d31e987, 0xb6cc15afb773b328, 0x011a0ca23cc71fdb}, FieldElement{0x36e8e7b6f74008e5, 0xf882b9697dcc56ee, 0xc6d7c56a6c2a7a48, 0x04d0fbdc78be2b25}},
+		{FieldElement{0x30e4d507338577e1, 0xa0cc709a67981afd, 0xb314b8d8da19e8b0, 0x07231cceb6472191}, FieldElement{0x8b8eb7efd3c555ac, 0xe88b9f76a7bf4d85, 0x376d1769af92ac3a, 0x07b877ad10124249}, FieldElement{0x62f1dd9681917d4d, 0x2dc12232c7e1d2e6, 0xfe7b48e4b65af06d, 0x0483b3993e25e132}},
+		{FieldElement{0xac804dbe8298d621, 0xd33671a917ac4fc0, 0xcde052c2775c17d4, 0x07177704e00c5dd4}, FieldElement{0x08ac6f9a0c8e6b4c, 0x8d328929ed6991ae, 0xcd4446a17f340c02, 0x010e9248a80f41ea}, FieldElement{0x50b75dd09370db74, 0x1355d0b3c4fd12fb, 0x7fac58c356b40f2d, 0x07cb6d9e90cf37d7}},
+		{FieldElement{0x97e51a0303d0eeb3, 0xd05538f1ae2ee886, 0x420dfc85f0a9ef72, 0x00c5fe3feedcc824}, FieldElement{0xdd027244599b9c47, 0xb5f0627e1e96b485, 0x17c05669bfcaaffb, 0x06505fc1bb8fea45}, FieldElement{0x25021caedd8526e3, 0x4b5b06500c7be04b, 0x3d74d9255ed92c36, 0x030e55a06ad508a6}},
+		{FieldElement{0x3b999568bbc254ca, 0xc1d051e10644e844, 0xbba9fd4fff2d29a7, 0x06203cbc08f0dc26}, FieldElement{0x9c322b1da8f0f859, 0x68379ab152cd8f6c, 0x65bd223014f01334, 0x07570137aabace29}, FieldElement{0x46d4bf764fc42919, 0x1cc0d39ccdb541a8, 0x45fa08cc2345a011, 0x01f658f8c704e18f}},
+		{FieldElement{0x664b9f66b293f188, 0x3535acb3e64c59a5, 0x9543bb6c1dd54dfc, 0x02926a8c506f6c56}, FieldElement{0x5c806de8632ac960, 0x8c8ba4df57f4e82a, 0x444336ff910135f5, 0x04754a14d6cc5e53}, FieldElement{0x2764d2526aa3259b, 0xc07b6562848c7695, 0x1cd9cca582f2216a, 0x06ad5ae4b04c55fe}},
+		{FieldElement{0x98914a8d43a99449, 0x31e23c9d928642bf, 0xe41d1e3c12465714, 0x06e5e3e1ae02e4cb}, FieldElement{0x13bc6853d7631095, 0xe5e192b7946264cb, 0x1c8f848a16514b2f, 0x06ee5927efb08d0f}, FieldElement{0x44cf61f6d3cf1e02, 0xb04d0f952a9dd56b, 0xd8a1f2add8b2977b, 0x01cef519de4314e5}},
+	},
+	{
+		{FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}},
+		{FieldElement{0xc5097590c61d723e, 0xf7f61b1819460f8a, 0x9befd87493e481c8, 0x02892db60d78deea}, FieldElement{0x5caff6429ec4c9e1, 0xa2ffa19a10a13286, 0xaab861d9ba37957b, 0x0059232a267fe85f}, FieldElement{0xe58ae51ef94f75e5, 0x7b0992119dea9e10, 0xbb092f989141c210, 0x031dae0e920a9c12}},
+		{FieldElement{0xcc23a21ffd7a4753, 0xd262f1eda1535bb5, 0xebd18fba2650b4ad, 0x036ba50e10a48d5f}, FieldElement{0x5d34cf6a1e35ada9, 0xe91417aa0bb1b104, 0xaa1d403bf058d482, 0x02bee46f35a6e3b6}, FieldElement{0x58e8e5fd4c1b23d0, 0xe3c3f03e1f53a566, 0x628b9eccfe042f78, 0x00828b3f93db1fe7}},
+		{FieldElement{0x1ce7b777e0b9c757, 0x8149a13f2a916a97, 0x3c4923efb22f153b, 0x0733c6506c01aa56}, FieldElement{0xab970105c7c94d19, 0x9966e967ceecc87a, 0x0511b3c2c19d615a, 0x0563dd4093c38351}, FieldElement{0x92f9bdf3c510cf31, 0x35193dbe1b64634c, 0x5d7085ebb9069efa, 0x02adfe5625888937}},
+		{FieldElement{0x05c947fcb0ec1d11, 0xfe96221f35d1d9d8, 0x33b26ef44e990227, 0x069f8cc590d730e0}, FieldElement{0x84702e48264465b5, 0x1a280229ad3f69e0, 0x060b6d4e39ada08f, 0x05863fc9bd21e2eb}, FieldElement{0x1ab343b901afc87f, 0x0df04ebc735bc022, 0x7b46fe430b5effc3, 0x045f52ddf086796b}},
+		{FieldElement{0x76d18018eca2017c, 0x2aba7470093af2ee, 0xdfafd9052fc37b8e, 0x044bab6a3f25f05b}, FieldElement{0x870c124b40ee8125, 0xa6ccbe778afbd315, 0xf6abe0a516359f48, 0x0023b140be8e8cda}, FieldElement{0x922dbca5bb339cc7, 0xb6be879f13889eb7, 0xd3cf481eccbf7ca4, 0x0467cfa227b1f674}},
+		{FieldElement{0x4fb764d5e2395988, 0x7059f9e7d65a6deb, 0x8d2fa0ff1a38e608, 0x02b5562a3992cf58}, FieldElement{0x48c482214fd30547, 0xe3afb1e71c576391, 0x3c2c180ed20fca47, 0x02b687673a3ce235}, FieldElement{0x5b0efba4bc0bb580, 0x46b9f70eed159fdf, 0xeeae69df25312d75, 0x076e57a29c3faf0e}},
+		{FieldElement{0x5bd0b43de0c59a4f, 0x4562c7189edd320f, 0x55630c2f2870a4b7, 0x0108bbc79a82b25c}, FieldElement{0x8f7f355b9a0eec16, 0x9f4f028d029de209, 0xc8fde1279ed13b29, 0x04f743e924ac72b7}, FieldElement{0x6b9db61ad1f0f72d, 0x546604e909e4379a, 0xf8eefd36c5c58abf, 0x02465074abd3fc52}},
+		{FieldElement{0xdef1da11b9cfadde, 0x447b9b0af50be34e, 0x2f1e16844cbd45a2, 0x04f2660c89316202}, FieldElement{0x83daaaad84ecc6ed, 0xcfa5a70e9a6b1fe5, 0x33deaaed5fbd0281, 0x05455198c95506c8}, FieldElement{0x9803b23ca9cd97d1, 0xc65f6267039791c3, 0xf39dbd8ea1f936d7, 0x00f46c2021418b77}},
+		{FieldElement{0x78643584bdd3ac49, 0x160c8258d9d91bd4, 0x98867318cd7ce95d, 0x06041d14e08061ed}, FieldElement{0xca0a9ef9951884d9, 0xb66e87562dca577c, 0xb25e1bb395404f99, 0x0151cb4b05216776}, FieldElement{0xfd746d7a8ba509d7, 0x503482c674f22fc0, 0x65bf09850f0d01fd, 0x07aa76c2629b351a}},
+		{FieldElement{0x9e0ec41533ba865b, 0x19808252dad9fd92, 0x9dc9e29e0aabfc3d, 0x02b2dc3f7c3c7a49}, FieldElement{0x829926ad3a9e6c8f, 0x39a0664a46afd81b, 0x2ebc001b16ea5f25, 0x06c6a864462d790d}, FieldElement{0x92c737fe317ea913, 0xffcea766b745ee1c, 0xf262a7ac6fb570f3, 0x06bb0cc3c2f2fae5}},
+		{FieldElement{0x4bd32b32b58304b9, 0xe2807582dd54e568, 0x42a1e9bc397205b9, 0x0509e5c90ec17a7d}, FieldElement{0xcc371af0f63c36ac, 0x59141ded735bf2c4, 0x33eeb1e584e54bf7, 0x057acc9ef163462b}, FieldElement{0xb6ce99fdb4662ff4, 0x9713a0d9a3f70eda, 0x54b7d547005731d8, 0x073552e3bbed47da}},
+		{FieldElement{0xde6266b4af6a17f0, 0x5cb218a0a35614ac, 0x0986b0170d74cf66, 0x00edeb04073617c2}, FieldElement{0x56d404671c185993, 0xe118a308bf0b3fe4, 0xff9ddce23c25a0f2, 0x030839b4bbed5123}, FieldElement{0x12dddbb0ee29a5d4, 0xd2fdbc0e2ed17ee7, 0x0562faaa4de94506, 0x01b9bca540d7ebc5}},
+		{FieldElement{0x9cc5d402619f2b81, 0x9fd203facc2cf317, 0x001e6a13e16fe47e, 0x07eb42c89cb9130d}, FieldElement{0x5c25473a1a14c679, 0x4485a20dd4d23e38, 0xbe18509b833e8efd, 0x077401c2667fa97c}, FieldElement{0x7f898e90eb05080d, 0x2adc9500d31aa4bb, 0xf6d3372381a5c5ea, 0x0337be685be4b867}},
+		{FieldElement{0x4d19a572b45a818f, 0x901bf41303ebde38, 0xf5e702067db543c2, 0x07054ff1835fe980}, FieldElement{0xf5a4448093517393, 0xad6aa1eacb8b2853, 0x3447f25c58e80c46, 0x020901601f7f2d2c}, FieldElement{0x82e119a50c743ca3, 0xa533b893a7031363, 0x583ce35518d218c6, 0x04e8d64301c53678}},
+		{FieldElement{0xa1d38c35b351f58f, 0x998cd61f0d1eb7e6, 0x249bab6cb81a152a, 0x0008467f35400f73}, FieldElement{0xdd06c5204907e89b, 0x6af4d80038e7928a, 0xbbec636b4b82505a, 0x067a01db5217b04b}, FieldElement{0x9404b2ef4e7d9ed7, 0x3e4cf7e3b7905c30, 0x9d8d7324904c96f7, 0x055252a9359d563c}},
+	},
+	{
+		{FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}},
+		{FieldElement{0xa039e346c546c95c, 0x9ab00488d8372277, 0x5da29ecf736b0a51, 0x0457604572019701}, FieldElement{0xf1ef85d0ec443b43, 0xac5e0c26e8ab28a6, 0xc1667341f580f59d, 0x04d540d804239f47}, FieldElement{0xe10687d202ce2f93, 0x23df63475e299a92, 0x69df331d639f031e, 0x014bb00c3cdd3255}},
+		{FieldElement{0x766337f65453cfc4, 0x5140e316eaea8434, 0x1b9fa033c4328b9c, 0x0167238fb697ed11}, FieldElement{0xfb6c3b6e6b0e250e, 0x5106970e744dd743, 0xe0ea803ee91258c1, 0x000ec81cd2c31fd7}, FieldElement{0x98af9f98054544c5, 0x309c60b340cc78b6, 0xb24d26cedccb4c31, 0x0633f70e2b75c7c6}},
+		{FieldElement{0x5a5176c6b30b6613, 0xe0f9a4056cc22452, 0xf35aad411cabb065, 0x06064afb4cf43742}, FieldElement{0x7ee3431cb1a0db97, 0x161e74b0914c2303, 0x8e872932872dc04a, 0x00c121b8870548a0}, FieldElement{0x20f19f5ceeb93c59, 0x8e234a20562c12b8, 0x914b88754d70fe35, 0x01c7795751bd2196}},
+		{FieldElement{0x2b5176c80f8129b4, 0xe7d92c9bd4aecf03, 0xb5083c76520d4b37, 0x06d3accbcf5822a9}, FieldElement{0x400b9185e14aad3f, 0x26e3ac7ea0d105e5, 0x04fbafff35e4773b, 0x029142edd8c313f2}, FieldElement{0x95b17e7d6e404b6f, 0x129f6698cd4b78eb, 0x942cbc57f1f8ba98, 0x0462f11d8e954f5b}},
+		{FieldElement{0x5837c97f3dd91765, 0x4516dab016a001fe, 0x6dbb98133543f011, 0x04472ec9b4379f7e}, FieldElement{0x5fc3d9790e4d9380, 0x04584dfa4cf6ab59, 0x43c5af6b2b636f5c, 0x0564e344b23b772b}, FieldElement{0x9dc851b19a1d8046, 0x56684902d9c97be9, 0xe9523ebfedf70892, 0x03d283417894d788}},
+		{FieldElement{0x21eaed7fbbb7fd18, 0x67964b21b0e55627, 0xb825a2fd4b872669, 0x036b2c16a4deafc1}, FieldElement{0x1012c2f6069c8644, 0x06988a157ab7876c, 0x8fc820d3ef0b1392, 0x07f7adab075e2c2d}, FieldElement{0xa042e5b35121d1c6, 0x70ba66ed15fae939, 0xdd1d4e5ddfc64f56, 0x01087c20308686cf}},
+		{FieldElement{0x25ebbc97218f2487, 0x1e8f48d50100cd81, 0x0cbd890ef6f242fc, 0x07d2ce78fab24bda}, FieldElement{0x187015265a10f442, 0xbe2c2054d1b34eaa, 0xe5751484868b3f15, 0x0359f49c5e126d93}, FieldElement{0xe944ca07430777c9, 0xa6abe576b5c89318, 0x97f78ca4ef127569, 0x025ec2161c7f625a}},
+		{FieldElement{0x5221a68efd70ed2f, 0xf3b5b1d645eb7508, 0x941240a7942675f4, 0x02006e5c8b4f121a}, FieldElement{0xa76522dfa90792ef, 0xd9b705a08c0734bf, 0x72292f0ea3930a9e, 0x03099940009cb72a}, FieldElement{0x0ef494fee8ce8269, 0x51a6f9a2583e2a8e, 0x257621200be28244, 0x068edb190e9f13a7}},
+		{FieldElement{0x3098737d15ebd5b9, 0xed5be5d6307484c9, 0x9edc3a123ab5f979, 0x07ca5f4b95695bbc}, FieldElement{0x453d3ea181e2e7f3, 0x8d964caf4dd6081e, 0x31bd7f85578ce09d, 0x045216b4452da512}, FieldElement{0x21f6ab8aa107bf7a, 0x2f8263606653c6a0, 0x4471ca8fc7dbf0df, 0x00972900d2843199}},
+		{FieldElement{0xf7c652ea432bfcde, 0xc70f2a07d2dd2d31, 0x065141a1dcd531ad, 0x04bd95a0e991fbe9}, FieldElement{0x497778a50b0fa1d8, 0x948dee2993a836db, 0xb5781280604989bc, 0x07ee13c456ec4049}, FieldElement{0xcfaf156e77f39199, 0xe205c326859bf602, 0x12443708812fea74, 0x0235c2c7b8e59049}},
+		{FieldElement{0xbd65e325d4da395a, 0x0c9a8aef9ce6f10c, 0x7bb9eef9db61135d, 0x06acca3701244271}, FieldElement{0x12f25daf63369699, 0xd72efdf96acc2302, 0xf9de930fb2a82886, 0x0408cb2d35bebcf7}, FieldElement{0x07274f023fe00840, 0x2b5352701862a7bb, 0xee87f1581f79d2f8, 0x05fd4fa5fb68d7b9}},
+		{FieldElement{0xba5fd5ac169b76df, 0xb4e2eb5f7654be74, 0x1aa78557e1896c13, 0x063614b61d2d7ddd}, FieldElement{0xafe87f5b0b3fea29, 0x89c3ed159209ae15, 0x1da4b2c012900c1f, 0x025008c826893e93}, FieldElement{0x5879a19c991d65aa, 0x9e8def891c79f1a5, 0xa0a6502279782156, 0x0421748dd90ba241}},
+		{FieldElement{0xaf5a72522e42ac9c, 0x9be20fa34d8346c6, 0xf92570f96a493401, 0x03629b49ce414591}, FieldElement{0x3326e42b33827abf, 0x91e7afee40ff744e, 0xeed116d56333cdb1, 0x038e4c011059ccb1}, FieldElement{0xc99439f387fabc5c, 0x51ef0c7b10879ef8, 0x7ab47c602010a923, 0x01c7b662d64b5e8b}},
+		{FieldElement{0x5dacc651da8b1e04, 0x7a364a977d925a19, 0x6603dddc2b8d0ac9, 0x03dc9a1910e59031}, FieldElement{0x0cc177b4a598f5da, 0x35a87fb96a5ecc7c, 0x1cad8f780a3172c5, 0x07f4b9af777317d8}, FieldElement{0x6a608c3d94bb2c5a, 0x7d57179e0d1caed5, 0x4475741b01e4f69e, 0x015be01867263b34}},
+		{FieldElement{0x742f410bfca18e4a, 0xbd61e684629cbcb1, 0x46d1da795182e0b5, 0x053cd7ce6ec2667b}, FieldElement{0xaad29a4c1faa640a, 0xc6d7e8d37273d930, 0x78e47b1d4db43660, 0x05a69140f128196c}, FieldElement{0xce6cd7f6fb81b564, 0x5ca682861d382971, 0xf00ef0e233a910ac, 0x058e20c74f7fe7f9}},
+	},
+	{
+		{FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}},
+		{FieldElement{0xe7642af5ee076ef4, 0x82818498cd3372bf, 0x21d1109a8ac307e4, 0x013512e3834bcec7}, FieldElement{0x7aab7902ebfa77ac, 0xb78d69258d8c4cbd, 0xf89c7f70af3f5c20, 0x044bac00f1ac99b5}, FieldElement{0xee5eda8f8ca35642, 0xcef1959f8ef486fd, 0xe892648a57e11d6e, 0x039659ab9eabdfb8}},
+		{FieldElement{0xd4b43481bb988041, 0x47d1646c5f810ddc, 0xc6d746194d089348, 0x005ce164ddc2d98d}, FieldElement{0xfe3b65298a58c828, 0xc06e2d413ed6ec25, 0x741ed7f9e4874d7b, 0x0380e320b8eda368}, FieldElement{0xead2d8973da699c3, 0x8e3af8d908977fc7, 0x9de110fef8305a0d, 0x07298b0cd5c7abff}},
+		{FieldElement{0x04628bd7e0d84c1a, 0x2309cf34ca958cbc, 0x011fde8cdf8b9367, 0x01d85a13291bd448}, FieldElement{0x3f48f15cda9bfd33, 0xd9ed3427ec752c16, 0xc19d511ed5a1f68f, 0x0687f0e5e3a174e5}, FieldElement{0x8f407e7f94bbb397, 0xf0d613ac0c60f70e, 0x2d7b5aa200a7d245, 0x057451c4bd0cbcea}},
+		{FieldElement{0xca5bdbe02f080584, 0xd443b31000784541, 0x2fa681d866b376d4, 0x0511e0133fe30d4f}, FieldElement{0xfdf917bca58a0ce7, 0xbadcd916ab368d3d, 0x97ba786278d9c137, 0x056c63d9bd96d482}, FieldElement{0x882b1bba1f45ceb4, 0x3b2561b2b2696e64, 0x13e7d05dc13183c6, 0x00e77f5bd90b39d6}},
+		{FieldElement{0x649be2794b8a48df, 0x866591c59652a5c6, 0x046b518202b3edb0, 0x0527a24b61ed7b58}, FieldElement{0x35e65714fe044f6d, 0x5cf7d34f5d783fe5, 0x14a16a7400324182, 0x041fdf2756f5410a}, FieldElement{0xbb9c73e1c86bfbaf, 0x5ad8c71ed96368f1, 0x27b6acdc4b92d9da, 0x0593a475ee2bf073}},
+		{FieldElement{0xc4ba6d7ee38e78af, 0x1ab3b43ba79ec672, 0xd2ebab0816780bc1, 0x06387a80410339b0}, FieldElement{0x23e49d5fa9b92afb, 0xe75897b598b56b1f, 0xebda3ddb99d69bcc, 0x051e20090a56dfc5}, FieldElement{0x2d6874596a13ba7c, 0xfb2cccf8ec34efaa, 0xf95463e60f658acd, 0x073daf3f9ab5abbf}},
+		{FieldElement{0x327811c6d50c9002, 0x3aba060d8b5edc2d, 0x5c3f9aafa4201ffd, 0x07feb1c4c886e408}, FieldElement{0xa149451e400252b7, 0x512e01b6f988b2d8, 0xa89631d7c92160b2, 0x00f760010f47b2d2}, FieldElement{0x369801b719c5795d, 0x3f7c8dda3258f7cd, 0xe345585d2b80c50d, 0x052a1f4d3811f6bc}},
+		{FieldElement{0xc69bc74d4ac5c4a7, 0x6bdb2246fb37e8a8, 0x05cfb84709d9db06, 0x040ee7aa9b746aea}, FieldElement{0xacf47298da59898d, 0x7311b03075d61cf8, 0x5292e33bb022e8c5, 0x0766ddea044a74c6}, FieldElement{0xf9e4cd9de5ce86f9, 0xe5ba2421f1f5128e, 0x4f6a0268478d3afc, 0x074f5403c9f03b7d}},
+		{FieldElement{0x6eb599bcd3f9a0bc, 0x8a1d588f3e417d3c, 0xa7a07f2b7b7863e5, 0x02466588166a9ac1}, FieldElement{0xc612ef9bece60e11, 0xda3374bdc6597a01, 0x32d1136533feffea, 0x06ef9098f5447b35}, FieldElement{0x43f1022685352388, 0x0c6171543cc1a86f, 0x93268d2512298603, 0x060640798d02160e}},
+		{FieldElement{0x10a12707b2df89b6, 0x14d3962e944a7c43, 0x340b0ab4f1ee7580, 0x05a9c55b9a6ab9ae}, FieldElement{0x6263b1402564825d, 0x747a3fc2c9a28b02, 0xcf3c0345a00bea9e, 0x0477ea913ffdedaa}, FieldElement{0xdc7d492853413b77, 0xaef01d87ef759033, 0x02632fee0f1b47c7, 0x0626705a53a15598}},
+		{FieldElement{0xbdf3957b652fbcdd, 0xf62b2a4d73b9fc08, 0x5fe3457af3cec4a8, 0x055d1d0ce0d83051}, FieldElement{0x00b6f492998fb55d, 0x4cd6a2c1cf526f45, 0x8a7517934e50a48c, 0x05254d92b1ddbb87}, FieldElement{0xcd3fe21e2cd1d745, 0xe95234257779b7bf, 0x54a57e461cde0278, 0x07d48db1eff291b1}},
+		{FieldElement{0x638b295976b75052, 0x4ef3b96db8be37c1, 0xfc740ee69b7a8348, 0x013ebd7f2fc2acbd}, FieldElement{0x97bdecb0cff343b6, 0x96d290832954ca63, 0x47163e72778e1d20, 0x039e0d609febf153}, FieldElement{0xc18d480bbd1bc1e1, 0xf65c4f0629cdeeb9, 0x696a936c474746c0, 0x03cf04a7bf6c95fc}},
+		{FieldElement{0x46bd81f8b296a4d4, 0x0f96e362d5746751, 0x7ae0092a9cf54130, 0x06f874f42d0cde5b}, FieldElement{0xa927adabbbb81f9a, 0x9803828874cd1381, 0xb8c2306a2f35aa6d, 0x05b5a3032c5bb85f}, FieldElement{0xb9897bab2c06f8a5, 0xb2a17c6376ea60fd, 0x31ba15e151497f97, 0x04f79d065641f692}},
+		{FieldElement{0xd570d996ba82ed10, 0x090552258208f9a5, 0x4648945edb235dca, 0x011aead66505c2d8}, FieldElement{0x295b61df3c172d71, 0x73e03a3b47a930cc, 0x30691db4e5bae95a, 0x04b46435992a0ec6}, FieldElement{0xe73dcbeb6dd86a11, 0x5d53c91bb3d1f469, 0x04a997fcf1cd688b, 0x0735d9f79a46714d}},
+		{FieldElement{0x30d3ccfca93e7ef0, 0x5c844bfaaa77d2e0, 0xf0095e84b8260f27, 0x05ff11dae26540d0}, FieldElement{0x4d86ac9cf595e4a7, 0xf7dcc35b0d11ac65, 0xc732ae9907a806e5, 0x0683c80c7c1ec00e}, FieldElement{0x74f09feb128870c7, 0x6bd2709e93dd62e3, 0x9bd6e5b78f409a74, 0x0048b6fe2d80d604}},
+	},
+	{
+		{FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}},
+		{FieldElement{0xad2a69abab6a7b4b, 0xde6e6b556b335a0d, 0xcb6337378d265057, 0x014ea4632552b199}, FieldElement{0x7e349c0826ed4529, 0x3dbd9e5300fea586, 0x7876726404324352, 0x04b56d45f95b4bf3}, FieldElement{0x875549990d4aad8f, 0x7dc8450a79ca9cd2, 0x6642d2780e1fa4eb, 0x03a0278720eba92b}},
+		{FieldElement{0xe16f1a1cd54e8620, 0x789e56b0a94c8788, 0x7ee701823334c054, 0x04e9b9cb08125bb8}, FieldElement{0xda41175dff4f91d9, 0x939300e20c3bdc08, 0x561306f598c358ca, 0x05c77eec1a087556}, FieldElement{0xb3fcacb2a3a1d8a2, 0xeac4f8687ba025a2, 0x34569afd99ea3de1, 0x07d9f7f67dd100d0}},
+		{FieldElement{0x2a9a4ba34589d0f3, 0xe4d2aed74d7cb2d2, 0x71049b538ad0d4a6, 0x02070a039a6337cf}, FieldElement{0x95e1b0e4d895d43e, 0x87795e6d6d89f574, 0x71e7eda1c95ad774, 0x0770a8c85ba393e3}, FieldElement{0x90c49f914edc6a84, 0xdd4f0d7817cc53e6, 0x6dacaee558dac3e9, 0x0737963caeb8586b}},
+		{FieldElement{0xa3177dc2eb92bdda, 0x32132a169984ec43, 0x1a74373889fcc6dc, 0x0239cb732769ca6c}, FieldElement{0x7dde87495d350f2f, 0x6680968fb570a3fe, 0xfcef9749474afc3b, 0x05a5c7f9c82ecffd}, FieldElement{0x79285b168659ec0c, 0xa8b38623a0e5370d, 0x023a7259ea1f5d48, 0x0499318845218136}},
+		{FieldElement{0x1f7c33e4cac2e6b1, 0x64adfaef1178a799, 0xdda5fff8854be39a, 0x063235398c5a14be}, FieldElement{0x7f838fb1d5d9bf6c, 0x4fb9daf719461e1e, 0xdb7482e4fc9ea8a5, 0x07708136085f221a}, FieldElement{0x372499ebb0a16c6e, 0x6f1d0bba85a7c0a4, 0x990adc5d05b444ae, 0x03482233ab25b2b1}},
+		{FieldElement{0x31afefedaf8fd3b9, 0xad76caf69d130a36, 0x4c2e6d44bc563203, 0x06d14f260276605c}, FieldElement{0x7062721df2777583, 0xa75f66f6216e7b66, 0xdbe4c64ec9e40357, 0x037c3c38bf290127}, FieldElement{0x14c16b4797c06db2, 0x82114c8bcded5762, 0x8f21e8d2a397f332, 0x01104515898ed4f9}},
+		{FieldElement{0x3b79e25cce6c90c7, 0xf63734bda7e0f248, 0x1388046f84e17dd3, 0x065bde8d389420f4}, FieldElement{0x64fd4c61f641aa1e, 0x65ab636426a8675b, 0xeba46c6381a0914e, 0x0434a62b7eac7e26}, FieldElement{0x2e8bdf44fe515c41, 0xd6dff18f8363dfb5, 0x335dc8afd835500b, 0x044d5a6959790ac5}},
+		{FieldElement{0xa0b08ad9e55122b9, 0x1895fd5b23642161, 0x4b6e68c57a5411b5, 0x06bed7010b1a4992}, FieldElement{0xac77869b74179c42, 0x0c491f6e3afb53b1, 0xeab87a481bea5c30, 0x02c2e8d0de69528b}, FieldElement{0x509391f72133b80c, 0x98064548686b7f1d, 0x6fc3aa27d80dc519, 0x03bcef34d7b44569}},
+		{FieldElement{0xb856b964dc0c1f04, 0xa6aafcdd67bc9b27, 0x5fa3d0042b68125d, 0x0174ca39d58a7ce6}, FieldElement{0xffbc691311c551a0, 0x9dc74c730a345fcb, 0x4b9de0b948a6188d, 0x05a0a20861f4c1eb}, FieldElement{0x684be45fa6bbfc4c, 0x1a8869c0f7032aac, 0x80ccd0da232f8cf7, 0x011974844996ab83}},
+		{FieldElement{0x5c4099ec1213b382, 0x3b3b722c58356c89, 0xcd9f8d9707204bd2, 0x059c38c822164020}, FieldElement{0x252aafc14e75169a, 0xf01e9984636e1913, 0x7797ca6e424225ee, 0x05a4c2acfe0eb315}, FieldElement{0x36364981a1c50fdf, 0xd2c04b70a681d5d3, 0xeb08efd6ffdfa890, 0x013bf4f8aff914a2}},
+		{FieldElement{0x51a33100a2f48aed, 0xd8324859c072e0ea, 0x788119fcdb433b82, 0x074fc37629f43d3e}, FieldElement{0x4869b5c830ee95bd, 0xf961980ac09eda45, 0xa16fe421f0cf2e42, 0x0558de253d850845}, FieldElement{0x0cd05006d3c12be0, 0x7a3a7f4147c56fc6, 0xaae8adba204769e2, 0x066b01b005d75946}},
+		{FieldElement{0x3a1c6e351c422fb5, 0x535594e1e7933b6f, 0x4f4fcc542d46a7c3, 0x0176623185c46551}, FieldElement{0xc4be02166cb0ac36, 0x97979b2b98d09d49, 0x021230d76d973c0c, 0x01a44ef58f432ca5}, FieldElement{0xcd9de0b149442229, 0x4d8662f9c6ec1e8c, 0x7c7b89148f9baa7e, 0x01116742c0cb7f4e}},
+		{FieldElement{0x821664f5d1385f6c, 0xe5eed32e28bff56e, 0xb8e2621905192ed7, 0x021ee45ad5ee3767}, FieldElement{0xabb756e64f45b5d2, 0x237fbd3678de5ebf, 0xbdfbf3f1e716649c, 0x03782ba7e109346d}, FieldElement{0x4ac60ae60a007844, 0x06318b64e51c53a0, 0xbadc2067b5cc3521, 0x061b6aa765b2ff2c}},
+		{FieldElement{0x13672a92717d55b6, 0x7c45ef719fdcd084, 0x6e7791e6b03977c1, 0x0237ae1988e4d9d1}, FieldElement{0x4d337d47686c5215, 0xf22618281602bd1a, 0x1cf932e26ae8da32, 0x07946d0cb679cf3b}, FieldElement{0xb3e9714fac0ad417, 0x1f5102ed05a892ef, 0xd0450e2501433825, 0x02b6abce96c4b341}},
+		{FieldElement{0xc045f09277dcc32e, 0x35451298d690d04d, 0xf69e8c3a347ce6a6, 0x0639729e90f5ad8e}, FieldElement{0xfdaa618b870928a4, 0xd11212aa713d1f3d, 0x9bcf0cacb512d919, 0x0616a716d8300c03}, FieldElement{0x808191c5f56f35d3, 0x91b577c6ecc37e32, 0x0eb0d547ccd41e98, 0x002cb5c79885d722}},
+	},
+	{
+		{FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}},
+		{FieldElement{0x4269c50306c7344a, 0xf8c383a5e6e437c3, 0x37760da8c243b52d, 0x005c3503ba44c6eb}, FieldElement{0xf89da5f20ed8afd1, 0x13f2494dedcba855, 0x20ada0e017234c0e, 0x04e4042ef7738bf0}, FieldElement{0xb5dfdff222dc1545, 0x09819c833dc756b1, 0x2ed353ab2dddc6ef, 0x03f3940495b17c3a}},
+		{FieldElement{0x5a5d2910a016d742, 0x21e0a5ac8567b2e3, 0xa7e417c3a524806d, 0x02d2a69d7a5731bb}, FieldElement{0xb8e2debd3aa754ff, 0x201309daf00dc6a6, 0x8c656bc27da38cc2, 0x064e152047691586}, FieldElement{0x50efe5835e166cbc, 0x20b85bc88a6b31d7, 0xab1110d3bf2cd6eb, 0x052c633177a90418}},
+		{FieldElement{0xf9cb3f8681aca3fa, 0x22dd3162283168aa, 0x55c939669ad558cb, 0x006e468bbdeda456}, FieldElement{0x28c7ed117409cbdf, 0x3a737fc58113e4e8, 0xd27ace659ee021f4, 0x05ae7541a8aee858}, FieldElement{0x87ee2df1ef44c555, 0x975d0cc058eaf61e, 0x2491316242b9a9d9, 0x04bc9e4b90ec5e27}},
+		{FieldElement{0x230265c17de58e48, 0x50310d959f7ce915, 0x8ba035314d48ace0, 0x0736387a0c65c181}, FieldElement{0x364fe03a8697e891, 0xd2584920149beab4, 0x69cfb5265184776f, 0x054e495369ae465d}, FieldElement{0x87f0bfdfad618d97, 0x6e2a12f44c899331, 0xe20817600b4abc62, 0x013716512b8a9d36}},
+		{FieldElement{0x45fe221b9e161af4, 0x0f596bf2bdd1aa06, 0x5cd48569c64c3988, 0x07a6e12bed087e23}, FieldElement{0x82c9e1d968979fff, 0x1bf566a0f2f98837, 0x5e18b718000178a4, 0x024b8449aa349c7a}, FieldElement{0x393fe8fc0ad99597, 0x2cff63c93c219c22, 0x7ac37ef21ae85d54, 0x07a2da308a9753dc}},
+		{FieldElement{0x9a824a1eeec0ba52, 0xebbf4bc0d9015b59, 0xf889fcf73be83c5a, 0x02575ba665e6d2b7}, FieldElement{0x371dce8775d9fbb9, 0xa36f230fd6073369, 0x8271c36373a219b1, 0x02033d3aea9e609e}, FieldElement{0xed38bc141fdf13af, 0x2d87f5f86e50daf9, 0xb4bed17ae4e75585, 0x0634420f55398b4c}},
+		{FieldElement{0x848592a66c168bbc, 0xf6bb2f6c72140386, 0xeb62dd0a7bd646e1, 0x07a03ffdafb3e554}, FieldElement{0x61d81dd07a182b05, 0x345263e8310db9f7, 0x51ad5865ad9d1a5c, 0x0564114c04c06432}, FieldElement{0x3a6d89f47c5f91db, 0x94ff114410bb2625, 0x5df709ea2b1a40dc, 0x01b336a85d453d27}},
+		{FieldElement{0x788274f64d4a85de, 0x35d0bb59b7d2950e, 0x367292449f6f739e, 0x03a2fc4ee4233181}, FieldElement{0x4a4f443bebb59acd, 0xa33ce5545ed02a7e, 0x5409c39fce36b278, 0x0275978c132993e5}, FieldElement{0x7c1458fbfc771753, 0x549dba2646b9194b, 0x3f86c04f134f24f7, 0x064bd3cfa184f166}},
+		{FieldElement{0x918b917d124ef047, 0x52ae490be1f5a122, 0xd3fd1c060a6160ce, 0x0717f49ac380fe96}, FieldElement{0xad02e375fdf1f4cf, 0x8231380bdda4827e, 0xca42167aa55ff291, 0x0308c164967412bb}, FieldElement{0xdf451aa9b7fd5a7a, 0x20c4b53fd78870f6, 0xbe6653843ca973e6, 0x03183c622c84bfc4}},
+		{FieldElement{0x46c4db4a81a37d4a, 0xcfb569e32b799aa9, 0x30e010f2ca89fe42, 0x066dc4d899f5b37d}, FieldElement{0xdee5840d4b093b9f, 0x3ad6050b76dbfcba, 0xec5033a10ec4f9c9, 0x01174a5613c569e2}, FieldElement{0x59eb0895f08f259f, 0xc4bc9a2aeca31072, 0x2c5f1e594fce05a5, 0x077c9ee2c66ff3e9}},
+		{FieldElement{0x614da69257b4aaa4, 0x836228dbb6c5a3b4, 0x75cac8190eea7f94, 0x011a075d1b70aaef}, FieldElement{0x575d99cdc4e064d2, 0x42e8bd2c9ff9ac38, 0x8075b706e0bf4c37, 0x02ccd011ee6ec9c0}, FieldElement{0x9c9a556945c50368, 0x67f9db1243469b03, 0xf522d0441559caf5, 0x020d9501cbc0aeec}},
+		{FieldElement{0x16fbcba60821217d, 0x5d73e9d8bb58f0fb, 0x5cbc9d07116875ba, 0x047465af01083a64}, FieldElement{0x8d33a70ab9f03ea0, 0x5da7493335030eb3, 0xe1dfc6803db58182, 0x006e0537539d0474}, FieldElement{0x2f573249930ff6d3, 0xecfe4bccde78d9f1, 0xdb5b1db6e04e1904, 0x04664250bd170563}},
+		{FieldElement{0x06b401e4b51f2632, 0x1c0024d1826f5533, 0x20f742557b28f45b, 0x0058fb8e2c5917c4}, FieldElement{0x7bd9d56ba48a99b1, 0xd421157d65acef6f, 0xea8139fb3c0cfb9f, 0x07cdaa7dca4d9eb6}, FieldElement{0x6eb6b826f3f501ac, 0xeae3f5df9c1e8520, 0x1505d1b22f9f5e83, 0x05bd02bbf4100374}},
+		{FieldElement{0xc8ac536b9aed99c0, 0x92e782d413bd2783, 0x2c262cf0745b86a4, 0x05c58748c09286e7}, FieldElement{0x3cb1c148d1db8bc4, 0x6a5523fac477b4dc, 0x88db7f7096a2f90a, 0x0080ae9daedb9f50}, FieldElement{0x63452d2ce97ec192, 0x9a3db43310660499, 0x7e6573a38f4f8e39, 0x02b225cd6060f8ef}},
+		{FieldElement{0x1febb81489cd08f8, 0xf00128bdf3d19659, 0xfd1c1f3918a168c0, 0x02de43c4fa960031}, FieldElement{0x766162f2f1150f27, 0xdd606deb1e4483ef, 0x0c2c59f95fd1c0a4, 0x00b79b5acf925cc7}, FieldElement{0x60611ed4b0bf81bf, 0xc5ab68d696399dab, 0x9ce47f256c4f3cb2, 0x071dabec2db1996d}},
+	},
+	{
+		{FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}},
+		{FieldElement{0xfea47ac323357ae5, 0x1d78f6f45b1bc2ca, 0xe36ff43e801fded7, 0x02bec19f8f844b44}, FieldElement{0x4bb9f70d34855f5f, 0xe4198695d7ef8eb7, 0xcbfb1c55e2561897, 0x01a3350a574d78ff}, FieldElement{0xb8c3630aa8ce3d72, 0x01e1a6d07151de99, 0xe2022af8bee68626, 0x015e5e4fb0192de2}},
+		{FieldElement{0x1234febb574f6105, 0x06e0120d5e8db952, 0x63d9245c3906074a, 0x0273de4fbf5b90cf}, FieldElement{0xff17d89951ee7268, 0xf8f280db22f59d57, 0x7ed5831143446c3e, 0x030121ef41bd3dd7}, FieldElement{0xb3eff0d637521f2e, 0x20749141bae6ae4d, 0x23f2b2a6f45d6a42, 0x0055e3ae9ce78a96}},
+		{FieldElement{0x3031f494a77023a7, 0x655411a81535174d, 0x58089f9cb18a2490, 0x013459d6e1bf065a}, FieldElement{0x665cdcfa18791426, 0xdc995bab88670d8a, 0x91777b5563b01610, 0x0780759f84b7b9a0}, FieldElement{0xe2e5c4751b0cb79d, 0x982251db4171c2da, 0xcaa6bd99e01fa312, 0x06a5a5c995e9f646}},
+		{FieldElement{0x08d21e0d64c09551, 0xf0b242ad04516fd5, 0x0cd45393854f4521, 0x07618c666d3b0d33}, FieldElement{0xab5855b3bc9df4d9, 0x2817ac41a6980f6c, 0x5b3bfd965b4f9e25, 0x0005d941463b123d}, FieldElement{0x21652cf3d316894c, 0xd50bf8d802ad11b3, 0x49d29df0af769688, 0x01eeeccaf9cccd44}},
+		{FieldElement{0x5b1ddd1c36c77d1e, 0xf93c3638c731e467, 0xc29fa76a9cd8cc7b, 0x0349a89ff33ab688}, FieldElement{0x1649b31a4fc2821d, 0xa43386bdd0956d1b, 0x12ae2b4b5f700fa7, 0x02279f81c7456ed4}, FieldElement{0xf5c7b90a9f8022f9, 0xda884d770eba4b28, 0x5697cc3a66c4f725, 0x06b77497bd9015f9}},
+		{FieldElement{0xa442a2e0c45b377a, 0x1d33b77b3605a4fa, 0xec84d78fc8d03a6b, 0x0397d867b15e646d}, FieldElement{0xc7145ceb61e6f939, 0xa7055da82eb030cb, 0x613e70c8fc3e4862, 0x0462ad1dc22a6e90}, FieldElement{0xc324d11017c61d5c, 0x3eedcc19ffa41d51, 0xe64fe91fb9b44d78, 0x008890feecd96798}},
+		{FieldElement{0xdc776305c90f52a1, 0xef4da5a96b24915c, 0x36ba8364ecc6ae07, 0x03b8da82a256c95b}, FieldElement{0x965ba9622f509dee, 0xc03cc701ce09f04e, 0xb3ca6154edc766f9, 0x039bf81a74df1409}, FieldElement{0xc5ac24b3f48e9e20, 0x344662dace5a0f5a, 0x84deae0ea6f3a5a2, 0x024896ac5145f48f}},
+		{FieldElement{0xc6e7e91008d84cdd, 0x4dcb094d068b2342, 0x2e912d1e16fbf981, 0x00844963919f91eb}, FieldElement{0xb6c313884edf4eb0, 0x4d948cd25f7458ca, 0xa6ea9a0d5386cd71, 0x07991919c4acfc95}, FieldElement{0x26f439ab33f8fb09, 0x64ccac9716c0232c, 0x2871afea0850ac2c, 0x0066bc8bd329c0ff}},
+		{FieldElement{0x92488252b550b5db, 0x3a976b141e1841c9, 0x39d4592677d53505, 0x00d8c7acb13ba520}, FieldElement{0x094d86fb65fd1628, 0x9f077364fd0f8ded, 0xc6b5f2fdecaed04c, 0x053470149164aa87}, FieldElement{0x99bfc7d28d1a92f1, 0xde78cd47bfbceb45, 0x40d891f8c2540e25, 0x00fc814542a051b0}},
+		{FieldElement{0xd7f01db850d72350, 0xa5a1e8e9dbc377cd, 0x267aa7a88737e069, 0x054c139d9fb6de3d}, FieldElement{0x1e7f0cb743df4511, 0xfdeb477ee90802ae, 0x4a68bf312af197dd, 0x0682536ea8a374d2}, FieldElement{0x4a95be32e2f28422, 0xa0a49079c76d5e33, 0x1107431a9b1572a8, 0x05dfa35d7cddca10}},
+		{FieldElement{0xf6929fae0cd3a473, 0x4468d6cefca1029b, 0x1a5e7caa0baf5d8a, 0x0236b978cdfb9cc4}, FieldElement{0x359ef52846e60fad, 0x640e8deefe303c83, 0xaaecf0741fcfcb22, 0x0531520d150ab895}, FieldElement{0xec94b71ac897c804, 0x4dcab897ccbc8a2b, 0x217553aa0559f762, 0x014994ab8c355e10}},
+		{FieldElement{0xbf6701e1ae99c1f1, 0x16ba3789e9ccd4d3, 0x4f44fd13233ab183, 0x019ccdbde5ae52d7}, FieldElement{0xd57b58464f8d61b3, 0xb0319ba394dcf0a5, 0x60b7eeed35d72b8e, 0x05c6639ae715e918}, FieldElement{0xcf1bd2abf92993ed, 0xdbb3ba8954b83645, 0xc682c96bafb4a463, 0x0202c11f5c64a356}},
+		{FieldElement{0x33fde38a39ca6266, 0x32b9d9c058c5d8be, 0xe60e0019932de3a6, 0x0600fd6ae18f5eaa}, FieldElement{0xe07e9dc62ed8021e, 0x8a743ee29b52b4b5, 0x261ad5d1bbb1fe65, 0x026c92b8963eb72d}, FieldElement{0xb27e7c8b3f113222, 0x632d018b00dc7de3, 0xd5d4129b00e071c6, 0x04e26f25a660cbe5}},
+		{FieldElement{0x7b2de791d082ae97, 0xf62c85a4b6c98532, 0x2bf69ee9dc79ecbb, 0x042c510853d5637a}, FieldElement{0x91090cee61080e0f, 0x28ba393e2a5cd80d, 0x46ee129ec612183d, 0x00630bc861ef7caf}, FieldElement{0xec0a64bf6138a0c0, 0xc30e918d6b0316d9, 0x6cc002bda74a6914, 0x0704b47131c9aa05}},
+		{FieldElement{0xc8fc2a57d150f5fa, 0x2b5b11c642e8eda2, 0x19efc643488acd7b, 0x02961f77f05fc7a8}, FieldElement{0x6fa6de958fbb4f56, 0x206dfc74b8b3b82c, 0x2cac932ae26df8c6, 0x00454f95e65d99e4}, FieldElement{0x3abf0dbf0868a83f, 0xf62680061e31a30c, 0x09c487a6067ad8d2, 0x05265513f4ed2582}},
+	},
+	{
+		{FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}},
+		{FieldElement{0x6bd49d85094e1aba, 0x2dd94796243f28ca, 0x5b93e2302e45f30e, 0x012fda3d156a6e17}, FieldElement{0xea9ecafbba3c898d, 0x613426650ed8f73d, 0x77779beb853e4609, 0x0105873b934148c7}, FieldElement{0xc4d3aa29f2a16102, 0x7011a571b6a2caab, 0x3609dda387c5fb3a, 0x007870e8c498d668}},
+		{FieldElement{0xb07400ced4b494fa, 0x7c2f7b4cf3fa339f, 0xfb8a04abbd822fd2, 0x07df3342de65eab5}, FieldElement{0xec51d2384ce50c96, 0x9bf21cd6213e2bfa, 0x510b9fb8556b4e64, 0x065caceb7cabee43}, FieldElement{0x20e737ac658c41dc, 0xdbf0a27467a7c9e3, 0xd279c6c8b1054985, 0x06cdf2288c4e8220}},
+		{FieldElement{0x8210a3a2ee28ef65, 0xc7341f118f428ae5, 0x4d053631d0fe041c, 0x0669d98396235a39}, FieldElement{0x0dd744bbadaeae84, 0x54a16c004c7abe26, 0x9cff96d07bacba1b, 0x05831a4c0dba9e5c}, FieldElement{0x7ad2eabe4bc1582f, 0xc0823b9ffe591f24, 0x26a7f4678c4c1cc7, 0x06788da059223d17}},
+		{FieldElement{0x62c4ce893b6d050b, 0x2fdd4ea2f814c14d, 0x4f13d4769621eaeb, 0x029dcacbc35b7061}, FieldElement{0x22faaf5432ac7511, 0xdf4f7aa7ce9f456b, 0x428d3ae5042b0296, 0x047a2e7fe7d9de81}, FieldElement{0xa6e4193f3b84b25c, 0x1ab1648db8cbb80f, 0xfa9cdb16694725ab, 0x02529d9603f4cb2a}},
+		{FieldElement{0x25a1cd92f310555d, 0x1f3be11cad02a1bc, 0x2664bc4c5cd7c895, 0x05807e1844917b9a}, FieldElement{0xe246bb3d8dd3cad1, 0x6279705bd0c5023d, 0x48582ccd7dfc9636, 0x07bb473ecf845ab7}, FieldElement{0x5b74d32912ce8aaf, 0x5919bcba9849b2a4, 0xdec70834547cc695, 0x066d1c43cf4ab437}},
+		{FieldElement{0xd6943103fd1da2ca, 0xbfbf3478a4838aec, 0xcb46a3361cac049a, 0x02bc249cfb2224ac}, FieldElement{0x6905e3dc6b5366f7, 0xc811ffc5107fee53, 0x261c97b097c3148c, 0x04144035da859e7e}, FieldElement{0x307258b5f38f92ed, 0x9379a0d029f01a28, 0x9d0fcac7adeb2f8b, 0x0176a2a2ea8f9f9e}},
+		{FieldElement{0x942a3892d6a5f2fc, 0xba1feb80fc73b745, 0xb0f0508ba89220f3, 0x00d45fe92acf1a13}, FieldElement{0x181a2b6891c96fab, 0x1aaa9e7dbc3bbf2f, 0xac3fd422138e1f69, 0x014ea42fed5a75e1}, FieldElement{0xa3b512979e1ed319, 0x8b5aa2f439cc52e3, 0x3a08bcdf00ffe910, 0x063ac2c337cd4fab}},
+		{FieldElement{0xeaeb6eada36ab64e, 0xd0aece6481fc15d6, 0xad659a7229717a0a, 0x00cbc1a163278bd9}, FieldElement{0x79b4c0801e14c4a7, 0xd8da83a45b4565ca, 0x5eb4519db8db1a9f, 0x034472f78b66f53e}, FieldElement{0x3ab5c37599d93bf1, 0x55bc603606abd4d9, 0xeb411790b359233e, 0x01726dc67eef307b}},
+		{FieldElement{0xac46d49a3c429a15, 0x65a77d86ddee0115, 0xd782a55ce7196634, 0x0201defc82585167}, FieldElement{0xbfb893f2f0b6cbf5, 0xee264b73365de526, 0xfa38165bee444eaa, 0x0427ffd5fcc83645}, FieldElement{0x00e32514e85fdb8e, 0x0ae547191d020d5c, 0x551a6f21194bf12a, 0x02f56821da5baa7f}},
+		{FieldElement{0x50a495d6111b9db4, 0x79490eb87a1c00f8, 0xadd3843db2d7fa6d, 0x03a01f379ca042b9}, FieldElement{0x92ce6a4d0743896b, 0x0e938ff7214cd439, 0x661c42d001eb3a7b, 0x0310a3ca97ad4475}, FieldElement{0x3ad0910f135aeec0, 0x4c1a19eb3147314d, 0xb0ee07868303ec26, 0x062b8f96c0a06932}},
+		{FieldElement{0xc70484487ce7cb3f, 0xeb11dbf235829509, 0x0b9cfd81b2c82b22, 0x07e654370a88cfe2}, FieldElement{0x5cd619ebf1cb3ffd, 0xbde8a7624c9990ed, 0x70f30230f78ed2bf, 0x06189ba233aa7aed}, FieldElement{0x601d86212953e34c, 0xcbb01a4517c381ae, 0xf034d86b2d1799ed, 0x02f0a7e90ca40537}},
+		{FieldElement{0x25e687405224cecc, 0x6121ec882690c775, 0x47c5cd580e2aa2a2, 0x07e37c7a3f8be877}, FieldElement{0x3fd68e561e7a9967, 0x31c191046b39550d, 0xea29f424d7ea2079, 0x004357a4c6b03636}, FieldElement{0xd5e2e6f01b723b78, 0xf4d23912dae7e813, 0x9a626e2933998a30, 0x0665097d15d6194a}},
+		{FieldElement{0x825de5358cb42eef, 0xb6ba705587b52bd9, 0xe455029c85343c63, 0x001c4d71763d9632}, FieldElement{0x26a40e374044268f, 0x0bd0cec4eb928aef, 0xd6d660fe9020c597, 0x00795158bff2ebd3}, FieldElement{0xefba9f1ae27adc6c, 0xe832a37b00819714, 0x3e835d1235e0666f, 0x0083e7dcaec25acc}},
+		{FieldElement{0xfe871fcd33b34245, 0x0c0aba7ba1197e8d, 0x26c879761782b697, 0x0311fb114657ca00}, FieldElement{0xfe372d48b98ecd90, 0xc67b2361f1af560c, 0xd173912a9e22c792, 0x04dfec1410c80102}, FieldElement{0x2d686bd7d4e42479, 0xf7f0e2926bafa1c6, 0x89df35d8453d913d, 0x0495d4eb99416ae5}},
+		{FieldElement{0xf34cdf7204d50ec6, 0x86c803d965809ccb, 0x76875768f7ac73a9, 0x07eda447d529b33a}, FieldElement{0x4b66a94c829c5ee4, 0x48ea5693118e0350, 0xbd0cc8cec169699e, 0x07a08750d49682af}, FieldElement{0x610773add8a4b634, 0xaf067d4e8cfbd5d0, 0xc674edd8126534d0, 0x06f9aa2756bd59f3}},
+	},
+	{
+		{FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}},
+		{FieldElement{0x30afb9a4cff7355f, 0x3aab95b00c0564fc, 0xe6a770a5805e7086, 0x009758696ab2f0c9}, FieldElement{0x53e14b8cdaeec239, 0x68b5afde22e262ac, 0x59b7823e86c5fdaa, 0x070b5d540886cf78}, FieldElement{0xe3744d110e593e50, 0xe3d0e354768e4b55, 0x77c73f8a7b0562b6, 0x05646e3d39b22869}},
+		{FieldElement{0xa375aa4a71b02f59, 0x58065111a51755d0, 0x422e7ac493a0c535, 0x06add90cfc06c344}, FieldElement{0x79840c64b2a23960, 0xb7f999d96c691e16, 0xf6046310d6fd0bf5, 0x005b282f5b79656f}, FieldElement{0xb77757a23c146298, 0xd44ba66bb0260c15, 0x3180d9b794c110be, 0x04454bbb59930586}},
+		{FieldElement{0xa8af3b291783f004, 0x7b4dd8c4fe0f401a, 0x0368d779db2bf49f, 0x02394a211e880e3a}, FieldElement{0x0ea0a9dcc96438df, 0x15a34ca5aa918fe6, 0x18738c2c4e1ea814, 0x0407d3a3950f6b63}, FieldElement{0x19bf05c5b286d420, 0x184121c48f559b55, 0xce90186c31560c74, 0x01de5c9595560e13}},
+		{FieldElement{0x1da056b35460be75, 0x3f056d4701f5d1cc, 0xa54bcf03c0135064, 0x015159dc2d6c8281}, FieldElement{0x6e3002affb3730ea, 0x150b67bee32e860a, 0x97bd214b8da71158, 0x01134227ac1faabd}, FieldElement{0x755f4f4e008f1f27, 0x2574607d79d6edc5, 0x30bb0d4eba2261c0, 0x054233d14286a9eb}},
+		{FieldElement{0x5bf2c872cd87617a, 0x1737ccc07d746ffb, 0x5bac92590cc20536, 0x00ce4a865def442f}, FieldElement{0xe1ff41a7924349c3, 0x78d4abcac5ba8bca, 0x88f59ee4b741a01b, 0x0190bb47bfe4f06c}, FieldElement{0x9285b212c5d7cfdd, 0x829a0085c602afc0, 0xc0c266022a494cf5, 0x04a2149c39aa9eb0}},
+		{FieldElement{0x9b5bbbbf77c49e82, 0xa084afc942d82e0c, 0x0abadfd12b21b2dd, 0x060f5ec281c82950}, FieldElement{0x8c7bac57fc95058e, 0xa94e2811dc1ccedd, 0xc2639f0e7ddf4d24, 0x042837d3e6e18ff3}, FieldElement{0xea01e8ed9812d26c, 0x71596ad0d0d12cc0, 0xe38b5b8a8b45f5a3, 0x02748d0414faa9ab}},
+		{FieldElement{0xd638344af067215c, 0x51a4074e6a09db9e, 0x5da19dc83af72a15, 0x07f2e459eee93d41}, FieldElement{0xd7c72df7af9d263c, 0x6426932f8a0f3dc5, 0x08e4a419aaf8e796, 0x070de7446b146d62}, FieldElement{0x7eba611ebfd8d04e, 0x488a2a8823394ebe, 0xce9c1d4bbec99bbf, 0x064e6f866a9c67d6}},
+		{FieldElement{0x93261b81a34cfaed, 0x59290f0d5bb860e0, 0xdebec13662303fa5, 0x02f98b04f7a1bac1}, FieldElement{0xb89406372051e647, 0x9fca34893bd91f7c, 0xa8e7a3fc387dd695, 0x013f2f960ee609e6}, FieldElement{0x9879ac872924c49d, 0x37d685eb6ef68ef7, 0x7cb084c69a3576a0, 0x04b760e12d176d5e}},
+		{FieldElement{0xdd5df57e7db741e1, 0x0abbdd7f7ba68fce, 0xd08f32b3f12805a0, 0x030d653afe8a6a8b}, FieldElement{0xd83cd5de97a3ecd7, 0x637d38e2d1162543, 0x34b8892314c175b6, 0x063ab18c800d1b45}, FieldElement{0x9908f88256e162c5, 0xff7a362f4a25fe0e, 0x6ed18d7da00d6426, 0x0605c2c8adce8e32}},
+		{FieldElement{0x6128537fa9ff0160, 0x339c08885db60b6a, 0x49fa5b0631aafca1, 0x021ed68fe9568d38}, FieldElement{0x86f1ce3532b4cf38, 0x93dc46d84ad71248, 0xa6d36db56038d246, 0x01eade2c2ac0fec7}, FieldElement{0x9aecd0f932db2db9, 0xa24c04578352d7ab, 0x50e62ac5eee932fd, 0x0163a96d2411a755}},
+		{FieldElement{0x4ec2ce79fc8cfcf4, 0xa40036faffa8d893, 0x15f2d2b6ce6de8ae, 0x05e613d2a75dc8d4}, FieldElement{0xf6a89eba4dbf9e50, 0xb5fccf953eaafce3, 0x171da7bf9bd0258e, 0x0104dd6f26c59840}, FieldElement{0x2835930d6a2250cb, 0x62889ff4bd5b707e, 0x836cbb69793fd93d, 0x061dba89b68afe85}},
+		{FieldElement{0xf51a9f66403b5a09, 0x0879c87b1fa62291, 0x5eeeb1eb4b16f28e, 0x0357d2814bd09091}, FieldElement{0x259638ce3e895e0f, 0x9655b366adab4cff, 0x4fbbd3b31663fe15, 0x0449fed4e6094531}, FieldElement{0x4a6e114cdc327bb9, 0x916371352f150765, 0x31d9afd1da9b54ef, 0x0250c1fa0194cadc}},
+		{FieldElement{0x81a945950b42815f, 0xe63022f61edcaeb3, 0xd7d936b558249bfc, 0x04015ccd709ab94e}, FieldElement{0x5a667d7fdf9170b1, 0xc62191ade5b00cdc, 0xd31b787184ef244b, 0x0399f560452c6744}, FieldElement{0x3870e4c842028085, 0x5b7ba77f629694ba, 0x22b4fe112075b91f, 0x039029d45804e693}},
+		{FieldElement{0x8fc5f7a1beb908ab, 0x7a8d4832b62a4f4b, 0xb2d7d611a6ef5ba8, 0x01595b2a94b63fe6}, FieldElement{0x78f91c1b65a40069, 0xeab07c9122368923, 0xad087e40b9920fc5, 0x0376e86eb11eb07e}, FieldElement{0x900964b51e3a0e46, 0x6093b1e4900e26af, 0x02eaa946557e181f, 0x01567f536df85e5b}},
+		{FieldElement{0xe4a97669c9f5ecdc, 0x2f5ac5e7f92faad5, 0xe2dab77ad558cb60, 0x06065c0e3477df1d}, FieldElement{0x428ffc13c6f16b51, 0xd29e8fd64646a3c0, 0x781c5c26897be7b5, 0x03ba4edb64af4e09}, FieldElement{0x0e0d43ed72e3ff7b, 0x46be87731a6139ce, 0xfc235692e5174240, 0x00513738256c8eb8}},
+	},
+	{
+		{FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}},
+		{FieldElement{0x88745fdc20caaae0, 0x9d764794f6978805, 0xf14503f57206002d, 0x0779252307c3ce6e}, FieldElement{0xc65c3ca8f64c75f7, 0xb7bacf0068280c59, 0x5a9d041f7330f042, 0x03037bf0eb315ac5}, FieldElement{0xcb23149bf0cf3494, 0xa562a261336746e0, 0x95b3a5154302920c, 0x0283741ddfea3db6}},
+		{FieldElement{0x7d3cdd6814398104, 0xce0f79269f645602, 0xd756118eb3a99ac9, 0x036e4ac6ad9f35ae}, FieldElement{0xc8f89eeff949eff7, 0x270338344cb20a74, 0x4c9f51003860fa5c, 0x0357465e3cf3f3d1}, FieldElement{0x35424321040e2c2e, 0xa24fd3a351358baa, 0x2bdfafac63319b4e, 0x03069136b4b54fd0}},
+		{FieldElement{0x394fe236061be856, 0x52af7753e0c55188, 0xb5c5c2298ed27b5b, 0x0793fb77f7c703f8}, FieldElement{0x8a86754dd903c669, 0x951141fcfd58abde, 0x8dc56262a6fc0b44, 0x011f0ac9283f121c}, FieldElement{0x7fe3c953af583f27, 0x7be8a9c40c6aa209, 0xfa1cabf0641c296b, 0x07cb63b141dfa2b8}},
+		{FieldElement{0x178f00112479150f, 0x6a6978affdc88e92, 0xfe9e6e045684a1f0, 0x05406b7a51945723}, FieldElement{0x492bcbc16b1fbf06, 0xc4a389888feec11b, 0x00dc38346548eca2, 0x037894e57deda020}, FieldElement{0xddefc86ccf72bd16, 0x8d0653aefdcb5335, 0x172a706e7504bd83, 0x07bf2b638176ca37}},
+		{FieldElement{0x2379c128baca6d2d, 0x61a2f0832a720869, 0x6243291b8e75ecd1, 0x04c584e9fda54cea}, FieldElement{0x7942a4ace2f26013, 0x24ef5c95dd6dbdf6, 0x0db1ee2a9c96ee8b, 0x03b182f013fb2ea5}, FieldElement{0x8d0d3c13ec2c2e73, 0x4b071fdd69bf9a1c, 0xa3b7c3a41eb6e906, 0x00c5c79a35310d4a}},
+		{FieldElement{0xc7c0d9bcd5312e7e, 0x0ed62708635b1263, 0x8b1e51bc60130e3c, 0x01e3b85f2d5cedb2}, FieldElement{0xdf344f6111612577, 0x2b8670cc9dda5168, 0x972265e0604e3037, 0x00e776ae884c4f9d}, FieldElement{0xee10041e8dc179f6, 0x5c196c181371f85b, 0x316be865489c7679, 0x047af39d6ebb82ca}},
+		{FieldElement{0x93b282240650a970, 0x5a917b35939277f4, 0x69b9b5d70eadf703, 0x04aa05da37663420}, FieldElement{0xf84d903c7269815f, 0x19e6857727a7c6e0, 0xec3bdf1db537bfe2, 0x04ed2197d69b8ec0}, FieldElement{0xde1860950eff8e8d, 0xa32fa27b55ddb47f, 0x880acbf3b3c8554b, 0x00167a5b8eb6eba7}},
+		{FieldElement{0xeb18c115d20089fe, 0xa5c0e7a5961a7763, 0x8844bf369175dfc7, 0x0642e1d262164131}, FieldElement{0x8b92565dfdbedf9e, 0xf0d36df7643a3eb2, 0x33b51f951f08ed7f, 0x06f1181bbeca1cba}, FieldElement{0xee4e94502d36f81c, 0x607d2e3bf0bc69b2, 0x60332ca220aa5937, 0x0773ba7fc2a46729}},
+		{FieldElement{0xe04f02493c189d52, 0x3a1a8c74e6a0660c, 0xe220785d6b9a280d, 0x004c3d480629c022}, FieldElement{0x05113c63973a3bf5, 0xa376dbec884c27df, 0x0c5ba162a635e281, 0x0477c3c8d3f19541}, FieldElement{0x4b9f98082eb62f08, 0x2de6005be17f189d, 0x05cdd69d8968f759, 0x0691377fe24787b8}},
+		{FieldElement{0xeacd88e662c3afb4, 0xe7127d91fb7d29cb, 0x4b86017ab0ec6309, 0x0282745368b7c460}, FieldElement{0xc713ae136ed1893d, 0x8f6f8bc02788aca3, 0x09ae3f53844780a5, 0x071c49f1e8be7a13}, FieldElement{0x1ddf98f2f86a5ef3, 0x41af3aee91c5c84e, 0xfd189b386b979bf4, 0x072ae322660c77ce}},
+		{FieldElement{0x4a59ec99746a0836, 0xfa7460d29f9aea10, 0xdab1c6221d94b828, 0x059a62c598ec4207}, FieldElement{0x3aedf845e94e81f6, 0x7ef1f1fb0635bdeb, 0xb5516c07aa244d1a, 0x06a81a732f18b43e}, FieldElement{0x650ad555360b8c68, 0xb26b324c6df0a126, 0xf77b5256c1abd60c, 0x018b133454a399d6}},
+		{FieldElement{0xf9537ae0f4a3bd9c, 0xe741ba4781e16fb4, 0xf712efa4bcf05c9a, 0x04c3ec0c6ea9acc9}, FieldElement{0x0fce497ff8568e12, 0x63de6d4ed07adcee, 0x517de0c12d58ef68, 0x002d69a7d34169ba}, FieldElement{0xef51fdc4f7f1c3ba, 0x9593d77b2c80bebb, 0xe5aad546e0d51401, 0x041095e299ab0467}},
+		{FieldElement{0x8482aba137ae82ef, 0x6ccadb13bd841b24, 0xa7fd4b05c36623de, 0x0020b8da640677f7}, FieldElement{0x207b38a6324111b4, 0xa3397e9b7ce9165b, 0xa6fe324b484a72be, 0x04c18596e6dae884}, FieldElement{0x01f7c584e9d82439, 0x193cba5c1ffe6c59, 0x8536a3833350cd7d, 0x04137d15c3f6a1a1}},
+		{FieldElement{0xd77303ec3f61da7c, 0x4e37f248e6dd95b6, 0x91089f82936fcda8, 0x06ad1c9abe64e182}, FieldElement{0x197555241ac8320b, 0x6bd81cba2241481e, 0x20d55eb0dc0edb65, 0x02acdc596e35da86}, FieldElement{0x88b404ebd173c704, 0x918bb60aecc68b08, 0x8cccbc15e3129d06, 0x05440d7b55d0e3f2}},
+		{FieldElement{0x76a9b6a852695d44, 0x33abca33eca7dabf, 0x7840fa1fed5b5705, 0x07dfff8fd3ac9b50}, FieldElement{0xf6bdd0d29b222c68, 0xdfc82290bff12e20, 0x3347bffe69902c6d, 0x00b61c5a4830d241}, FieldElement{0x13378cb568529d46, 0x1d9d430f268d5426, 0x2745677e3bc40962, 0x07d040e0200b0999}},
+	},
+	{
+		{FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}},
+		{FieldElement{0x7830672803cfd033, 0xedf55f579bf5caaf, 0x687d6ebd6f03aa25, 0x01b80abd2e72a49e}, FieldElement{0x3014285053257ef6, 0xc355feaac589a93b, 0x60056a055a1a279d, 0x064d80833947542d}, FieldElement{0xecd0ed3a72e3a617, 0x5b1cf838c013127a, 0x42f41708d1775e75, 0x04e8258e602e30b1}},
+		{FieldElement{0x27b172ec81fd58cb, 0x202d893576ff4bc6, 0x05d0df1b60b9cc1f, 0x02a43f025733d1fc}, FieldElement{0x66c2bc7feb1c66c4, 0xb4af26f0e41d797d, 0x5aab4c50516e21ec, 0x055a57642ac6b3b1}, FieldElement{0xa200e8cd006cbb74, 0x3c71b01c7eaeb024, 0x44b601a00084e54d, 0x031067e22538275b}},
+		{FieldElement{0x9611acd577ce2cb5, 0x1dc7d60d7a7e4cb8, 0x2e201f9251647ab7, 0x0071fcfcbeaa323e}, FieldElement{0x12b35de720d872e9, 0x15ab97ebd93497e6, 0x1a7f07b05d5fcb4f, 0x066e51d86a40c0e7}, FieldElement{0xcd09dbcd50373b93, 0x918f7f20a0d73dc5, 0xcdb01d54e1eb1454, 0x055e2ece90559123}},
+		{FieldElement{0x2d198ad83e4d6411, 0x7bcb56f1fd7a97e5, 0xb70e852e0c2d6e74, 0x0157f5a5d5e63705}, FieldElement{0x53e638d09e53b930, 0x9b2b76873e8a24d4, 0xf2b289f962b0d99e, 0x0617fd56d87bcf88}, FieldElement{0x4f6ae8a9974a8867, 0x3ebf8c6b333adef2, 0xa3c5f5c090af4b5d, 0x01230f43969e1fd4}},
+		{FieldElement{0x48b770c724e9e8cd, 0x306ae8257a976b8f, 0xf37dc9e33346aec6, 0x06bed546b22670fb}, FieldElement{0x458a817fe17c4204, 0x617dee91fbef2184, 0x07d5395da62c8738, 0x0464a7a61ab9bc50}, FieldElement{0x2f5222f087f83ff2, 0x9e17470f1407fe71, 0x85c5735071d8d446, 0x000a3cbc69766361}},
+		{FieldElement{0x88c0d7d66008af4b, 0x05d5d5c02a2af1ac, 0x9c86e317ebd0f51a, 0x04736b59ea001ec1}, FieldElement{0x615c57a1626ec246, 0x4be429234360d81d, 0x2bedb2f55a0647a4, 0x0621ccea7ff5fcfd}, FieldElement{0xecdf9a18feddc2de, 0x08d6024bb8384c67, 0x45110f70dad34a2c, 0x07680646d2afdf9e}},
+		{FieldElement{0x767852f72eb54a0a, 0x45b3fc0e4c61770f, 0x674090e5232eff49, 0x07f68ecaa3239779}, FieldElement{0x50095dd03433fc65, 0x8f5e7864401606e7, 0x14857d1a5d38d765, 0x068889cc28c731ac}, FieldElement{0xdc72323ed1672965, 0x1ff552debae5faf7, 0xcb3a9fd96166fe64, 0x04b4edda9c02ebb3}},
+		{FieldElement{0xbf986a95244ad64e, 0x964bb02c2a38f6d4, 0xa469a733d4e56586, 0x06408ec22cf83ae1}, FieldElement{0x11f67d053bf5b303, 0x00b2a4f767afe60c, 0x2da58720459645fc, 0x0674928d2eaaef79}, FieldElement{0xdd9ccf779afab6c3, 0x5fd1b8b38a0c403b, 0x7e694da30292ba2d, 0x057e3b16240fdb07}},
+		{FieldElement{0x782d2622e2427d2b, 0x79b21a3915745495, 0x3fda90b9f87c0146, 0x02b2fa42e59c096b}, FieldElement{0xde64734cd5642f68, 0xe38f515420a39337, 0xc9302d7bf4e9cba5, 0x044103e691afa26c}, FieldElement{0x7713c3cd761d4a1a, 0x4489fa2ebdc78ffd, 0x417f32d46f36aa6c, 0x07e21b1ba260ad6c}},
+		{FieldElement{0x011e9a40acf41736, 0x80579c5c162c43e7, 0x1f20842c7a09db6f, 0x040a7550a845b6b8}, FieldElement{0x379c34a4f8cb9e3a, 0xcc4c55c8ff80ecfa, 0x01840f54f1618453, 0x02eefaa85f7be91b}, FieldElement{0x0276e3eee20899a4, 0x20a8df274648053b, 0x599f58e746ccc208, 0x0598c989256836c9}},
+		{FieldElement{0xb77cdc997cb1b754, 0x748c31a79ca47f40, 0x5215597b640a4fe8, 0x071522e626cb69ee}, FieldElement{0x1b8719b015457594, 0xf4ab3e40b2865cbc, 0xe71e275ce1a6b485, 0x02743d34c717fe13}, FieldElement{0x2523799e2933abfa, 0x45b32cf3bdfabeab, 0x19a41b62e718845a, 0x03af8334190f6224}},
+		{FieldElement{0xf66098ddc9be1bb2, 0x9f8240b9db981001, 0x1d9464877b8f69a6, 0x036cdf02f61813ae}, FieldElement{0xc8cdbbe5a9407618, 0xdd555231dd5d5dec, 0x7089afe6cfac6523, 0x058d322a66ccca83}, FieldElement{0xef336731b7b404c0, 0x735b04262344a18a, 0x6e82ed6df5cf0974, 0x010611d534d9f121}},
+		{FieldElement{0xe6059de5f0a0d85d, 0x8e63cd1d0e862b47, 0xa7988b960cc7a7fa, 0x023a20a1b715d1e8}, FieldElement{0xf36e944a97d421c7, 0x5c113fe7eb772b0d, 0x483b6f1285b9893b, 0x065cf25e66601fd6}, FieldElement{0x959a9a719dbf0f3a, 0x7c849b695679b9c7, 0x5a33a05b9c19f858, 0x04c978d24003785e}},
+		{FieldElement{0x5f05464633c584b9, 0x81f29977650dfa16, 0x131e96307226e842, 0x0355b8eb9f5c4813}, FieldElement{0x5f4b7d728e12fd26, 0xdd0486c8127ce34c, 0x16bfc8ecb93a25bf, 0x075e67fbea71a05a}, FieldElement{0x9b333e58f8a95353, 0xac7475a239d4831e, 0x4d8e7bdd77cb9a15, 0x072db9daf134d5d5}},
+		{FieldElement{0xc0fbed1747f43c6d, 0x67236933f15ce513, 0xdf179b6bf7d80fed, 0x022054d741cb2adc}, FieldElement{0x367edc8177397f7f, 0xd447bf6fc416c0a2, 0x97eea0729f891675, 0x04a5ed49a6cda1a4}, FieldElement{0x4bb5dbc6157482e6, 0x749fe353e5b72325, 0x90bdc1ad06276cb0, 0x00b9147ede006b29}},
+	},
+	{
+		{FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}},
+		{FieldElement{0x07fe5892c398e850, 0x680a50c54323e8b0, 0xb8a6dc3e5103714f, 0x03027480c67191a8}, FieldElement{0x4fed348f3578895f, 0xedd9cf96e3111816, 0xb28450e424e5dfbb, 0x0272aa0acc8da6fb}, FieldElement{0x74178004873d9a5f, 0x12bdcbbc72188bfa, 0xb3be9b8554e24b03, 0x06e7c57f34f3eaa1}},
+		{FieldElement{0x94eb0c72ff99a64a, 0xc89c43832ed74d77, 0x2b79dde2d5cbdc33, 0x07d2bc05dd4731e6}, FieldElement{0xd1db38c2caaecbbf, 0x37fa1bfd79aea805, 0xd134ab2b32b097d3, 0x01d79fcf15f17251}, FieldElement{0xb7dca80d9c9a8bc6, 0x058b08cbe2b411df, 0x6064405221cb565d, 0x0409da432441d334}},
+		{FieldElement{0xd191658b63ddb3a3, 0xf427bb5a6ca8f803, 0x1bebbc24045345ef, 0x05ee02d253730a87}, FieldElement{0x199369993ea621a5, 0xa0072af6702bb3af, 0xac29e201db334782, 0x07e0f742a4209ce6}, FieldElement{0xd917e9f7e404e5b8, 0xa09fade3ec2dd58e, 0x11eafc48f14c0571, 0x06d1da176e8a5e86}},
+		{FieldElement{0xd41e2ac5011bcbcd, 0x9df17d300ec082e5, 0xc106471c7388365b, 0x06bf4ac835ba5ff2}, FieldElement{0xb6c0f4116b492091, 0x503b3b8ff73f27e6, 0x51c11775566d52a8, 0x0694bba2b45f5660}, FieldElement{0x8bcfdf4bc9c42127, 0x4954b59f87268e2f, 0x63f74139d17cf1d8, 0x064adf92c9ea509c}},
+		{FieldElement{0x3b42579e51c67391, 0xb0bddd028935d579, 0x3b7c405cfe79d1fd, 0x01eef025702ce733}, FieldElement{0xfad9ab190ba198d3, 0x304c354415fa7d85, 0xaa92c982ed3fec8d, 0x07c43a28c7bbac0e}, FieldElement{0x592ecceb27fa53ec, 0xb3b575d16567d565, 0x7bfafce759a475eb, 0x05c5541c7f7aee24}},
+		{FieldElement{0xec06bd22e5d6fa28, 0xfe06a3d9ee6ccd94, 0x111e6721e25ff538, 0x058e0a6c7d75d22d}, FieldElement{0x29ebaacff7af6d74, 0x355ca75c31d26f8c, 0xf79bb43fee46f671, 0x033e5aa421d7d0ce}, FieldElement{0xc57c5d246e459d3a, 0x2b5052a154721a39, 0x390d32902931057d, 0x0762d5ae192b5cb7}},
+		{FieldElement{0xcd029de106338cc3, 0x8c60d00b297d50c6, 0xba25b2cbd1436f0f, 0x0052656f4396a851}, FieldElement{0x596d704a879851a0, 0x80adf79a4763ca75, 0x2f2fbc27ab43ff5c, 0x0757c7ad5f653191}, FieldElement{0xf78e38dfec943233, 0x020a8a99cd1cc50f, 0xbdfe0acfd8ec3d36, 0x02a7e1f90022653f}},
+		{FieldElement{0xa473ca7ef92e605c, 0x582de44a6fae138c, 0xd8279d9177c6a445, 0x0354211ba8762194}, FieldElement{0xabb17f32fe975c3a, 0x26cb7f856e41386d, 0x2fe61f106db7b572, 0x0523a1bca5e2c6f1}, FieldElement{0x3ec0425f01ef7d83, 0x94ca3e137e175adc, 0x0e2a776fe2529511, 0x00b669a0f23f3f09}},
+		{FieldElement{0x12bc3123a72641fe, 0x098e503a06f8004e, 0xede1d5f858aad436, 0x0507bac4d57a1d94}, FieldElement{0xc0eb43ec1102e308, 0xc9e27f89129fc96f, 0x1d7028de635b6dc8, 0x0512e71b1e943391}, FieldElement{0x2fd562e2d80dd714, 0xf6f0fec1f1f80bf4, 0xa76ae4f7d816cea2, 0x0268dede2f3c0c0a}},
+		{FieldElement{0x85b10787a79079c4, 0x5031d37dfda05450, 0x948a4b2282227054, 0x019197d3f992a112}, FieldElement{0xd9fda73e35989151, 0x8e691d5173212fde, 0x7500a9c4164ed388, 0x0449670ba6e06435}, FieldElement{0x4f6ec70074eb3411, 0x4a83bc8717baeeed, 0xd46b7d55627de27e, 0x03b4b2f38ae5c2ad}},
+		{FieldElement{0x8ad257af220246d9, 0xfc64524439b6bd9b, 0x1abec2ff61fbc2d1, 0x0527d8cf8b6ac56d}, FieldElement{0x31480f7b053adc0a, 0x5c0d9697ccc3a3d6, 0x187bae521e46502c, 0x0603adf1bb8081c7}, FieldElement{0xf3d6eead6b14f838, 0x630ec4729b8762ee, 0x6509c5a0be35cc67, 0x020e30cda2d9221d}},
+		{FieldElement{0x38451e19700cb2ff, 0x749830871d57c249, 0x0e56a4d2a23c05d8, 0x01d957ce4c05f0ad}, FieldElement{0x61f9c63994469a8a, 0xb27b3b446b22330c, 0x8c6a4e3895b5face, 0x075d86a08ef0c926}, FieldElement{0x22b5c024dee900ba, 0xc8e8030aa7d10356, 0x56fee644bb146b8b, 0x044ef1eb76112996}},
+		{FieldElement{0xd8bd4ced96f0129a, 0x752b8501ed2c0ccd, 0x2b8c4d32f4cf2e73, 0x059d10d8312ee78a}, FieldElement{0x2e4144967f054ab9, 0x90ad2f810a165e8f, 0xca64fd9de943985d, 0x042200e3577de0fc}, FieldElement{0xf1c8e145def2b931, 0xbfd43e8d80dae72e, 0x6e8043b9da737e01, 0x079b6b72b7a187f0}},
+		{FieldElement{0x244281c5195d51e4, 0x34a0c1dbee2464d0, 0xb73de9f0b5da2ce8, 0x035fd8f61ab58d01}, FieldElement{0x84a0a6c8b6c9e9ff, 0x444e3dab1b9a3b01, 0x1024a223421d5daa, 0x02b1fc2a4bf5a47e}, FieldElement{0x6e6bce543f1d85a0, 0x5768494e2801b99b, 0xc5dab1420ea38603, 0x0500697460324fb7}},
+		{FieldElement{0xedb80f9b73e771a0, 0x2a9dc1775271760a, 0xfca7adf9160c6441, 0x010497d855ef947f}, FieldElement{0x17e9c759f0fe97c3, 0xec0ea1009d9a6aba, 0x6d811a9d05a31057, 0x00206414448e5309}, FieldElement{0x9c49ce60b11088d6, 0xef1d1de5b481d04e, 0xfa981717a15b0fb5, 0x07e4b8ae50cbf526}},
+	},
+	{
+		{FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}},
+		{FieldElement{0x307b78b44f1db27b, 0x7d3c304048a94ea2, 0xe37a80d20f69530e, 0x06008217400b8ca4}, FieldElement{0x6d57d46cd9e3d98d, 0x2b8d0df851b0248e, 0x5704fcfa2beef2da, 0x049a0cb84bff46b6}, FieldElement{0xd1a5885cb7aba7b4, 0x1615da1286bc6f03, 0xc2246e5943580d6f, 0x012b70a3017a5ce2}},
+		{FieldElement{0x017a9efaa337f2e3, 0x7eec0adbffac03d7, 0x99c135fc116bd736, 0x026e611768be5935}, FieldElement{0x86c1ca388949722a, 0x6959d15d2ee1834c, 0x221c2b279e88c57d, 0x06d6e95f578e36b9}, FieldElement{0x5e0950ca7a1cfc6f, 0x1418c4519d5af72f, 0x23fbdd129da1d75a, 0x0532bcee9dd18972}},
+		{FieldElement{0x14f8d0331f380d53, 0xa5a3f1eba21ca59f, 0x3251883e9da12538, 0x0333858bc26c1022}, FieldElement{0xf1953f6cca5344ad, 0x492c98f240c7808f, 0xc02a4d5a2b47b5c3, 0x015c1992ac690a60}, FieldElement{0x3a81769b2091e8cf, 0x3e59170b06312114, 0xb150c46fe99470f0, 0x00440fcc13b9841b}},
+		{FieldElement{0x52fa73013316363e, 0xce823f60712b9758, 0x5399769e2f22276a, 0x04f22739df677ecf}, FieldElement{0x6f16d0777096497d, 0x06c1ff3bb59d7a29, 0x50565c57d76bddd4, 0x029827857568a923}, FieldElement{0x7d4cc19d70ebe311, 0x0dc22c09bf307ce6, 0x920f9885e69d9f44, 0x0469ff2a4abe685c}},
+		{FieldElement{0xccea4ead4d1cf521, 0x4a0f2251727b4075, 0x150f2f361935bfc4, 0x045c7ce4e312d090}, FieldElement{0x22ef0a534c7e8cc1, 0xdaadc4a0f990a84e, 0x33dbec58f983a482, 0x0000d86f64d79ae2}, FieldElement{0x025432693a1eddee, 0x1b11153b6aa05aff, 0x6a2e5750cfd36333, 0x0146c56e4ca5c7e8}},
+		{FieldElement{0x6752a73cf0eae38d, 0xc7a6aaad2e4c1877, 0x51cf4efec38e69b2, 0x038363c44aa35501}, FieldElement{0x5d737ac840a37ed3, 0x51b64d9fc375d6eb, 0x054bda4245b269c9, 0x0126aa403f90ca02}, FieldElement{0x6972ace595bbd7ab, 0xf975d5850517e43b, 0x4e9364b94bafce08, 0x047d010d72bced6c}},
+		{FieldElement{0xa9dcb468f3affbea, 0xf5e9603631eb8ef4, 0xc544cc5b731c2481, 0x075bf33e4cd713d3}, FieldElement{0xfb7970b976607ea6, 0x74e881835bc90fed, 0x8524c799d897d40b, 0x02521b3ef7c7bcb1}, FieldElement{0x9bda40029cdf7860, 0xdc2cd7b25caae7d3, 0x25c59fa691c3313a, 0x02f8a3431e541e77}},
+		{FieldElement{0xd7af2478f80b9467, 0x26221487526772b1, 0xd833405ebdb55f99, 0x07dac597ac633a80}, FieldElement{0xe339bedabffa8884, 0x17b6b55eba99965d, 0xab682694a772f945, 0x059b2b07c44641cf}, FieldElement{0x6fd6aebcd8b07b19, 0x0601c5330bfd9442, 0x08900bd126d9b12d, 0x0522ead98ed029f8}},
+		{FieldElement{0xcc8ed14d5275d239, 0xf461783879209d0c, 0x475f49f475532fcb, 0x027010b7deeee968}, FieldElement{0xa59c097b7f7f4e5b, 0x3c7fa374d73294c4, 0x4f2286c76fd79aaf, 0x03b61641b72f1673}, FieldElement{0xc42e89ea6de324ec, 0xfb5a428ba7bc7aaa, 0xd5916ad16cfbc8fc, 0x00dce7ae1edcb006}},
+		{FieldElement{0x24435e500b3743d9, 0x18ce8f55b5a6d948, 0x04936b9cadc5850f, 0x01a96d2073025539}, FieldElement{0x407dcf8fac8655db, 0xb5c28bad13d2ccc7, 0xb72e1b76659b716a, 0x02c1b15884901628}, FieldElement{0xaaf3257a2b1bbece, 0x7ca99e4ff58d4f8d, 0x2825060931c233ae, 0x0136dda764a9dc10}},
+		{FieldElement{0x1d2c44a2ae92fe80, 0x005962c68c557c7f, 0x6048ab184b1f08eb, 0x06ae9199b8c1ea71}, FieldElement{0xf58378b11f92819c, 0xb410cd596731986e, 0x62a78ceb394e4a2c, 0x02cde81861f4a30c}, FieldElement{0xb13bd234bcc7d20d, 0xda3388c66edc1c5e, 0x389479756647a842, 0x03939d548bbdc14d}},
+		{FieldElement{0xc10f7d3e3a66caa7, 0x908c53665f07300e, 0x96478f6f8c302606, 0x003c0b7cde387352}, FieldElement{0xde97329c12eec2bc, 0x43d0a736f702a499, 0x94822e4e8fc637a3, 0x03e0565811a4ca3a}, FieldElement{0x3ac7c502d00b58d1, 0xbce80bd7d451a695, 0xadd84e34b1a74852, 0x00fe7fe4dab34ed0}},
+		{FieldElement{0x83ef7aa5d5d3a409, 0xbbcdbdd070bfc7f3, 0xca6f4612afa5d324, 0x03147789c8e6ab8d}, FieldElement{0x1c4a78a24cd99dfd, 0xa9faf7e1f1eaa816, 0xf8a67ed749a2d020, 0x06571d666434a2dc}, FieldElement{0x778bf95ff03b25f4, 0xf004acb0b6c96361, 0x3aa52ce13f27df7f, 0x02ca0db217d9c1ac}},
+		{FieldElement{0xa2b86b61e7c71f84, 0xe3daf5c4e25b1194, 0x3ce353abcbd16afb, 0x0759e979159d0dea}, FieldElement{0xb0b70ff5c67a1db4, 0x784ebb364bf5782a, 0x5fab90e264202a4a, 0x0298612fcfc71a38}, FieldElement{0xdbbbb79365f3253f, 0x27987f62767bdbe2, 0x1e0c193365ec42e9, 0x02ba84961f6908de}},
+		{FieldElement{0xfeba17d7f18e1790, 0xa9f57d862fe11ffa, 0x29b0722d087ea258, 0x02dca6a4efb05beb}, FieldElement{0x37d855b86175afa7, 0x2c76f3e7912b8144, 0x89df9786b1f3ad75, 0x02c810a49a82acff}, FieldElement{0x12bdf5c6ba4c9739, 0x5988a474909b76d7, 0xd9b577680da5950b, 0x0699969111385020}},
+	},
+	{
+		{FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}},
+		{FieldElement{0x698871348cab9a2e, 0xc2d8e1b0b488a377, 0xdde5ece09cd12705, 0x029a352d0a74793e}, FieldElement{0xf56fa5ed9a3c670d, 0x366c2e1e58218d9d, 0x5a37e82fb712f4b3, 0x018077a6f40ed1ac}, FieldElement{0x66966f9222197519, 0x74eb36df6ab71583, 0xea23fd4e8491b72d, 0x0061e64027d428b8}},
+		{FieldElement{0x6e13baa3172dd675, 0x0343a5aede7b4608, 0x864b822611442fe9, 0x0128dc880a5c283b}, FieldElement{0x58cbd95bb0095883, 0xee4642696a7b37a0, 0xe6f53faebbc8866d, 0x064370128a321894}, FieldElement{0xcf9245d7fcc54f94, 0xe256bbac29255e9b, 0x6145560e5dd6a831, 0x01e3c4a6e1c428b1}},
+		{FieldElement{0x246deee07e53b3db, 0xe3790a17e61eca80, 0xff3abd2f40ff0292, 0x049171fb89ecc9b4}, FieldElement{0x7cde5d122b7b7ac4, 0x532d8156549abc52, 0xbcc6e1a1d744efc8, 0x03b005656240ea7a}, FieldElement{0xe7d238ecccfab14f, 0xedc9934a8d0c9730, 0xa2e0a8079091a6b5, 0x01a8c1f2334a191f}},
+		{FieldElement{0x5cdc2a74ffa05607, 0x6d04fd1ea82bfe57, 0xf08d98ffb3f26c6d, 0x03d8180958fd377d}, FieldElement{0x73f58ac81f0d1b85, 0xf53bc606730c814a, 0x0d242545a936f255, 0x03afd15c18e5979f}, FieldElement{0xa31a860ce2b2c890, 0xaa7df48af13f59d0, 0x30f866a97f6a462f, 0x00d74df5ac2d4908}},
+		{FieldElement{0xcc3307076ae414ef, 0x583ef55fad8796cd, 0xf9f7f49110fa1694, 0x003edc3cd0293340}, FieldElement{0x98ce7eae7bc2b05b, 0x18cf08d1de3d6eba, 0x90bb3c7ce3b0815e, 0x00188b370b0292cd}, FieldElement{0xc7ccd8e162c0047a, 0x1d4c31406e0a5d24, 0x52e486710e0ff615, 0x075221a188ad9baa}},
+		{FieldElement{0x9e90441b9fc95994, 0x34e2f5a6c59e25cc, 0x04e8c015beef02b9, 0x0048882d363d2564}, FieldElement{0x65d1ad254437f14f, 0xa44086fe1ea0ba51, 0x9d5fbf5ff81f4958, 0x01c0898725e91984}, FieldElement{0x06a7a38ab64fb6e4, 0xd95a8a1d7c323ca7, 0x15b08938f0ede7de, 0x06d6ce92e3283aa1}},
+		{FieldElement{0x9697a181bf858c86, 0x2f32954b6d1479e3, 0x18ca52072dfcdaa7, 0x03e4ff50ecde62ee}, FieldElement{0x5dffd93b1feccaf0, 0x98076ecb6ce811a8, 0xb0a5ef0d4af84d1f, 0x01398daea9a6da85}, FieldElement{0xd035300d67e11ae2, 0xa8ba827e5ba6e89e, 0xab12ab28b31775c3, 0x01ea1b63465b9f69}},
+		{FieldElement{0x9bd350789defc27d, 0x12ec527168260e58, 0xc652cf909c660c33, 0x00fa983d6ac04081}, FieldElement{0xe516b5fc185fdb7d, 0x13f69ac409f9afa9, 0x3e33e862498c2d22, 0x0307f8470043023a}, FieldElement{0x5ac967a46f36de0c, 0x7e2a6c7f2eff623b, 0x2f5518475912d44e, 0x058bcc636c9be3f3}},
+		{FieldElement{0xba4589f5b6852539, 0xfc50176bf1c800a4, 0xed1932fffc2526a5, 0x05de09310f89756d}, FieldElement{0x4ec759dd5241878a, 0x6b2daaccec26d038, 0x82e50824aac65c90, 0x0671bae5ad783bc5}, FieldElement{0x1e3abd8204eaa530, 0x90027863d137bb65, 0x22df73d5db2b47e1, 0x0068f9c3244ed48d}},
+		{FieldElement{0xaab6c13a6f152bea, 0xc52218701dbfd365, 0xa424e31e77c3c234, 0x02cc98c03d867a67}, FieldElement{0x7c0a1851122e29b1, 0x9ef27d450ab3eac3, 0x2640dc9189eaabfa, 0x0158a4f8e25f7c7a}, FieldElement{0xd0c176cfa0b4fe0d, 0x36801025dc1ce165, 0xd9c98040c0463c43, 0x01cd53df043225ab}},
+		{FieldElement{0x6f4109ec199fe1c4, 0x07b8800135e3d0dc, 0xd77cff209b69b17e, 0x06a7a916913ff946}, FieldElement{0x62a812af436c405b, 0x3cff37aee42f2395, 0x584c2798b4b9c953, 0x0373b4a4b5b9fe9d}, FieldElement{0xb058d93b37654609, 0x3f0f8d4a153375b5, 0x1dd1ee1c83094568, 0x02047704d34fbad8}},
+		{FieldElement{0x514cbc6af0f68e7d, 0x009649820f783152, 0x01a85ddf0cf650e7, 0x05306fccc72f6052}, FieldElement{0x37c2634f13cab3dc, 0x14dc3933a900da57, 0x77b24d81546f4ed1, 0x000099d54bbe4ffc}, FieldElement{0xfe8bd5081c72199a, 0xf82848015955bc6a, 0xc330994bf81ae59b, 0x03a1e560bc20c25f}},
+		{FieldElement{0xb14748d98c738e05, 0x944669fb3b4c0ed4, 0x2feac1bd0c6338b8, 0x06846f08719f25a7}, FieldElement{0x4b83f5aef75aadaa, 0x88c867ddb53fa19e, 0x2bc34a79d4c503a4, 0x048ad78a1d0b904c}, FieldElement{0xbb33659799fb6bb6, 0x5c484da304f752b8, 0x517591005f20d10c, 0x04618ef67d1e64d2}},
+		{FieldElement{0x30064878140b703c, 0xb597aba533d94c8e, 0x266edba3bcfa78d2, 0x00d271d7a9db1c6e}, FieldElement{0x303735c31b1998c1, 0xc22f8790b52697d2, 0xeba67a4708ae5178, 0x006be6d480bc14f5}, FieldElement{0x20d54469c08bded4, 0x1e1ba9e983936a0d, 0x2dfc3be0b1cec92a, 0x068a4c8f32f24b55}},
+		{FieldElement{0x0ae91f3507f4c7d6, 0x924bff0f63692097, 0xf49d5ae54240b3af, 0x05005e55252cc54c}, FieldElement{0x5806d1e7e3d04f3c, 0x619013a84c6061fa, 0x2e81eb5b5bafdfaf, 0x002f8cc4be6ba1ab}, FieldElement{0xe4e703b43ad57c6e, 0x8f8b378a56733802, 0x25baf9c16390a092, 0x01f8c391e826c793}},
+	},
+	{
+		{FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}},
+		{FieldElement{0x4775554583102404, 0x8f171238c45db231, 0x49c5d8e45ba9b8c9, 0x0719ece2de3ee377}, FieldElement{0x88f1e5c172ac60d3, 0xf09e145f40401e24, 0x607f5d1014735b29, 0x03737c31f5bd421f}, FieldElement{0xb5d258bbed0ac8b1, 0xb76b47e5b4dbcdb3, 0x0c964e81f758c26b, 0x04f0dcd49056b0af}},
+		{FieldElement{0x3da4648c0f76ee26, 0x225f76389be5617f, 0x3e19a4ce69d7d59c, 0x07f10452692c94c8}, FieldElement{0xc0bfe339b0417f57, 0x62a9950679a58c2b, 0x3630456db24b956e, 0x0605be6bf34a8547}, FieldElement{0x8ffb73e22610c9b0, 0xc2c55d76a70b3695, 0x1e51ba02c8ff98d7, 0x047305d7e525108e}},
+		{FieldElement{0xd049fb22926291da, 0x03d9a26fd0739734, 0xd95462eec324825b, 0x0452911ec6c789ef}, FieldElement{0x595cc6cf3a675ad7, 0xeb4be5455a072cfd, 0x0f9d5b07484ce54e, 0x03557b7922018d81}, FieldElement{0xd6f4296e0ebd9473, 0x0309f087e834e4fb, 0xf1355eb3cd823d06, 0x06d0523abdbccee3}},
+		{FieldElement{0xa75035fea2b3542d, 0xa518af21099a1896, 0x2a31c2e66324c9d7, 0x06120a87f2ec033a}, FieldElement{0xfdd31ea93e573bcb, 0x37c7db40eced8d25, 0xcab9fbe62ae98b35, 0x07add2dd87de57b7}, FieldElement{0xe9195d98008aca5f, 0x8cf99728a3373cd5, 0x71891e7c76815127, 0x031b0df3d10fbd65}},
+		{FieldElement{0x1b3ae1c8dbdeefa2, 0x26721348487d72c2, 0x434d67edb59dcbaf, 0x01f0e92dbe7515ae}, FieldElement{0x9c60e298cb93d9c9, 0x0c20dd96bf5a3f3c, 0x884435fe463276fb, 0x076a89f761e7a87c}, FieldElement{0x84efca572e00fa49, 0xdcb677eafdead5d1, 0x0ae81b212e287ce4, 0x02b4ced5201025f8}},
+		{FieldElement{0xdcf1f9820b026266, 0x51efb12a8d2b7fea, 0x9334b365d2312911, 0x042c31ba5f1f27b2}, FieldElement{0x2c2274de2051a49a, 0x30ea24a395b791e5, 0xa345a74019a42265, 0x02b4556bf7a99a47}, FieldElement{0x88d1ad988e015d39, 0x704cfb6f8f2ddd55, 0x53e7472493740d04, 0x07896f5d62a798e0}},
+		{FieldElement{0x86f9029c9da186b5, 0x14d2c50202737637, 0x4ed4bc2185dd4b50, 0x04cd4ca0b490f266}, FieldElement{0x2bc9c90689fcb187, 0x051ae4065c52200e, 0x6314ff7e93b1e944, 0x0263d2dc5c8320ff}, FieldElement{0x882f23a7322d7c41, 0x655bc4cf96e65a1e, 0x3a074d096393ff44, 0x02ed68c4619daea4}},
+		{FieldElement{0x4f3718af1da282fd, 0x34403182d5a5ba3a, 0x0bce4dd98fffd3b9, 0x006afce8d1410752}, FieldElement{0x7767c7e72489b729, 0x4985311039b47023, 0x570ad14c1427aaf8, 0x008ecc72994fd081}, FieldElement{0x2575cb86ababe015, 0x2e60a01eebde62d5, 0x3f2b857b6d93db95, 0x01d6df2373a65aaf}},
+		{FieldElement{0x616821f3dd0d375c, 0x87dc232db92b80ab, 0xcd44553f969e0af1, 0x0431900aaa5607ed}, FieldElement{0x86f3aeb223c9f9d4, 0x61a9d6ea4e62c37a, 0x60a051fc29a7e84e, 0x048fd29247d6ceba}, FieldElement{0x8bbbf8371021e190, 0x7f394eb855914f9a, 0x93428b27dacd7764, 0x03f8608c96fcc9c7}},
+		{FieldElement{0xc670532735dd9fae, 0x16f0d4c08478706e, 0x82715587bb6c745d, 0x03c41704a3765664}, FieldElement{0x1512bc4e66bae540, 0xaf8203040ad5307b, 0xbe82f054d33166e4, 0x0414939067bcf907}, FieldElement{0x1c8946ae9c0c94da, 0x757f38b49b05603e, 0xf9458d833fdec519, 0x0127e98689eef6ce}},
+		{FieldElement{0xe4c10a1407ccf26d, 0xa284bc585af5e6b7, 0xf78ce7aef48ae3b3, 0x03cef988b3efc61d}, FieldElement{0x9ba336511f10a919, 0x77d65f939a714616, 0xaade3e11aba33055, 0x043c70f888eabedd}, FieldElement{0xca5a1360ab66ac9b, 0x4625240313954ead, 0x6eb2c7bf0fca7cd8, 0x02c98303993805a6}},
+		{FieldElement{0xc27289a0f1df1b9c, 0xb7675fc5ff40bc21, 0x13ee21ca9b283c16, 0x05c7b6a4524e0a04}, FieldElement{0xb9c2847fcfbd78f0, 0x2b32e8709ba0cb04, 0xc76aa1ec3adc26cb, 0x00e8021b45744fa7}, FieldElement{0xbb52cbb676984817, 0x4f50e0d4e5ed60af, 0xa9ee2177eb156878, 0x01bdff3c3e2aede6}},
+		{FieldElement{0x9af4b2ae7cd21291, 0xeed90ca20ee41791, 0x0436597c99f85088, 0x06652e161f714630}, FieldElement{0x4117e4392f7df889, 0xf7c872574dbab2cc, 0x7e4115f988448d74, 0x076eefda9be557ad}, FieldElement{0x9ac2ad9c4d6e5b32, 0xac8d3d14c1203009, 0xd6232ce002c8ceb4, 0x0709a9a104e56d64}},
+		{FieldElement{0x8ad6a453d60ccd08, 0x078906bae39fdac2, 0x8c84d87732b48910, 0x04f85f08533c3c99}, FieldElement{0x0c056d5454466a43, 0xe37491bea4bf98b5, 0x4181cfb750696fd5, 0x028033718136b4f6}, FieldElement{0xd23c087e1a0d167a, 0x8b749b9cdc37f034, 0x036b7c3b27c629d3, 0x0268cf934b1e76b9}},
+		{FieldElement{0xe802265c3acc1ae6, 0xa1317ff86b955e9a, 0x6d69e525f2ceade5, 0x06b1145672706d07}, FieldElement{0xb50a60418e5c47bc, 0x267b9684b4d938e0, 0x4b58a07e8d8100aa, 0x0637ae2a3b5802d4}, FieldElement{0x35c7d2f7c8e6e53c, 0x37e943b5bcebe7a0, 0x1535f9f689e79252, 0x04010e93b3909fe3}},
+	},
+	{
+		{FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}},
+		{FieldElement{0x99f89d46fab61b4e, 0xbe27f146bd230923, 0x6df0dc693a4bd606, 0x040bbd99aec0f1be}, FieldElement{0xb5d92dc516433a23, 0xda8c790fcfe1ad24, 0xfad3ee6fa88c2b30, 0x01abd69bd5ea12e7}, FieldElement{0x0cedf22585809853, 0xff2a9ea352794140, 0xa5cb17eb1b6c2733, 0x0314812eb0438801}},
+		{FieldElement{0xdb2c892634cd780f, 0x73df2966e748398e, 0x58bf9a6192d0a9fd, 0x02003880d44cd3aa}, FieldElement{0xab28f916952fffce, 0x263ae4fca3b8087c, 0x345977abc3404734, 0x025ccab5c996e21e}, FieldElement{0x5205b4cb4af98fa0, 0x1b5b519a0cb085bd, 0x8746baf373e0b38a, 0x034e8ce6d50c7306}},
+		{FieldElement{0xfb363fe517462400, 0x415aeefc807dbcd8, 0x733f30258c4308e4, 0x03a8c641a179eaed}, FieldElement{0x24ed33d5aa0a522c, 0xff8c6b4565dba32b, 0x800efe37516be6fb, 0x02802af1b691e927}, FieldElement{0x826995fe44a7a5e8, 0xe0279fa846928995, 0x86f2f5120e22bdae, 0x03ecbf1147f402bc}},
+		{FieldElement{0x4b8f9ee6f20b967c, 0x4a9438d9cb0c2dbd, 0xa52276ad8ade21a7, 0x02a6a60496410a13}, FieldElement{0x435b316a2728c1ac, 0x08862793fb1faae3, 0x88677586f33896b5, 0x07b805bf82cc0940}, FieldElement{0x5f678e84a2984a69, 0x4b5e194727f0e83e, 0x739770185485c566, 0x04f24479baaf74aa}},
+		{FieldElement{0x69a3133ccc45cc50, 0xe0b77b41d688bf5c, 0xcf9cb646a25bf045, 0x021719eaf17d8361}, FieldElement{0xab4a4a0ba7dca068, 0x2fdac2bb43907aac, 0xcb6d38fa37891119, 0x05ffd782f68327a1}, FieldElement{0xe65289e0e604f30d, 0x4dbef4d4da429ecc, 0x6e9290674bb792e8, 0x01e51ae9eaea9357}},
+		{FieldElement{0xc30366b8a803504b, 0xbe1a267c95984a6a, 0x589e1f25000b9c27, 0x02aea5d65940227f}, FieldElement{0x70d3ebf3088ed43e, 0x442cddb02208b9b2, 0x8d11ba9f0d71ae30, 0x07d6484eac297fea}, FieldElement{0x3cd2547f56dd7a57, 0xc79d150487aac52a, 0xe1f74bc81fab8fec, 0x0008916778dad7d5}},
+		{FieldElement{0xb1ef490d169b6ab3, 0xabf31f6c3a42ae70, 0xe8a704ee16c3ff49, 0x017bd017039d5806}, FieldElement{0x44ef93f2c3289709, 0x4310801fdc688d1b, 0x2da12b9d862673f8, 0x07951d89e3a23127}, FieldElement{0x99bd9a1356504dbc, 0x037ff6cdb1163c55, 0x2c9ede695fedec1e, 0x02519457c26fcf36}},
+		{FieldElement{0x2bb19f40e9b9dcd1, 0x647587546934f68e, 0x7203e7ae167b4c5f, 0x009ddd37730bf69c}, FieldElement{0xc14818a0e994220e, 0x2e5083e9788edf56, 0xc30160fb3925b64e, 0x03a9d491cd6207f9}, FieldElement{0x56a1fe9ed5790766, 0xb4688d9868063aad, 0xbc9c8b366e9753c8, 0x02f7a79b74d77657}},
+		{FieldElement{0x0e1d31928bd0ae0b, 0x5bfbe47cbd453755, 0x69655097847be09e, 0x0616a62f5c6ad422}, FieldElement{0xddd2a686e3851d77, 0xb4395e4d737ed065, 0xe6decc88803e3d3e, 0x06a01666f39500b0}, FieldElement{0x3540d7d6569851f1, 0xc97c5658579cd75c, 0x716b3d711645e711, 0x026395839084610f}},
+		{FieldElement{0x2185ea47137b8eb9, 0x05d67e935d62af47, 0xb56de29847525dce, 0x07c42da5f772ab29}, FieldElement{0x284f702067356266, 0xccfe57164b3c261f, 0x71ebb7e4cea995df, 0x028006bdfcef3b59}, FieldElement{0xdc0f940d96d020ca, 0x105b9a826d7204ee, 0x928f1a4a401e57eb, 0x06b7d23011f0686b}},
+		{FieldElement{0x0f2ca7b3b0062455, 0x4f1aefa9eb275ff0, 0x6d95ad39953678a1, 0x06f166ffe3dd58ba}, FieldElement{0x7c457a8053e074fd, 0x5fddaaf79301f21e, 0xe0d881e5d2b2f214, 0x034ab8301a095cb3}, FieldElement{0xc9eb87a76eb6307a, 0xf1f050ed16e22707, 0x329e3fd6a2551aba, 0x031d629ca52a5a0d}},
+		{FieldElement{0x88b1398f8db54236, 0x65f795bc2795bea0, 0x8853fdf79b025044, 0x057983b2135fd1e6}, FieldElement{0x2b73bad4ecdaae01, 0x219c627c232540c0, 0xb6512bdbe995111f, 0x05eba03991fa7180}, FieldElement{0x89a26f798390315f, 0xeab7a91c32f634e3, 0xbd624a5afa50a9ee, 0x046a3095ba06ea48}},
+		{FieldElement{0x40f86484452b12ee, 0x4678d0db87da20f1, 0x6e19f26705eadde4, 0x06eac9a43574d852}, FieldElement{0x012cb8b81b5b28cd, 0xaafb67586fb577cf, 0x709f1ac87837ecbd, 0x06c15f968de4cc3c}, FieldElement{0xac0e57a8d7cd9c69, 0xc3970855e423a031, 0x74b959b90fb6872f, 0x01ed7d9354de07f0}},
+		{FieldElement{0x9d9bcf5f1463d1d4, 0x1c48f3af6e8f2892, 0x1c0f16b215566473, 0x06d84f662b2abdd0}, FieldElement{0x1a33e7b7e11087f6, 0x90cbcbdba0965615, 0x71951d2537e60b98, 0x0590a64af2852da6}, FieldElement{0x1c9e5b6fbb20aaaa, 0x85aab18043e70e7b, 0x2521b4cc24022f36, 0x04a9eb46a6cdb867}},
+		{FieldElement{0x13bde5de1c47f987, 0xc4ed5e1b81836534, 0xcf48b27538c87787, 0x04c0b698e44299d1}, FieldElement{0xb2eb83c72a729369, 0x04c89c6e2063c860, 0xc959a7050e92bc18, 0x0539daba34e06d5e}, FieldElement{0xfc556e9a5a124406, 0x5c0e53b703e3ae31, 0x6ecdcbdf10adc80b, 0x00270fcccdd2feca}},
+	},
+	{
+		{FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}},
+		{FieldElement{0xa9007958ac98787b, 0x1d6f9408124e86d3, 0x161d4e52534ea343, 0x0121462bf31e28b8}, FieldElement{0xdd959e068f6e7e5a, 0xa625219c3ec35a6f, 0xd577482070ce01e4, 0x073c8b96c4e6ed5d}, FieldElement{0xa9e0f152543b7b2b, 0x6a90c42f4e3b9d30, 0x67446ee1fe4f3f2d, 0x02aff8c796909348}},
+		{FieldElement{0x56543feb2670c3d3, 0xfa6bbe09c44cd40b, 0x9964f42db5419e26, 0x006505df51a9eab0}, FieldElement{0x9491030975007c67, 0xe8aa6736276b0510, 0x03ce34f60167b21d, 0x011a71ece0907c35}, FieldElement{0x6e689811eff79926, 0x9c16ef8f1629c593, 0x5341fc62cdebf67b, 0x06936c5af7bed426}},
+		{FieldElement{0x23bf22f93c7bba6d, 0xf252d0d2503421d4, 0xdac3c4a52524f34b, 0x02e45efd7d84f03f}, FieldElement{0x741ba0748bc84b26, 0xa87cc8a5311f2613, 0xe7752aae4a3a61ac, 0x00bf8b239db7c0d2}, FieldElement{0x95afbc6abfa1043c, 0xc859d71b9b75cd34, 0xcbca3856494d05b4, 0x03432a0eecfb7757}},
+		{FieldElement{0x2abe2865936e3a6e, 0x1507b369da62088a, 0x6d72921e33bb6874, 0x045c5a15ff7cc734}, FieldElement{0xfd03281ecec19fee, 0xbbf2feac36713174, 0xaa77389604c8861b, 0x014d329a20b0b8b4}, FieldElement{0x2aa3ec361a7e4166, 0xaebced2ec304fc57, 0xb14e2093c6d96fd0, 0x0551d2a9b8008d78}},
+		{FieldElement{0xe1fb8ec7482c04a9, 0xf29fa4adb988e382, 0x60bc01cb664b20e1, 0x03b3f02877b8f309}, FieldElement{0xef199f9682e4ab2d, 0x02a4ac8816f15ac6, 0x826c561d89d6979e, 0x05694c79144153e4}, FieldElement{0x260815ccf0080ea8, 0xac3a27404caa488f, 0x2c711a94878eb5bd, 0x02d08f4d5031b9f7}},
+		{FieldElement{0xe3567a942ffc8fd2, 0x6d350485925860ed, 0x0e92d20eaf7f2f2c, 0x0672dde7e2b167db}, FieldElement{0x127cec9e7465b260, 0x01d88cc7bddf36ac, 0x67a5efc34c7b6875, 0x01a3e2884004a362}, FieldElement{0x302c56b343d86623, 0x7cc297153520ef31, 0xcfd9673c1481da1f, 0x041d8568b8cfc3fa}},
+		{FieldElement{0x91994d8c96268cbc, 0x5d9ad991254242a9, 0xae590ce9593064f6, 0x01b7c63fbb5e87f1}, FieldElement{0x11e2335b979e08b6, 0x974ede8ffc8a21e5, 0x6625357340efffda, 0x0125fabe8f8db8a6}, FieldElement{0x0a8551140ef230d9, 0xb690edebd2dbba64, 0x4c08aa3838cba80f, 0x019aff1b09d1e0ad}},
+		{FieldElement{0xf51efd03f75a5b04, 0x62899676c11d2c64, 0x3b26a78460113f2d, 0x065385b19f66b393}, FieldElement{0x1e59734f023a4d1e, 0x70d7f4a9ec4da818, 0x766d43b991aac89e, 0x0093bc98e0545686}, FieldElement{0x8586f6f7a8a39aa0, 0x94ef231760d68c1c, 0x8394c59d4afa307b, 0x023a4c3d090d4eef}},
+		{FieldElement{0x895e0a25be06b502, 0xb5aaf5518075cf37, 0xbbd95800eed890be, 0x04be7371656b6ab8}, FieldElement{0xbee2c6bde3b3ba4a, 0xc9f5474b1bcab02d, 0xdb690970a289333e, 0x03668bbe5c70ee52}, FieldElement{0x53499702981190d4, 0x5ba61c39d3f5e7a9, 0x17a9305be084a210, 0x06a1e85228ba5b33}},
+		{FieldElement{0x9785cb217b5b0eeb, 0x84f59b6f73c1d5e2, 0xab42b6274b3c1436, 0x01154f5f44202174}, FieldElement{0x694420ef294b996d, 0x5cc2bda84711e796, 0x5855d48b94cf0953, 0x007855584589a535}, FieldElement{0x4274bfb37f010b3a, 0xc20a77c5e2e0f373, 0xccbbd1dbf6cb70ba, 0x009bb7a7427c7cd8}},
+		{FieldElement{0xd409cca8b0389bf2, 0x61fa6e6fd4ff0a2a, 0xbd66060140fe5b84, 0x040754db70cc3ce6}, FieldElement{0xdd6bf5d28d9b617b, 0xd5c02c5910cd8be5, 0x362bc5cf7e4c74e2, 0x023b8e722a3043fd}, FieldElement{0x76f95ae7199b857f, 0x137e4459d7ccc7c2, 0x2aeb6880effeb22b, 0x01254362c15bd605}},
+		{FieldElement{0xb27f37befbb8cf48, 0xef3be77c97bdace7, 0xb523a0e47ef6d1dc, 0x03ab1b78e6ac440f}, FieldElement{0x6e31c29056c803b9, 0xb21fc9f78ec482f6, 0x69cd2a6b3bf673af, 0x025ddf0794f0c00e}, FieldElement{0xf71168f210609c9f, 0x29ed8d457ed6140c, 0xa385241c3bd592e1, 0x034d6288c2b07fc8}},
+		{FieldElement{0x0fcac00792f6a45a, 0x70a525b75d775445, 0xe6267efb2675ecb0, 0x03843ea33be519bd}, FieldElement{0xcd24df0eb0ddc349, 0x4983c58682df5e4e, 0xf07b253864cf19e3, 0x062afc9cc03528ae}, FieldElement{0xd3e37aeb9ed9cb47, 0xa3a00f805603209b, 0x7e308ae022974e13, 0x06c1a30fb5a46d01}},
+		{FieldElement{0x398d64f0e220235a, 0x28f4f4eb773a3bf4, 0xeba39e6ce1d9ac03, 0x0741ed2dcb54028e}, FieldElement{0xc8f0fbaf8383cedc, 0xa4ece0382aca8176, 0x775fecbcbf9b2933, 0x048efcebd4e63979}, FieldElement{0x9292428bf33b2423, 0x7819e4ada02a132d, 0x1336e20915ecda5d, 0x06461c821de35a98}},
+		{FieldElement{0x3d1e4a166568cb0a, 0x36083713a9e042a5, 0x4ee72fa32bfaf575, 0x0211b2efedf07aed}, FieldElement{0xdabad38dfaf52d16, 0x8bf9959cb1b5aad3, 0x7868cc1ac85bc56c, 0x03a8558b5a35020a}, FieldElement{0x1bfa681e4fd3f0e8, 0x7c084b1e209a9625, 0xbb22d9ea7cf3b4c2, 0x06fb951a6a30746b}},
+	},
+	{
+		{FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}},
+		{FieldElement{0xd326f26232c479ba, 0x8c186380d1a6ca54, 0xb95c57c096602b58, 0x058f92343d203f9b}, FieldElement{0x3b43bb8a9c615baa, 0x4a31ed016267dd70, 0x799eb5b4af7e5908, 0x00026359a4b60190}, FieldElement{0x331b8cc669c05580, 0x8c2213a229adfe18, 0xdb21acd0faf63964, 0x05c41a0f9c998209}},
+		{FieldElement{0xdcb47aa4759c5586, 0x1e3ca56f88d72305, 0x8fc10bec6bd49a49, 0x00fbe1c3f71eb3f1}, FieldElement{0x0fc87d6281b264e8, 0x57ba6849aada9986, 0x49999d44a2677bb4, 0x0015f323b98fa486}, FieldElement{0xa73e52aca708d036, 0xeadd250434f73a61, 0x5fa4d1b99e08a8a6, 0x00a66d92aa66d629}},
+		{FieldElement{0x98fec956fd8b5a52, 0xa0a4544f87ce4577, 0x6c7a5598a3d1a9c7, 0x04937ff8bb707fe9}, FieldElement{0x8af3d1ae533da6a4, 0x6fe60cbdbec092d7, 0x8ba82d83c965c863, 0x014f3f03f7a7e38c}, FieldElement{0x6df29bff478a759f, 0xd2df06e1ff3df013, 0x4de2c0ef3d9b48d7, 0x01bf17a1d1fd4fec}},
+		{FieldElement{0x88d3327ae9b46060, 0x7e1f90b795082a50, 0xff0c2999cc0e914d, 0x029ed96c60f1c1d6}, FieldElement{0x1f64896d9ff9808b, 0x3208725bcd24e36b, 0x658b18e120c433f3, 0x06c96d7c9140e72d}, FieldElement{0x769ea2821cd903c8, 0xdad8f63784a3c966, 0xade6785bd8732cd6, 0x0028abae66381ccd}},
+		{FieldElement{0xac32c8fda973403d, 0x7ad9026306d47062, 0x2ac9c9191bac4c06, 0x0205a7fe35279c32}, FieldElement{0x4348043fbf0dda3c, 0xdf841b25c5ca2db3, 0x7f3b7264928b96b4, 0x07781b61a7342ff6}, FieldElement{0x5a95ad9b77d16ce7, 0x081694d13e3ebec0, 0x39672d458a456465, 0x069bbe7a3fb314d2}},
+		{FieldElement{0x01c906f51c8dd67e, 0x854059fe4a171973, 0xdfec9d3685164bc3, 0x03b3ae6fe3a5d607}, FieldElement{0x2fc9b8a60953ed3a, 0x7ebb4fb7d6cbad4a, 0x9396b8bc0e6055f2, 0x05bf1561135fff77}, FieldElement{0xc8ea181f05b26f72, 0xad3608ada1af1ee0, 0x1fb32b43d790ca07, 0x01a7c64b5a45e75c}},
+		{FieldElement{0xc72ac3aa2b344469, 0x348e12aa8918a5b8, 0x6375468ee53bfcc7, 0x016fada8e11225f2}, FieldElement{0x83b4d754476b55cc, 0x56c5b8d8faf1f2c5, 0x920afec2555c8fb2, 0x05db9f14d56d2f8d}, FieldElement{0x5a2b135d6200862a, 0x024f92bacafa2609, 0x9c7033dfe47f15b6, 0x04779db392625ec8}},
+		{FieldElement{0xb4061e956ae33244, 0x8bfffddd79305abf, 0x7f8e22a026f0abd4, 0x0572fe07b506f530}, FieldElement{0xc85c9dc34b1c25e8, 0x4235228b6ac67012, 0xe6dd8593dac99f65, 0x043daa6bd20decf6}, FieldElement{0xa214bc9d3bd73cc0, 0x5c44dcdb0cfaa63f, 0xcf70a0ac691adfc7, 0x0373b90b547c20c0}},
+		{FieldElement{0x5a4a58a2947790d3, 0x09c98dbee2fac104, 0xdae8d35aa25d27eb, 0x06dbab67aa1c2ed9}, FieldElement{0xc9ca8658bc100f1a, 0x4e23c70fa62e4811, 0x3d7c3454970abf84, 0x0769b982276863d1}, FieldElement{0xf81848c3c72acbbf, 0x6d1df9c3b1bc9e6d, 0x9cbdf10493b043a3, 0x05175b6e08313e36}},
+		{FieldElement{0x4614dd93a31fc644, 0x27985b8108d774f4, 0xf8f37272d3bcfd17, 0x0432ff0125a7bf19}, FieldElement{0x0b94c7a216931aed, 0x21147925e103d50d, 0x4a9524afb3074efd, 0x0339863784069109}, FieldElement{0xbb88799c081b6453, 0x8a5a730c7b3d82b1, 0xe3592b9d4594e97a, 0x0696e63c779d90d4}},
+		{FieldElement{0x49b7e08d9c141efd, 0xe98089f46b85fef2, 0x6defb2ddaa757cae, 0x031571f9ce79b966}, FieldElement{0x2708f6add2cf9959, 0x2bc63e3bb2a09a15, 0x50a2538b467dc724, 0x04b45e35f94483ac}, FieldElement{0xfe19269c4ea5ab0f, 0xbe83192a6e31cbdd, 0x109372a623a3d5a7, 0x036494e2882a8e05}},
+		{FieldElement{0xc459cea1e77e3910, 0x6c962bcc1632d06d, 0x6d0dc9ed57176ffa, 0x055149e4c811ea0c}, FieldElement{0x119a7b4c156a91c9, 0xd1b5bc3ad5035126, 0xe30725370a046172, 0x06545f2d4b5f77bc}, FieldElement{0x8fd66522aa681357, 0x1b04492eaf756bef, 0x5fa307ec7a9fff6d, 0x009d06b6986831e4}},
+		{FieldElement{0xcbef5aeed3cb4abb, 0x1693cf7d31b0475d, 0xfb49cca1da50660a, 0x04a5e588b97375ae}, FieldElement{0x42238877a586b36f, 0xd8780d0df5da75f8, 0x5be97f37109cffb0, 0x01c986dafc473a29}, FieldElement{0x4bbafdfe36baf1b2, 0xf3576933504512c6, 0x062f2c5d9fd32456, 0x036dc603f7d92dc1}},
+		{FieldElement{0x0dc429362a57bac4, 0xf36d1062ebd27cbf, 0xec5c6854c127cf9e, 0x07c6bc982c69c755}, FieldElement{0x6aec79491cce44f0, 0xb60c7e7710e66409, 0xad2f97c700835630, 0x03406f17b7e2b474}, FieldElement{0xda1954ba79bf0f7f, 0xa2e8e8e4f1bf64ee, 0x8ce32d02c0a17c76, 0x056f013d70bc4b30}},
+		{FieldElement{0xba5265103331038e, 0xada83ac2d34f3790, 0xb2df27f70d81bf8e, 0x0001d2ece275f8cc}, FieldElement{0x941a4198675b2fd0, 0x78a928767284d2cf, 0xbf5f95ee061211aa, 0x044dfdb27850e132}, FieldElement{0xd39279321c46cef4, 0x57fbb810fda1e95c, 0x3e78cfd6a0d28773, 0x067fa004403ef53f}},
+	},
+	{
+		{FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}},
+		{FieldElement{0xac9e82a4bba65f79, 0xf41e830d55ff8cf7, 0xba29453ca25d7edf, 0x047757265a94d266}, FieldElement{0xfea51defcc4da562, 0x669891b3d5f08ac9, 0xf1b554b6e439a1de, 0x0004384fe942c84c}, FieldElement{0x91bfc417be32d424, 0x0f384641f3838bb0, 0x0219b58b1d43a796, 0x02fd8985d03db4cd}},
+		{FieldElement{0x88e2312b953d1cca, 0x71747a6bbcbc4b17, 0xe8dba08d3dd5f395, 0x01d8ff8f31d01e34}, FieldElement{0xc0a9a6c968ea0993, 0x7104a984cdbf137d, 0xcb935248f39053fd, 0x020d3591a8f84ea9}, FieldElement{0xfe99c2133305a7ed, 0xb5a6b23363a1332c, 0x368c63d2e966c5aa, 0x0277e8732e89cf2f}},
+		{FieldElement{0x9cd99bd8b1605889, 0x8ff9379184321f42, 0xc50a75b6fceb8ede, 0x06f21f878b782d0f}, FieldElement{0x5dba413be54e61ac, 0x79ec6a3e84e94df6, 0x173d4ec2a0fa20cb, 0x07ffc7f5ad304157}, FieldElement{0x908f4fc09f95ec37, 0x643726e1f2d1f598, 0xd657f0d9c2573731, 0x064457629b3ca203}},
+		{FieldElement{0x636435c82bfe1365, 0x1a6eb39c186a1f4b, 0xe978b156002682a4, 0x0450b63af15b347f}, FieldElement{0xeb443c3c1991c4bb, 0x769864223802acb7, 0x2cb6ec2d37962180, 0x000b49d5eb556b0b}, FieldElement{0xfc55cf2b52f769ed, 0x70e231d49cd0e9dd, 0x68b23155fa197dac, 0x024f44beef359f83}},
+		{FieldElement{0x1c69501b8479d8f0, 0x1c5557e7620fe872, 0x7598e1bdd1999298, 0x0776fb59b9c7c062}, FieldElement{0x19bfe0cadcb25ea3, 0x264992df0cce359c, 0x961626600b75c201, 0x01a2ce01c72655c7}, FieldElement{0x929243978fffdd46, 0x03d9a20d6126e57d, 0x9f7f7106efe042b2, 0x06ade5dc8b88e2fa}},
+		{FieldElement{0x76dfba013fcbc166, 0x587c6ec8d129608f, 0x7492600ff1993e60, 0x05b738613feadde0}, FieldElement{0xcc99b85bf40ae8ce, 0xb163cadcb03e2d40, 0xf1ea78beaf3ee511, 0x03288673aaaee033}, FieldElement{0x16e34f2aed2979d2, 0x977ee6d382e86a15, 0xad11a67235adf2d9, 0x0357473cdb308211}},
+		{FieldElement{0xf323f6e0c49b0675, 0xb87abf6c52cb9074, 0x8afdfad371436db5, 0x044b65f806877024}, FieldElement{0x50111859bb7a8b01, 0xbbc1535fa0548690, 0xc045c0b1f80789cf, 0x01e60e7f9a9fdf74}, FieldElement{0x0a1c2d9076f3702f, 0x50541769d31fb01e, 0xd60446ae7b8f0ad9, 0x01ad9e50bc99f385}},
+		{FieldElement{0xb01abbebbf4d2bcb, 0x21ffc5cf2fab3b31, 0x026a13d6e246a527, 0x04e587ffd950b0c8}, FieldElement{0x190f763a4649bbb5, 0xd5dea487cec8493d, 0xfc7e92ebd721536f, 0x067e9e072ddb866a}, FieldElement{0xf59e08938786130d, 0xdbc12a6e111c526b, 0xa4c850a3f0d0549b, 0x072c10858dd5781c}},
+		{FieldElement{0xa359d907dfc5d872, 0x04e0b2a3f6743262, 0xd970866dd7bb5f8a, 0x0217b394c4a0306d}, FieldElement{0x16bcddfda10a9b23, 0xa18a43517cbe3f21, 0x41bc51cb66fd0374, 0x05fc290f490260a5}, FieldElement{0x8363b1ef789b3a21, 0x51b2cc7161aaf134, 0x9cfaeaa7b546ea19, 0x00f71f7a8bd0ffa1}},
+		{FieldElement{0x88be33238351dff9, 0xba6ed621a0130fd0, 0xde31a4ece9691d0f, 0x051177c8530bcc26}, FieldElement{0x411fb3024cac8901, 0x352e529f5cd32421, 0x6e243ae1eea14ea6, 0x014e84bdd243fcbc}, FieldElement{0x8af39069869f398f, 0x6529b0e2def211ad, 0xd84671f02b305674, 0x03f4799251838c34}},
+		{FieldElement{0x6be56de14bfdd01b, 0x568748763430085a, 0x1a34c9e955dacbee, 0x04b2fbd3eaabbe0b}, FieldElement{0xba9afa18b16c1c94, 0x0f660b3fa921ebcb, 0xe4cd659e867baabe, 0x075bc710aa8d4818}, FieldElement{0x392689f22a221ca0, 0x73d95ab7f9b6d3a8, 0xc86e5b7136420ecd, 0x01b0f88612769353}},
+		{FieldElement{0xff96b3f91933d8ac, 0x01bf9177be84c43c, 0x7b6e48238e73ae99, 0x04daf1ea441c5cc2}, FieldElement{0x649016f177435f14, 0x2c7283456c9f278f, 0x43598a72f3a73ea9, 0x018f52b9156744d2}, FieldElement{0x9029b590402b2411, 0xb952b3405d3c210c, 0x1e7d33b0ee1042f1, 0x00ba2adefca82685}},
+		{FieldElement{0xaff88b552f1dc39c, 0x96d655caf8123664, 0x54f817831a07c63c, 0x07f940c695c88e9e}, FieldElement{0xa0ebb6ff1e328bbe, 0xf8def73c65dcd8ab, 0x9ba5e5d6a15d67f1, 0x05a95415a11f4abc}, FieldElement{0xfb519d718654892a, 0x159c9f39abb96e6a, 0xb04a0bbee84a6b6b, 0x0263fbb7880cddb0}},
+		{FieldElement{0xe2e67ed921516074, 0xe1a8769dcd841df8, 0x3c09ae7c6429e2b5, 0x009bb017fd6c726d}, FieldElement{0xe9ac872209c457c6, 0x7b5a6b81bd7b97bf, 0xe92f1990221dde2f, 0x01068adcc89005c2}, FieldElement{0xbc44e58dd1846310, 0xd368d3f22db0bb9e, 0xb579ebd84c33c835, 0x05d06d22d121ecc7}},
+		{FieldElement{0x91c17a97f396b45a, 0xd3a52060f65eacfc, 0x58832fd92cd0bef9, 0x01d7165bbd953670}, FieldElement{0x1c0e651b2c7e7cad, 0x741e7d23c6467bb2, 0x4d36625bfb0666b4, 0x01d98ec60d37f457}, FieldElement{0x23a8426ec0e12193, 0xb2e41b729c37ae4b, 0x03f0d884a74e6f65, 0x047cc3852d9a2ff3}},
+	},
+	{
+		{FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}},
+		{FieldElement{0x2e3889793f329dd0, 0x4d1ea3d78702b84a, 0xa334ea563d66701d, 0x04f1d7079605fe6b}, FieldElement{0x826e5fb0c525c2d9, 0xb947caf9855eba09, 0x557bf4b0b78a7243, 0x0750053e785e9007}, FieldElement{0x84990ff1a60cb5e6, 0xe0a66aead9053cf0, 0xd03643e5ce8e59fb, 0x05b3dc8aee98e083}},
+		{FieldElement{0x4367290da815f984, 0x4a20cf96b2b41ecf, 0x16997bdaaed533b6, 0x056ff160bd578075}, FieldElement{0x871582c7b43df79e, 0xc85df7a2da3f4044, 0xe947152c9772b1cb, 0x0283bd176c59f587}, FieldElement{0x012798d8d1740f93, 0x6723d5828603ff30, 0x4073dd30be7eccf2, 0x042bf087e493bddc}},
+		{FieldElement{0xe3a0c301e343e6e5, 0xfacadd925249ec65, 0x696dcda9a4edd09a, 0x05aaa31016b2ef10}, FieldElement{0x3ab5180926b015e3, 0x924c20f50c9a6acf, 0x82550567f24339c0, 0x06b9fb385cb0095e}, FieldElement{0x886d67dc259bd21e, 0x0b75cc04c8c42f67, 0x0d705e6a4c2e2b34, 0x068bb85c933ec9ff}},
+		{FieldElement{0x2fa7745007558ef3, 0x01aa1f6b077a3e59, 0x66c2e9f9ef791883, 0x044061af4cae58ed}, FieldElement{0x1894cb6982ffc655, 0x703d2dfad3b95d3d, 0xe85a3095124151fd, 0x05584649a5a3f11a}, FieldElement{0xe2ffa52f1f4271b0, 0x7bb37e4268517533, 0x6c8da5065cf34040, 0x03e7397d9a2af349}},
+		{FieldElement{0x1513f5b4311a44e6, 0x7dcbcf8a1b4515bd, 0x5ce88ad0112a74a8, 0x078822a629defc94}, FieldElement{0xa932a3c9fd917f11, 0xd17e45cc8877206b, 0x6bd0e88d25382e58, 0x070b4792ad868c62}, FieldElement{0x4279a87707ca9090, 0xde198a53a4a4cdcf, 0xda65f8385f53871a, 0x02f5b7278a4737a7}},
+		{FieldElement{0xd39bd72f9880fddf, 0x2f38da249886f036, 0xb734a7360af97e56, 0x07669a63089cfa1b}, FieldElement{0x22d349ba110c6347, 0x0924e2a4597da621, 0x00aed1276226d7d4, 0x0716998ca1d17a3f}, FieldElement{0x8c0c0ce9e2f1fc64, 0x4b8ee70e6c6752d6, 0x9830637b63211214, 0x014d6efe9c08c75f}},
+		{FieldElement{0x820611061592102c, 0xde67f6295e52ae80, 0xa2519b33e5a1d5e4, 0x00a03cf160441e52}, FieldElement{0x1a20242acd943bd4, 0xec108f7ff88b73e2, 0xce80c2200a99911f, 0x032abec8243c8be4}, FieldElement{0x827e85f25196a5c7, 0x371c0837cffec76e, 0x3c009b2f53cf492b, 0x0342716c8369d9aa}},
+		{FieldElement{0x898cbdfb0c1005ac, 0xdf481f2f8ec27c70, 0x82c76ed313bef1b0, 0x03f9a9cd4c3ccde8}, FieldElement{0x496ae0ceee325768, 0xb574f6cd2eff6db5, 0x78db1118c34e636b, 0x04168c7b691be7ac}, FieldElement{0x71ce99bb9b0f0cf4, 0x87bf2d04a8b281b1, 0xb9f1fd45129d953b, 0x01863c6d530418f1}},
+		{FieldElement{0x6d85ae5047d358ce, 0x934de928b5950c8b, 0x0ef45d7d0dec4186, 0x01f80f719a059a39}, FieldElement{0x40a9741632ae70fc, 0xc91c72591fdf2143, 0x7773fb334028d426, 0x007e0fc33ee9a9ec}, FieldElement{0xffb37dd2bf34240c, 0xe339ec3937c8c945, 0x37e73bff050f5fd2, 0x01d06b25615e9042}},
+		{FieldElement{0x696409cdebb67a20, 0x841a2c5257350d8a, 0x1d443c442f306dfd, 0x00aadc88642b0481}, FieldElement{0xac3151463b48556e, 0x4adf891415865a01, 0xee68e6f6a16273e7, 0x05dddbfa4fea7d34}, FieldElement{0xec2b97aa975d419f, 0x40e6da9a80d2d041, 0x40b4ee3790a72d16, 0x00234e50a79a57d0}},
+		{FieldElement{0x4b8ad22ec16bfad9, 0xb339e65be338b9b9, 0xf27a2a79fe844493, 0x03a3dc355491aa5e}, FieldElement{0x3da9ebae619db12a, 0x7cd9f1346a243aed, 0xc5cf73c0bf7796cf, 0x066f49f8c3d8d675}, FieldElement{0x01d560b4bc9d8bdf, 0x4db6dad9fba41d74, 0x8d01a92db940b536, 0x059395d5a93d59a9}},
+		{FieldElement{0x229fa5032c0a7772, 0x2fc348cc4b483430, 0x54ee01a914266e4b, 0x0656da71368f06f4}, FieldElement{0xcf757512785d7755, 0x43a50d96510cd653, 0xf27c512086b2845e, 0x02d368d3a11d0942}, FieldElement{0xb2dbfbedc8217add, 0x50d6a652d4aa7984, 0x802a11808b6a8970, 0x0452ca1e5394986a}},
+		{FieldElement{0x7f5db1747c343880, 0x0ebf725b6754a96b, 0x426e0b9657db3854, 0x033c5c9be6354f31}, FieldElement{0xc2d5a92704e910e6, 0x4a805df736fa419c, 0x0d7f17cbce707573, 0x06580721e0db2a41}, FieldElement{0xbcee63d9521a22be, 0x8f499a4a0ce5a43b, 0x1c35d49e1f01330e, 0x07a97eb0e68842f3}},
+		{FieldElement{0x1ac47de6d1e67880, 0x0bbf87985e8fd8a3, 0x0a5dd3cf444be99c, 0x059a94fed3bb4711}, FieldElement{0x03076b4949519dd1, 0xcc2271d7f12e7aad, 0xf3f85eb2da737810, 0x02d8f9a990accca4}, FieldElement{0xa51691ccd4a89c12, 0x57030b1f7ee2cd80, 0x16b130214f703a26, 0x07894a56d3d660da}},
+		{FieldElement{0xc3d1db6f1802a6ef, 0xb26d7444867e9d95, 0xd74fbf753c579bf7, 0x0613667ef2bc8b29}, FieldElement{0x7fdf1902e708905f, 0x1cc95e4416599b2c, 0xc5ada5643e992fe7, 0x02838a225c5f9a7b}, FieldElement{0x7cbf2cb3b36bce07, 0xd0ef4647e9a77cda, 0xf20d18e51c5b45a8, 0x03ca68098c58fd3b}},
+	},
+	{
+		{FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}},
+		{FieldElement{0x38b733ab31178aa5, 0x579ba4520fd6d01a, 0x902f9f6d3062285f, 0x04b47bdfba0a70f7}, FieldElement{0xc8c370124f667afa, 0x524e86f4c0281a09, 0xa893c09d4dc42afd, 0x053145bd560e8b31}, FieldElement{0xce2d967049903eeb, 0xcc53f0d0160d7dd6, 0x3d41703b5d4486f7, 0x033299c17b70ff14}},
+		{FieldElement{0x3fce9653345e4013, 0x9cc11198ae98987a, 0x0d07cc2bffea296b, 0x0664504c41bea9ec}, FieldElement{0x8147da315947f7b4, 0xdb6c5e4c7c1d39b6, 0x5514d5367b3572f1, 0x0416a789a02cad9e}, FieldElement{0x9c9edef44c70d343, 0x577fd68451edc775, 0x1d8897ecfc3b27a3, 0x0427adf320e39e84}},
+		{FieldElement{0x6fd04a024aa3f1c5, 0xc10a30d00b611c05, 0xd6cf6462df4469ae, 0x02e38f479e276f18}, FieldElement{0xeadad95cf9a291b2, 0x4aafa77d440bc2ec, 0xf2a5b6d024592707, 0x01d14efa2f4a65f1}, FieldElement{0x7734b4e12a499df1, 0x9b41324ceadf6fcf, 0xad678fb81af570a1, 0x04e5da1dc6c79e44}},
+		{FieldElement{0x3242ffc7739eef48, 0x631953da8c477942, 0x82fa3b2fbb9ebed4, 0x03195477c2af2dd2}, FieldElement{0x11f72d9e78d45d76, 0xd956354c9f6c16ef, 0x2b0742282a9ea9c0, 0x063a8c1aba6a6909}, FieldElement{0x7d667c8e1c6e9fe4, 0xf0d1029b6c4d74c5, 0x0af64fa948368b67, 0x06f5f186175fcdc0}},
+		{FieldElement{0x8e22e6041aaa5313, 0x46433e0f673dd374, 0x2997675a2186bd9e, 0x01cb1f1f605977f5}, FieldElement{0xbfdefb461220a176, 0x1d59049c383db65e, 0xf446f6ff9c42802c, 0x001f878bacc32d6d}, FieldElement{0x410906e8a8db3938, 0xe7186738be903e95, 0xeedebcf9a9ab733d, 0x053f7f55d7727cea}},
+		{FieldElement{0xade3668980521768, 0x898baaa9b20282cb, 0x1d87f1b1241bc55b, 0x0434a506199708d5}, FieldElement{0xddb3167665951447, 0x277806d584128ea3, 0xf0c98f4554d503e2, 0x02d7b0bcb089a9f0}, FieldElement{0xab2a3a0a20759227, 0x4be6c8fe0bb4532a, 0x57ed9bc5f0cecb92, 0x00efc789dd8d516f}},
+		{FieldElement{0xbb6327b5c91895d0, 0x2de4c6d17a0d254f, 0x08fcbce713f74e8b, 0x0638ed8393748c3a}, FieldElement{0xcf1928333fa64e9c, 0x086474bc36b602b4, 0x540273716b147aaf, 0x01cd90117a3801b0}, FieldElement{0xb8244835346c62ea, 0xf1860a062ca3e2f5, 0x3823b82cb8659cae, 0x06f14bf6a3f0dbb4}},
+		{FieldElement{0x2ec9945d2bea8d98, 0x8705697947a3ccb0, 0xbcec1a608ac9462f, 0x016f1b005b41da04}, FieldElement{0x779da9910ec64adc, 0x7d7a52f779ddda61, 0x66e8cd0282d1863f, 0x04c04abfd5b5d467}, FieldElement{0x0fe3bb3b84481854, 0xa10e0b1889988006, 0xde6b928d24e11f35, 0x05bfde65fd1ec83b}},
+		{FieldElement{0xf621c546954c5c58, 0x1dad1a3dc5e1a0a5, 0x56cbf1719641c4c2, 0x033191a1a7d528b5}, FieldElement{0x3099712c26aec749, 0x3a8739785ef6f66e, 0x15c6e887b8260df8, 0x01a06b04f844ede1}, FieldElement{0x36085054635b3a07, 0x2a19802bdc9501a7, 0xeb40e74467080e7b, 0x0377dd63e72ebb2d}},
+		{FieldElement{0xd50e913fdbd94098, 0x0b3d39acbcfb3373, 0x81027d7a69006d85, 0x030ac38ec7fa8b28}, FieldElement{0xd2c34c705093d524, 0xb40baea3e34860a9, 0xb1b98f137697d7c1, 0x03ba1e20bf35dec0}, FieldElement{0xbdebab34baf46273, 0x7a72f9bf8f457aea, 0x1b4303316abef794, 0x01fed9d0c634d251}},
+		{FieldElement{0xb57d24ea8c604b1d, 0xe942026877c20ad4, 0x9efa27543e23d9d6, 0x006d5f9d6866b718}, FieldElement{0x762a286d9353cccd, 0xe7fb29d4334327fa, 0xfb2f7836a2fae5f5, 0x036c36f15a322407}, FieldElement{0x07de19a8fa0895af, 0x156d2960c3250f83, 0xd0da29f7797fdaf2, 0x076dc6c1523187ac}},
+		{FieldElement{0xb70c38634f4420c1, 0xce1d2f75fb68b0f3, 0x9b9e2df7f79eefed, 0x0103d487a5303c4c}, FieldElement{0xc53b1db2e3c3f253, 0xf5cef36f9ffd9c66, 0x8cd4c9a60cf2251d, 0x06503ef59f30feda}, FieldElement{0x02b8fcdb7dac448a, 0xc153eaa4fd1664b9, 0xe4923547713a434c, 0x02e48c40c9ca1ad7}},
+		{FieldElement{0x41c7e58eb0993407, 0x6881f57143ab0a82, 0x51cd2dec7c1315c6, 0x07565c15852fa1d9}, FieldElement{0x7634ee04af51b1b7, 0xe7295da462621552, 0xbcfeb601a764a934, 0x01de40db88b0b95a}, FieldElement{0x4c12ee057ee02fc9, 0xa8a2123f8b2dbe2f, 0xf1768620854a2f37, 0x07a9480ef2c5ac72}},
+		{FieldElement{0x0fac2d91b02f08de, 0x0306a44a59e839cb, 0xcc7daa4ad9f975bf, 0x0234ed207e2bbda2}, FieldElement{0x7aab797a9082b83e, 0x2a829d460cbe8751, 0xcb33367e401d4d37, 0x03c672176268e141}, FieldElement{0xf7aeecb6281b9bd5, 0x55a3a3b3c954e279, 0xcdfe2166525fe74d, 0x03811f5d94a1299d}},
+		{FieldElement{0xaf0c23ca9e11ff8d, 0xad0a84b2f99ee086, 0xb2b1fd32a9612a01, 0x00511c93d2fb1841}, FieldElement{0x8469472118475a60, 0xabb0bec24ef18ab9, 0x21e16eaf2c7a628e, 0x0111c02082a26770}, FieldElement{0x799c9aa6aa6c2266, 0x74f2132da95b1c6f, 0xae3dfd97bd9524f1, 0x0433b038b054e60d}},
+	},
+	{
+		{FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}},
+		{FieldElement{0x7a6f128bf3d44b42, 0x8f868142f4a9ad58, 0x601408e15047c3a6, 0x00544f07d7bdfced}, FieldElement{0x5c956f74fd78cccc, 0x7284039d19e42f48, 0xc2129d2f43f5aefc, 0x06c7ce0c21f0de4e}, FieldElement{0xd7cb19d2a9cc6f64, 0xe30c04ac2929c45d, 0xfd4073780de32a87, 0x009cb971e2dbd846}},
+		{FieldElement{0x91270f819c45a319, 0xa32ad30a10310265, 0x24bc3688b29ab019, 0x0059db7d8a6a0363}, FieldElement{0x1f0485c9f8e41078, 0xd922dcccef76ff28, 0x6def1ad26e82c4e6, 0x06ff23a045e3e539}, FieldElement{0xfc256b868c88294d, 0x55b55e3b8e74cb11, 0xbcf7cdae7cd8d266, 0x010c2855a1565453}},
+		{FieldElement{0x29e5f8f83ecb1662, 0xb9cebfffa227f3c2, 0x724d7a3fa3a4ee9a, 0x00daa0cb8feef6b6}, FieldElement{0x0beaa0e3deb1af5b, 0x2b3d2fd9df8869d0, 0x196ea4879b2f876a, 0x07f76c485194d1f6}, FieldElement{0x0faa14fc68f7f202, 0x7b6ba0d67fc65f0e, 0xc733d41ec54a54a7, 0x032e4a31f69d9a4f}},
+		{FieldElement{0x5974f7263a15f122, 0x08fe733e9ee8623b, 0xfa937635ec07154f, 0x07bc16c1ef444f7b}, FieldElement{0x77264d2d6e2670b6, 0xdcad9f26999ba79f, 0xbe1a5482a750492a, 0x000bf6a55452afec}, FieldElement{0xf317cc35be490147, 0x4f6fb9ad05a511b1, 0x606e686d2141e5ec, 0x02b6b397caf69033}},
+		{FieldElement{0x664b469c471dd936, 0x3bbf0a7220afc597, 0x9f6d01bad1210e69, 0x008b98ae09702d5c}, FieldElement{0x37e3732761effbb7, 0x62f9dac76eedce16, 0xa8595586bc8936c6, 0x07840a8f004a83ac}, FieldElement{0x6091b5988b706dff, 0xd48f7de3751bdd5c, 0xa762e7e9adc4a402, 0x079f873bfefbbc9c}},
+		{FieldElement{0x0b936b8163625b3d, 0x08a886ba6b226be7, 0xb98897dd68179fa6, 0x06002edaf85983e0}, FieldElement{0x876e7d08ac627d66, 0x64bfd38afb3cd59c, 0x32bcfa688afb87ea, 0x03ea452db626a8dd}, FieldElement{0xb92dac426c29102b, 0xb664535e5a80d0ca, 0xc26161954fc3cee2, 0x043a1771b31b84a9}},
+		{FieldElement{0xbfeeef733f7d848c, 0x7a029f9ad4397bb3, 0x61f267cdbcfe9d96, 0x00bcb429de3d6d61}, FieldElement{0x8e3ab16804ad6b07, 0xb712902f535f7f75, 0x9a0e84629b667ab0, 0x066090a5d208d4ef}, FieldElement{0x446a81e0f50bda9f, 0xd68575498cda9395, 0xf0e5d61b25ba0e9f, 0x05c072e39c8c7e00}},
+		{FieldElement{0xdecfaf8e2fa25f46, 0xbd71dd4cf9507c1a, 0x0214b3f8f05c3c03, 0x052ea49d09869a3f}, FieldElement{0x6991a071eaa09309, 0x59b4508e520e1749, 0x26452675d3fc4b59, 0x038181ce9abd1fcd}, FieldElement{0x798633d2db8c79b5, 0xeb3bcf764c0be76c, 0xe740a8196f12cf05, 0x055af5082b014127}},
+		{FieldElement{0x2cca28e7b2f35800, 0xf8a626bc1f938817, 0x07a4212cdf9f0373, 0x06359b1ab062d466}, FieldElement{0x179f8e463c6bcfc7, 0x7bda9632d7eeac29, 0xfebbd197832b420d, 0x077410612eaa242f}, FieldElement{0x5157e4a451b3ca34, 0xf08826fc1afc1a33, 0xc9e97acb391ed3a0, 0x075ca38160394cfa}},
+		{FieldElement{0x85cb83f7a5c26187, 0xf61c7d401b1a3f7f, 0xba5fbdbb13dccd41, 0x02ef76656845f32e}, FieldElement{0x2ae6ba1c8dea6db6, 0xc490f76686cd8932, 0x80ca1b2383300b4d, 0x04760c5efb4a6d53}, FieldElement{0xe47331a326b3b433, 0xd64403e58aa218b2, 0x20d0cb525d11f1e8, 0x07dd1c05863211a5}},
+		{FieldElement{0xbfd646e673c61d60, 0x0ba7388ddf8157b6, 0x3cbd63678a383995, 0x0264634833e46a46}, FieldElement{0x93e1eadb69c035a6, 0xc3dbf935b8a0a42b, 0x1f3c530d28327c37, 0x02e4f07c874b3ed2}, FieldElement{0x9c422148bf778c4c, 0xb743c7499f67163a, 0x8c1c122f8731cfdb, 0x07242decca0bbf26}},
+		{FieldElement{0x5a64b4e2530d16c2, 0x06ca1da1f151c85c, 0xc60af0cc8f126599, 0x0033a626d1a6787b}, FieldElement{0x8da8e765a9691cae, 0xa6b07ea2796411fd, 0x8ef8bdc26b5de7e8, 0x073b75ca37fffd9f}, FieldElement{0x5c7f0d4d48f21ec0, 0xd548175c7d7e80a5, 0xeb267c0d2650fa8f, 0x0445af66cee3cf2d}},
+		{FieldElement{0x1ae7a95d3c0ceb05, 0x5021c7955927b9ed, 0x8ca77ac2cea91a62, 0x02d328b62f5197dc}, FieldElement{0xfe7b0014e7b89c04, 0xb349e13a8312e06a, 0x0e85d5fea0d476c2, 0x04e3c32d825ff9da}, FieldElement{0x2a5e12830689a95d, 0x58bc1bcc43ebd6f4, 0x760c0bd62b3e34b3, 0x03e4e6c2f93b3220}},
+		{FieldElement{0x4b873bae619f2f0f, 0xcfd9e984e552bb6e, 0x979ae79fc4f2e2ec, 0x0057a898d72fb6da}, FieldElement{0x0991631e5e09bff2, 0xcc2887e32ebcf8a3, 0xa7173a6d53aa4fb5, 0x0556f2592bb89a72}, FieldElement{0xb7a409f12ec78c9d, 0xf7378e9e164aac71, 0xc727eb5704bd49a7, 0x0554d74a28a1fdf6}},
+		{FieldElement{0xa5464f316800f4be, 0xcdc9ed8b87fc6c55, 0x3a4ddcc56b325574, 0x0288a81276b49d1a}, FieldElement{0x01ed5f1aa4841c8e, 0x339219b1855f1a4f, 0xce32487e65f6874b, 0x06170f1d281beb44}, FieldElement{0x52df312be6841b13, 0x0b61e3cff1a2349d, 0xb81992bbf6ab0206, 0x007cea45eae8adc9}},
+	},
+	{
+		{FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}},
+		{FieldElement{0x5ab4af1bd46c3070, 0x2b2445e596cd6baf, 0x6160a6365e6b839a, 0x074594cf9c17efc6}, FieldElement{0x299b54c93d8715dc, 0x6b5aac80a8db8d58, 0x54e995b439d609fe, 0x0754c42d3b8d13eb}, FieldElement{0x20e0c16203ad0056, 0x973f59cff15a0b47, 0x1a4d5cd46bc8c987, 0x05909c82627a04b5}},
+		{FieldElement{0x51c5561f57602ac8, 0xcc8f62304ad8dea9, 0x2c72b52f5405e3ac, 0x07771c52db19432e}, FieldElement{0x23e8f73b896913ff, 0x78be7d7342c8d9d6, 0x6d74b953fdcbda22, 0x00291d3f7f329af9}, FieldElement{0x100e5380dc674923, 0x4488296a0a1509d3, 0x28f615cd87f88776, 0x01af99e3b54d31a6}},
+		{FieldElement{0xbaab5a56728b5753, 0x141e8c2631736588, 0xc258d43007c6aef5, 0x0480f5204f956c3b}, FieldElement{0xf5d17d8706acad60, 0xc37a1d15cedc8fc4, 0xc433b5052dfaa5e8, 0x03b6d79c5ebf14da}, FieldElement{0xadcb7b96b3cb628e, 0x764d043d5483eacf, 0xf4f70dac2dd1fcd6, 0x0321dcd55e7a75ca}},
+		{FieldElement{0x10cf1091d57c405f, 0x2e7fa44fd98f3558, 0x1eb073c4b97b99ab, 0x07e677b3b19860db}, FieldElement{0x9c676c1eebf4be35, 0x3479b8e0714eb74b, 0x835e9c74b4274238, 0x02736204c271241a}, FieldElement{0x8c81ef80d9203b95, 0xfa389992fc37d0fe, 0x82fa0c24750d071b, 0x0389b2b3d911865c}},
+		{FieldElement{0xf87443a7d4269fef, 0xc63d11e9b5677d2d, 0x3c26b078d8ee182c, 0x020da22634fbae3b}, FieldElement{0xaa58c982cc8b1096, 0x8d834c170609aafe, 0x30778568bc81f801, 0x075ff139b41da823}, FieldElement{0xfb201f67befeff84, 0xe10e10c94602efa5, 0xe0ca9a24a6fbd443, 0x03e2d7120e8c5f28}},
+		{FieldElement{0x6e65cc9cfeb54426, 0x02e55fb62048c6b1, 0x27784279f1a9807b, 0x047da07a9b7deddf}, FieldElement{0x92e696ffdb56c201, 0x999100402b9b29de, 0x0a36ff56037025fb, 0x028f8add95d5dbb7}, FieldElement{0x89ffba7dea7c93f4, 0x304a02b1cf3b5788, 0xce1c724ccbc3fa33, 0x07f5ae7d2030bc35}},
+		{FieldElement{0xd585d5d95e4e5ac9, 0x6011e29ccfe4f9af, 0xf9832e9fbf36ae85, 0x01b77dd630a8e13b}, FieldElement{0xd93ed395586e4711, 0xfae399b2799748b2, 0x7857c72277de67b8, 0x0619f96045e77a67}, FieldElement{0xbf4c4f91a452d6fe, 0x61ef6dcd0da68022, 0xbc85fb61c3775de7, 0x06d543de695aff0c}},
+		{FieldElement{0x72ab5a3bced45674, 0xa1c6fbd12c21948f, 0x92687fc61097f9f7, 0x01931d9158a0d08c}, FieldElement{0x02c0803c5d3e1aa6, 0xd1805aab17a52b9f, 0xee6ac154464370da, 0x0366bace06b8e726}, FieldElement{0xbb1ac6308be1a264, 0xa1f856467751b889, 0x916b1a971b2ef9d7, 0x0191e1d5cfc913b7}},
+		{FieldElement{0x9bffd8fe298e570c, 0xba954f3bdb72189c, 0xb2a7dca4009db15e, 0x02f953d20841dff6}, FieldElement{0xdc354c14ba5739b5, 0xabb1410f3b9b9d41, 0x9e787ce43b9785ce, 0x02b8d9ba397fd1be}, FieldElement{0x0c6995140911afaa, 0x281397753e95194c, 0x67681cd75e87de7d, 0x049d89c1f5154a4a}},
+		{FieldElement{0xa112cf9f21319fe2, 0x73d577abf277e98e, 0x81248c2bb24f7a9b, 0x04b5bc360c81aa1b}, FieldElement{0x2ac7a7f57929c783, 0x39278a0c761beaa4, 0xbe0b447a28fe397c, 0x00373dc491cb48b2}, FieldElement{0x87517d8775be5ebd, 0x11a143bdb6d16955, 0x0c6771171de6a140, 0x04f069e9ae29a4f8}},
+		{FieldElement{0xda0fbc6f5fee698b, 0xd611114c6e379c26, 0xfc8f56a971344d50, 0x020b5583df57ace7}, FieldElement{0xb3e0400289c6b75c, 0x4ce80702d8f98708, 0x1a1b8dd00d733b74, 0x0495f0e8e9455080}, FieldElement{0xdc5d71353afc0bb3, 0x2dabb5469c76b1e6, 0xe97be92a32ace454, 0x03b51ecf84105548}},
+		{FieldElement{0x69ab73a9ba141033, 0xac6e2c6c3cea854a, 0x0b17d9b6e4a8ba36, 0x07fb751ea8f1670b}, FieldElement{0x23131c370fffe032, 0x1b0c2bbcefff16e5, 0xecb8975a25cf210b, 0x024a8dd3a0886098}, FieldElement{0x4c89e7e9809d10c6, 0xc0f67a7e366a2f21, 0x56e300411f0b5b2b, 0x02615b9f8a37038c}},
+		{FieldElement{0x2f4796a94b71b785, 0x55257278cf55bbf6, 0x7e6583c3c71b20d4, 0x0372f4c2e968ce1d}, FieldElement{0xcd51aeb4f8927cb0, 0xf2dd378ba59e8d53, 0x4fcf29234aad3e0e, 0x0689a0820e914a10}, FieldElement{0x5e4e6bd25c75d901, 0x7d44eff2e74f61fa, 0xfecb0771a38943e9, 0x01c647a127677256}},
+		{FieldElement{0x2a90dce173d35960, 0xe6d83ea38d351e6e, 0xc9a9bf4e550f05b5, 0x01b4f53062e0ee70}, FieldElement{0xefe360833c5f5485, 0x3d32c2eee627b2c4, 0x198588cac312f2cf, 0x050020a98394b4b7}, FieldElement{0x10604a2819db9c2d, 0x308832a25181fdad, 0xfa9b4f255eacc2bb, 0x01b41c52339649bd}},
+		{FieldElement{0xe6947f3b7c963402, 0x0cbe3bf48972a29b, 0x99428abf49ecdb61, 0x03a35cb63681b95d}, FieldElement{0x927c4fa9b48ac6a6, 0x67897dc99f199c5c, 0x278e0c96e3762d3a, 0x033a0b48203b509e}, FieldElement{0x27c261c1c503f50f, 0x00a07719a1de9afa, 0x536e9c996e10e23a, 0x058ac5e3bd6fae55}},
+	},
+	{
+		{FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}},
+		{FieldElement{0xbd4b2a85ee549771, 0x6c032d5e90efbe48, 0x76aa573f93e43c93, 0x05e6c7413f93352f}, FieldElement{0xb901369eb7a88c05, 0xc68e32becfb7a441, 0xf90aa30c2239a046, 0x05e405433d48ce32}, FieldElement{0x31c7fedef8ebc7ea, 0xeedfae7499568ab0, 0xef59336ca65dafaf, 0x00e7ebf5eb157216}},
+		{FieldElement{0x107c0115d06cf28c, 0x8e3ac0647d2d258f, 0x13bda7498cde8aed, 0x073dcc18319bb44d}, FieldElement{0xad53c151f878874a, 0x60421165c67dbb65, 0x2dfa543a01433507, 0x0239da57615195ac}, FieldElement{0x62b4fd9f54e5a37c, 0x4544120191287543, 0x62ee339b154370fe, 0x062540116049293d}},
+		{FieldElement{0xbb45fa9821bf36f7, 0xaf70a9712b142234, 0x0449cc2148d2f2bd, 0x01e53d586ac34bd3}, FieldElement{0x766c9d593f513313, 0x2d58d65b09d4cf20, 0x249cead337618bd7, 0x043443f6e4d79b32}, FieldElement{0x01415b1422969693, 0xcef283b50fdc32a5, 0x197e2cae6100e7c4, 0x016d505b5476a995}},
+		{FieldElement{0x70286cc5a70f7201, 0x4e278b40600bd575, 0x3216110e5f5e0508, 0x05ac7f37a46ff678}, FieldElement{0x1f344868d43a0f3e, 0x018f7c8f5bdc2d84, 0x2b7b28790e053470, 0x00f57d51079958ad}, FieldElement{0x785496342420a236, 0xf3429e377abfe254, 0x0b7480740da2cf04, 0x0411547cb242f8f0}},
+		{FieldElement{0xc30bf3f54eab337e, 0x235996ef49a71bc7, 0xafb3fbad61c66cfd, 0x0140a53adeda8ed9}, FieldElement{0x1010da68ce2b3bf3, 0x3119687164a37cdf, 0x41fb6499a330a543, 0x0046e5343d1e71c6}, FieldElement{0xa115bbc188cc6a8d, 0x95df9f6fc662a2bb, 0xc37a4459f466c4e6, 0x014b96400356b16a}},
+		{FieldElement{0xfcb3de745f2586cc, 0xa3c216573ffbf37f, 0x6b8c4270ef968f07, 0x07d783e4624488d3}, FieldElement{0xb25bc85233cd25fd, 0x1d94d5ef80ba657e, 0x2c8c76a6cff78622, 0x035b9cd86e86a654}, FieldElement{0xff9ef5de179f6f59, 0x34d55dd48c2bab7e, 0x87fbc292aedf626f, 0x0747b0882dc74968}},
+		{FieldElement{0xfe1a5623c0843ea0, 0xf10a9aaf45d5b2d6, 0x639a514a66bbbde3, 0x07b288b8e7847d3d}, FieldElement{0xe45e98f17fd97066, 0xf14f0c6fab52431c, 0x2c1b4cb0d8b09e09, 0x05fc14a07526c9da}, FieldElement{0xe9db82b98d6b5ff7, 0x67d58e33b4b2f5c9, 0xab227361484f00c5, 0x0029f89c869f3dad}},
+		{FieldElement{0x61493e6d0e875241, 0x9da1488597ea7497, 0xc1556c6438468552, 0x00125c9bf41499e5}, FieldElement{0x3adbba90f78a0ba5, 0x8dd960c89e8ab787, 0x104a4c0f1b36fc5b, 0x02dfdd4175169fc1}, FieldElement{0xb4e69541c7657f9f, 0x0a488e44d8d1b1ab, 0x6337f61c96b57014, 0x0501bc29334bef08}},
+		{FieldElement{0x69acf1514c269011, 0xc993272192288e24, 0x319f9667935e5284, 0x04c9bc4837744285}, FieldElement{0x9c66d94b38ea4353, 0xa2bdd70cf6587fa6, 0xfbb752123c442119, 0x01ba4308678b4a5c}, FieldElement{0xe2084328b825adcf, 0xf6dc8fced9cad37a, 0x793cf54a9708dae9, 0x029e784bfbaa9a6d}},
+		{FieldElement{0x232a38b15761f603, 0xdb4b9f44df09e3cf, 0xc4756c708fa4fa85, 0x027c60022f124792}, FieldElement{0x7c6071c75b5cd71d, 0x7152293192d76353, 0x004517f224657e98, 0x06335546e64c69f1}, FieldElement{0xe3d72cbb86458c17, 0xb3f62c27db45cb20, 0x7f71bec9d6b1ca6f, 0x05b6b0727dff1231}},
+		{FieldElement{0x2468bc5ef7fdaf20, 0x47bf4093bdfd10b6, 0x5135708ddf96d326, 0x06b4603e0bb6354f}, FieldElement{0xb9a6576ee8708fdb, 0x3ba3720de8ae25fc, 0x832c713195864b64, 0x05793ab0ed11e039}, FieldElement{0x713fe7216af0c701, 0x755c98dbc31937db, 0xd087147020d782c4, 0x0245ebb9e4246f63}},
+		{FieldElement{0x34db208d9cb256e1, 0xe9fce6188b4ec885, 0x98ed22a0c13e13ed, 0x0408301434f30c5e}, FieldElement{0x73449b6545c3d98d, 0xdf78749e424a029b, 0x4967369a7370ca9d, 0x07fe204717df87e9}, FieldElement{0x40db75267a355f94, 0x829641e337e085ac, 0xf5fdb0d97476d470, 0x012635befba2d0af}},
+		{FieldElement{0xbe03d651e498f671, 0x9b3abd3828452e5a, 0x30cda3bfa38fe249, 0x0656f5b240e700e5}, FieldElement{0x9d366062c24a26aa, 0xcbb37a7040089a18, 0xda63c0494894c037, 0x0042e9a3f717c9be}, FieldElement{0xdab1e217f8f60087, 0xb811582edb40c4df, 0x0b00e8c2b383c094, 0x05487e0c9937f4fd}},
+		{FieldElement{0x49e6bf104c37f542, 0xde4f27b10c8bdd3a, 0xf13459684c0c621d, 0x05b662582953cbc7}, FieldElement{0x12239371122341ae, 0x5fb9367702118071, 0x61ab5b74332bef0d, 0x038d2a387ba3f1a6}, FieldElement{0x16429d853f655267, 0x3651679b40fa9b54, 0x2b3c281b3da6734f, 0x0667aa24e12516d8}},
+		{FieldElement{0x33ba5c31aafd6517, 0x01a1d2d4678be94e, 0x4f1fe6fcf0ba2465, 0x03fa40aa58479d33}, FieldElement{0xcf9ad0c7b8348ec6, 0x0580a9a426f759c5, 0x8cc4b2b138bc100b, 0x0131832594609a85}, FieldElement{0x16161eb519ba9c54, 0xb6b567173931e817, 0xc633272150d92bd1, 0x056e71f7fe53f170}},
+	},
+	{
+		{FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}},
+		{FieldElement{0xab6598c56f524f7a, 0x4abcddc0ff1f6dfd, 0xc666768fb3c5df5d, 0x0212fec0f05da291}, FieldElement{0x7ce963ae84e63777, 0xeda5946ad9f6d434, 0x745a2463f05a8206, 0x05d2edf6b4472198}, FieldElement{0x01e7974e52efbccd, 0x522ad53738bdf80d, 0x96dfc9306acff6f3, 0x05bbecad63f8a8e0}},
+		{FieldElement{0x6561a86402383316, 0xe94105053abd4d56, 0x445d1e476b56354f, 0x064644ebc4686b11}, FieldElement{0x74827b016765e0dc, 0x97e697fbcf8d0a71, 0x0b9df81c4ff5c879, 0x02ddbcaac839f4cf}, FieldElement{0x4461d6084e5b35b3, 0x8d67ccc4d79413f2, 0x2da597db27eaf469, 0x071997c03b7e20b2}},
+		{FieldElement{0x5d081f036dfdc752, 0x536efeb8c125662f, 0xa27add1fe48c7b81, 0x03bc4a2543e79e23}, FieldElement{0x92d1288034dc9283, 0x908b64c83b5d8a2e, 0x854ddeab6b60cfac, 0x01e1e7843965f273}, FieldElement{0x457db757cab5fa43, 0x8ffa7732ad483783, 0xa4c27e635c9100bb, 0x01336bda9349a939}},
+		{FieldElement{0xd948ef8acdb7eb0e, 0x0601d4ee7aef5eb1, 0x3b82d752dbc2bf25, 0x05eb13a57437fbfb}, FieldElement{0x4f32819701743732, 0x3248c92a796286c5, 0x7b00c96e7d076ea1, 0x047a5611ba41fd93}, FieldElement{0x4571223ce9651c18, 0xe08248506b231f80, 0x64dffcb392b21d40, 0x0645f4937ae233e5}},
+		{FieldElement{0xa4b52dcfdd464a34, 0xf1320b09db559a10, 0x20415d5f3a70d250, 0x03b65fb5cc0d35a4}, FieldElement{0x564a427fd765bff0, 0x163d4c333b9279ed, 0xab6fda4a9197db27, 0x0688184ea8f362e5}, FieldElement{0x07df5b8aaaf2395b, 0x37b17b8c74c8fdaf, 0xe8a38f472ccfe4ec, 0x0035abba392a5e75}},
+		{FieldElement{0xda01ff2b70f9ec2d, 0x5f5bdf2d9f1212eb, 0xc78a4c777049b133, 0x03b8f5105ddad833}, FieldElement{0xdbc5ce00e79aa789, 0x14e31f0fdc7cb253, 0xf6bb627983373bff, 0x05a9e0f26166e60e}, FieldElement{0x03952056f469a239, 0xc47caf1c148375e0, 0x57f101834b6a9cd8, 0x01c1fb7bc0dd0ed9}},
+		{FieldElement{0x80bc1a61f5da36bc, 0x9ea7bbef0d561835, 0x7d2f839641c8eb94, 0x06fc12206b690ca2}, FieldElement{0x2adc92bf5c6be455, 0xa5c8056cb9b7e9a4, 0x34f6e1ca33ae8a3c, 0x07c1638966b36951}, FieldElement{0xf7bdf4705d5d1eee, 0x3565a81d48a4e931, 0x341b4bb7b2b7c263, 0x02a6849015d19413}},
+		{FieldElement{0xc6b66130937eb07b, 0x3aad25eae97ac770, 0x0445550795e10606, 0x0607b656263702bb}, FieldElement{0x720d6d951e85f59c, 0x02ce5db6bb3d6396, 0xd7f0369ba36496c5, 0x05ce6590ac81d16b}, FieldElement{0xd02ec340a919e267, 0x76bc22c78a7b845d, 0x58f7ae15997c1c7e, 0x0449c312efc5e084}},
+		{FieldElement{0x9993771fc466d7a8, 0x28a58d693b7a00d5, 0xaf2a8377254a30a7, 0x0333ec3a0403af44}, FieldElement{0x1eccb1cb4322cfb6, 0x7017f7b0673524f8, 0x20d86adff6acf873, 0x04a27b48b25b4e85}, FieldElement{0xa1b1614b4ea46a53, 0xc8b0d9c587ff983d, 0x8dc67b5a7338a2ba, 0x07dbdd3428ee9717}},
+		{FieldElement{0xbd7c181838e13e6a, 0xfd17222cd1178ee5, 0x36806d57727dfec8, 0x069bdefa89db226b}, FieldElement{0x6132532af1a8e37b, 0xa3fd2b37afa89bd1, 0xc1a82298c5af17c7, 0x007090b3a86950d6}, FieldElement{0xc0018c0146dce25b, 0x769e8de254e987ee, 0x1c26e7c21b4b4fe4, 0x04d59ec3ca78938d}},
+		{FieldElement{0x6371793bbc79e2f8, 0xa7fac0b6818c074e, 0xca52f7aa0f091fa9, 0x03333452958c3000}, FieldElement{0xd2032e12118bf9c0, 0x3da2aedbab21f43b, 0x77cd45863ccd6cdb, 0x0476ab42635220a0}, FieldElement{0xa7ca51681d5bb900, 0xe7c9af0b513e78d3, 0x808a243658f01399, 0x03801f6236d033de}},
+		{FieldElement{0x4ba73660407c2e39, 0x0d835221869ee195, 0x0be540624b4005d5, 0x0703519291298471}, FieldElement{0x3611f2d45be04de9, 0xd2593a66914bf6f4, 0xae0616a93829794d, 0x05931fc794f35fcf}, FieldElement{0x7d5a8caec1c26f8b, 0xd5ede0ebd6990451, 0xa48b050e98dabc06, 0x022de9d23f230e10}},
+		{FieldElement{0xbb0ced29b34791b7, 0xc47568a6f3b7fd7e, 0x5144b7984f439a56, 0x04e42a303b7a02b6}, FieldElement{0x11aa06d01b6427bd, 0xcf8bebf7bb4ab73d, 0xc7ecefb57a7df646, 0x04cbb3b413cdc247}, FieldElement{0xd584005f79d92995, 0xd887b882c9489fff, 0xd16ac01386356de2, 0x034e6472c4132be1}},
+		{FieldElement{0xdfc8c69f612f2a84, 0x72d7fcb31ef16e3c, 0xbe9dc18f860e8fe5, 0x0747cdc95b2efa21}, FieldElement{0x572223b0c840c4d8, 0xc82dd1dcd613d2ec, 0x60764c97efe37043, 0x03d74bfc439551ca}, FieldElement{0xffe0e2b62ba783ef, 0xde2b77b9dfa7d187, 0x7014f7442e7f9686, 0x069537e065bf2a8c}},
+		{FieldElement{0x58822e55be466eb4, 0xbfdbd49246128e5a, 0x74a4849a6e5e4faf, 0x02e904230a52d7db}, FieldElement{0x8a7a4d6b31e70195, 0xf7f6e8c67dd84f15, 0x6be2b77ccfb0b035, 0x01e35f737db3a636}, FieldElement{0x8502ce6c0b4ab77a, 0x0575429e44470b63, 0x47591d670385c4bd, 0x050f3e1af1f7169f}},
+	},
+	{
+		{FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}},
+		{FieldElement{0x28561603d528597c, 0xd7248d9a67c747c4, 0x7a8cef5aba436c01, 0x0747ffb947638d68}, FieldElement{0x617c7fb9b3f447ef, 0xe81bc0b7996b1f1a, 0xb5185e7c9bc0baf7, 0x06d4400304f0ac61}, FieldElement{0x04124d3294900986, 0xe71b5d48731aa6be, 0xf13b15a8436ae57e, 0x02c3f3e005cdf877}},
+		{FieldElement{0x7b3334ea1b6e1d9e, 0x0646e472d9a256e0, 0x261001f4f5f7dfa5, 0x0370f3108792d028}, FieldElement{0x2a8618c06ba058d6, 0xd1329b473eead668, 0x6cfd686cb2766186, 0x068f9c09f82d5e58}, FieldElement{0xad0bd938ceb72a2a, 0x31bf560ec464e46c, 0xfee973a68d01b3c7, 0x04e79ffb60e326f6}},
+		{FieldElement{0xf60a4496b90443ae, 0xc295e17d37ab3fa7, 0x108b1089a6251f34, 0x018cf2357c446551}, FieldElement{0x22c5184aa3baf0de, 0x76395c26cc20641b, 0x4cdb1f554efe45ae, 0x073db0bd52aad9ed}, FieldElement{0x6b69ede136ce132a, 0xb488ecd9e66d8509, 0x5c48321007ecb87d, 0x003caf75384c2861}},
+		{FieldElement{0x62fc5bcf7ca21f08, 0xeaf684c053b46574, 0x7d69c775bac691bb, 0x06ae1cdf338c6f18}, FieldElement{0x933b3e7e932e61b4, 0x0cb3e754196f09f4, 0xbf5834b4680c7ee9, 0x0051002396f6d53f}, FieldElement{0x04c794e7c7ae91a0, 0x7900d7529f10def0, 0x5e308fd4570a93b9, 0x0798d70c4e707593}},
+		{FieldElement{0x966fd30470e9a900, 0x6732b4bc5d62c107, 0xd28a8f168f76739c, 0x06734f3f8344b6af}, FieldElement{0x1640712dba5ba04e, 0xfca998f13220deca, 0xa833bbd74a3196c9, 0x06cae4d0b7733300}, FieldElement{0xee08f50496e36b2e, 0xeae375bb5c73edc2, 0x7df870c868c28e44, 0x013f00f72967085c}},
+		{FieldElement{0x9bf4b58b557b0d87, 0x2e4743454a0e9924, 0xded11e10d1d85b35, 0x0519adc5ed914c19}, FieldElement{0x432883c32123fe97, 0xbea9d384b078f5ee, 0xe7991d2cd3d8da2d, 0x06025128e51ec44b}, FieldElement{0x7d3d70a83ceec59d, 0x0697fdab9d9073a4, 0x02868052b3d00aad, 0x076b9c4403c45a58}},
+		{FieldElement{0x2a856a2cdd3bc9dd, 0xe786d430844a163e, 0x408d146d5e0d1e9f, 0x05c97e9cdc7637f4}, FieldElement{0x45d54e195a0de8fa, 0xe90427408e2381cb, 0x3b39432b7290fbc7, 0x04cfd609646c0c85}, FieldElement{0xbbc26055a7aaff83, 0x72a7bb54970de559, 0x7c54c8087a28bee8, 0x066da024d009b71a}},
+		{FieldElement{0x1edb00115ae29dd3, 0xe7017bf105a29c6e, 0x95126567dd74d642, 0x02afdd8f7fe50787}, FieldElement{0x26f45f3494377a7a, 0x435ab2a7002f05a2, 0x0da7980368b8a05d, 0x04861a55f8d49046}, FieldElement{0xd73ff142c632f311, 0xd73448769cd8bd60, 0x23d99076d1fdac85, 0x006d9eb7d0f056bb}},
+		{FieldElement{0x5f856485cc7be901, 0xa40c63d9206e592f, 0x277c731d5e5aeafa, 0x045112e0c3e59086}, FieldElement{0x358cf77b3e138a96, 0x6f803d54400c1ae8, 0x6573e18443e8f655, 0x00eb4742c6fbea04}, FieldElement{0x2032c62f295a7fec, 0x03dc5bff1c8f38d4, 0x2fe422d57981488e, 0x075f9fa45e233ad1}},
+		{FieldElement{0x9ae31de1f0619794, 0x2b91bfa7a6ff58dc, 0xf2d9bcf2ecd0e8db, 0x0015d2aaa727f01f}, FieldElement{0x00360851d8585ca7, 0xcdbd175228a6992d, 0xa0ebca5f7069aa5c, 0x04af20d5d8eb2664}, FieldElement{0xed4e9fd8152902df, 0x19c9a567d404dc0f, 0x9a6c4324c58f3e06, 0x023901c4de4c468d}},
+		{FieldElement{0x8925d024085eff56, 0x442bff52a2037a9d, 0x27246d113cda4898, 0x023d70dadd712b4c}, FieldElement{0xf8675b18420c44b7, 0x7fe8b0a645c2db9e, 0xb8fb382ef521c5d3, 0x0402f04ce3f6aaca}, FieldElement{0x81972eb721c7e749, 0x255e14b872027a03, 0x3f835a23de46c4b8, 0x00c7b1d1d1a9bf20}},
+		{FieldElement{0x12ce911c78f13a68, 0x6d2cf11bd37c916e, 0x5b875e9a3e119a8c, 0x048bbaa3a5fe21b9}, FieldElement{0xa4919e203235e698, 0x5d5f1fbaeb7f0321, 0x4a16af44d4105696, 0x01e975d8ffa5da96}, FieldElement{0x08401fa6d819db1d, 0x71585dfd9d1cd5e8, 0x269f78ad060a8115, 0x05f5f8ed09358ae7}},
+		{FieldElement{0x208c46fb6e7560db, 0x23c263299b4571ae, 0x7fdb318dc4bf609a, 0x04c7bd138f670a67}, FieldElement{0x09e0d52a6a05d095, 0xafcb38fa045d1e0c, 0x4239b0b5618a89e2, 0x04c1c5a8fc4641fa}, FieldElement{0xec6afbc7584b9f22, 0x4c36104a7bde48e2, 0xe1e0cfc962e8f604, 0x00ab0eb83b3b0125}},
+		{FieldElement{0x527068d596a185be, 0x68cb842df35b9f73, 0xa0a631899f8e19f5, 0x06f5998d738b7bf8}, FieldElement{0xde83d3425facb36e, 0x26c9398ce1897dcf, 0x70b01281d4dd4999, 0x07dbefc976a42d2c}, FieldElement{0xf788255e2c207d9c, 0x8e375d00d73becdd, 0x993c930aff52e5cb, 0x002e4a7a5692e014}},
+		{FieldElement{0x05c0f7f8e1e3480b, 0xf841614d6ba0bab9, 0xe9bb2c6489ee5139, 0x05b6d6a16191eed0}, FieldElement{0x6397d3278d65e7c2, 0xe84d1d71b9fc5b16, 0xd8b90900bb8fbdba, 0x00102aac2e3b7767}, FieldElement{0x94701f2a698e3175, 0x342e3f334e13dfd1, 0xc2c3b6220be017f3, 0x058ddd389d07c8db}},
+	},
+	{
+		{FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}},
+		{FieldElement{0xfda6e5db4974241b, 0xe3ecf523910f3422, 0x3ed69a950beff109, 0x062032f6f44cba7a}, FieldElement{0xd885ca7f5c2a971d, 0xf6cc63dd4fbca485, 0xa6d889b232ab625a, 0x0341f2db4e51ca98}, FieldElement{0xd8dddd32add5dde1, 0x293d73dbd508eb88, 0x07ed90121e9a4396, 0x00eb44207723116c}},
+		{FieldElement{0x354f891e8455b53a, 0x52922e3d2d5b49c1, 0x0483408b6fae6292, 0x031964ed8be68b90}, FieldElement{0xcf2c3de982becf78, 0x228a8bd2bf5e3afa, 0x7c9574915ba00d55, 0x02b36b7f7be4bd42}, FieldElement{0xafeb50207287de33, 0x641e221185b14a82, 0x8442e4a154cfe322, 0x06d2971857fff2fe}},
+		{FieldElement{0x5b8c673071b8a036, 0x050ca9cd1e68a1ae, 0xf4f05a7c4d7b775b, 0x02caa8e373a5c3b9}, FieldElement{0x8ded755e99f25145, 0xb5c0da72caa823e3, 0xde521982cc47a34e, 0x05bb567d76843ef4}, FieldElement{0x67e77859628be228, 0x6fb7725b791913e6, 0xeb91544f9183e876, 0x0557ce32b6b535be}},
+		{FieldElement{0xc9b9bcba53057ea0, 0xac45827279436d39, 0x8ca95d24be6b1f1c, 0x06743ddfaec7ec05}, FieldElement{0x963963152e2a4c0a, 0x562bbdf87cbd9229, 0xaf59e1d20ac73578, 0x05a6b806015a0791}, FieldElement{0x2d4684752be030ce, 0xf4a58c1d07fc8675, 0x4323778a8285d56f, 0x01946fc243e346c2}},
+		{FieldElement{0x32d48d002031ff5b, 0xdc0de66d59e9882f, 0x30ecc799c280d203, 0x01b5c1f91310829d}, FieldElement{0x3de5447fef974c47, 0xaa266217f4ee3955, 0xd7ef8dd5f67bc66a, 0x0446cb2d43cc3e1f}, FieldElement{0x700dc2f74ccf364e, 0xc9b04ec5cf1f569f, 0xa7d7202f645e67fd, 0x02207ed584fb0b6a}},
+		{FieldElement{0x7a09ed34f3033fca, 0xc58ff9f62be0a505, 0xc659a7eb43c2768b, 0x01c4306e3109918b}, FieldElement{0x89aff091868ee97b, 0xecb2cc3f7d7fbd37, 0xe187451eb615f5c4, 0x071b3cd6ad13e3c2}, FieldElement{0x2cab7acac7c6932a, 0xf333ec45c43e7ec2, 0xeecbea15a3f484ae, 0x02b48875dd7c06fc}},
+		{FieldElement{0xd7b901e0d4ecda1b, 0x52c14e450ef28e3e, 0xe3691bd8c4831658, 0x02c2fa8efa8ba34c}, FieldElement{0x7d0f62ddaa436cdb, 0x13ca907cc7c54c1b, 0x0207ba4a27fce42c, 0x07adee16dfcca166}, FieldElement{0xa9d1bf015574a036, 0x125defb442ecf518, 0x4dc2c6bd9aa29cb5, 0x0027351e61939b43}},
+		{FieldElement{0x381976bd6c447f68, 0x21e1b410055b7435, 0xec557c2fc141fa04, 0x0754e34552f522de}, FieldElement{0xd179e13d50996cf9, 0x188924bcb0fdcd10, 0x13504ea700a6d790, 0x03f4d38fd0999783}, FieldElement{0xeecff0e30105d7a7, 0x4044b7d0fe1dd5ca, 0xa0babc247e6d4e7e, 0x050f963c609884d4}},
+		{FieldElement{0x90aefce5d990ce9d, 0x26af66a4c3d0f892, 0x7fe5b7ec375f124f, 0x0172d4b8845f541c}, FieldElement{0x1d6112821b316286, 0x5e9c932ed78d0479, 0x8629f0945fbbb090, 0x056eb31ed1b8e6bd}, FieldElement{0xd413b13a2bb5c51f, 0x3a48b0d0ef23edde, 0x38637a73f3f6555e, 0x07ff516c095cce9d}},
+		{FieldElement{0x250ba967de67f89e, 0xff44c66d27b6a9f7, 0xd0054f7c34d76f12, 0x05bf4ffa567bad36}, FieldElement{0x74a70595670c1b4d, 0xb4d1b596ac7fffab, 0x19988506cb39e422, 0x05c2e1659598bc7b}, FieldElement{0x6d2196da2a20767c, 0xe7e276cb3c489964, 0x230a65d564b81ec0, 0x04c49b881fe0c734}},
+		{FieldElement{0x9efa9aa0e2e68343, 0x727b18c62a7ae896, 0xfa1e8e04193c4262, 0x04d1c5676432b9d4}, FieldElement{0x3f7a00df2b162565, 0xc7bf7c4747a81f4b, 0x64637f9fc02b232c, 0x05884616a09b7a2f}, FieldElement{0xdb7b70fb6130f7c7, 0x71b96cc0b4131af9, 0x363e0aea3c14fb3d, 0x004ad83948fe5677}},
+		{FieldElement{0x23238e0f3948b33d, 0x2bcb42bb7842461d, 0x3617dc67b84724ea, 0x00d791e0b3d13940}, FieldElement{0xf988445fb3b0eca4, 0xc929e0709fe507f9, 0x34033ef4704d0f33, 0x009fea54b06cace1}, FieldElement{0xbc4458023ec1229c, 0x18e1a0cbc375ba7a, 0x1aaf2e867302b4af, 0x06ac0fed211d1564}},
+		{FieldElement{0xcf0e883eaac9a222, 0xf7b82341c76e2d43, 0xbf88a2456bc14870, 0x047578a201bee5ce}, FieldElement{0xdbd4a547e5cf9e12, 0x247fcbd549621c6e, 0x03f15896dec9cb36, 0x067fb803261747ee}, FieldElement{0x79f8cd25f373d687, 0x486d13bde7710c43, 0xf3a36e487f8ebe61, 0x036fcbbe45efffd1}},
+		{FieldElement{0x2f738a93b1a40c9b, 0x9d0bd76712c0d323, 0x42459ec9d7a9c68e, 0x0085ac8f25267291}, FieldElement{0xfcd0175ebaa92843, 0x95dad7f9454f9043, 0xe49f6c5cad694c8d, 0x014f133dcb0e8db4}, FieldElement{0xb95b394483257678, 0x9709ffa85e6d0818, 0x7460302d1228b10e, 0x0370be8c7d180ea0}},
+		{FieldElement{0x32757445ff104eb2, 0x10776a8289f3f34d, 0xfd833e7277ef7530, 0x04b0cbdc06051c70}, FieldElement{0xe9e4db350a368db6, 0x81821b74bd57468d, 0xd4f4ac00c94ea7d8, 0x0341456c65340825}, FieldElement{0xf1455f874f28df56, 0xb1278bcb8ca88ca7, 0xbd5a645baf580832, 0x07c0266ff58faf8c}},
+	},
+	{
+		{FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}},
+		{FieldElement{0xd1c7d34b0f180960, 0xc2a91843c2042857, 0xd0fd5a075b23bfa2, 0x00805a0573bc9306}, FieldElement{0x94d0ec5519abfd0e, 0x725233e23b880d09, 0x4eba6ea2d34141d7, 0x06663cfb74d0be14}, FieldElement{0x66565b6bf123a7de, 0x9e1ec85e62893568, 0x230ae072d9ad7443, 0x03767f1fd3fa0666}},
+		{FieldElement{0xa265dcba37bd04a4, 0x35c1f29d1d1c2768, 0x1fa40eadc22f5b0f, 0x052d013ec016e928}, FieldElement{0xaa5a92699c5418ee, 0x68611fabe62369da, 0xffdeab26d7324d92, 0x075c5bf52d2c8cce}, FieldElement{0xea1c8f5a9d19fcaa, 0x15416c18523e24ad, 0x93244a282e1f2d2e, 0x0269b65d91040914}},
+		{FieldElement{0x23b8684089ad310d, 0x7c54e31b462a99cd, 0x6e95485bc515d479, 0x00db4e133de4930c}, FieldElement{0x39c56f41a97e213a, 0x458d482811b53d3a, 0xbfdff93819effbc2, 0x0107f9457d0293ee}, FieldElement{0xa715d18736f4befa, 0x6fd82ed3ecb7b699, 0xcef1a57ccdaedcf0, 0x029b3661f2afd7f0}},
+		{FieldElement{0x443e23d4edb94d05, 0x106ad33370fb7168, 0x6161a26c4b45e113, 0x03124bf4a8060a70}, FieldElement{0x26dd78ca6db97313, 0x184cf7a85238f79e, 0x7b810f3670b35f36, 0x02e151e27a6f2bda}, FieldElement{0xa056903cbb6a7227, 0x694bd27805fd2276, 0xdba9f697f0311ed3, 0x03e3f08d6afbe094}},
+		{FieldElement{0x08d0e5e4adb8bce5, 0xc6f189e5445c3da3, 0xe65cf515d2a21003, 0x05339f2ab29abd82}, FieldElement{0x12110ad355e3347f, 0xa5f3914ccf24859b, 0x14a1b7415f287317, 0x072c24b4fcd0a924}, FieldElement{0x3e0be9dcee9a39ec, 0x82c674fbafb00f55, 0xe01b808cc1b9db22, 0x012acb0be065cfbd}},
+		{FieldElement{0xc3f5dca2c4000869, 0xe5a5a19d53d1fb1a, 0x2ae8ba3027395eee, 0x0264eef45dbd34d2}, FieldElement{0x58db048798c5d609, 0x7b9f770ea49f6c99, 0x2234cc57cb2f47aa, 0x04a300e2c1b8a897}, FieldElement{0x7d0e91da3adce82f, 0xaf630cf96421357b, 0xfc6c4a63af8e4670, 0x02b1f6cd549dda24}},
+		{FieldElement{0x813816dc6e96e1b8, 0x9dc6521ba5731c27, 0x182177a7c6b5efdc, 0x042fa4ad70d641b4}, FieldElement{0x180b4c28bdfca2eb, 0x3aeba738f4e1e37d, 0x8d3e62911140aa78, 0x03029017b302cd7b}, FieldElement{0x967956c9d8ecc760, 0x0628bd1e6a659c06, 0x0549c1eb01591023, 0x04b930d388bc24b2}},
+		{FieldElement{0x0c519d862b6dc219, 0xbfbda37def1015df, 0x7f1b1c6ddd2b501c, 0x06f2fb1b4e9732b9}, FieldElement{0xcbb8af99d701b830, 0x9cdb81b8ba5e87c1, 0xd4fcb0bd88277518, 0x04bcf54f67a5dbca}, FieldElement{0xbf8bebe0d9dec2a6, 0x0d311febd4ea9db7, 0x17ffdd49edcef8d6, 0x00e2065f5cae90a3}},
+		{FieldElement{0xbdeda94fca54e112, 0x71920138cbceb5b4, 0x376b58f790a2c5ab, 0x04395689bb46c517}, FieldElement{0x5006d21412bff14e, 0x3058fa1a968cc661, 0xb481c05988d087dd, 0x00ba6d27d9d0d707}, FieldElement{0x88dfdbaba998ec03, 0x5daacaaeb750e60b, 0x032c85d08704dac7, 0x0184973fbd0f6d0b}},
+		{FieldElement{0x7161de01a2c3932d, 0x8adf9a3baa13d736, 0xb6b27836dc3c47d7, 0x0749572d4d8488e4}, FieldElement{0xcb290f3c5eb7592d, 0x04f012367a418e6c, 0x5a6608c189b94f64, 0x03882d94e6dfd7c6}, FieldElement{0x23073b375aadd812, 0xe241071d9f282b38, 0x87fa2348e21c567b, 0x06fd322b0434a991}},
+		{FieldElement{0x5de2b6b706ab5efb, 0xa935a47430bf8bbc, 0x8a955decc0ece1f7, 0x068fe21bf83384ac}, FieldElement{0x7f3174c5e97c96af, 0xa68aebd17a116b6e, 0x38888fcfcfe5fbd2, 0x072b36ffc4c5c716}, FieldElement{0x087c2687504911e5, 0xb087d8634c37aadb, 0xb3799e5f1905c75a, 0x00e12a75b2fb08e9}},
+		{FieldElement{0x3e211df9128e90a5, 0x02b4d71641074637, 0x01621f9e03725584, 0x00ef389d066b40fe}, FieldElement{0xa172d08a459ed1b0, 0xae83c8a609fcfe2b, 0x6363afc5f2af00df, 0x0254955ca1760fae}, FieldElement{0x248dab9e5ce6c4ca, 0x1e224a05407dface, 0xfe0a0ab07265726a, 0x07390659ddcbe81b}},
+		{FieldElement{0xca929b49a3577d1f, 0x98b8f51ad66ceecd, 0xf36d77959c6ddd20, 0x06a3f4e441be5574}, FieldElement{0x5dc890803cc6426c, 0x9b828a3ea6893edb, 0x4e313ae3b84a2832, 0x002f43b86cad82ac}, FieldElement{0xaf9aedb5134ddbb8, 0xd0b89d7a86da0eb7, 0x90cd6075f4c3d063, 0x07055b3f370aed59}},
+		{FieldElement{0x88965f32f49d82af, 0xe9a05160d8330f57, 0x7b00e19ab71b2c92, 0x0347b96b558c70fc}, FieldElement{0xb88fe36a161042d8, 0xc14a6043adab7644, 0xb0581f1467afb9cb, 0x07da6a6cf8ece409}, FieldElement{0x5485e4c3c147ca33, 0x2495e975519c95fc, 0xe3283525f4c18118, 0x04c50e0ee47f9d42}},
+		{FieldElement{0x533470205d67c17a, 0x18784f9613898205, 0x06b552a04841d848, 0x009149ba84277365}, FieldElement{0xf5d57a634e7874ff, 0x199b4ef82decbcff, 0x1a77694766eae286, 0x03410a6cfeaafe56}, FieldElement{0x4af92606d5eef106, 0x3012fe4d3ff9638c, 0xad31b741be437ae0, 0x07586280b9d03030}},
+	},
+	{
+		{FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}},
+		{FieldElement{0x3675d9d3cacdf3a0, 0x48cd3ca3e3d4bcf2, 0xff1f9893c1f836ee, 0x04275cee9aaa835b}, FieldElement{0x915fccd391df7228, 0xbdec57f5a47daed2, 0xbefef7f2f50168b5, 0x026b6cba8e238bc0}, FieldElement{0x3c2e3302b7cf4c70, 0xcdfb09ab6f350009, 0x9ffc4a67d1f6d139, 0x01451172ad6b1c3d}},
+		{FieldElement{0x69b2bcdd54a0d89e, 0xe4ed3c253201ba5d, 0x9aaa781dd98c58e1, 0x02d2e573f3e0e1c8}, FieldElement{0x8dfc41ca0c296073, 0x9b1bb8924eacece5, 0x0b23863a6164518e, 0x01b68650cee19d22}, FieldElement{0x83611b2e138d1e84, 0xbd4f8d997c3442f1, 0xf60bbd28299930e5, 0x03d22087555d914a}},
+		{FieldElement{0xe51c60bd1d5fd0cb, 0x5e00c048ddb7f806, 0x71e0a1f0ae3d40d4, 0x02bd8e9ef05dc9f1}, FieldElement{0x9da90d69266ced49, 0x917e273cfa4bbd56, 0xe71b95b945302945, 0x049260344708c3e2}, FieldElement{0x7dde111441cc367e, 0xb0f0aea2ee0995bf, 0xd4231454ff9bbf69, 0x02901f9c0e303fa9}},
+		{FieldElement{0xab6ca0685a6ee1a1, 0x00c46b064444120a, 0xdc1d8128e36d6069, 0x0330376beb0f882a}, FieldElement{0xf7d67dd2ab63f77f, 0xc3bf10c18bab0ba8, 0x8ad8a5fc25edd523, 0x0646a6a860e3a037}, FieldElement{0xb1b0dfa721a00c2a, 0xa0e1f8da2324661f, 0xe18f31c9c0fdc1fb, 0x076391ef3074d5c5}},
+		{FieldElement{0xe4be02cdcd7a3212, 0x456a4eb62e825897, 0xcd68b086c878e063, 0x02028cfb995bff8d}, FieldElement{0x278631dd391de97f, 0x4290daeae2da6136, 0xaeb0d47c89ac2225, 0x063df22b2bedb3cb}, FieldElement{0xdcebe8036bee8610, 0xc69f6e1147206168, 0xfac80f870cb1983c, 0x020f06180f044ca3}},
+		{FieldElement{0x9e88c95ca67e26ab, 0xcf862a967c027919, 0xee91331a57dfa73f, 0x021aff0c29eadd39}, FieldElement{0x1abcec739bb1b91b, 0x25acea25824e316e, 0x32f82706d3c117c6, 0x046d6c161ebc41a5}, FieldElement{0xda9ee38b280b9941, 0x3cea4a6965c25fbf, 0x96cb258f5ed112c8, 0x03637a887aa8d1f7}},
+		{FieldElement{0x00b6f859c9ec4b5d, 0xbc19b87065d6810f, 0xbeb8959a2b662dd7, 0x05b135e317723ea2}, FieldElement{0x9cd3285d055bc286, 0x3148f500848342e6, 0x32d405de8a1ceb69, 0x0774f016a5d7c419}, FieldElement{0xa1fa989fc37c3a1d, 0x4351d652c7d8a21e, 0xae9a13c06a40b6fe, 0x055a7b2cc26e257a}},
+		{FieldElement{0x8d8c239d6c26a69f, 0xd16ded756aef7379, 0x243f2d78a9b346e8, 0x04a32b17252ba333}, FieldElement{0x0129970a68b972bb, 0x2a926a17e2fd2d70, 0x5d991458dfcafa29, 0x040628f59183ca5f}, FieldElement{0xbce8c90f24bdf497, 0xdcae5831ac2d59b5, 0x6a1853ff2aa70bb1, 0x0647c1ab85ba2f8e}},
+		{FieldElement{0x4627bd21dbca23bd, 0x4290459b9e0d6620, 0xd3f6838470226a1a, 0x07d92744943d23ad}, FieldElement{0xc89492fc9a4f086a, 0xef2bd43e5d163917, 0x15a1d4099f5aaff6, 0x078e6f6e21e5031e}, FieldElement{0x246b77d1c2f062b9, 0x9bac2f99b296d46b, 0x9c75bd41147b74c2, 0x03ff39f26fa70daf}},
+		{FieldElement{0x8940422ef2e0f72b, 0xb966f3a721dd2897, 0x3da31de6d047e2cb, 0x033556ad882e9fef}, FieldElement{0xbc4e416bf4ce9de8, 0xe5ef4d4cbe045ded, 0x95fbff4b0a037694, 0x0134066892c2a504}, FieldElement{0x9855a1eabab9db49, 0xdbef99ab88ab0286, 0xae04b3bd5cb66fc4, 0x01ae2a4222e35f1f}},
+		{FieldElement{0xd7158df856467d2b, 0xcdac79245da62ff9, 0x5b460cbf1a583221, 0x0207438813e6abb3}, FieldElement{0x4f36662f8446fd44, 0xf8973a94306d2f04, 0x32a26ce9fe2780d7, 0x035fc05138bd9b2d}, FieldElement{0xa61b560702931a87, 0x51e5a60d8ba03434, 0x18c50d4aa877f17a, 0x033a3f882dbb747f}},
+		{FieldElement{0x8c6760dc0ca5256c, 0xff8c038bb41406c0, 0x83f948200928a576, 0x00684f4a2c97fdcb}, FieldElement{0x7d1b94350cce3698, 0x4f652bbcf96f554d, 0x3327e752e064b232, 0x0429b8b7a52c9eb6}, FieldElement{0xc976d00c8e325710, 0xee6a965d50fc5936, 0x98ecad99a9c898b4, 0x019792264e0c596c}},
+		{FieldElement{0x82040b93c9db1948, 0x1e075379173ba659, 0x51a8190934ef95e3, 0x01b0cefb84867688}, FieldElement{0xbb565516711fd1e8, 0x63f2b0e4fbe0da89, 0x78093e11223fd65d, 0x0497237e70460319}, FieldElement{0x6cb6f0509c571b37, 0x6d9a6f4f019a31c1, 0x0c7af169274fc069, 0x0104f09f5096d1d0}},
+		{FieldElement{0x4aaa8a090eb84cd7, 0x3318f8a64f8575d0, 0xdf761f5859df607c, 0x064c4984fe05ed53}, FieldElement{0xa1ba6fe212bb3276, 0xa6e407ba45336e25, 0xb4eabed5bbd78642, 0x0584337d09ee0024}, FieldElement{0x6edd15de21e302bc, 0xa614a8d2deffa97b, 0xe41ff10397c6deca, 0x02a88d8776afce3e}},
+		{FieldElement{0xd13270a2555e1072, 0x6266cb94c689ab0e, 0x4efdd778371ca6f9, 0x05e69b0c98f82daa}, FieldElement{0x2db22d2a5bbc2cea, 0x77c35a02c313a376, 0xe871cbf96d822e0e, 0x00226bbbd112f188}, FieldElement{0x915a9efaaaeb5d8e, 0xe6a035fd8be75730, 0x7da642d2176abaef, 0x00c850e5b8b176f1}},
+	},
+	{
+		{FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}},
+		{FieldElement{0x30504d8fda722652, 0xbe6954f10979e023, 0xc417134ec2a18a8d, 0x065644c3254bef87}, FieldElement{0x519a36ca0e031785, 0x91ef2b13089ce9b0, 0x43eb4c588d34be97, 0x077da97ca045776e}, FieldElement{0x64bd6e76a1aaf61c, 0x4f771a08a0a1443f, 0xd81bff8ea17ba5f7, 0x0413e615d614eb81}},
+		{FieldElement{0x95b15809e064c6ca, 0x4b478c34cfafadd0, 0x1d1d743d02d0724e, 0x014bfb55fac77653}, FieldElement{0xb126314a6921aa00, 0xe8cbac44a6486c8a, 0x91d9e383fbb4e794, 0x017ab7fa564b2b38}, FieldElement{0x060628879581646c, 0xd5e658bcf6812d71, 0x962be7bbe6c139e3, 0x053ec1cd9156d745}},
+		{FieldElement{0xe94ace75c170fa4b, 0x2a4665d3a2977ee3, 0xb13339e09912af51, 0x00695e3e30792bf9}, FieldElement{0x8d0ce1c804cd8224, 0xc5a7b6868520f5d4, 0x711f388f91e6bf72, 0x0237120be2008d3b}, FieldElement{0x0d3ce8de0d04f288, 0x59bbec6c9f3a470c, 0x07f527ba32d8695d, 0x014db575415904b6}},
+		{FieldElement{0xee6ae0ca650d2082, 0xaabde3fae0805725, 0x6fb05608ce513bd2, 0x02d0638c9db4a471}, FieldElement{0x06abca088ec4fd13, 0x40d452bb61c20926, 0x82cda199f1de368d, 0x049bedf76d2927ec}, FieldElement{0x0770be426d928fe1, 0xd196131be62d851b, 0x2ea2a2f6e1de428c, 0x047686e184d84d35}},
+		{FieldElement{0x717a10777f2e0f43, 0x01e0463d4e6b1ebd, 0x95077189850280c0, 0x05cb48fae7800f59}, FieldElement{0x60f83c1a721d3c9d, 0xf273fc4af7e9477c, 0x9ced1683f83fec54, 0x00db217433c87bc0}, FieldElement{0x833bae50f781b91a, 0x7923b1163bf43533, 0xbdd0d57fef525f82, 0x00f62e76b2f9d3db}},
+		{FieldElement{0x36b6f7f7353f7ccb, 0x66faf2fec81bb697, 0x5d6fdd637e838708, 0x0014781608969400}, FieldElement{0xb44da1471aa1f6e0, 0x5adc63ae9d37a14d, 0xed04e9876b24e3ba, 0x044573b778ac3218}, FieldElement{0x53e77cc79a897e9e, 0xe4b0054d30b66db8, 0x834ad5042366af73, 0x0584412843c5b004}},
+		{FieldElement{0x5cff712528135531, 0x1837d9cf0c98e513, 0x37618bfd9f8f62d2, 0x00141708f5fde1f6}, FieldElement{0x6a44571ab9f587c1, 0x718a0df320ec8d13, 0xc379022eea51cf68, 0x05f4374cfa26aa27}, FieldElement{0x319aed88bd6ae135, 0xcff8cfb00b551f60, 0xb074013aa28b5c2d, 0x0423472d081c6d77}},
+		{FieldElement{0x05790666e9dd02b5, 0x697df953dcc724e6, 0x4e1f3d9f133aafda, 0x07feb21ca83c126b}, FieldElement{0x949abad0389448d9, 0x57fce62fb5c2d430, 0xcec2be2cc25e5b17, 0x06f591fb069862df}, FieldElement{0xa98270c09eefd1fb, 0x38780e51e50f1674, 0xfa2a2df44040ff27, 0x01f50ff88d43b670}},
+		{FieldElement{0xf4e013c7e33984d9, 0x0f4caac8c72913a2, 0x2097120df3c0bddf, 0x06f3e34dafd91d38}, FieldElement{0xc7ec37a142ca0b35, 0xc7fed05d5f1bd445, 0x0bcf25ae374c7e18, 0x000721afe5914e9b}, FieldElement{0xe1e3657bd5c56d0e, 0x5c40b44c6dac4bc1, 0x1636ba4ed944f6d7, 0x05b877348ea7fb94}},
+		{FieldElement{0xfb0a9ddb0302f4c1, 0xd0670a551ac0e53b, 0xb90dbb5a3cb28f8e, 0x00255b3eb6fe1d76}, FieldElement{0x65b629824d8663c3, 0x9e7b44bb93321802, 0xc0bd8d0cfcae0157, 0x00b9849d8f96e731}, FieldElement{0xe451995898746d82, 0x0ee479c015a32b84, 0xe93f3e2863109549, 0x04192a2c63008190}},
+		{FieldElement{0x860eb77e65c967d3, 0x22cbd18d770c6f32, 0x7e45b7dea881792f, 0x0502f0dbe6ed591f}, FieldElement{0x822393b6803fb006, 0x4c7e7219b36d9f3f, 0xaf90d74677290bfd, 0x06a68ffb05e86d09}, FieldElement{0x6392afc2e736c3de, 0xcf7d9deecfd4ab16, 0xbc6e11405a7060d6, 0x03a9899e80facd6c}},
+		{FieldElement{0x96e842a75805b44c, 0xae80e444f90ccffb, 0x50798afe0491574f, 0x050aeef4a5fb0845}, FieldElement{0xc1b067a5b4b40961, 0x1eb1e8a8955d56ae, 0xbcf65ac2220a8234, 0x030b02debe2a05a2}, FieldElement{0x7d96d848853aa039, 0xec60b983bea016ab, 0x5402c18976198418, 0x0144299ab7ca6552}},
+		{FieldElement{0x5bfdbe3693d4cfda, 0x99ad193361f2a464, 0xd0a325cfe7108519, 0x013879a1f7d14655}, FieldElement{0x4de77e0918659ae0, 0x82ead9ff5ef7bd28, 0xf35d5f51aebc6c8e, 0x0319c09e182270fc}, FieldElement{0x95033d5822125b70, 0xb54305f1257414a4, 0x3a2bc147e5603656, 0x03f5c4c2edfaea27}},
+		{FieldElement{0xe5d221cab1bfaf86, 0x5b1bc7fba82b5e47, 0xafe7ba386506786f, 0x05a8017386e1a13b}, FieldElement{0x467fd2171ddc3e70, 0xb4cfd6ce12221c63, 0x7614ee97748d2d94, 0x043dd5128f4f20e6}, FieldElement{0x2cc6b1201cd1a88d, 0xc971a37bb38d8583, 0x50f5d6e5b048d06c, 0x037562bb5a1381c2}},
+		{FieldElement{0x88673af248979ede, 0x93656ca4c6860478, 0xcbfb7c47e75501d8, 0x07a1f5b7ee7e77b1}, FieldElement{0x89c6725df739a526, 0x24e8fd1b70b1ebf5, 0xd9583d466c7ffd7e, 0x043b80c305299a7b}, FieldElement{0x11a02325e3a27bc2, 0x688c19c405c350dc, 0xc5e04cee3b9a93e7, 0x05eb8e6239ce4b1d}},
+	},
+	{
+		{FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}},
+		{FieldElement{0xe935d16a64bb3442, 0xdf28b864b2bd0384, 0xf8470f833326120f, 0x05bb7b4a13f86a6d}, FieldElement{0xc4eb1d25e5b40b64, 0x96043d5228d17eb4, 0x4c5d376afba6b108, 0x0657e2dcdda739ed}, FieldElement{0x25fd7ebfa889f4c5, 0xc494567fa4ea1baa, 0x420dfa2b9e382480, 0x0599429229773c75}},
+		{FieldElement{0xda93a29806d9ec85, 0xe3f27022f3ef846a, 0xaf701be74c8429de, 0x0600f8212465cb27}, FieldElement{0x729ebc98bd954393, 0xdd054b602997fb39, 0x62531c21df30687f, 0x06b69afdd06c1726}, FieldElement{0x42697ab103e5b5f8, 0x7dafc89fc6478af8, 0xd70546c29c50f0e7, 0x0168519d123a33a1}},
+		{FieldElement{0x8c4ceb122d6b5468, 0x27a8fa8547d6507c, 0xf6fcca3a923771d2, 0x015af4cac01e317c}, FieldElement{0x46cbc8a503035663, 0xd92985deae6c8e3d, 0x0077e332f3a0454e, 0x06e8051b755133fb}, FieldElement{0x77e1ea3e43a9d1f9, 0x7bc969f3179656eb, 0xb820c417b5eac1e3, 0x023347085c903f30}},
+		{FieldElement{0x7850ca4d9a57b6ca, 0xd814fa4bf1a61412, 0x44ef2ff8f2c62782, 0x07e2213fa0cb426f}, FieldElement{0xf92822cbecb1cfff, 0x20dadcb165b235b9, 0xb4066e2b6a7cb71f, 0x023515268e5982b1}, FieldElement{0x962c204a11f7f597, 0xe109ff044a08e96e, 0xb1e31c705ebb8a5d, 0x04c69dfd44e8bce3}},
+		{FieldElement{0xe76913736ce745a0, 0x313dbce3df7d283e, 0x587553c2fb6009f6, 0x03769c1de04ce34e}, FieldElement{0xbef49910f08a9ff2, 0x3373fa13320f662a, 0x3020fa331d81cf0d, 0x00e8c85d1493819f}, FieldElement{0xd4f8d243f347a527, 0xb0ac0d77ca3b4b45, 0x31dc14c8b25ec36f, 0x00b476b6b898b312}},
+		{FieldElement{0x850d793bbc52ee4e, 0x9c1dad58a72a0e85, 0x19ab687bcaab4b56, 0x002f27f9d7ac9cbd}, FieldElement{0x3beddffbc7af4b55, 0xda43d62ef692bf66, 0xc60feafbbb61ee2c, 0x053592958ae15a23}, FieldElement{0x4fc8610e81f15c76, 0x511c9abdaa8d6e2e, 0x15f307471c5a2ef3, 0x0358153f060076e9}},
+		{FieldElement{0xf37439cac09aa215, 0xfe65c02f491c192e, 0xf49f76f6091b2c54, 0x065ff409d23b439b}, FieldElement{0x0bc5096405434cae, 0x5a986bb91a171c9d, 0xe88a9dcb2b5dbad0, 0x06c3719c9057e16b}, FieldElement{0xf0f1bdc69025c4ba, 0xdfeb3523651ed9d6, 0xd371299927669070, 0x04caf19063b52d0e}},
+		{FieldElement{0xa3ba0c268c6cafe7, 0x056f0993b89b6a91, 0xeb7ff98852911ac9, 0x0006a58fc963a1dc}, FieldElement{0xf0c18220d04e1ab2, 0x1872f9c6181c1a08, 0xa3a21ec056be431f, 0x008a091cf6e28bd3}, FieldElement{0x4f851626afc8c722, 0x979fc4f2486f8d6b, 0xb7afafe6c50f496d, 0x02408785b2c0d57d}},
+		{FieldElement{0x7a12649ce11b480e, 0x18672967e88c4715, 0x0ce8f555dd48a530, 0x03b0264db5e791f2}, FieldElement{0x03579de823b1af12, 0x57c15b0cf98bc2b6, 0x7ced4a2a59df2356, 0x03ceb2600a1dc4b9}, FieldElement{0x37906732799725c8, 0x38d47608defd519d, 0xd636ce7c9541533d, 0x02760fcb75fb3375}},
+		{FieldElement{0x22faa61638b9d5c3, 0x04392430a4427a8f, 0xb6c6a38383f893d5, 0x021111ebd89a1ff5}, FieldElement{0xf5c0ed13c34fcca9, 0xd4bc6476f4facb8e, 0xcf4cbbce965a030e, 0x0684f44c771ea19f}, FieldElement{0x7f46692193c12ae7, 0x4813d9b1a197e855, 0x3e1e6d7498cfe254, 0x020a6baee1d22c36}},
+		{FieldElement{0x344912a19256814a, 0x64a3e7c24111cdc2, 0x79d8c2b251f31e3b, 0x02de109ba795bc4a}, FieldElement{0x724068bb999d8132, 0x4cc44c5d6f0ac97c, 0x11902b31510977fd, 0x04e55e80e0c419bc}, FieldElement{0x8af28a1034d78c71, 0x22e5563590ea8edf, 0x351388a21b2ae193, 0x03f499c55da9b45f}},
+		{FieldElement{0xa1166f991c7235c1, 0xb8a7f3b6a736a3d8, 0xe35d9692e193c105, 0x04e3f9815526f872}, FieldElement{0xa9f654da93d656dd, 0xf216ad2b06a4b636, 0x7b588caae093520f, 0x05befe7a1694b5a6}, FieldElement{0x1ae01e1321f82d55, 0xe4dccf0b1cf18c4c, 0xf25d980fb0620102, 0x05048b86dec02537}},
+		{FieldElement{0x25238d4b6dc84130, 0xf56d45e2490e2a40, 0xeb807dcbb2b0349a, 0x067f06d7fac476d4}, FieldElement{0x3b493f063cc23587, 0x94ca2f7c09b37dd0, 0x8a8fc3ac0a093fa9, 0x05b1e6a2012df1fc}, FieldElement{0xc5fbe705bd62344f, 0xd56cb45b62867206, 0x208ce28d87c51032, 0x01894b7852022c73}},
+		{FieldElement{0xab8b61cd654a2dc7, 0x3f50276b41ac5297, 0x7b66316d639651fb, 0x031a1b2a43546c86}, FieldElement{0xe675f033449fade5, 0xe8290e8a89ab4302, 0xfee60e06db8cd11a, 0x055587e611ce932d}, FieldElement{0x0edfbb62d8a7d285, 0xab1b20e1eb4364d1, 0xaa41858385062b2f, 0x011032c0b28ad7d8}},
+		{FieldElement{0x216ec45fec707e59, 0x6bf660764f6851f4, 0xb8e15deb35be381c, 0x0382e3f5935d4623}, FieldElement{0x2e9c26bb5ac14167, 0x234cf444026a7261, 0x10415f129162e7d7, 0x04cec47abcc868e1}, FieldElement{0xd62d3285d5b84c13, 0xf49ba610e3e9cf46, 0xe3a024d7a1713c48, 0x01239a78967344ac}},
+	},
+	{
+		{FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}},
+		{FieldElement{0x786e9ae77577a70a, 0x2f281f751202b535, 0x4392869e1dc664ba, 0x0022bc10620c27c9}, FieldElement{0xbb9b07b099a84655, 0x25a26b284ab4b1e0, 0x67fd27cb1832025a, 0x0121ec21a1928b3e}, FieldElement{0x63fde4ca3740be35, 0x69ec7bdaf9ae5ae2, 0x38b6be4ea1227786, 0x072ac39476c7face}},
+		{FieldElement{0x3143ac4a39f75018, 0x983e96f7eddaa0db, 0x92bf8fc40624c2d6, 0x05d119d6bfee3338}, FieldElement{0x6ec15a7ab1f295ac, 0xd6e7a73893423f9b, 0xd31379302f7449ea, 0x0686c79f3aafc1e3}, FieldElement{0x86ac021da51d7952, 0xfdccd1961d8ae323, 0x209585e3ceb89c84, 0x040d63c57b046b3d}},
+		{FieldElement{0x725ec851f81a79a1, 0xb45cec733a9f951b, 0xbbb81ccee7b3b009, 0x011b37d94851f925}, FieldElement{0x07c3458db4af3cc1, 0xa73a906f8ed8f800, 0xedbdb31f225c1378, 0x014ca80edebd9c82}, FieldElement{0x8b3ca243d5ac4981, 0x96d3d34fc13f4245, 0x39d106987db4cba9, 0x0666a8b9ab276ef1}},
+		{FieldElement{0x09e26d13547924ad, 0xc501bd1ffbe029e2, 0x56fd47b8c68ba68a, 0x001225406e0526de}, FieldElement{0x11a9ed7f101b6152, 0xec2404ba00a3eb15, 0x57aa44439391f70a, 0x02a922e9a08dc4e5}, FieldElement{0x5d25c5e19550edcc, 0x2264683af3db371d, 0xdd0be41e3178f74d, 0x015c59d65f29e2f3}},
+		{FieldElement{0xe58515d9fc780e7f, 0x5e6f1df7f44cc269, 0x708ade0aa666d85a, 0x04670d439ce27d8a}, FieldElement{0x205b23e91c1e7959, 0x7cb857acf1d78664, 0xfa91c13252de1ea5, 0x023a62d12835cbb1}, FieldElement{0xb5004d3be249b465, 0x6be4097c83cfbf39, 0x5c9427cba3eea3fd, 0x00556317a8d94590}},
+		{FieldElement{0xb0004cabb44a0503, 0x9c1e635346315d8f, 0x830a26774ce745aa, 0x00a61163ed50349f}, FieldElement{0xbedaf912d389e9ee, 0x9439a53618f9ef24, 0x34329862b758907a, 0x027336ce58709e2d}, FieldElement{0xae7f69378e60f463, 0x87a884f079789ce0, 0xf61c8ac8f6d5b43c, 0x0242942a0609b398}},
+		{FieldElement{0xd6559a88d768132c, 0xd8adb988a3da1493, 0x89459a44300a85dc, 0x03a7614699e5ccc3}, FieldElement{0xe205d85ac1ca9999, 0xd5b9000f06d84721, 0x4c8fca22f747e78a, 0x032ef2fc357111b3}, FieldElement{0x3dfbafdfc2287c7b, 0x5f15b9605b9a0559, 0xf544f829ea3b2a0f, 0x0382833d7ddd3f50}},
+		{FieldElement{0xae1f918432803ede, 0x596a54a335b7682f, 0x91df86fc4382b436, 0x03c5496877183312}, FieldElement{0x7d0f6641f48038fe, 0x0c0703934f753f94, 0x3f4db69eeeeb23db, 0x062b7df09ca2a4c2}, FieldElement{0x378d250fa8f46ff4, 0x43cb0d2d0e2cbcbd, 0x81f8d75c14342d33, 0x07c68874f817f20f}},
+		{FieldElement{0x5412d99ef02e2d39, 0xe2ad91a70efe149c, 0xca1ddf0f6325cc54, 0x03b77f9ac6ae6b5a}, FieldElement{0x2dcfcee9d64376b6, 0xf5501dcec4e9755c, 0xace6c6ec51da20f7, 0x07066599b98043ce}, FieldElement{0xbe0396255c9d2af2, 0x81b1b0ec19dca0ce, 0xbe7ad901b1f95f5b, 0x0364204aec6e24f1}},
+		{FieldElement{0xd39e9ee9446d8f08, 0x5fa7b0902646f77f, 0xdc568a182645edf5, 0x024c617c6c6af033}, FieldElement{0xeb3d371bce29db95, 0xe3ebee9903257df4, 0x808308c00734a87d, 0x044f3da8633f9385}, FieldElement{0x3c3bb6a2f102ae2b, 0xdd445cd348f63ee4, 0x05ddb7170f25a2ab, 0x0062bf4cf6695715}},
+		{FieldElement{0x9e89ab232620c908, 0x0eecae7e52b1d279, 0x281708d2b056403d, 0x004a0f3c486d425a}, FieldElement{0xaa466bee2242cfee, 0x3be5646409b41e6e, 0x18a2c9e0f2efa104, 0x06745f61ea9c4ae6}, FieldElement{0x08378d65058d9bae, 0x1911380ebcfafd52, 0x5cfafb633ace8b25, 0x03968dc5a82becbc}},
+		{FieldElement{0x1419aeb3142da7af, 0xcfc1b4be468b3a63, 0xab51e83937b0ffd9, 0x009357b7dda19e70}, FieldElement{0xaefd53e9c852b302, 0x361d8340103a6642, 0x82ee67058c983902, 0x00441a88c8e6709d}, FieldElement{0xb939b6a920be4e5a, 0x180cf7fd68b61c0d, 0x2e43f400be821dda, 0x007abfccecb3998f}},
+		{FieldElement{0x17ba30f950d87831, 0x53fa8c23fbc8926a, 0xb9207df046719b89, 0x03cb6c7f65240b65}, FieldElement{0x0ac0b0b5e3362976, 0xe0ac18f3f3fbf8f1, 0xc844d1e25519347a, 0x0086c96dbc40563d}, FieldElement{0x8b5f313605bc1383, 0x4d49d8208a197eb4, 0x8013c50d62eef1f7, 0x030397c7ee4b2b54}},
+		{FieldElement{0x64c586d1e838ca0b, 0x88703e6eeff53fef, 0xa27b1f5fcb970156, 0x037dfdfeddc3eecb}, FieldElement{0xac01b19a55adba72, 0xceb1e5f6d6920eab, 0x23f9f2f9810c0e90, 0x033926e1a886bb0f}, FieldElement{0x1c1480e3ae149224, 0x0f1c8b85d4e75377, 0x45b20316d31949f1, 0x05fe4dea751297d3}},
+		{FieldElement{0x836c0c09eddb12c1, 0xfb8ec8c2634429a1, 0x905d70cf7e7c162b, 0x07347c22a1de7c09}, FieldElement{0x7c11bdeb2e8faba9, 0xf165ac4c2b814120, 0x360b45efbd442f33, 0x066c9b5de9cee357}, FieldElement{0x4f2ebd9061d2c595, 0x6380b5fb549d73ac, 0xc1eb2205786fcfd9, 0x0193152c69739f55}},
+	},
+	{
+		{FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}},
+		{FieldElement{0x20525056f00b03b8, 0xb3d52130f0166cba, 0x697874a8938f0eb8, 0x0647227c583676b1}, FieldElement{0xbc68f0bfadd3e5ef, 0xbbacf3eccb628f4c, 0x2e2288fe17e60334, 0x05f9f6c6b0349fd0}, FieldElement{0x444e4d624b30bb51, 0x379a4b6fabfa1fca, 0x596ab4f4058431ab, 0x0296ef71b226464e}},
+		{FieldElement{0x61ce052a8156c7b6, 0xf95081b8e543b931, 0x6512a6dc58be9041, 0x02c548dab2331612}, FieldElement{0x5265be91c9f273b9, 0x92789a0a677b64b1, 0x71d34299b335eb4c, 0x043645752b729c76}, FieldElement{0x0ec04b4afc511d07, 0x777b0acd53336760, 0x3808854130111a3d, 0x07ca863229f1a5aa}},
+		{FieldElement{0x7fb26f51db09ad49, 0xb735a8db467d3afa, 0xa0f75da02cd5d82a, 0x07c1dc77f1523751}, FieldElement{0x03013612ff49f761, 0x13b9ef1bdaeb465a, 0x09d013f92014d57c, 0x07faacd23713e91c}, FieldElement{0x986724dc753a539e, 0xa81d633fa77384fc, 0xd3f415d1393b2471, 0x04e3fea8e94d8de1}},
+		{FieldElement{0x5f8ec9202e10bdeb, 0x0ce5198b5018a98b, 0x080adfb09803b7e3, 0x066577bab4ddd1a9}, FieldElement{0x08c292ed2ac7a80c, 0x3aa049d5e5c79469, 0xa7a8612bbf885eea, 0x06da14284d8863e5}, FieldElement{0xc5e1903f5c8ed0a9, 0x7f81f169668a6a51, 0xb79c75103145d872, 0x063840017abc2c4d}},
+		{FieldElement{0x7a484ad97fc65741, 0x42ae7bc9a2511115, 0xb06d41736b9930d3, 0x02b008aeb15e5971}, FieldElement{0x0f93874fa85daf7e, 0x01399911de99c857, 0x3fa709bbcd9396b4, 0x0215720ff4d816c9}, FieldElement{0x2bc6898f99feaa21, 0xcb1ed297d6ee8dc7, 0xc212d92edae35556, 0x01d4211c6dc6bb94}},
+		{FieldElement{0xcecf500954e71cce, 0x12c5f285ae92106c, 0x04b3e009f17909ac, 0x007f6ee490e5a09d}, FieldElement{0x620e7fa8a58e9103, 0x234afb70a1a14b4f, 0x2272a9245bad12a2, 0x014bfb521df381b9}, FieldElement{0x491fb15ff5b0a4af, 0xeae6216405703d88, 0x92fe258bf5608e67, 0x03d62b256b794607}},
+		{FieldElement{0x77fa968b77170d3a, 0x6f34ff539c97ad3e, 0xe5a2aaa70060acfe, 0x055872f5caad33bc}, FieldElement{0x1a0636763325ca78, 0x3851a7d2412b5e32, 0xda5aacfb1d420e87, 0x058b0b2373df13ad}, FieldElement{0x1ea40dbd273196a6, 0x7e73b7cfa378bc61, 0x746bf6ede0c4d59e, 0x04013b4ff0817ad8}},
+		{FieldElement{0x747cb9ec4daf9893, 0x52d3d79fac354224, 0xcdae3e978a170a47, 0x05fccd26b030638b}, FieldElement{0x68b4177ba91226c8, 0xf8fdb59143d15cbf, 0x2ab13bdd44918b40, 0x0553950ef87aeccc}, FieldElement{0x90e3236e0f05ff13, 0xbad15579fd675cc9, 0x4af7fc498de48083, 0x02068062cb88c278}},
+		{FieldElement{0xd867a58210f33a36, 0xf5311113c5b2b3e5, 0xf548bcfc8da2032f, 0x04a0f64821a7cd62}, FieldElement{0xc828a2f7a6b252df, 0x988ea99ccc5419fa, 0x57a56dd93131e970, 0x0661a8a8619b375f}, FieldElement{0x5c20f8f4fb61128e, 0x0eddffbc8b39b0b9, 0xbfed9d21310b96a9, 0x02e022c1013aa195}},
+		{FieldElement{0x8fe6bb1943cb1275, 0x2dd783a2c7ccca89, 0xf1bcceb8674a2ba1, 0x00fef5682996c216}, FieldElement{0x0237c52e178a0ffe, 0x14a0d9ec7a2c8943, 0x2c1d63c46e5e27cb, 0x006cc69e691d03d6}, FieldElement{0xfb6f2d683acc85af, 0xdb86e4b6aa956c3c, 0x8b94372652ac28a5, 0x046e6b7b9226ce5c}},
+		{FieldElement{0xcaacf93d0c8d6bd3, 0x5ce3ab2e259f0775, 0x26973826ce262a65, 0x0149fa5ace946c6e}, FieldElement{0x7a234ed6bfe9c022, 0xd02264d442d55385, 0xb1d7a4063cc90fd5, 0x01397c8478df5f9a}, FieldElement{0x3c0d0fc0378a8721, 0xeb53c38134fb46e9, 0xe42e7e08001dcd3a, 0x052cdbeb30fd5061}},
+		{FieldElement{0xf77acb9642a678e0, 0x36b3dac5d6096a49, 0x5660e385c472e682, 0x05b8d619a96d9f0c}, FieldElement{0x7c7afb1488e16ef4, 0xc81eafd7cadc848a, 0x29a4c5d13679ce07, 0x00ec714e3eafb639}, FieldElement{0x058adfdf7463906f, 0x639415b2b21f41c8, 0x1e66acd6d4e1aa3d, 0x02f3169388991a2c}},
+		{FieldElement{0xf5d47453ab0be22c, 0xdcefb925a10cd3aa, 0xe308e0a84084b078, 0x07321e157ea234c0}, FieldElement{0x55fb3cb8b75d5973, 0x711b78472594ec99, 0x863785b3ae73a231, 0x026e30ef185c3396}, FieldElement{0x69851e7df2196abd, 0x28f560da9a465a0d, 0x3d7db7fa8bd446c2, 0x028698cfac1cafcf}},
+		{FieldElement{0xb5fa2544ce10be91, 0xf93817c68ad28bcd, 0x4c209dac4203ba26, 0x02e73cb3e2164655}, FieldElement{0xe386882c9b38e956, 0xf342bf2e5d7405d0, 0x4040b54a5baf5e02, 0x07f88f46559b7d92}, FieldElement{0xd27e44f46bc9132f, 0x9c2dad5f76e4ccaf, 0x716db884aaa116c8, 0x07fcc2212784cdc7}},
+		{FieldElement{0x3204a2b7f551ef3d, 0x01890bfc65419cd6, 0xd8236c32315c8042, 0x02481976161ec7a6}, FieldElement{0x8dd0b337bbed86b5, 0xbf5afabab978caba, 0x0f9687991a76c716, 0x075ab87c80e98f01}, FieldElement{0xbcb626153474ff1b, 0x5164da3251ca4d12, 0x310babd064acaad8, 0x07f93ddb4bb9041c}},
+	},
+	{
+		{FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}},
+		{FieldElement{0x638513fe70f36294, 0xb62eb29d26a5b60f, 0x781471fac527049d, 0x0718ab1d41c96ae2}, FieldElement{0x5cd9a6a726b8cfbd, 0xac9bf89abb2ef946, 0x2107d977cd8df504, 0x01485b29e8c0819c}, FieldElement{0xbc87de6e9f8e2949, 0x024805706513af75, 0x6d387ecb56c1de26, 0x0226d1fc63fbb449}},
+		{FieldElement{0xd567a76014320156, 0x45aaee207ba54a3a, 0xfd85968344ceaf5b, 0x05c8f70d2fe18e35}, FieldElement{0x324cc90de647849d, 0xb13a30983c7c7423, 0x955670bf5e4932c2, 0x04f5cb96507f3053}, FieldElement{0x6e80307b61dc9896, 0x3bddf56e38e99b63, 0x21f03f7baf0d89e0, 0x0028c10e6c0a71b4}},
+		{FieldElement{0x81285e126ef330e8, 0x1a82de921c1b71ec, 0x480f148fe7ab956b, 0x06379bfb4f1ef84b}, FieldElement{0xa20d646d84b81f2c, 0x38ac26b2d9d66d36, 0x85f6ca611e04e0b2, 0x0193e20ddd1eb0db}, FieldElement{0xa7ea356adaf73dac, 0x2f4c47980b9c9611, 0xb680d359c35aa00f, 0x00fbfeac61cc5185}},
+		{FieldElement{0x241e84ae345edce1, 0xd0f175f399c25242, 0x16544cf25bf9fa47, 0x035d41d1d5a85654}, FieldElement{0xaad0ea95642523f7, 0x44e390fe07fda4a0, 0x9283d96aadb11c8d, 0x074b7107f76dbcd0}, FieldElement{0xb6c099522013162d, 0xf386629c58c47412, 0xd366db3152590db1, 0x0503c77c33d3babf}},
+		{FieldElement{0x97e8038e34e2c3fc, 0x9de3631223e771f6, 0xf957272318e0d53f, 0x07c0663f4f85a205}, FieldElement{0xa943520bef3ea806, 0x2b3cb53052bc081e, 0x0caa5964adf7a144, 0x0563b1a5dfa74e81}, FieldElement{0x5e48952b9a70f938, 0xf181b9c370b2c250, 0xd0a790d2cfb5aa58, 0x05c5aa4a70fedf31}},
+		{FieldElement{0x34f28c1bd45332d4, 0xa9e22aea8b8dc5e5, 0x620df72b71a8ab46, 0x0647d58cdf4740c0}, FieldElement{0x2a648b13f7a92415, 0x8abf9b167648ba49, 0xb0d80bc0ee6f9827, 0x01277a4b005256d6}, FieldElement{0x1e4d8755fe844d19, 0xa4175cc27fe5f9d3, 0xe132e3d1bd01382f, 0x01b3ec1238a667ab}},
+		{FieldElement{0xb2c171adadba8e57, 0x3e6e7da0d13fe262, 0xbc9778d1ecfd566a, 0x0582b517af92b838}, FieldElement{0x2c918e0f92e03409, 0xfc6fc1b4b5dcfa58, 0x4ae14f81ac90797b, 0x04f2b528daf2604f}, FieldElement{0xde44ae841925d1c8, 0x5e4587986f9361cf, 0x10648c0dc53872fd, 0x0332603ce1096d94}},
+		{FieldElement{0xe0d594562d2af4f9, 0x3e0946507f432eae, 0x86aa6c49526f3e52, 0x05162a79e11c3d98}, FieldElement{0x058906b5d6fde568, 0x2297a72628c3729f, 0x4a4b2510e3ffcd78, 0x0487c94ba0ec4f9d}, FieldElement{0x9e235e6aa270566d, 0xa47319660990758e, 0xd5827afbe795c21e, 0x07e23d858fb3780c}},
+		{FieldElement{0x0b2eac31fdaf9a92, 0xa89bf1ef775d2ca1, 0xd01e28b7643154ad, 0x051e84b6863ad31a}, FieldElement{0xd488271d79466bae, 0xae4b198b85d571c2, 0x4029965fd904b0ef, 0x03dd7fb95d91eafd}, FieldElement{0x86f63ed7d64dde6e, 0xb867ef7939c9b3ce, 0xe7421767dca35d8e, 0x02da9b31fdcaa808}},
+		{FieldElement{0xff594cd75f59a164, 0xe7f4eca68f6327f0, 0xb7df167186dfe4b1, 0x05bb08247caaab8c}, FieldElement{0x790a419da1df8e28, 0xcc81a37e0aac1fe3, 0xdc73f546a5836db7, 0x034fa61fedbb3bb7}, FieldElement{0x6a349c44c2fa2d8b, 0xd14168f704501375, 0x5faad4ce8f49468a, 0x042a9e5474e013c4}},
+		{FieldElement{0x8604db24f47d67f9, 0x359be7deb4f51a43, 0x7d09fac6e11ba6bc, 0x01480f38ef3df57b}, FieldElement{0xabc670bd08477521, 0xdc10f84a55974904, 0xd735d6c747c2ec7f, 0x009103244eab5665}, FieldElement{0x28491d357f93f71d, 0x666b7756bdf7cce5, 0x633e8db9df7744d2, 0x07516f059655f098}},
+		{FieldElement{0xac83dd8c9ae3987a, 0xe88df8e5ac59c3e2, 0x9cbb8d2d56e85798, 0x07daa04bf2cc5842}, FieldElement{0x1eaa952a5656a2f5, 0x5a5bc2093365d9e8, 0xe20ae0a757a87a9d, 0x07a25e51f0ac847b}, FieldElement{0xebf20803480c5cbf, 0x3f39459c7f030ec9, 0x614f5e7c49f2ae17, 0x0709311bd31f3b85}},
+		{FieldElement{0xe9a3cbc96e041334, 0xd263e58246ac669f, 0x65d1169448aeb9b0, 0x03690b477ad85b33}, FieldElement{0x798859cbc10d58de, 0x4b295787af290197, 0x57231b5730ed0048, 0x003c54d401b47d89}, FieldElement{0xe9198f8553e2652c, 0xc2ef1e0841aecb30, 0xa1ae8885fcb2d3e7, 0x00678a6ed6a59350}},
+		{FieldElement{0xe3cc058d08157297, 0x90ea837b914facd2, 0x054385a3af0ed5b9, 0x0234c28fb7743dae}, FieldElement{0xd527ae596361a3c5, 0xb72474e8578a33b9, 0x561c7b71730572bb, 0x05c95e9bd0bb1b30}, FieldElement{0x27213480bd719da1, 0x1ec3888f86d72721, 0xb4edc20ec5cc053b, 0x01cc2f9c82a7bba0}},
+		{FieldElement{0x624eb8dcd1945148, 0x181a65096c5f0549, 0xd8c6100eb24367af, 0x02b6b693e2296c92}, FieldElement{0x189805244a4635ed, 0xda24fcc21a751fd8, 0xc5bff15f82639e15, 0x045711ae137160d1}, FieldElement{0x90f07623d2400b27, 0xfc460a9940e093fa, 0xe987b25dcd64ba92, 0x017baa4a96f5790b}},
+	},
+	{
+		{FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000001, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}, FieldElement{0x0000000000000000, 0x0000000000000000, 0x0000000000000000, 0x0000000000000000}},
+		{FieldElement{0xcf2cce8c363aa223, 0xbabb519c5594ca24, 0x81e4aa2f9266dc28, 0x0393bf5dadf07427}, FieldElement{0x4e2cb6c4e4fcfab5, 0x3aaa046ebecc2251, 0xa5e22a695f2a80d0, 0x019456f475256afb}, FieldElement{0xd26c494a7a9302f9, 0x53d233b41e9c784a, 0x3ee8cbbf5dc064a8, 0x011a330422789005}},
+		{FieldElement{0x2dd1ced309e311ab, 0xae97cce17f945e20, 0x7479e4ece4adc94b, 0x01d027e9e03304ec}, FieldElement{0x3c38aec10c79613d, 0x9f9e81a2c98a45ae, 0x56d62fff58a7850a, 0x04b09b5998f0ca23}, FieldElement{0xcf881cd9baba45a3, 0x7a05a6ca67914cc8, 0xf1a8a5032fd88064, 0x0645524587c30ead}},
+		{FieldElement{0x7863fecdc373ad7c, 0xd314a987ad26f57b, 0xb577f46754d42328, 0x0534ccb8e77c5d7c}, FieldElement{0x7a35825c03ed560c, 0x6c7a4afa08c2449e, 0x8b9ac1e914951218, 0x02b2564a2229b9c9}, FieldElement{0xa34eb2d6c3100e47, 0xac69387a7428181b, 0xbb588a896f9293d7, 0x054066511ae72b2e}},
+		{FieldElement{0xcc46bed2b22df5ae, 0xf94c365eed58ed77, 0xd7142994dddc89eb, 0x03047f3d91a8760c}, FieldElement{0x3d6c76814577cafc, 0xf6ffa76fe649f5da, 0x396f6f30b41bbbc3, 0x07407a8f5a064179}, FieldElement{0xf47d7f096bcb8c35, 0xc548d8c413116aab, 0x589562509d396d8e, 0x040ce504096701ad}},
+		{FieldElement{0x89b04afa2c823a0e, 0x52e87f2154b22310, 0x3488aa73ba6e98b5, 0x06b3bd0ed073df70}, FieldElement{0xee81e231daaa9d64, 0x7eb51d267fbc045e, 0xb57e9fc450071263, 0x074f0088725c99dd}, FieldElement{0x0d7179891902cf9a, 0xbfbb83b0f91c79b2, 0x7614bd529a8c3b51, 0x045673b561a54826}},
+		{FieldElement{0x95db69e8a6cb004e, 0xa2918f6105c2621b, 0x82cd851408f1265d, 0x0535d6217dd736b2}, FieldElement{0x27c6f14628777c89, 0x79bf6e0eaa9dd6a4, 0x9dd2c8b0885950c5, 0x05a15511674f5fe4}, FieldElement{0x4b6c581d57155abe, 0x0d81da2c087547dd, 0x4d9238b4dd97a2c9, 0x0183d40fb4777551}},
+		{FieldElement{0x8b185547e01e7a31, 0xe7f66e01bee31607, 0xc98795cf420f6c40, 0x05d24a8690b3f24c}, FieldElement{0x68b4bc5925fd9814, 0x8aff72befaa2ad92, 0x0e20c35211224c8a, 0x018ee6d05eac2fe3}, FieldElement{0x400e8e783d3fc158, 0xf6301a3bc3e00d90, 0xdc1ee0cfcdb74a5e, 0x03747e2547955aea}},
+		{FieldElement{0xb0a26cdcc1c49003, 0x83d44a2dca7d729a, 0xb5b4a00dcf817005, 0x063e014f60457305}, FieldElement{0x5be860e333c1dc50, 0x8d5c006a6b725fad, 0x9006b10d264c47be, 0x0738f21aad38c68a}, FieldElement{0xa25e38fb9749d0fc, 0x6a73353a481c0a87, 0xde06440b5ebe892c, 0x02184c42b8ccf608}},
+		{FieldElement{0xb077a368c046b51e, 0x2f7a1d005de1b3c0, 0x4c9aa5b6bac8bb04, 0x05226354431b4cc5}, FieldElement{0x6c93a96a1f28c510, 0x0e9ff3f797d5ded9, 0x8fc533b4f307ff3d, 0x007784e89b344ef0}, FieldElement{0x65625d3a945ecc4a, 0xd4325889cbc26399, 0x9b3a3fd37a4f5912, 0x00d1ed355c62ebb8}},
+		{FieldElement{0xae624b1055e2fab2, 0xaba350ff86a491df, 0x11250e9189f64188, 0x013c6d3a077c1498}, FieldElement{0xe436c8c304749fac, 0x3965f66d8f7949f6, 0xda1bd4758266c385, 0x00d5c3b197de724b}, FieldElement{0x5088f43f339764af, 0xa7d77a9d77c5e8d4, 0xfb00e81529b2d441, 0x021dc89e8799a61a}},
+		{FieldElement{0xe9d6caad7cc21c4d, 0x67da164bfd6e6d32, 0x0fc078fc405d5767, 0x01372ef0a3d5ba10}, FieldElement{0x511e3381aef663df, 0x703a82f500c74a03, 0x096e99ee9a9ac386, 0x0073797ea7b7e38e}, FieldElement{0xab39534d106428b8, 0xe81cacfe7e160b8c, 0x151e3f9b8271db0a, 0x0286548b50edaa0c}},
+		{FieldElement{0x5dc0dc9f5443e6e2, 0xb02193c17abd66fa, 0x5142b053a5b98bd1, 0x065e4356d1344b7b}, FieldElement{0xc218a2ff91f3bf2d, 0x6cc56129421b1273, 0x9c67d22a15eb3b90, 0x057f8e653046fd03}, FieldElement{0x508434665c0df5b9, 0x123ba19927c67a37, 0xda1453df265b1445, 0x0443b6e4ac485919}},
+		{FieldElement{0xbcb658dce828a024, 0x66233c9130da7a42, 0xe6e0950e372a6cee, 0x0720f7645a2c0799}, FieldElement{0x9a4ac44377623623, 0x7d834f7319334753, 0x797cb73ec4012029, 0x0179d75db1f37b89}, FieldElement{0x1f21caa68e23f4c5, 0x4990f0c4111b04d5, 0x2981fa0136993747, 0x0377a7e00100632d}},
+		{FieldElement{0x5c25bdf520264394, 0xe054e97942d701dc, 0x87a72610fde25e29, 0x02fcd142f73c69da}, FieldElement{0x2a775c66f61a783f, 0x26c4587a0651b2e3, 0xca6f1d20e9b91c89, 0x015d75f84b46f81c}, FieldElement{0x1218a5aa58e9ace6, 0x38149ed0c20befd0, 0x64295c0c3ee52fcc, 0x033e3a813db12f6d}},
+		{FieldElement{0x7e833cb01b805f8b, 0x2d4b10a9b83fef4c, 0xcade8e8f14643094, 0x0731f81a2e4103c7}, FieldElement{0x1026cedfd1a001e7, 0x560f4f76072637b3, 0x145f27d7fb40ac2e, 0x02e183272230d35d}, FieldElement{0x804498da3372818e, 0x2a59fe34126f1a4b, 0xd0ecd4256b8af0f9, 0x01b68a8c25960dde}},
+	},
+}
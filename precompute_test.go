@@ -0,0 +1,89 @@
+package curve1174
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestBasePrecomputationLevels(t *testing.T) {
+	defer SetBasePrecomputation(GetBasePrecomputation())
+	for _, level := range []BasePrecomputation{PrecomputeNone, PrecomputeSmall, PrecomputeBig} {
+		SetBasePrecomputation(level)
+		if GetBasePrecomputation() != level {
+			t.Errorf("level not set %d", level)
+		}
+		randomTestOp(t, func(res *FieldElement, x *FieldElement, y *FieldElement) {
+			var p Point
+			p.ScalarBaseMult(x).ToAffine(&p)
+			res.Set(&p.X)
+		}, func(res *big.Int, x *big.Int, y *big.Int) {
+			a := FromBigInt(x)
+			var p Point
+			p.ScalarMult(Base, a).ToAffine(&p)
+			res.Set(p.X.ToBigInt())
+		}, 1000)
+	}
+}
+
+func TestSetBasePrecomputationInvalid(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic")
+		}
+	}()
+	SetBasePrecomputation(PrecomputeBig + 1)
+}
+
+func TestPrecomputedTableSmall(t *testing.T) {
+	var p Point
+	var el [16]Point
+	p.Set(Base)
+	sp := &p
+	for i := 0; i < 64; i++ {
+		el[0].Set(E)
+		el[1].Set(sp)
+		for j := 2; j < 16; j++ {
+			el[j].Add(&el[j-1], sp)
+		}
+		for j := 0; j < 16; j++ {
+			var c AffineCachedPoint
+			el[j].ToAffine(&el[j])
+			c.SetPoint(&el[j])
+			if c != precomputedBaseSmallTable[i][j] {
+				t.Errorf("%d %d\n%x\n%x", i, j, c, precomputedBaseSmallTable[i][j])
+			}
+		}
+		sp.Double(&el[8]).ToAffine(sp)
+	}
+}
+
+func TestPrecomputedTableBig(t *testing.T) {
+	if precomputedBaseBigTable != *computeBaseTableBig() {
+		t.Error("precomputed table doesn't match")
+	}
+}
+
+//computeBaseTableBig computes precomputedBaseBigTable with curve1174 package
+func computeBaseTableBig() *bigBaseTable {
+	table := new(bigBaseTable)
+	var p Point
+	var el [129]Point
+	p.Set(&basePoint)
+	sp := &p
+	for i := 0; i < 32; i++ {
+		el[0].Set(&identity)
+		el[1].Set(sp)
+		for j := 2; j < 129; j += 2 {
+			el[j].Double(&el[j/2]).ToAffine(&el[j])
+			if j < 128 {
+				el[j+1].AddZ1(&el[j], sp).ToAffine(&el[j+1])
+			}
+		}
+		for j := 0; j < 129; j++ {
+			table.rows[i][j].SetPoint(&el[j])
+		}
+		sp.Double(&el[128]).ToAffine(sp)
+	}
+	table.top.SetPoint(sp)
+	return table
+}
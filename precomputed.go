@@ -0,0 +1,188 @@
+package curve1174
+
+//PrecomputedPoint holds precomputed tables for fast multiplication of fixed point (like additional generator or
+//long-lived public key) by many scalars. It has to be initialized with Set.
+//
+//Window size w selects memory/speed tradeoff. ScalarMultPrecomputed uses ceil(256/w) additions and no doublings, its
+//table holds ceil(256/w)*(2^(w-1)+1) entries. VarTimeScalarMultPrecomputed uses width w+1 NAF and 2^(w-1) odd multiples.
+//Each entry costs 96 bytes, e.g. w == 4 needs ~56kB, w == 6 ~139kB and w == 8 ~409kB.
+type PrecomputedPoint struct {
+	window int
+	rows   int
+	//comb[i*(2^(w-1)+1)+j] is j*2^(w*i)*P
+	comb []AffineCachedPoint
+	//top is 2^(w*rows)*P, it's needed when recoding scalar to signed digits produces final carry
+	top AffineCachedPoint
+	//odd[i] is (2i+1)*P
+	odd []AffineCachedPoint
+}
+
+//Set computes tables for point p with window size window (1 <= window <= 8) and stores them in t
+func (t *PrecomputedPoint) Set(p *Point, window int) *PrecomputedPoint {
+	if window < 1 || window > 8 {
+		panic("curve1174: window size has to be between 1 and 8")
+	}
+	half := 1 << (window - 1)
+	t.window = window
+	t.rows = (256 + window - 1) / window
+	t.comb = make([]AffineCachedPoint, t.rows*(half+1))
+	t.odd = make([]AffineCachedPoint, half)
+
+	points := make([]Point, len(t.comb)+1+len(t.odd))
+	var sp Point
+	sp.Set(p)
+	for i := 0; i < t.rows; i++ {
+		el := points[i*(half+1) : (i+1)*(half+1)]
+		el[0].Set(&identity)
+		el[1].Set(&sp)
+		for j := 2; j <= half; j++ {
+			if j%2 == 0 {
+				el[j].Double(&el[j/2])
+			} else {
+				el[j].Add(&el[j-1], &sp)
+			}
+		}
+		sp.Double(&el[half])
+	}
+	points[len(t.comb)].Set(&sp)
+
+	odd := points[len(t.comb)+1:]
+	var p2 Point
+	p2.Double(p)
+	odd[0].Set(p)
+	for i := 1; i < len(odd); i++ {
+		odd[i].Add(&odd[i-1], &p2)
+	}
+
+	batchToAffine(points)
+	for i := range t.comb {
+		t.comb[i].SetPoint(&points[i])
+	}
+	t.top.SetPoint(&points[len(t.comb)])
+	for i := range t.odd {
+		t.odd[i].SetPoint(&odd[i])
+	}
+	return t
+}
+
+//Window returns window size used by t
+func (t *PrecomputedPoint) Window() int {
+	return t.window
+}
+
+//ScalarMultPrecomputed multiplies point precomputed in t by scalar b and stores result in p.
+//Execution time doesn't depend on b.
+func (p *Point) ScalarMultPrecomputed(t *PrecomputedPoint, b *FieldElement) *Point {
+	var digits [256]int64
+	var pp AffineCachedPoint
+	n := 1<<(t.window-1) + 1
+	carry := signedWindows(b, t.window, digits[:t.rows])
+
+	selectSignedDigitSlice(&pp, t.comb[:n], digits[0])
+	p.setAffineCached(&pp)
+	for i := 1; i < t.rows; i++ {
+		selectSignedDigitSlice(&pp, t.comb[i*n:(i+1)*n], digits[i])
+		p.AddAffineCached(p, &pp)
+	}
+
+	pp = affineCachedE
+	pp.condSet(&t.top, carry)
+	return p.AddAffineCached(p, &pp)
+}
+
+//VarTimeScalarMultPrecomputed multiplies point precomputed in t by scalar b and stores result in p.
+//Execution time depends on b, use it only when b is public (e.g. for signature verification)!
+func (p *Point) VarTimeScalarMultPrecomputed(t *PrecomputedPoint, b *FieldElement) *Point {
+	var naf [257]int32
+	wnaf(&naf, b, uint(t.window+1))
+	return p.varTimeScalarMultNAF(&naf, t.odd)
+}
+
+//varTimeScalarMultNAF computes sum(naf[i]*2^i)*P and stores result in p, odd[i] has to be (2i+1)*P
+func (p *Point) varTimeScalarMultNAF(naf *[257]int32, odd []AffineCachedPoint) *Point {
+	i := len(naf) - 1
+	for i >= 0 && naf[i] == 0 {
+		i--
+	}
+	p.Set(&identity)
+	//T is needed only by addition and in final result, so runs of zero digits are doubled in projective coordinates
+	var acc ProjectivePoint
+	projective := false
+	for ; i >= 0; i-- {
+		if naf[i] == 0 && i > 0 {
+			if !projective {
+				acc.SetPoint(p)
+				projective = true
+			}
+			acc.Double(&acc)
+			continue
+		}
+		if projective {
+			p.DoubleProjective(&acc)
+			projective = false
+		} else {
+			p.Double(p)
+		}
+		if naf[i] > 0 {
+			p.AddAffineCached(p, &odd[naf[i]/2])
+		} else if naf[i] < 0 {
+			p.SubAffineCached(p, &odd[-naf[i]/2])
+		}
+	}
+	return p
+}
+
+//selectSignedDigitSlice sets res to digit*table[1], table has to contain 0..len(table)-1 multiples of the point.
+//Execution time doesn't depend on digit.
+func selectSignedDigitSlice(res *AffineCachedPoint, table []AffineCachedPoint, digit int64) {
+	neg := uint64(digit) >> 63
+	mask := -neg
+	abs := (uint64(digit) ^ mask) - mask
+	selectAffineCachedPointSlice(res, table, abs)
+	res.condNeg(neg)
+}
+
+//batchToAffine transforms all points to affine coordinates using single inversion (Montgomery's trick)
+func batchToAffine(points []Point) {
+	batchToAffineInverse(points, false)
+}
+
+//varTimeBatchToAffine is batchToAffine using variable time inversion, use it only for public points
+func varTimeBatchToAffine(points []Point) {
+	batchToAffineInverse(points, true)
+}
+
+func batchToAffineInverse(points []Point, varTime bool) {
+	if len(points) == 0 {
+		return
+	}
+	//small batches (like in VarTimeScalarMult) don't need heap allocation
+	var buf [16]FieldElement
+	acc := buf[:]
+	if len(points) > len(buf) {
+		acc = make([]FieldElement, len(points))
+	}
+	acc[0].Set(&points[0].Z)
+	for i := 1; i < len(points); i++ {
+		acc[i].Mul(&acc[i-1], &points[i].Z)
+	}
+	var inv, zInv FieldElement
+	if varTime {
+		inv.varTimeInverse(&acc[len(points)-1])
+	} else {
+		inv.Inverse(&acc[len(points)-1])
+	}
+	for i := len(points) - 1; i >= 0; i-- {
+		if i > 0 {
+			zInv.Mul(&inv, &acc[i-1])
+			inv.Mul(&inv, &points[i].Z)
+		} else {
+			zInv.Set(&inv)
+		}
+		pt := &points[i]
+		pt.X.Mul(&pt.X, &zInv).Mod(&pt.X)
+		pt.Y.Mul(&pt.Y, &zInv).Mod(&pt.Y)
+		pt.T.Mul(&pt.T, &zInv).Mod(&pt.T)
+		pt.Z.Set(&one)
+	}
+}
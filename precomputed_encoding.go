@@ -0,0 +1,251 @@
+package curve1174
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"io"
+)
+
+//Binary format of PrecomputedPoint (all integers are little endian):
+//
+//	magic       8 bytes "C1174PT\x00"
+//	version     1 byte, precomputedVersion
+//	curve       1 byte, precomputedCurve1174
+//	window      1 byte, 1..8
+//	reserved    1 byte, 0
+//	count       4 bytes, number of entries, it has to match window
+//	entries     entries * 96 bytes, YPlusX, YMinusX and T2D of each AffineCachedPoint: comb table, top, odd multiples
+//	checksum    32 bytes, SHA-256 of everything above
+//
+//Entries are stored in the same form as in memory so loading doesn't require any field inversions.
+const (
+	precomputedVersion     = 1
+	precomputedCurve1174   = 1
+	precomputedHeaderSize  = 16
+	precomputedEntrySize   = 3 * 32
+	precomputedChecksumLen = sha256.Size
+)
+
+var precomputedMagic = [8]byte{'C', '1', '1', '7', '4', 'P', 'T', 0}
+
+var (
+	//ErrInvalidTableFormat is returned when serialized table is malformed (bad magic, truncated, wrong size)
+	ErrInvalidTableFormat = errors.New("curve1174: invalid precomputed table format")
+	//ErrUnsupportedTableVersion is returned when serialized table uses unknown format version
+	ErrUnsupportedTableVersion = errors.New("curve1174: unsupported precomputed table version")
+	//ErrTableCurveMismatch is returned when serialized table was created for different curve
+	ErrTableCurveMismatch = errors.New("curve1174: precomputed table is for different curve")
+	//ErrTableChecksum is returned when checksum of serialized table doesn't match its content
+	ErrTableChecksum = errors.New("curve1174: precomputed table checksum mismatch")
+	//ErrInvalidTableEntry is returned when serialized table contains point that is not on the curve or is not
+	//consistent with the rest of the table
+	ErrInvalidTableEntry = errors.New("curve1174: precomputed table contains invalid point")
+	//ErrTableNotInitialized is returned when trying to serialize table that wasn't initialized with Set
+	ErrTableNotInitialized = errors.New("curve1174: precomputed table is not initialized")
+)
+
+//precomputedEntries returns number of entries stored for window size w
+func precomputedEntries(w int) int {
+	rows := (256 + w - 1) / w
+	half := 1 << (w - 1)
+	return rows*(half+1) + 1 + half
+}
+
+//MarshalBinary implements encoding.BinaryMarshaler
+func (t *PrecomputedPoint) MarshalBinary() ([]byte, error) {
+	if t.window == 0 {
+		return nil, ErrTableNotInitialized
+	}
+	n := precomputedEntries(t.window)
+	data := make([]byte, precomputedHeaderSize, precomputedHeaderSize+n*precomputedEntrySize+precomputedChecksumLen)
+	copy(data, precomputedMagic[:])
+	data[8] = precomputedVersion
+	data[9] = precomputedCurve1174
+	data[10] = byte(t.window)
+	binary.LittleEndian.PutUint32(data[12:], uint32(n))
+	for i := range t.comb {
+		data = t.comb[i].appendBinary(data)
+	}
+	data = t.top.appendBinary(data)
+	for i := range t.odd {
+		data = t.odd[i].appendBinary(data)
+	}
+	sum := sha256.Sum256(data)
+	return append(data, sum[:]...), nil
+}
+
+//UnmarshalBinary implements encoding.BinaryUnmarshaler. It verifies header and checksum, checks that every entry is
+//valid point on the curve and spot-checks that entries are multiples of the same point (see checkMultiples), t is left
+//unchanged if any check fails. Entries are copied, so data is not retained.
+//
+//Checksum only detects accidental corruption, anyone who can modify the table can recompute it. Table that passes all
+//checks can still contain wrong multiples (in entries that are not spot-checked), so ScalarMultPrecomputed would
+//return wrong results. Load tables only from sources trusted as much as the code itself, or compare ScalarMultPrecomputed
+//with ScalarMult for random scalar after loading.
+func (t *PrecomputedPoint) UnmarshalBinary(data []byte) error {
+	return t.unmarshalBinary(data, false)
+}
+
+//UnmarshalBinaryShared is UnmarshalBinary that uses data directly instead of copying it, so data can be read-only
+//memory-mapped file shared by many processes. data must not be modified or unmapped while t is in use. Entries are
+//copied anyway if their layout in data doesn't match memory layout (on big endian platforms or if data is not 8-byte
+//aligned).
+func (t *PrecomputedPoint) UnmarshalBinaryShared(data []byte) error {
+	return t.unmarshalBinary(data, true)
+}
+
+func (t *PrecomputedPoint) unmarshalBinary(data []byte, shared bool) error {
+	window, err := parsePrecomputedHeader(data)
+	if err != nil {
+		return err
+	}
+	n := precomputedEntries(window)
+	if len(data) != precomputedHeaderSize+n*precomputedEntrySize+precomputedChecksumLen {
+		return ErrInvalidTableFormat
+	}
+	body := data[:len(data)-precomputedChecksumLen]
+	sum := sha256.Sum256(body)
+	if !bytes.Equal(sum[:], data[len(body):]) {
+		return ErrTableChecksum
+	}
+
+	half := 1 << (window - 1)
+	rows := (256 + window - 1) / window
+	body = body[precomputedHeaderSize:]
+	var entries []AffineCachedPoint
+	if shared {
+		entries = sharedEntries(body, n)
+	}
+	if entries == nil {
+		entries = make([]AffineCachedPoint, n)
+		for i := range entries {
+			entries[i].setBinary(body[i*precomputedEntrySize:])
+		}
+	}
+	for i := range entries {
+		if !entries[i].isReduced() || !entries[i].isOnCurve() {
+			return ErrInvalidTableEntry
+		}
+	}
+	comb := entries[:rows*(half+1)]
+	top := &entries[len(comb)]
+	odd := entries[len(comb)+1:]
+	if !checkMultiples(comb, top, odd, window) {
+		return ErrInvalidTableEntry
+	}
+
+	t.window = window
+	t.rows = rows
+	t.comb = comb
+	t.top = *top
+	t.odd = odd
+	return nil
+}
+
+//checkMultiples spot-checks that table entries are multiples of the same point P: first entry of every comb row has to
+//be identity, second one has to be 2^(w*i)*P (computed by doubling P) and top has to be 2^(w*rows)*P. odd has to
+//start with P and 3P. Other entries are not checked, it would cost as much as computing the table.
+func checkMultiples(comb []AffineCachedPoint, top *AffineCachedPoint, odd []AffineCachedPoint, window int) bool {
+	half := 1 << (window - 1)
+	var p, sp Point
+	var c AffineCachedPoint
+	p.setAffineCached(&comb[1])
+	sp.Set(&p)
+	for i := 0; i < len(comb); i += half + 1 {
+		if comb[i] != affineCachedE || comb[i+1] != *c.SetPoint(sp.ToAffine(&sp)) {
+			return false
+		}
+		for j := 0; j < window; j++ {
+			sp.Double(&sp)
+		}
+	}
+	if *top != *c.SetPoint(sp.ToAffine(&sp)) || odd[0] != comb[1] {
+		return false
+	}
+	return len(odd) < 2 || odd[1] == *c.SetPoint(sp.Double(&p).Add(&sp, &p).ToAffine(&sp))
+}
+
+//WriteTo writes t in binary format to w. It implements io.WriterTo
+func (t *PrecomputedPoint) WriteTo(w io.Writer) (int64, error) {
+	data, err := t.MarshalBinary()
+	if err != nil {
+		return 0, err
+	}
+	n, err := w.Write(data)
+	return int64(n), err
+}
+
+//ReadFrom reads table in binary format from r and stores it in t. It reads exactly one table so r can contain more
+//data after it. It implements io.ReaderFrom
+func (t *PrecomputedPoint) ReadFrom(r io.Reader) (int64, error) {
+	header := make([]byte, precomputedHeaderSize)
+	read, err := io.ReadFull(r, header)
+	if err != nil {
+		return int64(read), unexpectedEOF(err)
+	}
+	window, err := parsePrecomputedHeader(header)
+	if err != nil {
+		return int64(read), err
+	}
+	data := make([]byte, precomputedHeaderSize+precomputedEntries(window)*precomputedEntrySize+precomputedChecksumLen)
+	copy(data, header)
+	n, err := io.ReadFull(r, data[precomputedHeaderSize:])
+	read += n
+	if err != nil {
+		return int64(read), unexpectedEOF(err)
+	}
+	return int64(read), t.UnmarshalBinary(data)
+}
+
+//parsePrecomputedHeader checks header of serialized table and returns window size stored in it
+func parsePrecomputedHeader(data []byte) (int, error) {
+	if len(data) < precomputedHeaderSize || !bytes.Equal(data[:8], precomputedMagic[:]) {
+		return 0, ErrInvalidTableFormat
+	}
+	if data[8] != precomputedVersion {
+		return 0, ErrUnsupportedTableVersion
+	}
+	if data[9] != precomputedCurve1174 {
+		return 0, ErrTableCurveMismatch
+	}
+	window := int(data[10])
+	if window < 1 || window > 8 || data[11] != 0 ||
+		binary.LittleEndian.Uint32(data[12:]) != uint32(precomputedEntries(window)) {
+		return 0, ErrInvalidTableFormat
+	}
+	return window, nil
+}
+
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+//appendBinary appends YPlusX, YMinusX and T2D as little endian 32 byte numbers to data
+func (c *AffineCachedPoint) appendBinary(data []byte) []byte {
+	var b [precomputedEntrySize]byte
+	for j, f := range []*FieldElement{&c.YPlusX, &c.YMinusX, &c.T2D} {
+		for i := 0; i < 4; i++ {
+			binary.LittleEndian.PutUint64(b[j*32+i*8:], f[i])
+		}
+	}
+	return append(data, b[:]...)
+}
+
+//setBinary sets c from data written by appendBinary
+func (c *AffineCachedPoint) setBinary(data []byte) {
+	for j, f := range []*FieldElement{&c.YPlusX, &c.YMinusX, &c.T2D} {
+		for i := 0; i < 4; i++ {
+			f[i] = binary.LittleEndian.Uint64(data[j*32+i*8:])
+		}
+	}
+}
+
+//isReduced checks if all coordinates of c are reduced mod p
+func (c *AffineCachedPoint) isReduced() bool {
+	return c.YPlusX.Cmp(&modulus) < 0 && c.YMinusX.Cmp(&modulus) < 0 && c.T2D.Cmp(&modulus) < 0
+}
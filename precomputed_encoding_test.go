@@ -0,0 +1,180 @@
+package curve1174
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"io"
+	"testing"
+	"unsafe"
+)
+
+func TestIsOnCurve(t *testing.T) {
+	var p Point
+	p.ScalarMult(Base, &FieldElement{0xDEADBEEF, 0xCAFEBABE})
+	for _, pt := range []*Point{Base, E, &p} {
+		if !pt.IsOnCurve() {
+			t.Errorf("point should be on curve %x", pt)
+		}
+	}
+	var invalid Point
+	invalid.Set(Base)
+	invalid.X.Add(&invalid.X, UOne)
+	if invalid.IsOnCurve() {
+		t.Errorf("point shouldn't be on curve %x", &invalid)
+	}
+	invalid.Set(&p)
+	invalid.T.Add(&invalid.T, UOne)
+	if invalid.IsOnCurve() {
+		t.Errorf("point with invalid T shouldn't be on curve %x", &invalid)
+	}
+	invalid = Point{}
+	if invalid.IsOnCurve() {
+		t.Errorf("zero point shouldn't be on curve")
+	}
+}
+
+func testPrecomputedPoint(window int) (*Point, *PrecomputedPoint) {
+	var sp Point
+	sp.ScalarBaseMult(&FieldElement{0xDEADBEEF, 0xCAFEBABE}).ToAffine(&sp)
+	return &sp, new(PrecomputedPoint).Set(&sp, window)
+}
+
+func TestPrecomputedPointMarshal(t *testing.T) {
+	for _, w := range []int{1, 4, 8} {
+		sp, table := testPrecomputedPoint(w)
+		data, err := table.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var buf bytes.Buffer
+		if n, err := table.WriteTo(&buf); err != nil || n != int64(len(data)) || !bytes.Equal(buf.Bytes(), data) {
+			t.Fatalf("WriteTo: %d %v", n, err)
+		}
+		buf.WriteString("trailing data")
+
+		var t1, t2 PrecomputedPoint
+		if err := t1.UnmarshalBinary(data); err != nil {
+			t.Fatal(err)
+		}
+		if n, err := t2.ReadFrom(&buf); err != nil || n != int64(len(data)) {
+			t.Fatalf("ReadFrom: %d %v", n, err)
+		}
+		if buf.String() != "trailing data" {
+			t.Errorf("ReadFrom consumed too much data")
+		}
+
+		b := FieldElement{0x0123456789ABCDEF, 0xFEDCBA9876543210, 0x1111, 0x0222}
+		var expected, p1, p2, p3 Point
+		expected.ScalarMult(sp, &b).ToAffine(&expected)
+		p1.ScalarMultPrecomputed(&t1, &b).ToAffine(&p1)
+		p2.ScalarMultPrecomputed(&t2, &b).ToAffine(&p2)
+		p3.VarTimeScalarMultPrecomputed(&t2, &b).ToAffine(&p3)
+		if t1.Window() != w || !p1.Equals(&expected) || !p2.Equals(&expected) || !p3.Equals(&expected) {
+			t.Errorf("window %d\n%x\n%x\n%x\n%x", w, &p1, &p2, &p3, &expected)
+		}
+	}
+}
+
+func TestPrecomputedPointUnmarshalInvalid(t *testing.T) {
+	_, table := testPrecomputedPoint(2)
+	data, _ := table.MarshalBinary()
+
+	modified := func(f func(d []byte), fixChecksum bool) []byte {
+		d := append([]byte(nil), data...)
+		f(d)
+		if fixChecksum {
+			sum := sha256.Sum256(d[:len(d)-sha256.Size])
+			copy(d[len(d)-sha256.Size:], sum[:])
+		}
+		return d
+	}
+	tests := []struct {
+		name string
+		data []byte
+		err  error
+	}{
+		{"empty", nil, ErrInvalidTableFormat},
+		{"truncated", data[:len(data)-1], ErrInvalidTableFormat},
+		{"magic", modified(func(d []byte) { d[0] = 'X' }, true), ErrInvalidTableFormat},
+		{"version", modified(func(d []byte) { d[8] = 2 }, true), ErrUnsupportedTableVersion},
+		{"curve", modified(func(d []byte) { d[9] = 2 }, true), ErrTableCurveMismatch},
+		{"window", modified(func(d []byte) { d[10] = 3 }, true), ErrInvalidTableFormat},
+		{"checksum", modified(func(d []byte) { d[100]++ }, false), ErrTableChecksum},
+		{"not on curve", modified(func(d []byte) { d[precomputedHeaderSize+precomputedEntrySize]++ }, true), ErrInvalidTableEntry},
+		{"not reduced", modified(func(d []byte) {
+			copy(d[precomputedHeaderSize+precomputedEntrySize:], bytes.Repeat([]byte{0xFF}, 32))
+		}, true), ErrInvalidTableEntry},
+		{"swapped entries", modified(func(d []byte) {
+			e1 := d[precomputedHeaderSize+precomputedEntrySize:]
+			e2 := d[precomputedHeaderSize+2*precomputedEntrySize:]
+			var tmp [precomputedEntrySize]byte
+			copy(tmp[:], e1)
+			copy(e1, e2[:precomputedEntrySize])
+			copy(e2, tmp[:])
+		}, true), ErrInvalidTableEntry},
+	}
+	//with window 2 every row has 3 entries (0, 1 and 2 multiples) and top is after 128 rows
+	copyEntry := func(d []byte, dst, src int) {
+		copy(d[precomputedHeaderSize+dst*precomputedEntrySize:], d[precomputedHeaderSize+src*precomputedEntrySize:][:precomputedEntrySize])
+	}
+	tests = append(tests, []struct {
+		name string
+		data []byte
+		err  error
+	}{
+		{"wrong multiple", modified(func(d []byte) { copyEntry(d, 4, 2) }, true), ErrInvalidTableEntry},
+		{"wrong top", modified(func(d []byte) { copyEntry(d, 3*128, 1) }, true), ErrInvalidTableEntry},
+		{"wrong odd multiple", modified(func(d []byte) { copyEntry(d, 3*128+2, 2) }, true), ErrInvalidTableEntry},
+	}...)
+	for _, test := range tests {
+		var t1, t2 PrecomputedPoint
+		if err := t1.UnmarshalBinary(test.data); !errors.Is(err, test.err) {
+			t.Errorf("%s: expected %v, got %v", test.name, test.err, err)
+		}
+		if err := t2.UnmarshalBinaryShared(test.data); !errors.Is(err, test.err) {
+			t.Errorf("%s: expected %v, got %v", test.name, test.err, err)
+		}
+		if t1.Window() != 0 || t2.Window() != 0 {
+			t.Errorf("%s: table modified", test.name)
+		}
+	}
+
+	var t1 PrecomputedPoint
+	if _, err := t1.ReadFrom(bytes.NewReader(data[:len(data)/2])); err != io.ErrUnexpectedEOF {
+		t.Errorf("expected %v, got %v", io.ErrUnexpectedEOF, err)
+	}
+	if _, err := t1.MarshalBinary(); err != ErrTableNotInitialized {
+		t.Errorf("expected %v, got %v", ErrTableNotInitialized, err)
+	}
+}
+
+func TestPrecomputedPointUnmarshalShared(t *testing.T) {
+	sp, table := testPrecomputedPoint(4)
+	data, _ := table.MarshalBinary()
+	//make returns 8-byte aligned memory, buffer shifted by one byte has to be copied
+	aligned := make([]byte, len(data))
+	copy(aligned, data)
+	unaligned := make([]byte, len(data)+1)[1:]
+	copy(unaligned, data)
+
+	b := FieldElement{0x0123456789ABCDEF, 0xFEDCBA9876543210, 0x1111, 0x0222}
+	var expected Point
+	expected.ScalarMult(sp, &b).ToAffine(&expected)
+	for _, d := range [][]byte{aligned, unaligned} {
+		var t1 PrecomputedPoint
+		if err := t1.UnmarshalBinaryShared(d); err != nil {
+			t.Fatal(err)
+		}
+		shared := unsafe.Pointer(&t1.comb[0]) == unsafe.Pointer(&d[precomputedHeaderSize])
+		littleEndian := *(*byte)(unsafe.Pointer(&[]uint16{1}[0])) == 1
+		if shared != (littleEndian && &d[0] == &aligned[0]) {
+			t.Errorf("table should share memory only with aligned data")
+		}
+		var p Point
+		p.ScalarMultPrecomputed(&t1, &b).ToAffine(&p)
+		if !p.Equals(&expected) {
+			t.Errorf("\n%x\n%x", &p, &expected)
+		}
+	}
+}
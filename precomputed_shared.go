@@ -0,0 +1,15 @@
+package curve1174
+
+import "unsafe"
+
+//sharedEntries returns n entries serialized by appendBinary in data as slice that shares memory with data or nil if
+//memory layout of AffineCachedPoint is different (big endian platform or data not aligned)
+func sharedEntries(data []byte, n int) []AffineCachedPoint {
+	one := uint16(1)
+	littleEndian := *(*byte)(unsafe.Pointer(&one)) == 1
+	if !littleEndian || unsafe.Sizeof(AffineCachedPoint{}) != precomputedEntrySize ||
+		uintptr(unsafe.Pointer(&data[0]))%unsafe.Alignof(FieldElement{}) != 0 {
+		return nil
+	}
+	return unsafe.Slice((*AffineCachedPoint)(unsafe.Pointer(&data[0])), n)
+}
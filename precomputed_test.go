@@ -0,0 +1,95 @@
+package curve1174
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+	"time"
+)
+
+func TestWNAF(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	for w := uint(2); w <= 9; w++ {
+		for i := 0; i < 1000; i++ {
+			b := FieldElement{r.Uint64(), r.Uint64(), r.Uint64(), r.Uint64()}
+			var naf [257]int32
+			wnaf(&naf, &b, w)
+			res := new(big.Int)
+			last := -int(w)
+			for j := len(naf) - 1; j >= 0; j-- {
+				res.Lsh(res, 1).Add(res, big.NewInt(int64(naf[j])))
+				if naf[j] == 0 {
+					continue
+				}
+				if naf[j]%2 == 0 || naf[j] >= 1<<(w-1) || naf[j] <= -(1<<(w-1)) {
+					t.Fatalf("invalid digit %d", naf[j])
+				}
+				if last-j < int(w) && last >= 0 {
+					t.Fatalf("non-zero digits too close %d %d", last, j)
+				}
+				last = j
+			}
+			if res.Cmp(b.ToBigInt()) != 0 {
+				t.Errorf("\n%x\n%x", &b, res)
+			}
+		}
+	}
+}
+
+func TestScalarMultPrecomputed(t *testing.T) {
+	var sp Point
+	sp.ScalarBaseMult(&FieldElement{0xDEADBEEF, 0xCAFEBABE, 1, 2})
+	for w := 1; w <= 8; w++ {
+		var table PrecomputedPoint
+		table.Set(&sp, w)
+		if table.Window() != w {
+			t.Errorf("invalid window %d", table.Window())
+		}
+		for _, vartime := range []bool{false, true} {
+			randomTestOp(t, func(res *FieldElement, x *FieldElement, y *FieldElement) {
+				var p Point
+				if vartime {
+					p.VarTimeScalarMultPrecomputed(&table, x)
+				} else {
+					p.ScalarMultPrecomputed(&table, x)
+				}
+				p.ToAffine(&p)
+				res.Set(&p.X)
+			}, func(res *big.Int, x *big.Int, y *big.Int) {
+				var p Point
+				p.ScalarMult(&sp, FromBigInt(x)).ToAffine(&p)
+				res.Set(p.X.ToBigInt())
+			}, 200)
+		}
+	}
+}
+
+func TestScalarMultPrecomputedSpecific(t *testing.T) {
+	var table PrecomputedPoint
+	table.Set(Base, 5)
+	vectors := []FieldElement{{}, {1}, {2}, {15}, {16}, {17}, {0, 0, 0, 1 << 63}, {^uint64(0), ^uint64(0), ^uint64(0), ^uint64(0)}}
+	for _, b := range vectors {
+		var p1, p2, p3 Point
+		p1.ScalarMultPrecomputed(&table, &b).ToAffine(&p1)
+		p2.VarTimeScalarMultPrecomputed(&table, &b).ToAffine(&p2)
+		p3.ScalarMult(Base, &b).ToAffine(&p3)
+		if !p1.Equals(&p3) || !p2.Equals(&p3) || !p1.T.Equals(&p3.T) || !p2.T.Equals(&p3.T) {
+			t.Errorf("%x\n%x\n%x\n%x", &b, &p1, &p2, &p3)
+		}
+	}
+}
+
+func TestBatchToAffine(t *testing.T) {
+	points := make([]Point, 10)
+	expected := make([]Point, 10)
+	for i := range points {
+		points[i].ScalarMult(Base, &FieldElement{uint64(i * 1000)})
+		expected[i].ToAffine(&points[i])
+	}
+	batchToAffine(points)
+	for i := range points {
+		if !points[i].Equals(&expected[i]) || !points[i].T.Equals(&expected[i].T) {
+			t.Errorf("%x\n%x", &points[i], &expected[i])
+		}
+	}
+}
@@ -1,4 +1,4 @@
-//go:build !curve1174_purego || !amd64
+//go:build amd64 && !curve1174_purego && !curve1174_radix51
 
 package curve1174
 
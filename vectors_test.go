@@ -0,0 +1,151 @@
+package curve1174
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+//vectorFile is content of JSON file in testdata/vectors generated by gen/vectors
+type vectorFile struct {
+	NumberOfTests int `json:"numberOfTests"`
+	TestGroups    []struct {
+		Type  string `json:"type"`
+		Tests []struct {
+			TcID    int      `json:"tcId"`
+			Comment string   `json:"comment"`
+			Flags   []string `json:"flags"`
+			A       string   `json:"a"`
+			B       string   `json:"b"`
+			Out     string   `json:"out"`
+			Encoded string   `json:"encoded"`
+			X       string   `json:"x"`
+			Y       string   `json:"y"`
+			Private string   `json:"private"`
+			Public  string   `json:"public"`
+			Shared  string   `json:"shared"`
+			Result  string   `json:"result"`
+		} `json:"tests"`
+	} `json:"testGroups"`
+}
+
+func decodeHex(t *testing.T, s string) []byte {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+//fieldFromHex decodes hex encoded 32 byte little endian number, it doesn't have to be reduced
+func fieldFromHex(t *testing.T, s string) FieldElement {
+	b := decodeHex(t, s)
+	if len(b) != 32 {
+		t.Fatalf("invalid field element %s", s)
+	}
+	var f FieldElement
+	for i := range f {
+		f[i] = binary.LittleEndian.Uint64(b[i*8:])
+	}
+	return f
+}
+
+var vectorFieldOps = map[string]func(out, a, b *FieldElement){
+	"FieldAdd":     func(out, a, b *FieldElement) { out.Add(a, b) },
+	"FieldSub":     func(out, a, b *FieldElement) { out.Sub(a, b) },
+	"FieldMul":     func(out, a, b *FieldElement) { out.Mul(a, b) },
+	"FieldSqr":     func(out, a, b *FieldElement) { out.Sqr(a) },
+	"FieldMulD":    func(out, a, b *FieldElement) { out.MulD(a) },
+	"FieldMul2":    func(out, a, b *FieldElement) { out.Mul2(a) },
+	"FieldInverse": func(out, a, b *FieldElement) { out.Inverse(a) },
+	"FieldMod":     func(out, a, b *FieldElement) { out.Mod(a) },
+}
+
+//TestVectors runs test vectors from testdata/vectors, valid and acceptable ones have to give expected result and
+//invalid encodings have to be rejected
+func TestVectors(t *testing.T) {
+	files, err := filepath.Glob("testdata/vectors/*.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) == 0 {
+		t.Fatal("no test vectors")
+	}
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var vectors vectorFile
+		if err = json.Unmarshal(data, &vectors); err != nil {
+			t.Fatalf("%s: %v", file, err)
+		}
+		n := 0
+		for _, group := range vectors.TestGroups {
+			for _, tc := range group.Tests {
+				n++
+				fail := func(format string, args ...interface{}) {
+					t.Helper()
+					t.Errorf("%s, test %d (%s, %s, %v): "+format,
+						append([]interface{}{file, tc.TcID, tc.Comment, tc.Result, tc.Flags}, args...)...)
+				}
+				switch group.Type {
+				case "PointDecode":
+					var p Point
+					_, err := p.SetBytes(decodeHex(t, tc.Encoded))
+					if (err != nil) != (tc.Result == "invalid") {
+						fail("SetBytes error %v", err)
+						continue
+					}
+					if err != nil {
+						continue
+					}
+					x, y := fieldFromHex(t, tc.X), fieldFromHex(t, tc.Y)
+					p.ToAffine(&p)
+					if !p.X.Equals(&x) || !p.Y.Equals(&y) || !p.IsOnCurve() {
+						fail("decoded %x", &p)
+					}
+					if enc := hex.EncodeToString(p.Bytes()); enc != tc.Encoded {
+						fail("encoded again as %s", enc)
+					}
+				case "EcdhComp":
+					var pub Point
+					_, err := pub.SetBytes(decodeHex(t, tc.Public))
+					if (err != nil) != (tc.Result == "invalid") {
+						fail("SetBytes error %v", err)
+						continue
+					}
+					if err != nil {
+						continue
+					}
+					k := fieldFromHex(t, tc.Private)
+					var shared Point
+					if res := shared.ScalarMult(&pub, &k).Bytes(); !bytes.Equal(res, decodeHex(t, tc.Shared)) {
+						fail("shared secret %x", res)
+					}
+				default:
+					op, ok := vectorFieldOps[group.Type]
+					if !ok {
+						t.Fatalf("%s: unknown test group type %s", file, group.Type)
+					}
+					a, b, out := fieldFromHex(t, tc.A), FieldElement{}, fieldFromHex(t, tc.Out)
+					if tc.B != "" {
+						b = fieldFromHex(t, tc.B)
+					}
+					var res FieldElement
+					op(&res, &a, &b)
+					if *res.Mod(&res) != out {
+						fail("got %x", &res)
+					}
+				}
+			}
+		}
+		if n != vectors.NumberOfTests {
+			t.Errorf("%s: %d tests, expected %d", file, n, vectors.NumberOfTests)
+		}
+	}
+}
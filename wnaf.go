@@ -0,0 +1,59 @@
+package curve1174
+
+import "math/bits"
+
+//scalarWindow returns w bits of b starting from bit pos (bits above 2^256 are 0)
+func scalarWindow(b *FieldElement, pos, w int) uint64 {
+	limb, offset := pos/64, pos%64
+	if limb >= len(b) {
+		return 0
+	}
+	v := b[limb] >> offset
+	if offset+w > 64 && limb+1 < len(b) {
+		v |= b[limb+1] << (64 - offset)
+	}
+	return v & (1<<w - 1)
+}
+
+//signedWindows recodes b to len(digits) base 2^w digits from [-2^(w-1), 2^(w-1))
+//(b == sum(digits[i]*2^(w*i)) + carry*2^(w*len(digits))). Execution time doesn't depend on b.
+func signedWindows(b *FieldElement, w int, digits []int64) (carry uint64) {
+	var c int64
+	for i := range digits {
+		d := int64(scalarWindow(b, i*w, w)) + c
+		c = (d + 1<<(w-1)) >> w
+		digits[i] = d - c<<w
+	}
+	return uint64(c)
+}
+
+//wnaf recodes b to width w non-adjacent form (b == sum(naf[i]*2^i)), every non-zero digit is odd, |naf[i]| < 2^(w-1)
+//and there is at most one non-zero digit in any w consecutive digits. Execution time depends on b!
+func wnaf(naf *[257]int32, b *FieldElement, w uint) {
+	k := [5]uint64{b[0], b[1], b[2], b[3], 0}
+	mask := uint64(1)<<w - 1
+	for i := range naf {
+		naf[i] = 0
+	}
+	for i := 0; k[0]|k[1]|k[2]|k[3]|k[4] != 0; i++ {
+		if k[0]&1 == 1 {
+			d := int32(k[0] & mask)
+			if d >= 1<<(w-1) {
+				d -= 1 << w
+			}
+			naf[i] = d
+			//k -= d (d sign extended to 320 bits), k becomes divisible by 2^w
+			ext := uint64(int64(d) >> 63)
+			var borrow uint64
+			k[0], borrow = bits.Sub64(k[0], uint64(int64(d)), 0)
+			for j := 1; j < 5; j++ {
+				k[j], borrow = bits.Sub64(k[j], ext, borrow)
+			}
+		}
+		k[0] = k[0]>>1 | k[1]<<63
+		k[1] = k[1]>>1 | k[2]<<63
+		k[2] = k[2]>>1 | k[3]<<63
+		k[3] = k[3]>>1 | k[4]<<63
+		k[4] >>= 1
+	}
+}